@@ -69,6 +69,13 @@ type ACL struct {
 	operator string
 	quota    string
 	plugin   string
+
+	// agentCapabilities, nodeCapabilities and operatorCapabilities are the
+	// fine-grained capabilities granted for the agent, node and operator
+	// APIs, which are all denied by a deny policy.
+	agentCapabilities    capabilitySet
+	nodeCapabilities     capabilitySet
+	operatorCapabilities capabilitySet
 }
 
 // maxPrivilege returns the policy which grants the most privilege
@@ -96,7 +103,11 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 	}
 
 	// Create the ACL object
-	acl := &ACL{}
+	acl := &ACL{
+		agentCapabilities:    make(capabilitySet),
+		nodeCapabilities:     make(capabilitySet),
+		operatorCapabilities: make(capabilitySet),
+	}
 	nsTxn := iradix.New().Txn()
 	wnsTxn := iradix.New().Txn()
 	hvTxn := iradix.New().Txn()
@@ -221,12 +232,30 @@ func NewACL(management bool, policies []*Policy) (*ACL, error) {
 		// Take the maximum privilege for agent, node, and operator
 		if policy.Agent != nil {
 			acl.agent = maxPrivilege(acl.agent, policy.Agent.Policy)
+			for _, cap := range expandAgentPolicy(policy.Agent.Policy) {
+				acl.agentCapabilities.Set(cap)
+			}
+			for _, cap := range policy.Agent.Capabilities {
+				acl.agentCapabilities.Set(cap)
+			}
 		}
 		if policy.Node != nil {
 			acl.node = maxPrivilege(acl.node, policy.Node.Policy)
+			for _, cap := range expandNodePolicy(policy.Node.Policy) {
+				acl.nodeCapabilities.Set(cap)
+			}
+			for _, cap := range policy.Node.Capabilities {
+				acl.nodeCapabilities.Set(cap)
+			}
 		}
 		if policy.Operator != nil {
 			acl.operator = maxPrivilege(acl.operator, policy.Operator.Policy)
+			for _, cap := range expandOperatorPolicy(policy.Operator.Policy) {
+				acl.operatorCapabilities.Set(cap)
+			}
+			for _, cap := range policy.Operator.Capabilities {
+				acl.operatorCapabilities.Set(cap)
+			}
 		}
 		if policy.Quota != nil {
 			acl.quota = maxPrivilege(acl.quota, policy.Quota.Policy)
@@ -558,6 +587,19 @@ func (a *ACL) AllowAgentWrite() bool {
 	}
 }
 
+// AllowAgentOperation checks if the fine-grained agent capability is
+// allowed. A deny agent policy denies all capabilities.
+func (a *ACL) AllowAgentOperation(op string) bool {
+	switch {
+	case a.management:
+		return true
+	case a.agent == PolicyDeny:
+		return false
+	default:
+		return a.agentCapabilities.Check(op)
+	}
+}
+
 // AllowNodeRead checks if read operations are allowed for a node
 func (a *ACL) AllowNodeRead() bool {
 	switch {
@@ -584,6 +626,19 @@ func (a *ACL) AllowNodeWrite() bool {
 	}
 }
 
+// AllowNodeOperation checks if the fine-grained node capability is allowed.
+// A deny node policy denies all capabilities.
+func (a *ACL) AllowNodeOperation(op string) bool {
+	switch {
+	case a.management:
+		return true
+	case a.node == PolicyDeny:
+		return false
+	default:
+		return a.nodeCapabilities.Check(op)
+	}
+}
+
 // AllowOperatorRead checks if read operations are allowed for a operator
 func (a *ACL) AllowOperatorRead() bool {
 	switch {
@@ -610,6 +665,19 @@ func (a *ACL) AllowOperatorWrite() bool {
 	}
 }
 
+// AllowOperatorOperation checks if the fine-grained operator capability is
+// allowed. A deny operator policy denies all capabilities.
+func (a *ACL) AllowOperatorOperation(op string) bool {
+	switch {
+	case a.management:
+		return true
+	case a.operator == PolicyDeny:
+		return false
+	default:
+		return a.operatorCapabilities.Check(op)
+	}
+}
+
 // AllowQuotaRead checks if read operations are allowed for all quotas
 func (a *ACL) AllowQuotaRead() bool {
 	switch {
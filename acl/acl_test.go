@@ -231,6 +231,97 @@ quota {
 }
 `
 
+func TestACL_AllowCapabilityOperations(t *testing.T) {
+	ci.Parallel(t)
+
+	// Capabilities are granted individually, and write policies grant the
+	// ones they cover.
+	onCall, err := Parse(`
+node {
+	policy = "read"
+	capabilities = ["drain-node"]
+}
+operator {
+	capabilities = ["snapshot-save"]
+}
+`)
+	require.NoError(t, err)
+	agentWrite, err := Parse(`
+agent {
+	policy = "write"
+}
+`)
+	require.NoError(t, err)
+
+	acl, err := NewACL(false, []*Policy{onCall, agentWrite})
+	require.NoError(t, err)
+
+	require.True(t, acl.AllowNodeOperation(NodeCapabilityDrainNode))
+	require.False(t, acl.AllowNodeOperation(NodeCapabilityToggleEligibility))
+	require.True(t, acl.AllowNodeRead())
+	require.False(t, acl.AllowNodeWrite())
+
+	require.True(t, acl.AllowOperatorOperation(OperatorCapabilitySnapshotSave))
+	require.False(t, acl.AllowOperatorOperation(OperatorCapabilitySnapshotRestore))
+	require.False(t, acl.AllowOperatorRead())
+
+	require.True(t, acl.AllowAgentOperation(AgentCapabilityGossipKeyring))
+	require.True(t, acl.AllowAgentWrite())
+
+	// A deny policy takes precedence over the capabilities of other
+	// policies.
+	operatorDeny, err := Parse(`
+operator {
+	policy = "deny"
+}
+`)
+	require.NoError(t, err)
+
+	acl, err = NewACL(false, []*Policy{onCall, operatorDeny})
+	require.NoError(t, err)
+	require.False(t, acl.AllowOperatorOperation(OperatorCapabilitySnapshotSave))
+	require.True(t, acl.AllowNodeOperation(NodeCapabilityDrainNode))
+
+	// Management tokens are allowed all capabilities.
+	require.True(t, ManagementACL.AllowOperatorOperation(OperatorCapabilityRootKeyring))
+	require.True(t, ManagementACL.AllowNodeOperation(NodeCapabilityToggleEligibility))
+	require.True(t, ManagementACL.AllowAgentOperation(AgentCapabilityGossipKeyring))
+}
+
+func TestACL_AllowCapabilityOperations_WritePolicy(t *testing.T) {
+	ci.Parallel(t)
+
+	p, err := Parse(`
+node {
+	policy = "write"
+}
+agent {
+	policy = "write"
+}
+operator {
+	policy = "write"
+}
+`)
+	require.NoError(t, err)
+
+	acl, err := NewACL(false, []*Policy{p})
+	require.NoError(t, err)
+
+	// The write policy grants the capabilities it covered before they
+	// existed
+	require.True(t, acl.AllowNodeOperation(NodeCapabilityDrainNode))
+	require.True(t, acl.AllowNodeOperation(NodeCapabilityToggleEligibility))
+	require.True(t, acl.AllowAgentOperation(AgentCapabilityGossipKeyring))
+	require.True(t, acl.AllowOperatorOperation(OperatorCapabilitySchedulerConfig))
+
+	// The operator capabilities which required a management token must be
+	// granted explicitly
+	require.False(t, acl.AllowOperatorOperation(OperatorCapabilitySnapshotSave))
+	require.False(t, acl.AllowOperatorOperation(OperatorCapabilitySnapshotRestore))
+	require.False(t, acl.AllowOperatorOperation(OperatorCapabilityRaftPeerRemove))
+	require.False(t, acl.AllowOperatorOperation(OperatorCapabilityRootKeyring))
+}
+
 func TestAllowNamespace(t *testing.T) {
 	ci.Parallel(t)
 
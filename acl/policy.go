@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/hcl"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -78,6 +79,25 @@ const (
 	VariablesCapabilityDeny    = "deny"
 )
 
+const (
+	// The following are the fine-grained capabilities that can be granted
+	// for the node, agent and operator APIs. The write policy is a short
+	// hand for granting the capabilities it already covered, while the
+	// others must be granted explicitly. A deny policy takes precedence and
+	// denies all of them.
+
+	NodeCapabilityDrainNode         = "drain-node"
+	NodeCapabilityToggleEligibility = "toggle-eligibility"
+
+	AgentCapabilityGossipKeyring = "gossip-keyring"
+
+	OperatorCapabilitySnapshotSave    = "snapshot-save"
+	OperatorCapabilitySnapshotRestore = "snapshot-restore"
+	OperatorCapabilityRaftPeerRemove  = "raft-peer-remove"
+	OperatorCapabilitySchedulerConfig = "scheduler-config"
+	OperatorCapabilityRootKeyring     = "root-keyring"
+)
+
 // Policy represents a parsed HCL or JSON policy.
 type Policy struct {
 	Namespaces  []*NamespacePolicy  `hcl:"namespace,expand"`
@@ -127,15 +147,18 @@ type HostVolumePolicy struct {
 }
 
 type AgentPolicy struct {
-	Policy string
+	Policy       string
+	Capabilities []string
 }
 
 type NodePolicy struct {
-	Policy string
+	Policy       string
+	Capabilities []string
 }
 
 type OperatorPolicy struct {
-	Policy string
+	Policy       string
+	Capabilities []string
 }
 
 type QuotaPolicy struct {
@@ -244,6 +267,72 @@ func expandNamespacePolicy(policy string) []string {
 	}
 }
 
+// nodeCapabilities, agentCapabilities and operatorCapabilities are the
+// capabilities supported by the node, agent and operator rules respectively.
+var (
+	nodeCapabilities = []string{
+		NodeCapabilityDrainNode,
+		NodeCapabilityToggleEligibility,
+	}
+	agentCapabilities = []string{
+		AgentCapabilityGossipKeyring,
+	}
+	operatorCapabilities = []string{
+		OperatorCapabilitySnapshotSave,
+		OperatorCapabilitySnapshotRestore,
+		OperatorCapabilityRaftPeerRemove,
+		OperatorCapabilitySchedulerConfig,
+		OperatorCapabilityRootKeyring,
+	}
+)
+
+// isCapabilityPolicyValid validates the policy and capabilities of a node,
+// agent or operator rule, where valid lists the capabilities the rule
+// supports.
+func isCapabilityPolicyValid(policy string, caps, valid []string) bool {
+	if policy == "" && len(caps) == 0 {
+		return false
+	}
+	if policy != "" && !isPolicyValid(policy) {
+		return false
+	}
+	for _, cap := range caps {
+		if !slices.Contains(valid, cap) {
+			return false
+		}
+	}
+	return true
+}
+
+// expandNodePolicy provides the node capabilities granted by a policy.
+func expandNodePolicy(policy string) []string {
+	if policy == PolicyWrite {
+		return nodeCapabilities
+	}
+	return nil
+}
+
+// expandAgentPolicy provides the agent capabilities granted by a policy.
+func expandAgentPolicy(policy string) []string {
+	if policy == PolicyWrite {
+		return agentCapabilities
+	}
+	return nil
+}
+
+// expandOperatorPolicy provides the operator capabilities granted by a
+// policy. The write policy only grants updating the scheduler configuration,
+// which it allowed before capabilities existed. Saving and restoring
+// snapshots, removing Raft peers and managing the root keyring expose the
+// secrets and membership of the cluster, so they are only granted
+// explicitly.
+func expandOperatorPolicy(policy string) []string {
+	if policy == PolicyWrite {
+		return []string{OperatorCapabilitySchedulerConfig}
+	}
+	return nil
+}
+
 func isHostVolumeCapabilityValid(cap string) bool {
 	switch cap {
 	case HostVolumeCapabilityDeny, HostVolumeCapabilityMountReadOnly, HostVolumeCapabilityMountReadWrite:
@@ -370,15 +459,15 @@ func Parse(rules string) (*Policy, error) {
 		}
 	}
 
-	if p.Agent != nil && !isPolicyValid(p.Agent.Policy) {
+	if p.Agent != nil && !isCapabilityPolicyValid(p.Agent.Policy, p.Agent.Capabilities, agentCapabilities) {
 		return nil, fmt.Errorf("Invalid agent policy: %#v", p.Agent)
 	}
 
-	if p.Node != nil && !isPolicyValid(p.Node.Policy) {
+	if p.Node != nil && !isCapabilityPolicyValid(p.Node.Policy, p.Node.Capabilities, nodeCapabilities) {
 		return nil, fmt.Errorf("Invalid node policy: %#v", p.Node)
 	}
 
-	if p.Operator != nil && !isPolicyValid(p.Operator.Policy) {
+	if p.Operator != nil && !isCapabilityPolicyValid(p.Operator.Policy, p.Operator.Capabilities, operatorCapabilities) {
 		return nil, fmt.Errorf("Invalid operator policy: %#v", p.Operator)
 	}
 
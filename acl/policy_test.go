@@ -245,6 +245,64 @@ func TestParse(t *testing.T) {
 			"Invalid operator policy",
 			nil,
 		},
+		{
+			`
+			node {
+				capabilities = ["drain-node", "snapshot-save"]
+			}
+			`,
+			"Invalid node policy",
+			nil,
+		},
+		{
+			`
+			operator {}
+			`,
+			"Invalid operator policy",
+			nil,
+		},
+		{
+			`
+			agent {
+				policy = "read"
+				capabilities = ["gossip-keyring"]
+			}
+			node {
+				policy = "read"
+				capabilities = ["drain-node"]
+			}
+			operator {
+				capabilities = ["snapshot-save"]
+			}
+			`,
+			"",
+			&Policy{
+				Agent: &AgentPolicy{
+					Policy:       PolicyRead,
+					Capabilities: []string{AgentCapabilityGossipKeyring},
+				},
+				Node: &NodePolicy{
+					Policy:       PolicyRead,
+					Capabilities: []string{NodeCapabilityDrainNode},
+				},
+				Operator: &OperatorPolicy{
+					Capabilities: []string{OperatorCapabilitySnapshotSave},
+				},
+			},
+		},
+		{
+			`
+			operator {
+				policy = "write"
+			}
+			`,
+			"",
+			&Policy{
+				Operator: &OperatorPolicy{
+					Policy: PolicyWrite,
+				},
+			},
+		},
 		{
 			`
 			quota {
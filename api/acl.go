@@ -206,6 +206,14 @@ var (
 	// errMissingACLRoleID is the generic errors to use when a call is missing
 	// the required ACL Role ID parameter.
 	errMissingACLRoleID = errors.New("missing ACL role ID")
+
+	// errMissingACLAuthMethodName is the generic error to use when a call is
+	// missing the required ACL auth method name parameter.
+	errMissingACLAuthMethodName = errors.New("missing ACL auth method name")
+
+	// errMissingACLBindingRuleID is the generic error to use when a call is
+	// missing the required ACL binding rule ID parameter.
+	errMissingACLBindingRuleID = errors.New("missing ACL binding rule ID")
 )
 
 // ACLRoles is used to query the ACL Role endpoints.
@@ -292,6 +300,206 @@ func (a *ACLRoles) GetByName(roleName string, q *QueryOptions) (*ACLRole, *Query
 	return &resp, qm, nil
 }
 
+// ACLAuthMethods is used to query the ACL auth method endpoints.
+type ACLAuthMethods struct {
+	client *Client
+}
+
+// ACLAuthMethods returns a new handle on the ACL auth methods API client.
+func (c *Client) ACLAuthMethods() *ACLAuthMethods {
+	return &ACLAuthMethods{client: c}
+}
+
+// List is used to detail all the ACL auth methods currently stored within
+// state.
+func (a *ACLAuthMethods) List(q *QueryOptions) ([]*ACLAuthMethodListStub, *QueryMeta, error) {
+	var resp []*ACLAuthMethodListStub
+	qm, err := a.client.query("/v1/acl/auth-methods", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// Create is used to create an ACL auth method.
+func (a *ACLAuthMethods) Create(authMethod *ACLAuthMethod, w *WriteOptions) (*ACLAuthMethod, *WriteMeta, error) {
+	if authMethod.Name == "" {
+		return nil, nil, errMissingACLAuthMethodName
+	}
+	var resp ACLAuthMethod
+	wm, err := a.client.write("/v1/acl/auth-method", authMethod, &resp, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Update is used to update an existing ACL auth method.
+func (a *ACLAuthMethods) Update(authMethod *ACLAuthMethod, w *WriteOptions) (*ACLAuthMethod, *WriteMeta, error) {
+	if authMethod.Name == "" {
+		return nil, nil, errMissingACLAuthMethodName
+	}
+	var resp ACLAuthMethod
+	wm, err := a.client.write("/v1/acl/auth-method/"+authMethod.Name, authMethod, &resp, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Delete is used to delete an ACL auth method and the binding rules linked
+// to it.
+func (a *ACLAuthMethods) Delete(authMethodName string, w *WriteOptions) (*WriteMeta, error) {
+	if authMethodName == "" {
+		return nil, errMissingACLAuthMethodName
+	}
+	wm, err := a.client.delete("/v1/acl/auth-method/"+authMethodName, nil, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Get is used to look up an ACL auth method.
+func (a *ACLAuthMethods) Get(authMethodName string, q *QueryOptions) (*ACLAuthMethod, *QueryMeta, error) {
+	if authMethodName == "" {
+		return nil, nil, errMissingACLAuthMethodName
+	}
+	var resp ACLAuthMethod
+	qm, err := a.client.query("/v1/acl/auth-method/"+authMethodName, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// ACLBindingRules is used to query the ACL binding rule endpoints.
+type ACLBindingRules struct {
+	client *Client
+}
+
+// ACLBindingRules returns a new handle on the ACL binding rules API client.
+func (c *Client) ACLBindingRules() *ACLBindingRules {
+	return &ACLBindingRules{client: c}
+}
+
+// List is used to detail all the ACL binding rules currently stored within
+// state.
+func (a *ACLBindingRules) List(q *QueryOptions) ([]*ACLBindingRuleListStub, *QueryMeta, error) {
+	var resp []*ACLBindingRuleListStub
+	qm, err := a.client.query("/v1/acl/binding-rules", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, qm, nil
+}
+
+// Create is used to create an ACL binding rule.
+func (a *ACLBindingRules) Create(bindingRule *ACLBindingRule, w *WriteOptions) (*ACLBindingRule, *WriteMeta, error) {
+	if bindingRule.ID != "" {
+		return nil, nil, errors.New("cannot specify ACL binding rule ID")
+	}
+	var resp ACLBindingRule
+	wm, err := a.client.write("/v1/acl/binding-rule", bindingRule, &resp, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Update is used to update an existing ACL binding rule.
+func (a *ACLBindingRules) Update(bindingRule *ACLBindingRule, w *WriteOptions) (*ACLBindingRule, *WriteMeta, error) {
+	if bindingRule.ID == "" {
+		return nil, nil, errMissingACLBindingRuleID
+	}
+	var resp ACLBindingRule
+	wm, err := a.client.write("/v1/acl/binding-rule/"+bindingRule.ID, bindingRule, &resp, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// Delete is used to delete an ACL binding rule.
+func (a *ACLBindingRules) Delete(bindingRuleID string, w *WriteOptions) (*WriteMeta, error) {
+	if bindingRuleID == "" {
+		return nil, errMissingACLBindingRuleID
+	}
+	wm, err := a.client.delete("/v1/acl/binding-rule/"+bindingRuleID, nil, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Get is used to look up an ACL binding rule.
+func (a *ACLBindingRules) Get(bindingRuleID string, q *QueryOptions) (*ACLBindingRule, *QueryMeta, error) {
+	if bindingRuleID == "" {
+		return nil, nil, errMissingACLBindingRuleID
+	}
+	var resp ACLBindingRule
+	qm, err := a.client.query("/v1/acl/binding-rule/"+bindingRuleID, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// ACLOIDC is used to query the ACL OIDC login endpoints.
+type ACLOIDC struct {
+	client *Client
+}
+
+// ACLOIDC returns a new handle on the ACL OIDC login API client.
+func (c *Client) ACLOIDC() *ACLOIDC {
+	return &ACLOIDC{client: c}
+}
+
+// GetAuthURL generates the URL the user should visit in order to
+// authenticate with the OIDC provider of the auth method.
+func (a *ACLOIDC) GetAuthURL(req *ACLOIDCAuthURLRequest, q *WriteOptions) (*ACLOIDCAuthURLResponse, *WriteMeta, error) {
+	var resp ACLOIDCAuthURLResponse
+	wm, err := a.client.write("/v1/acl/oidc/auth-url", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// CompleteAuth exchanges the OIDC provider token for a Nomad ACL token,
+// whose roles and policies are given by the binding rules of the auth
+// method.
+func (a *ACLOIDC) CompleteAuth(req *ACLOIDCCompleteAuthRequest, q *WriteOptions) (*ACLToken, *WriteMeta, error) {
+	var resp ACLToken
+	wm, err := a.client.write("/v1/acl/oidc/complete-auth", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
+// ACLAuth is used to query the ACL login endpoint.
+type ACLAuth struct {
+	client *Client
+}
+
+// ACLAuth returns a new handle on the ACL login API client.
+func (c *Client) ACLAuth() *ACLAuth {
+	return &ACLAuth{client: c}
+}
+
+// Login exchanges a JWT issued by a third party, such as a CI system, for a
+// Nomad ACL token using a JWT auth method. The roles and policies of the
+// token are given by the binding rules of the auth method.
+func (a *ACLAuth) Login(req *ACLLoginRequest, q *WriteOptions) (*ACLToken, *WriteMeta, error) {
+	var resp ACLToken
+	wm, err := a.client.write("/v1/acl/login", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
 // ACLPolicyListStub is used to for listing ACL policies
 type ACLPolicyListStub struct {
 	Name        string
@@ -475,3 +683,262 @@ type ACLRoleListStub struct {
 	CreateIndex uint64
 	ModifyIndex uint64
 }
+
+const (
+	// ACLAuthMethodTokenLocalityLocal is the ACLAuthMethod.TokenLocality that
+	// will generate ACL tokens which can only be used on the local cluster the
+	// request was made.
+	ACLAuthMethodTokenLocalityLocal = "local"
+
+	// ACLAuthMethodTokenLocalityGlobal is the ACLAuthMethod.TokenLocality that
+	// will generate ACL tokens which can be used on all federated clusters.
+	ACLAuthMethodTokenLocalityGlobal = "global"
+
+	// ACLAuthMethodTypeOIDC the ACLAuthMethod.Type and represents an
+	// auth-method which uses the OIDC protocol.
+	ACLAuthMethodTypeOIDC = "OIDC"
+
+	// ACLAuthMethodTypeJWT is the ACLAuthMethod.Type and represents an
+	// auth-method which validates JWTs issued by a third party, such as a CI
+	// system, against static public keys or a JWKS.
+	ACLAuthMethodTypeJWT = "JWT"
+)
+
+// ACLAuthMethod is used to capture the properties of an authentication method
+// used for single sign-on.
+type ACLAuthMethod struct {
+
+	// Name is the identifier for this auth method and is unique across all
+	// federated clusters. The name can be used to refer to the method when
+	// logging in.
+	Name string
+
+	// Type is the SSO identifier this auth method is. Either OIDC or JWT.
+	Type string
+
+	// TokenLocality defines whether the ACL tokens created by this method are
+	// local to the cluster the login was made, or global to all federated
+	// clusters.
+	TokenLocality string
+
+	// MaxTokenTTL is the maximum life of the ACL tokens generated by the
+	// method.
+	MaxTokenTTL time.Duration
+
+	// Default identifies whether this is the default auth method of its type,
+	// which is used when a login does not name a method.
+	Default bool
+
+	// Config contains the detailed configuration which is specific to the
+	// auth method type.
+	Config *ACLAuthMethodConfig
+
+	CreateTime  time.Time
+	ModifyTime  time.Time
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLAuthMethodConfig is used to store configuration of an auth method.
+type ACLAuthMethodConfig struct {
+
+	// OIDCDiscoveryURL is the OIDC provider discovery URL, from which the
+	// issuer and the endpoints of the provider are read.
+	OIDCDiscoveryURL string
+
+	// OIDCClientID and OIDCClientSecret are the credentials of Nomad with
+	// the OIDC provider.
+	OIDCClientID     string
+	OIDCClientSecret string
+
+	// OIDCScopes are the scopes requested in addition to openid.
+	OIDCScopes []string
+
+	// BoundAudiences are the audiences the ID token or JWT must be issued
+	// for. Defaults to the client ID for OIDC methods. JWTs with an audience
+	// are rejected by JWT methods without bound audiences.
+	BoundAudiences []string
+
+	// JWTValidationPubKeys are the PEM encoded public keys JWTs are
+	// validated with. Only one of JWTValidationPubKeys and JWKSURL may be set
+	// for JWT methods.
+	JWTValidationPubKeys []string
+
+	// JWKSURL is the URL of the JSON Web Key Set JWTs are validated with. A
+	// file:// URL reads the key set from the disk of the servers instead.
+	JWKSURL string
+
+	// JWKSCACert is the PEM encoded CA certificate used to talk to the JWKS
+	// URL. The system roots are used if empty.
+	JWKSCACert string
+
+	// BoundIssuer is the issuer JWTs must be issued by, if set.
+	BoundIssuer string
+
+	// ClockSkewLeeway is the leeway given when validating the times of JWTs.
+	// Defaults to a minute.
+	ClockSkewLeeway time.Duration
+
+	// AllowedRedirectURIs are the redirect URIs logins may use.
+	AllowedRedirectURIs []string
+
+	// DiscoveryCaPem are the PEM encoded CA certificates used to talk to the
+	// OIDC provider. The system roots are used if empty.
+	DiscoveryCaPem []string
+
+	// SigningAlgs are the algorithms the ID token or JWT may be signed
+	// with. Defaults to RS256.
+	SigningAlgs []string
+
+	// ClaimMappings and ListClaimMappings map the claims of the ID token to
+	// the values and lists binding rule selectors and bind names can refer
+	// to.
+	ClaimMappings     map[string]string
+	ListClaimMappings map[string]string
+}
+
+// ACLAuthMethodListStub is the stub object returned when performing a listing
+// of ACL auth methods.
+type ACLAuthMethodListStub struct {
+	Name    string
+	Type    string
+	Default bool
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+const (
+	// ACLBindingRuleBindTypeRole is the ACL binding rule bind type that binds
+	// the role named by ACLBindingRule.BindName to the token.
+	ACLBindingRuleBindTypeRole = "role"
+
+	// ACLBindingRuleBindTypePolicy is the ACL binding rule bind type that
+	// binds the policy named by ACLBindingRule.BindName to the token.
+	ACLBindingRuleBindTypePolicy = "policy"
+
+	// ACLBindingRuleBindTypeManagement is the ACL binding rule bind type that
+	// will generate management ACL tokens when matched.
+	ACLBindingRuleBindTypeManagement = "management"
+)
+
+// ACLBindingRule contains a direct relation to an ACLAuthMethod and
+// represents a rule to apply when logging in via the named AuthMethod. This
+// allows the transformation of OIDC provider claims, to Nomad based ACL
+// concepts such as ACL Roles and Policies.
+type ACLBindingRule struct {
+
+	// ID is an internally generated UUID for this rule and is controlled by
+	// Nomad.
+	ID string
+
+	// Description is a human-readable, operator set description that can
+	// provide additional context about the binding rule. This is an
+	// operational field.
+	Description string
+
+	// AuthMethod is the name of the auth method for which this rule applies
+	// to. This is required and the method must exist within state before the
+	// cluster administrator can create the rule.
+	AuthMethod string
+
+	// Selector is an expression that matches against verified identity
+	// attributes returned from the auth method during login. This is
+	// optional and when not set, provides a catch-all rule.
+	Selector string
+
+	// BindType adjusts how this binding rule is applied at login time. The
+	// valid values are ACLBindingRuleBindTypeRole,
+	// ACLBindingRuleBindTypePolicy and ACLBindingRuleBindTypeManagement.
+	BindType string
+
+	// BindName is the target of the binding. It can use the ${value.NAME}
+	// syntax to refer to the mapped claims of the identity. It is ignored
+	// by management bindings.
+	BindName string
+
+	CreateTime  time.Time
+	ModifyTime  time.Time
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLBindingRuleListStub is the stub object returned when performing a
+// listing of ACL binding rules.
+type ACLBindingRuleListStub struct {
+
+	// ID is an internally generated UUID for this rule and is controlled by
+	// Nomad.
+	ID string
+
+	// Description is a human-readable, operator set description that can
+	// provide additional context about the binding rule. This is an
+	// operational field.
+	Description string
+
+	// AuthMethod is the name of the auth method for which this rule applies
+	// to.
+	AuthMethod string
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLOIDCAuthURLRequest is the request to make when starting the OIDC
+// authentication login flow.
+type ACLOIDCAuthURLRequest struct {
+
+	// AuthMethodName is the OIDC auth-method to use. This is a required
+	// parameter.
+	AuthMethodName string
+
+	// RedirectURI is the URL that authorization should redirect to. This is a
+	// required parameter.
+	RedirectURI string
+
+	// ClientNonce is a randomly generated string to prevent replay attacks.
+	// It is up to the client to generate this and it must then be passed
+	// back to ACLOIDCCompleteAuthRequest. This is a required parameter.
+	ClientNonce string
+}
+
+// ACLOIDCAuthURLResponse is the response when starting the OIDC
+// authentication login flow.
+type ACLOIDCAuthURLResponse struct {
+
+	// AuthURL is URL to begin authorization and is where the user logging in
+	// should go.
+	AuthURL string
+}
+
+// ACLOIDCCompleteAuthRequest is the request object to begin completing the
+// OIDC auth cycle after receiving the callback from the OIDC provider.
+type ACLOIDCCompleteAuthRequest struct {
+
+	// AuthMethodName is the name of the auth method being used to login via
+	// OIDC. This will match ACLOIDCAuthURLRequest.AuthMethodName. This is a
+	// required parameter.
+	AuthMethodName string
+
+	// ClientNonce, State, and Code are provided from the parameters given to
+	// the redirect URL. These are all required parameters.
+	ClientNonce string
+	State       string
+	Code        string
+
+	// RedirectURI is the URL that authorization should redirect to. This is a
+	// required parameter.
+	RedirectURI string
+}
+
+// ACLLoginRequest is the request object to log in with a JWT auth method.
+type ACLLoginRequest struct {
+
+	// AuthMethodName is the name of the JWT auth method the login token is
+	// validated by. This is a required parameter.
+	AuthMethodName string
+
+	// LoginToken is the JWT exchanged for a Nomad ACL token. This is a
+	// required parameter.
+	LoginToken string
+}
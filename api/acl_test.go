@@ -563,3 +563,152 @@ func TestACLRoles(t *testing.T) {
 	require.Empty(t, aclRoleListResp)
 	assertQueryMeta(t, queryMeta)
 }
+
+func TestACLAuthMethods(t *testing.T) {
+	testutil.Parallel(t)
+
+	testClient, testServer, _ := makeACLClient(t, nil, nil)
+	defer testServer.Stop()
+
+	// An initial listing shouldn't return any results.
+	aclAuthMethodsListResp, queryMeta, err := testClient.ACLAuthMethods().List(nil)
+	require.NoError(t, err)
+	require.Empty(t, aclAuthMethodsListResp)
+	assertQueryMeta(t, queryMeta)
+
+	// Create an ACL auth method.
+	authMethod := ACLAuthMethod{
+		Name:          "acl-auth-method-api-test",
+		Type:          ACLAuthMethodTypeOIDC,
+		TokenLocality: ACLAuthMethodTokenLocalityLocal,
+		MaxTokenTTL:   15 * time.Minute,
+		Config: &ACLAuthMethodConfig{
+			OIDCDiscoveryURL:    "https://example.com",
+			OIDCClientID:        "nomad",
+			OIDCClientSecret:    "secret",
+			AllowedRedirectURIs: []string{"http://localhost:4649/oidc/callback"},
+		},
+	}
+	aclAuthMethodCreateResp, writeMeta, err := testClient.ACLAuthMethods().Create(&authMethod, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+	require.Equal(t, authMethod.Name, aclAuthMethodCreateResp.Name)
+
+	// Another listing should return one result.
+	aclAuthMethodsListResp, queryMeta, err = testClient.ACLAuthMethods().List(nil)
+	require.NoError(t, err)
+	require.Len(t, aclAuthMethodsListResp, 1)
+	assertQueryMeta(t, queryMeta)
+
+	// Read the auth method.
+	aclAuthMethodReadResp, queryMeta, err := testClient.ACLAuthMethods().Get(authMethod.Name, nil)
+	require.NoError(t, err)
+	assertQueryMeta(t, queryMeta)
+	require.Equal(t, aclAuthMethodCreateResp, aclAuthMethodReadResp)
+
+	// Update the auth method token TTL.
+	authMethod.MaxTokenTTL = time.Hour
+	aclAuthMethodUpdateResp, writeMeta, err := testClient.ACLAuthMethods().Update(&authMethod, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+	require.Equal(t, time.Hour, aclAuthMethodUpdateResp.MaxTokenTTL)
+
+	// Delete the auth method.
+	writeMeta, err = testClient.ACLAuthMethods().Delete(authMethod.Name, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+
+	// Make sure there are no ACL auth methods now present.
+	aclAuthMethodsListResp, queryMeta, err = testClient.ACLAuthMethods().List(nil)
+	require.NoError(t, err)
+	require.Empty(t, aclAuthMethodsListResp)
+	assertQueryMeta(t, queryMeta)
+}
+
+func TestACLBindingRules(t *testing.T) {
+	testutil.Parallel(t)
+
+	testClient, testServer, _ := makeACLClient(t, nil, nil)
+	defer testServer.Stop()
+
+	// An initial listing shouldn't return any results.
+	aclBindingRulesListResp, queryMeta, err := testClient.ACLBindingRules().List(nil)
+	require.NoError(t, err)
+	require.Empty(t, aclBindingRulesListResp)
+	assertQueryMeta(t, queryMeta)
+
+	// Create the ACL auth method the binding rule is linked to.
+	authMethod := ACLAuthMethod{
+		Name:          "acl-binding-rule-api-test",
+		Type:          ACLAuthMethodTypeOIDC,
+		TokenLocality: ACLAuthMethodTokenLocalityLocal,
+		MaxTokenTTL:   15 * time.Minute,
+		Config: &ACLAuthMethodConfig{
+			OIDCDiscoveryURL:    "https://example.com",
+			OIDCClientID:        "nomad",
+			OIDCClientSecret:    "secret",
+			AllowedRedirectURIs: []string{"http://localhost:4649/oidc/callback"},
+		},
+	}
+	_, writeMeta, err := testClient.ACLAuthMethods().Create(&authMethod, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+
+	// Create an ACL binding rule.
+	bindingRule := ACLBindingRule{
+		AuthMethod: authMethod.Name,
+		Selector:   "engineering in list.roles",
+		BindType:   ACLBindingRuleBindTypeRole,
+		BindName:   "engineering",
+	}
+	aclBindingRuleCreateResp, writeMeta, err := testClient.ACLBindingRules().Create(&bindingRule, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+	require.NotEmpty(t, aclBindingRuleCreateResp.ID)
+
+	// Another listing should return one result.
+	aclBindingRulesListResp, queryMeta, err = testClient.ACLBindingRules().List(nil)
+	require.NoError(t, err)
+	require.Len(t, aclBindingRulesListResp, 1)
+	assertQueryMeta(t, queryMeta)
+
+	// Read the binding rule.
+	aclBindingRuleReadResp, queryMeta, err := testClient.ACLBindingRules().Get(aclBindingRuleCreateResp.ID, nil)
+	require.NoError(t, err)
+	assertQueryMeta(t, queryMeta)
+	require.Equal(t, aclBindingRuleCreateResp, aclBindingRuleReadResp)
+
+	// Update the binding rule description.
+	bindingRule.ID = aclBindingRuleCreateResp.ID
+	bindingRule.Description = "engineers are bound to their role"
+	aclBindingRuleUpdateResp, writeMeta, err := testClient.ACLBindingRules().Update(&bindingRule, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+	require.Equal(t, bindingRule.Description, aclBindingRuleUpdateResp.Description)
+
+	// Delete the binding rule.
+	writeMeta, err = testClient.ACLBindingRules().Delete(bindingRule.ID, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, writeMeta)
+
+	// Make sure there are no ACL binding rules now present.
+	aclBindingRulesListResp, queryMeta, err = testClient.ACLBindingRules().List(nil)
+	require.NoError(t, err)
+	require.Empty(t, aclBindingRulesListResp)
+	assertQueryMeta(t, queryMeta)
+}
+
+func TestACLAuth_Login(t *testing.T) {
+	testutil.Parallel(t)
+
+	testClient, testServer, _ := makeACLClient(t, nil, nil)
+	defer testServer.Stop()
+
+	// Logging in with an auth method that does not exist fails.
+	token, _, err := testClient.ACLAuth().Login(&ACLLoginRequest{
+		AuthMethodName: "api-test-jwt",
+		LoginToken:     "not-a-jwt",
+	}, nil)
+	require.ErrorContains(t, err, `auth-method "api-test-jwt" not found`)
+	require.Nil(t, token)
+}
@@ -141,6 +141,42 @@ func (a *Allocations) GC(alloc *Allocation, q *QueryOptions) error {
 	return err
 }
 
+// Pin prevents the client from garbage collecting the allocation's directory
+// once the allocation is terminal, regardless of the job's GC policy. Pins are
+// held in memory by the client and do not survive a client restart.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Pin(alloc *Allocation, q *QueryOptions) error {
+	req := AllocationPinRequest{
+		NodeID: alloc.NodeID,
+	}
+
+	var resp struct{}
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/pin", &req, &resp, q)
+	return err
+}
+
+// Unpin removes a pin added by Pin, making the allocation eligible for
+// garbage collection again. Allocations which the servers have already
+// garbage collected can be unpinned with an Allocation that only has its ID
+// and NodeID set.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Unpin(alloc *Allocation, q *QueryOptions) error {
+	req := AllocationPinRequest{
+		Unpin:  true,
+		NodeID: alloc.NodeID,
+	}
+
+	var resp struct{}
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/pin", &req, &resp, q)
+	return err
+}
+
 // Restart restarts the tasks that are currently running or a specific task if
 // taskName is provided. An error is returned if the task to be restarted is
 // not running.
@@ -209,6 +245,39 @@ func (a *Allocations) Signal(alloc *Allocation, q *QueryOptions, task, signal st
 	return err
 }
 
+// Pause freezes the processes of the allocation's running tasks, or of the
+// given task if not empty, without stopping them.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Pause(alloc *Allocation, q *QueryOptions, task string) error {
+	req := AllocPauseRequest{
+		Task: task,
+	}
+
+	var resp GenericResponse
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/pause", &req, &resp, q)
+	return err
+}
+
+// Resume thaws the allocation's tasks paused by Pause, or the given task if
+// not empty.
+//
+// Note: for cluster topologies where API consumers don't have network access to
+// Nomad clients, set api.ClientConnTimeout to a small value (ex 1ms) to avoid
+// long pauses on this API call.
+func (a *Allocations) Resume(alloc *Allocation, q *QueryOptions, task string) error {
+	req := AllocPauseRequest{
+		Task:   task,
+		Resume: true,
+	}
+
+	var resp GenericResponse
+	_, err := a.client.putQuery("/v1/client/allocation/"+alloc.ID+"/pause", &req, &resp, q)
+	return err
+}
+
 // Services is used to return a list of service registrations associated to the
 // specified allocID.
 func (a *Allocations) Services(allocID string, q *QueryOptions) ([]*ServiceRegistration, *QueryMeta, error) {
@@ -473,6 +542,16 @@ type AllocSignalRequest struct {
 	Signal string
 }
 
+type AllocPauseRequest struct {
+	Task   string
+	Resume bool
+}
+
+type AllocationPinRequest struct {
+	Unpin  bool
+	NodeID string
+}
+
 // GenericResponse is used to respond to a request where no
 // specific response information is needed.
 type GenericResponse struct {
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// SinkType is the type of destination an event sink delivers events to.
+type SinkType string
+
+const (
+	// SinkWebhook delivers batches of events to an HTTP endpoint, as JSON
+	// objects with the same Index and Events fields as the frames of the
+	// event stream.
+	SinkWebhook SinkType = "webhook"
+)
+
+// EventSinks is used to query the event sink endpoints.
+type EventSinks struct {
+	client *Client
+}
+
+// EventSinks returns a new handle on the event sinks.
+func (c *Client) EventSinks() *EventSinks {
+	return &EventSinks{client: c}
+}
+
+// List is used to list the event sinks.
+func (e *EventSinks) List(q *QueryOptions) ([]*EventSink, *QueryMeta, error) {
+	var resp []*EventSink
+	qm, err := e.client.query("/v1/event/sinks", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].ID < resp[j].ID })
+	return resp, qm, nil
+}
+
+// Info is used to query a single event sink by its ID.
+func (e *EventSinks) Info(id string, q *QueryOptions) (*EventSink, *QueryMeta, error) {
+	var resp EventSink
+	qm, err := e.client.query("/v1/event/sink/"+url.PathEscape(id), &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// Register is used to register or update an event sink.
+func (e *EventSinks) Register(sink *EventSink, w *WriteOptions) (*WriteMeta, error) {
+	if sink == nil || sink.ID == "" {
+		return nil, fmt.Errorf("missing event sink ID")
+	}
+
+	wm, err := e.client.write("/v1/event/sink/"+url.PathEscape(sink.ID), sink, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// Deregister is used to deregister an event sink.
+func (e *EventSinks) Deregister(id string, w *WriteOptions) (*WriteMeta, error) {
+	wm, err := e.client.delete("/v1/event/sink/"+url.PathEscape(id), nil, nil, w)
+	if err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// EventSink is a destination the events of the event stream are delivered to
+// by the leader.
+type EventSink struct {
+	// ID is the unique identifier of the sink.
+	ID string
+
+	// Type is the type of the sink.
+	Type SinkType
+
+	// Topics are the topics and keys of the events delivered to the sink.
+	// All events are delivered if no topics are set.
+	Topics map[Topic][]string
+
+	// Namespace is the namespace of the events delivered to the sink, "*"
+	// for all namespaces. Defaults to the default namespace.
+	Namespace string
+
+	// Filter is an optional go-bexpr expression further restricting the
+	// events delivered to the sink.
+	Filter string
+
+	// Address is the URL events are delivered to.
+	Address string
+
+	// LatestIndex is the index of the latest events acknowledged by the sink,
+	// as last recorded by the leader.
+	LatestIndex uint64
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSinks_CRUD(t *testing.T) {
+	testutil.Parallel(t)
+	c, s := makeClient(t, nil, nil)
+	defer s.Stop()
+	sinks := c.EventSinks()
+
+	sink := &EventSink{
+		ID:      "audit",
+		Type:    SinkWebhook,
+		Topics:  map[Topic][]string{TopicJob: {"*"}},
+		Address: "http://127.0.0.1:8080/events",
+	}
+	wm, err := sinks.Register(sink, nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, wm)
+
+	out, qm, err := sinks.Info("audit", nil)
+	require.NoError(t, err)
+	assertQueryMeta(t, qm)
+	require.Equal(t, sink.Address, out.Address)
+	require.Equal(t, "default", out.Namespace)
+
+	list, qm, err := sinks.List(nil)
+	require.NoError(t, err)
+	assertQueryMeta(t, qm)
+	require.Len(t, list, 1)
+	require.Equal(t, "audit", list[0].ID)
+
+	wm, err = sinks.Deregister("audit", nil)
+	require.NoError(t, err)
+	assertWriteMeta(t, wm)
+
+	list, _, err = sinks.List(nil)
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
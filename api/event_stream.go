@@ -11,13 +11,20 @@ import (
 )
 
 const (
-	TopicDeployment Topic = "Deployment"
-	TopicEvaluation Topic = "Evaluation"
-	TopicAllocation Topic = "Allocation"
-	TopicJob        Topic = "Job"
-	TopicNode       Topic = "Node"
-	TopicService    Topic = "Service"
-	TopicAll        Topic = "*"
+	TopicDeployment      Topic = "Deployment"
+	TopicEvaluation      Topic = "Evaluation"
+	TopicAllocation      Topic = "Allocation"
+	TopicJob             Topic = "Job"
+	TopicNode            Topic = "Node"
+	TopicService         Topic = "Service"
+	TopicNamespace       Topic = "Namespace"
+	TopicVariables       Topic = "Variables"
+	TopicACLRole         Topic = "ACLRole"
+	TopicCSIVolume       Topic = "CSIVolume"
+	TopicCSIPlugin       Topic = "CSIPlugin"
+	TopicScalingPolicy   Topic = "ScalingPolicy"
+	TopicSchedulerConfig Topic = "SchedulerConfig"
+	TopicAll             Topic = "*"
 )
 
 // Events is a set of events for a corresponding index. Events returned for the
@@ -102,13 +109,102 @@ func (e *Event) Service() (*ServiceRegistration, error) {
 	return out.Service, nil
 }
 
+// Namespace returns a Namespace struct from a given event payload. If the
+// Event Topic is Namespace this will return a valid Namespace.
+func (e *Event) Namespace() (*Namespace, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Namespace, nil
+}
+
+// Variable returns a VariableMetadata struct from a given event payload. If
+// the Event Topic is Variables this will return the metadata of a variable;
+// the contents of variables are never included in events.
+func (e *Event) Variable() (*VariableMetadata, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Variable, nil
+}
+
+// ACLRole returns an ACLRole struct from a given event payload. If the Event
+// Topic is ACLRole this will return a valid ACLRole.
+func (e *Event) ACLRole() (*ACLRole, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.ACLRole, nil
+}
+
+// CSIVolume returns a CSIVolume struct from a given event payload. If the
+// Event Topic is CSIVolume this will return a valid CSIVolume without its
+// secrets.
+func (e *Event) CSIVolume() (*CSIVolume, error) {
+	// CSIVolume has mapstructure tags for parsing volume specifications, so
+	// the payload is decoded as JSON instead.
+	raw, ok := e.Payload["Volume"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	buf, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var vol CSIVolume
+	if err := json.Unmarshal(buf, &vol); err != nil {
+		return nil, err
+	}
+	return &vol, nil
+}
+
+// CSIPlugin returns a CSIPlugin struct from a given event payload. If the
+// Event Topic is CSIPlugin this will return a valid CSIPlugin.
+func (e *Event) CSIPlugin() (*CSIPlugin, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.Plugin, nil
+}
+
+// ScalingPolicy returns a ScalingPolicy struct from a given event payload. If
+// the Event Topic is ScalingPolicy this will return a valid ScalingPolicy.
+func (e *Event) ScalingPolicy() (*ScalingPolicy, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.ScalingPolicy, nil
+}
+
+// SchedulerConfig returns a SchedulerConfiguration struct from a given event
+// payload. If the Event Topic is SchedulerConfig this will return a valid
+// SchedulerConfiguration.
+func (e *Event) SchedulerConfig() (*SchedulerConfiguration, error) {
+	out, err := e.decodePayload()
+	if err != nil {
+		return nil, err
+	}
+	return out.SchedulerConfig, nil
+}
+
 type eventPayload struct {
-	Allocation *Allocation          `mapstructure:"Allocation"`
-	Deployment *Deployment          `mapstructure:"Deployment"`
-	Evaluation *Evaluation          `mapstructure:"Evaluation"`
-	Job        *Job                 `mapstructure:"Job"`
-	Node       *Node                `mapstructure:"Node"`
-	Service    *ServiceRegistration `mapstructure:"Service"`
+	Allocation      *Allocation             `mapstructure:"Allocation"`
+	Deployment      *Deployment             `mapstructure:"Deployment"`
+	Evaluation      *Evaluation             `mapstructure:"Evaluation"`
+	Job             *Job                    `mapstructure:"Job"`
+	Node            *Node                   `mapstructure:"Node"`
+	Service         *ServiceRegistration    `mapstructure:"Service"`
+	Namespace       *Namespace              `mapstructure:"Namespace"`
+	Variable        *VariableMetadata       `mapstructure:"Variable"`
+	ACLRole         *ACLRole                `mapstructure:"ACLRole"`
+	Plugin          *CSIPlugin              `mapstructure:"Plugin"`
+	ScalingPolicy   *ScalingPolicy          `mapstructure:"ScalingPolicy"`
+	SchedulerConfig *SchedulerConfiguration `mapstructure:"SchedulerConfig"`
 }
 
 func (e *Event) decodePayload() (*eventPayload, error) {
@@ -147,7 +243,9 @@ func (c *Client) EventStream() *EventStream {
 }
 
 // Stream establishes a new subscription to Nomad's event stream and streams
-// results back to the returned channel.
+// results back to the returned channel. The Filter of the query options is
+// evaluated by the server against each event matching the topics, and only the
+// matching events are streamed.
 func (e *EventStream) Stream(ctx context.Context, topics map[Topic][]string, index uint64, q *QueryOptions) (<-chan *Events, error) {
 	r, err := e.client.newRequest("GET", "/v1/event/stream")
 	if err != nil {
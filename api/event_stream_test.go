@@ -49,6 +49,51 @@ func TestEvent_Stream(t *testing.T) {
 	}
 }
 
+func TestEvent_Stream_Filter(t *testing.T) {
+	testutil.Parallel(t)
+
+	c, s := makeClient(t, nil, nil)
+	defer s.Stop()
+
+	// register job to generate events
+	jobs := c.Jobs()
+	job := testJob()
+	resp2, _, err := jobs.Register(job, nil)
+	require.Nil(t, err)
+	require.NotNil(t, resp2)
+
+	// build event stream request filtering out the evaluation events
+	events := c.EventStream()
+	q := &QueryOptions{Filter: `Topic == "Job"`}
+	topics := map[Topic][]string{
+		TopicJob:        {"*"},
+		TopicEvaluation: {"*"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streamCh, err := events.Stream(ctx, topics, 0, q)
+	require.NoError(t, err)
+
+	select {
+	case event := <-streamCh:
+		require.NoError(t, event.Err)
+		require.Len(t, event.Events, 1)
+		require.Equal(t, TopicJob, event.Events[0].Topic)
+		require.Equal(t, "JobRegistered", event.Events[0].Type)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "failed waiting for event stream event")
+	}
+
+	// invalid filters are rejected
+	q = &QueryOptions{Filter: `Topic ==`}
+	_, err = events.Stream(ctx, topics, 0, q)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "400")
+	require.Contains(t, err.Error(), "failed to read filter expression")
+}
+
 func TestEvent_Stream_Err_InvalidQueryParam(t *testing.T) {
 	testutil.Parallel(t)
 
@@ -270,6 +315,56 @@ func TestEventStream_PayloadValueHelpers(t *testing.T) {
 				require.Equal(t, "some-service-namespace-id", a.Namespace)
 			},
 		},
+		{
+			desc:  "namespace",
+			input: []byte(`{"Topic": "Namespace", "Payload": {"Namespace":{"Name":"some-namespace","Description":"some description"}}}`),
+			expectFn: func(t *testing.T, event Event) {
+				require.Equal(t, TopicNamespace, event.Topic)
+				ns, err := event.Namespace()
+				require.NoError(t, err)
+				require.Equal(t, &Namespace{
+					Name:        "some-namespace",
+					Description: "some description",
+				}, ns)
+			},
+		},
+		{
+			desc:  "variable",
+			input: []byte(`{"Topic": "Variables", "Payload": {"Variable":{"Namespace":"some-namespace","Path":"some/path","ModifyIndex":10}}}`),
+			expectFn: func(t *testing.T, event Event) {
+				require.Equal(t, TopicVariables, event.Topic)
+				v, err := event.Variable()
+				require.NoError(t, err)
+				require.Equal(t, &VariableMetadata{
+					Namespace:   "some-namespace",
+					Path:        "some/path",
+					ModifyIndex: 10,
+				}, v)
+			},
+		},
+		{
+			desc:  "csi volume",
+			input: []byte(`{"Topic": "CSIVolume", "Payload": {"Volume":{"ID":"some-volume-id","Namespace":"some-namespace","PluginID":"some-plugin-id"}}}`),
+			expectFn: func(t *testing.T, event Event) {
+				require.Equal(t, TopicCSIVolume, event.Topic)
+				vol, err := event.CSIVolume()
+				require.NoError(t, err)
+				require.Equal(t, "some-volume-id", vol.ID)
+				require.Equal(t, "some-namespace", vol.Namespace)
+				require.Equal(t, "some-plugin-id", vol.PluginID)
+			},
+		},
+		{
+			desc:  "scheduler config",
+			input: []byte(`{"Topic": "SchedulerConfig", "Payload": {"SchedulerConfig":{"SchedulerAlgorithm":"spread","MemoryOversubscriptionEnabled":true}}}`),
+			expectFn: func(t *testing.T, event Event) {
+				require.Equal(t, TopicSchedulerConfig, event.Topic)
+				config, err := event.SchedulerConfig()
+				require.NoError(t, err)
+				require.Equal(t, SchedulerAlgorithmSpread, config.SchedulerAlgorithm)
+				require.True(t, config.MemoryOversubscriptionEnabled)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
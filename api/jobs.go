@@ -841,6 +841,22 @@ type ParameterizedJobConfig struct {
 	MetaOptional []string `mapstructure:"meta_optional" hcl:"meta_optional,optional"`
 }
 
+// GCPolicy configures how long clients retain the allocation directories of
+// a job's terminal allocations before garbage collecting them.
+type GCPolicy struct {
+	RetainFailed     *time.Duration `mapstructure:"retain_failed" hcl:"retain_failed,optional"`
+	RetainSuccessful *time.Duration `mapstructure:"retain_successful" hcl:"retain_successful,optional"`
+}
+
+func (g *GCPolicy) Canonicalize() {
+	if g.RetainFailed == nil {
+		g.RetainFailed = pointerOf(time.Duration(0))
+	}
+	if g.RetainSuccessful == nil {
+		g.RetainSuccessful = pointerOf(time.Duration(0))
+	}
+}
+
 // Job is used to serialize a job.
 type Job struct {
 	/* Fields parsed from HCL config */
@@ -863,6 +879,7 @@ type Job struct {
 	ParameterizedJob *ParameterizedJobConfig `hcl:"parameterized,block"`
 	Reschedule       *ReschedulePolicy       `hcl:"reschedule,block"`
 	Migrate          *MigrateStrategy        `hcl:"migrate,block"`
+	GC               *GCPolicy               `hcl:"gc,block"`
 	Meta             map[string]string       `hcl:"meta,block"`
 	ConsulToken      *string                 `mapstructure:"consul_token" hcl:"consul_token,optional"`
 	VaultToken       *string                 `mapstructure:"vault_token" hcl:"vault_token,optional"`
@@ -980,6 +997,9 @@ func (j *Job) Canonicalize() {
 	if j.Multiregion != nil {
 		j.Multiregion.Canonicalize()
 	}
+	if j.GC != nil {
+		j.GC.Canonicalize()
+	}
 
 	for _, tg := range j.TaskGroups {
 		tg.Canonicalize(j)
@@ -758,30 +758,32 @@ func TestJobs_Canonicalize(t *testing.T) {
 								LogConfig:   DefaultLogConfig(),
 								Templates: []*Template{
 									{
-										SourcePath:   pointerOf(""),
-										DestPath:     pointerOf("local/file.yml"),
-										EmbeddedTmpl: pointerOf("---"),
-										ChangeMode:   pointerOf("restart"),
-										ChangeSignal: pointerOf(""),
-										Splay:        pointerOf(5 * time.Second),
-										Perms:        pointerOf("0644"),
-										LeftDelim:    pointerOf("{{"),
-										RightDelim:   pointerOf("}}"),
-										Envvars:      pointerOf(false),
-										VaultGrace:   pointerOf(time.Duration(0)),
+										SourcePath:    pointerOf(""),
+										DestPath:      pointerOf("local/file.yml"),
+										EmbeddedTmpl:  pointerOf("---"),
+										ChangeMode:    pointerOf("restart"),
+										ChangeSignal:  pointerOf(""),
+										Splay:         pointerOf(5 * time.Second),
+										Perms:         pointerOf("0644"),
+										LeftDelim:     pointerOf("{{"),
+										RightDelim:    pointerOf("}}"),
+										Envvars:       pointerOf(false),
+										VaultGrace:    pointerOf(time.Duration(0)),
+										ErrMissingKey: pointerOf(false),
 									},
 									{
-										SourcePath:   pointerOf(""),
-										DestPath:     pointerOf("local/file.env"),
-										EmbeddedTmpl: pointerOf("FOO=bar\n"),
-										ChangeMode:   pointerOf("restart"),
-										ChangeSignal: pointerOf(""),
-										Splay:        pointerOf(5 * time.Second),
-										Perms:        pointerOf("0644"),
-										LeftDelim:    pointerOf("{{"),
-										RightDelim:   pointerOf("}}"),
-										Envvars:      pointerOf(true),
-										VaultGrace:   pointerOf(time.Duration(0)),
+										SourcePath:    pointerOf(""),
+										DestPath:      pointerOf("local/file.env"),
+										EmbeddedTmpl:  pointerOf("FOO=bar\n"),
+										ChangeMode:    pointerOf("restart"),
+										ChangeSignal:  pointerOf(""),
+										Splay:         pointerOf(5 * time.Second),
+										Perms:         pointerOf("0644"),
+										LeftDelim:     pointerOf("{{"),
+										RightDelim:    pointerOf("}}"),
+										Envvars:       pointerOf(true),
+										VaultGrace:    pointerOf(time.Duration(0)),
+										ErrMissingKey: pointerOf(false),
 									},
 								},
 							},
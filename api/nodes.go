@@ -474,6 +474,31 @@ func (n *Nodes) GC(nodeID string, q *QueryOptions) error {
 	return err
 }
 
+// GCStatus lists the terminal allocations whose directories are still present
+// on the node because they have not been garbage collected yet.
+func (n *Nodes) GCStatus(nodeID string, q *QueryOptions) ([]*AllocGCStatus, error) {
+	var resp []*AllocGCStatus
+	path := fmt.Sprintf("/v1/client/gc/status?node_id=%s", nodeID)
+	if _, err := n.client.query(path, &resp, q); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AllocGCStatus describes a terminal allocation that has not been garbage
+// collected by its client yet.
+type AllocGCStatus struct {
+	AllocID       string
+	Namespace     string
+	JobID         string
+	TaskGroup     string
+	ClientStatus  string
+	Pinned        bool
+	TerminalSince int64
+	RetainUntil   int64
+	DiskBytes     int64
+}
+
 // TODO Add tests
 func (n *Nodes) GcAlloc(allocID string, q *QueryOptions) error {
 	path := fmt.Sprintf("/v1/client/allocation/%s/gc", allocID)
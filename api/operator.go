@@ -249,6 +249,57 @@ func (op *Operator) SnapshotRestore(in io.Reader, q *WriteOptions) (*WriteMeta,
 	return wm, nil
 }
 
+// SnapshotAgentStatus is the status of the scheduled snapshots of a server.
+type SnapshotAgentStatus struct {
+	// Server is the name of the server reporting the status.
+	Server string
+
+	// Enabled is whether scheduled snapshots are configured on the server.
+	Enabled bool
+
+	// Active is whether the server is currently taking scheduled snapshots.
+	Active bool
+
+	// Storage describes where snapshots are written to.
+	Storage string
+
+	// Interval is the interval between snapshots.
+	Interval time.Duration
+
+	// LastSuccess is when the latest snapshot was successfully taken, and
+	// LastSnapshot the name it is stored under.
+	LastSuccess  time.Time
+	LastSnapshot string
+
+	// LastFailure is when the latest snapshot failed, and LastError the
+	// reason it failed.
+	LastFailure time.Time
+	LastError   string
+
+	// Snapshots are the retained snapshots, oldest first.
+	Snapshots []*StoredSnapshot
+}
+
+// StoredSnapshot describes a snapshot retained by the scheduled snapshots of
+// a server.
+type StoredSnapshot struct {
+	Name  string
+	Index uint64
+	Time  time.Time
+	Size  int64
+}
+
+// SnapshotStatus is used to query the status of the scheduled snapshots of
+// the leader, or of the queried server if the query allows stale reads.
+func (op *Operator) SnapshotStatus(q *QueryOptions) (*SnapshotAgentStatus, *QueryMeta, error) {
+	var resp SnapshotAgentStatus
+	qm, err := op.c.query("/v1/operator/snapshot/status", &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
 type License struct {
 	// The unique identifier of the license
 	LicenseID string
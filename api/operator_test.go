@@ -97,3 +97,17 @@ func TestOperator_SchedulerSetConfiguration(t *testing.T) {
 	require.True(t, schedulerConfig.SchedulerConfig.MemoryOversubscriptionEnabled)
 	require.Equal(t, newSchedulerConfig.PreemptionConfig, schedulerConfig.SchedulerConfig.PreemptionConfig)
 }
+
+func TestOperator_SnapshotStatus(t *testing.T) {
+	testutil.Parallel(t)
+	c, s := makeClient(t, nil, nil)
+	defer s.Stop()
+
+	// The test server does not take scheduled snapshots
+	status, _, err := c.Operator().SnapshotStatus(nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, status.Server)
+	require.False(t, status.Enabled)
+	require.False(t, status.Active)
+	require.Empty(t, status.Snapshots)
+}
@@ -54,6 +54,13 @@ type TaskResourceUsage struct {
 	ResourceUsage *ResourceUsage
 	Timestamp     int64
 	Pids          map[string]*ResourceUsage
+	Processes     map[string]*ProcessInfo
+}
+
+// ProcessInfo describes a process of a task
+type ProcessInfo struct {
+	PPID    int
+	Cmdline []string
 }
 
 // AllocResourceUsage holds the aggregated task resource usage of the
@@ -85,10 +92,13 @@ type AllocCheckStatuses map[string]AllocCheckStatus
 // RestartPolicy defines how the Nomad client restarts
 // tasks in a taskgroup when they fail
 type RestartPolicy struct {
-	Interval *time.Duration `hcl:"interval,optional"`
-	Attempts *int           `hcl:"attempts,optional"`
-	Delay    *time.Duration `hcl:"delay,optional"`
-	Mode     *string        `hcl:"mode,optional"`
+	Interval   *time.Duration `hcl:"interval,optional"`
+	Attempts   *int           `hcl:"attempts,optional"`
+	Delay      *time.Duration `hcl:"delay,optional"`
+	Mode       *string        `hcl:"mode,optional"`
+	Backoff    *string        `mapstructure:"backoff" hcl:"backoff,optional"`
+	MaxDelay   *time.Duration `mapstructure:"max_delay" hcl:"max_delay,optional"`
+	ResetAfter *time.Duration `mapstructure:"reset_after" hcl:"reset_after,optional"`
 }
 
 func (r *RestartPolicy) Merge(rp *RestartPolicy) {
@@ -104,6 +114,15 @@ func (r *RestartPolicy) Merge(rp *RestartPolicy) {
 	if rp.Mode != nil {
 		r.Mode = rp.Mode
 	}
+	if rp.Backoff != nil {
+		r.Backoff = rp.Backoff
+	}
+	if rp.MaxDelay != nil {
+		r.MaxDelay = rp.MaxDelay
+	}
+	if rp.ResetAfter != nil {
+		r.ResetAfter = rp.ResetAfter
+	}
 }
 
 // Reschedule configures how Tasks are rescheduled  when they crash or fail.
@@ -667,13 +686,38 @@ const (
 )
 
 type TaskLifecycle struct {
-	Hook    string `mapstructure:"hook" hcl:"hook,optional"`
-	Sidecar bool   `mapstructure:"sidecar" hcl:"sidecar,optional"`
+	Hook    string             `mapstructure:"hook" hcl:"hook,optional"`
+	Sidecar bool               `mapstructure:"sidecar" hcl:"sidecar,optional"`
+	Timeout *time.Duration     `mapstructure:"timeout" hcl:"timeout,optional"`
+	PreStop *TaskPreStopConfig `mapstructure:"prestop" hcl:"prestop,block"`
 }
 
 // Determine if lifecycle has user-input values
 func (l *TaskLifecycle) Empty() bool {
-	return l == nil || (l.Hook == "")
+	return l == nil || (l.Hook == "" && l.Timeout == nil && l.PreStop == nil)
+}
+
+func (l *TaskLifecycle) Canonicalize() {
+	if l == nil {
+		return
+	}
+	l.PreStop.Canonicalize()
+}
+
+// TaskPreStopConfig is a command executed inside the task before it is killed.
+type TaskPreStopConfig struct {
+	Command string         `mapstructure:"command" hcl:"command,optional"`
+	Args    []string       `mapstructure:"args" hcl:"args,optional"`
+	Timeout *time.Duration `mapstructure:"timeout" hcl:"timeout,optional"`
+}
+
+func (p *TaskPreStopConfig) Canonicalize() {
+	if p == nil {
+		return
+	}
+	if p.Timeout == nil {
+		p.Timeout = pointerOf(30 * time.Second)
+	}
 }
 
 // Task is a single process in a task group.
@@ -740,6 +784,7 @@ func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
 	if t.Lifecycle.Empty() {
 		t.Lifecycle = nil
 	}
+	t.Lifecycle.Canonicalize()
 	if t.CSIPluginConfig != nil {
 		t.CSIPluginConfig.Canonicalize()
 	}
@@ -832,21 +877,22 @@ func (ch *ChangeScript) Canonicalize() {
 }
 
 type Template struct {
-	SourcePath   *string        `mapstructure:"source" hcl:"source,optional"`
-	DestPath     *string        `mapstructure:"destination" hcl:"destination,optional"`
-	EmbeddedTmpl *string        `mapstructure:"data" hcl:"data,optional"`
-	ChangeMode   *string        `mapstructure:"change_mode" hcl:"change_mode,optional"`
-	ChangeScript *ChangeScript  `mapstructure:"change_script" hcl:"change_script,block"`
-	ChangeSignal *string        `mapstructure:"change_signal" hcl:"change_signal,optional"`
-	Splay        *time.Duration `mapstructure:"splay" hcl:"splay,optional"`
-	Perms        *string        `mapstructure:"perms" hcl:"perms,optional"`
-	Uid          *int           `mapstructure:"uid" hcl:"uid,optional"`
-	Gid          *int           `mapstructure:"gid" hcl:"gid,optional"`
-	LeftDelim    *string        `mapstructure:"left_delimiter" hcl:"left_delimiter,optional"`
-	RightDelim   *string        `mapstructure:"right_delimiter" hcl:"right_delimiter,optional"`
-	Envvars      *bool          `mapstructure:"env" hcl:"env,optional"`
-	VaultGrace   *time.Duration `mapstructure:"vault_grace" hcl:"vault_grace,optional"`
-	Wait         *WaitConfig    `mapstructure:"wait" hcl:"wait,block"`
+	SourcePath    *string        `mapstructure:"source" hcl:"source,optional"`
+	DestPath      *string        `mapstructure:"destination" hcl:"destination,optional"`
+	EmbeddedTmpl  *string        `mapstructure:"data" hcl:"data,optional"`
+	ChangeMode    *string        `mapstructure:"change_mode" hcl:"change_mode,optional"`
+	ChangeScript  *ChangeScript  `mapstructure:"change_script" hcl:"change_script,block"`
+	ChangeSignal  *string        `mapstructure:"change_signal" hcl:"change_signal,optional"`
+	Splay         *time.Duration `mapstructure:"splay" hcl:"splay,optional"`
+	Perms         *string        `mapstructure:"perms" hcl:"perms,optional"`
+	Uid           *int           `mapstructure:"uid" hcl:"uid,optional"`
+	Gid           *int           `mapstructure:"gid" hcl:"gid,optional"`
+	LeftDelim     *string        `mapstructure:"left_delimiter" hcl:"left_delimiter,optional"`
+	RightDelim    *string        `mapstructure:"right_delimiter" hcl:"right_delimiter,optional"`
+	Envvars       *bool          `mapstructure:"env" hcl:"env,optional"`
+	VaultGrace    *time.Duration `mapstructure:"vault_grace" hcl:"vault_grace,optional"`
+	Wait          *WaitConfig    `mapstructure:"wait" hcl:"wait,block"`
+	ErrMissingKey *bool          `mapstructure:"error_on_missing_key" hcl:"error_on_missing_key,optional"`
 }
 
 func (tmpl *Template) Canonicalize() {
@@ -890,6 +936,9 @@ func (tmpl *Template) Canonicalize() {
 	if tmpl.Envvars == nil {
 		tmpl.Envvars = pointerOf(false)
 	}
+	if tmpl.ErrMissingKey == nil {
+		tmpl.ErrMissingKey = pointerOf(false)
+	}
 
 	//COMPAT(0.12) VaultGrace is deprecated and unused as of Vault 0.5
 	if tmpl.VaultGrace == nil {
@@ -978,6 +1027,7 @@ type TaskState struct {
 	Failed      bool
 	Restarts    uint64
 	LastRestart time.Time
+	NextRestart time.Time
 	StartedAt   time.Time
 	FinishedAt  time.Time
 	Events      []*TaskEvent
@@ -1015,6 +1065,9 @@ const (
 	TaskLeaderDead             = "Leader Task Dead"
 	TaskBuildingTaskDir        = "Building Task Directory"
 	TaskClientReconnected      = "Reconnected"
+	TaskPreStop                = "Pre-Stop"
+	TaskPaused                 = "Paused"
+	TaskResumed                = "Resumed"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
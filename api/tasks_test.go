@@ -391,6 +391,20 @@ func TestTask_Canonicalize_TaskLifecycle(t *testing.T) {
 			},
 			expected: nil,
 		},
+		{
+			name: "prestop only",
+			task: &Task{
+				Lifecycle: &TaskLifecycle{
+					PreStop: &TaskPreStopConfig{Command: "/bin/drain"},
+				},
+			},
+			expected: &TaskLifecycle{
+				PreStop: &TaskPreStopConfig{
+					Command: "/bin/drain",
+					Timeout: pointerOf(30 * time.Second),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
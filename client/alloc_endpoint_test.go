@@ -463,6 +463,115 @@ func TestAllocations_Signal_ACL(t *testing.T) {
 	}
 }
 
+func TestAllocations_Pause(t *testing.T) {
+	ci.Parallel(t)
+
+	client, cleanup := TestClient(t, nil)
+	defer cleanup()
+
+	a := mock.Alloc()
+	require.Nil(t, client.addAlloc(a, ""))
+
+	// Try with bad alloc
+	req := &nstructs.AllocPauseRequest{}
+	var resp nstructs.GenericResponse
+	err := client.ClientRPC("Allocations.Pause", &req, &resp)
+	require.NotNil(t, err)
+	require.True(t, nstructs.IsErrUnknownAllocation(err))
+
+	// Try with good alloc
+	req.AllocID = a.ID
+	req.Task = "web"
+
+	var resp2 nstructs.GenericResponse
+	err = client.ClientRPC("Allocations.Pause", &req, &resp2)
+
+	require.Error(t, err, "Expected error, got: %s, resp: %#+v", err, resp2)
+	require.Contains(t, err.Error(), "Task not running")
+
+	// Try to resume
+	req.Resume = true
+
+	var resp3 nstructs.GenericResponse
+	err = client.ClientRPC("Allocations.Pause", &req, &resp3)
+
+	require.Error(t, err, "Expected error, got: %s, resp: %#+v", err, resp3)
+	require.Contains(t, err.Error(), "Task not paused")
+}
+
+func TestAllocations_Pause_ACL(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	server, addr, root, cleanupS := testACLServer(t, nil)
+	defer cleanupS()
+
+	client, cleanupC := TestClient(t, func(c *config.Config) {
+		c.Servers = []string{addr}
+		c.ACLEnabled = true
+	})
+	defer cleanupC()
+
+	job := mock.BatchJob()
+	job.TaskGroups[0].Count = 1
+	job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+		"run_for": "20s",
+	}
+
+	// Wait for client to be running job
+	alloc := testutil.WaitForRunningWithToken(t, server.RPC, job, root.SecretID)[0]
+
+	// Try request without a token and expect failure
+	{
+		req := &nstructs.AllocPauseRequest{}
+		req.AllocID = alloc.ID
+		var resp nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pause", &req, &resp)
+		require.NotNil(err)
+		require.EqualError(err, nstructs.ErrPermissionDenied.Error())
+	}
+
+	// Try request with an invalid token and expect failure
+	{
+		token := mock.CreatePolicyAndToken(t, server.State(), 1005, "invalid", mock.NodePolicy(acl.PolicyDeny))
+		req := &nstructs.AllocPauseRequest{}
+		req.AllocID = alloc.ID
+		req.AuthToken = token.SecretID
+
+		var resp nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pause", &req, &resp)
+
+		require.NotNil(err)
+		require.EqualError(err, nstructs.ErrPermissionDenied.Error())
+	}
+
+	// Try request with a valid token
+	{
+		token := mock.CreatePolicyAndToken(t, server.State(), 1005, "test-valid",
+			mock.NamespacePolicy(nstructs.DefaultNamespace, "", []string{acl.NamespaceCapabilityAllocLifecycle}))
+		req := &nstructs.AllocPauseRequest{}
+		req.AllocID = alloc.ID
+		req.AuthToken = token.SecretID
+		req.Namespace = nstructs.DefaultNamespace
+
+		var resp nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pause", &req, &resp)
+		require.NoError(err)
+	}
+
+	// Try request with a management token
+	{
+		req := &nstructs.AllocPauseRequest{}
+		req.AllocID = alloc.ID
+		req.AuthToken = root.SecretID
+		req.Resume = true
+
+		var resp nstructs.GenericResponse
+		err := client.ClientRPC("Allocations.Pause", &req, &resp)
+		require.NoError(err)
+	}
+}
+
 func TestAllocations_Stats(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -58,6 +59,11 @@ var (
 	// directory
 	TaskSecrets = "secrets"
 
+	// CheckpointDirName is the name of the directory in each alloc directory
+	// holding the checkpoints of its tasks. It is hidden so it does not
+	// collide with task directories, and is included in snapshots.
+	CheckpointDirName = ".checkpoint"
+
 	// TaskDirs is the set of directories created in each tasks directory.
 	TaskDirs = map[string]os.FileMode{TmpDirName: os.ModeSticky | 0777}
 
@@ -131,7 +137,7 @@ func (d *AllocDir) NewTaskDir(name string) *TaskDir {
 }
 
 // Snapshot creates an archive of the files and directories in the data dir of
-// the allocation and the task local directories
+// the allocation, the task local directories and the task checkpoints
 //
 // Since a valid tar may have been written even when an error occurs, a special
 // file "NOMAD-${ALLOC_ID}-ERROR.log" will be appended to the tar with the
@@ -145,6 +151,9 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 	for _, taskdir := range d.TaskDirs {
 		rootPaths = append(rootPaths, taskdir.LocalDir)
 	}
+	if checkpointDir := filepath.Join(d.AllocDir, CheckpointDirName); pathExists(checkpointDir) {
+		rootPaths = append(rootPaths, checkpointDir)
+	}
 
 	tw := tar.NewWriter(w)
 	defer tw.Close()
@@ -215,7 +224,8 @@ func (d *AllocDir) Snapshot(w io.Writer) error {
 	return nil
 }
 
-// Move other alloc directory's shared path and local dir to this alloc dir.
+// Move other alloc directory's shared path, local dirs and task checkpoints
+// to this alloc dir.
 func (d *AllocDir) Move(other *AllocDir, tasks []*structs.Task) error {
 	d.mu.RLock()
 	if !d.built {
@@ -255,6 +265,17 @@ func (d *AllocDir) Move(other *AllocDir, tasks []*structs.Task) error {
 				return fmt.Errorf("error moving task %q local dir: %v", task.Name, err)
 			}
 		}
+
+		otherCheckpoint := filepath.Join(other.AllocDir, CheckpointDirName, task.Name)
+		if pathExists(otherCheckpoint) {
+			checkpointDir := filepath.Join(d.AllocDir, CheckpointDirName)
+			if err := os.MkdirAll(checkpointDir, 0700); err != nil {
+				return fmt.Errorf("error creating checkpoint dir: %v", err)
+			}
+			if err := os.Rename(otherCheckpoint, filepath.Join(checkpointDir, task.Name)); err != nil {
+				return fmt.Errorf("error moving task %q checkpoint: %v", task.Name, err)
+			}
+		}
 	}
 
 	return nil
@@ -313,6 +334,56 @@ func (d *AllocDir) UnmountAll() error {
 	return mErr.ErrorOrNil()
 }
 
+// DiskUsage returns the number of bytes used by the files in the allocation
+// directory. Directories mounted into task directories, such as the shared
+// alloc dir, secrets and special dirs, are skipped so they are not counted
+// more than once or at all if they are not backed by disk.
+func (d *AllocDir) DiskUsage() (int64, error) {
+	d.mu.RLock()
+	skip := make(map[string]struct{}, len(d.TaskDirs)*4)
+	for _, dir := range d.TaskDirs {
+		skip[dir.SharedTaskDir] = struct{}{}
+		skip[dir.SecretsDir] = struct{}{}
+		skip[filepath.Join(dir.Dir, "dev")] = struct{}{}
+		skip[filepath.Join(dir.Dir, "proc")] = struct{}{}
+	}
+	d.mu.RUnlock()
+
+	var size int64
+	walkFn := func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may be removed while walking, which is not an error
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			if _, ok := skip[path]; ok {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	}
+
+	if err := filepath.WalkDir(d.AllocDir, walkFn); err != nil {
+		return size, err
+	}
+	return size, nil
+}
+
 // Build the directory tree for an allocation.
 func (d *AllocDir) Build() error {
 	// Make the alloc directory, owned by the nomad process.
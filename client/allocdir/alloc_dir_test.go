@@ -278,6 +278,49 @@ func TestAllocDir_Move(t *testing.T) {
 	}
 }
 
+func TestAllocDir_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	d1 := NewAllocDir(testlog.HCLogger(t), t.TempDir(), "test")
+	require.NoError(t, d1.Build())
+	defer d1.Destroy()
+
+	d2 := NewAllocDir(testlog.HCLogger(t), t.TempDir(), "test")
+	require.NoError(t, d2.Build())
+	defer d2.Destroy()
+
+	td1 := d1.NewTaskDir(t1.Name)
+	require.NoError(t, td1.Build(false, nil))
+	td2 := d2.NewTaskDir(t1.Name)
+	require.Equal(t, filepath.Join(d1.AllocDir, CheckpointDirName, t1.Name), td1.CheckpointDir)
+
+	// Write a checkpoint image for the task
+	require.NoError(t, os.MkdirAll(td1.CheckpointDir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(td1.CheckpointDir, "core-1.img"), []byte("core"), 0600))
+
+	// The checkpoint is included in snapshots
+	var b bytes.Buffer
+	require.NoError(t, d1.Snapshot(&b))
+
+	tr := tar.NewReader(&b)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	require.Contains(t, names, filepath.Join(CheckpointDirName, t1.Name, "core-1.img"))
+
+	// The checkpoint is moved along with the task
+	require.NoError(t, d2.Move(d1, []*structs.Task{t1}))
+	content, err := ioutil.ReadFile(filepath.Join(td2.CheckpointDir, "core-1.img"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("core"), content)
+}
+
 func TestAllocDir_EscapeChecking(t *testing.T) {
 	ci.Parallel(t)
 
@@ -39,6 +39,12 @@ type TaskDir struct {
 	// <task_dir>/secrets/
 	SecretsDir string
 
+	// CheckpointDir is the path to the task's checkpoint directory on the
+	// host. It is outside of the task directory so it isn't visible to the
+	// task.
+	// <alloc_dir>/.checkpoint/<task_name>/
+	CheckpointDir string
+
 	// skip embedding these paths in chroots. Used for avoiding embedding
 	// client.alloc_dir recursively.
 	skip map[string]struct{}
@@ -66,6 +72,7 @@ func newTaskDir(logger hclog.Logger, clientAllocDir, allocDir, taskName string)
 		SharedTaskDir:  filepath.Join(taskDir, SharedAllocName),
 		LocalDir:       filepath.Join(taskDir, TaskLocal),
 		SecretsDir:     filepath.Join(taskDir, TaskSecrets),
+		CheckpointDir:  filepath.Join(allocDir, CheckpointDirName, taskName),
 		skip:           skip,
 		logger:         logger,
 	}
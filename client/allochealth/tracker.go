@@ -135,7 +135,7 @@ func NewTracker(
 	for _, task := range t.tg.Tasks {
 		t.taskHealth[task.Name] = &taskHealthState{task: task}
 
-		if task.Lifecycle != nil && !task.Lifecycle.Sidecar {
+		if !task.IsMain() && !task.Lifecycle.Sidecar {
 			t.lifecycleTasks[task.Name] = task.Lifecycle.Hook
 		}
 
@@ -342,7 +342,8 @@ func (t *Tracker) watchTaskEvents() {
 				return
 			}
 
-			if state.State == structs.TaskStatePending {
+			// Paused tasks are not healthy until resumed, like pending ones
+			if state.State == structs.TaskStatePending || state.State == structs.TaskStatePaused {
 				latestStartTime = time.Time{}
 				break
 			} else if state.StartedAt.After(latestStartTime) {
@@ -370,9 +371,11 @@ func (t *Tracker) watchTaskEvents() {
 			// Prevent the timer from firing at the old start time
 			waiter.disable()
 
-			// Set the timer since all tasks are started
+			// Set the timer since all tasks are started. The start time is
+			// reset otherwise, so a resumed task restarts the timer even
+			// though its start time is unchanged.
+			allStartedTime = latestStartTime
 			if !latestStartTime.IsZero() {
-				allStartedTime = latestStartTime
 				waiter.wait(t.minHealthyTime)
 			}
 		}
@@ -654,9 +657,11 @@ func (t *taskHealthState) event(deadline time.Time, healthyDeadline, minHealthyT
 			return fmt.Sprintf("Task not running by healthy_deadline of %v", healthyDeadline), true
 		case structs.TaskStateDead:
 			// non-sidecar hook lifecycle tasks are healthy if they exit with success
-			if t.task.Lifecycle == nil || t.task.Lifecycle.Sidecar {
+			if t.task.IsMain() || t.task.Lifecycle.Sidecar {
 				return "Unhealthy because of dead task", true
 			}
+		case structs.TaskStatePaused:
+			return fmt.Sprintf("Task paused by healthy_deadline of %v", healthyDeadline), true
 		case structs.TaskStateRunning:
 			// We are running so check if we have been running long enough
 			if t.state.StartedAt.Add(minHealthyTime).After(deadline) {
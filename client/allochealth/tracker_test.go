@@ -270,6 +270,78 @@ func TestTracker_Checks_PendingPostStop_Healthy(t *testing.T) {
 	}
 }
 
+func TestTracker_Paused_Unhealthy(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.LifecycleAllocWithPoststopDeploy()
+	alloc.Job.TaskGroups[0].Migrate.MinHealthyTime = 1 // let's speed things up
+
+	// Synthesize running alloc with a paused task
+	startedAt := time.Now()
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	alloc.TaskStates = map[string]*structs.TaskState{
+		"web": {
+			State:     structs.TaskStatePaused,
+			StartedAt: startedAt,
+		},
+		"post": {
+			State: structs.TaskStatePending,
+		},
+	}
+
+	logger := testlog.HCLogger(t)
+	b := cstructs.NewAllocBroadcaster(logger)
+	defer b.Close()
+
+	consul := regmock.NewServiceRegistrationHandler(logger)
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	checks := checkstore.NewStore(logger, state.NewMemDB(logger))
+	checkInterval := 10 * time.Millisecond
+	tracker := NewTracker(ctx, logger, alloc, b.Listen(), consul, checks, time.Millisecond, true)
+	tracker.checkLookupInterval = checkInterval
+	tracker.Start()
+
+	// assert that we don't get marked healthy while paused
+	select {
+	case <-time.After(4 * checkInterval):
+		// still unhealthy, good
+	case h := <-tracker.HealthyCh():
+		require.Fail(t, "unexpected health event", h)
+	}
+	require.False(t, tracker.tasksHealthy)
+
+	// resuming the task keeps its start time
+	resumedAlloc := alloc.Copy()
+	resumedAlloc.TaskStates["web"].State = structs.TaskStateRunning
+	require.NoError(t, b.Send(resumedAlloc))
+
+	select {
+	case <-time.After(4 * checkInterval):
+		require.Fail(t, "timed out while waiting for health")
+	case h := <-tracker.HealthyCh():
+		require.True(t, h)
+	}
+}
+
+func TestTaskHealthState_Event_Paused(t *testing.T) {
+	ci.Parallel(t)
+
+	task := mock.Job().TaskGroups[0].Tasks[0]
+	state := &taskHealthState{
+		task: task,
+		state: &structs.TaskState{
+			State:     structs.TaskStatePaused,
+			StartedAt: time.Now().Add(-time.Hour),
+		},
+	}
+
+	desc, unhealthy := state.event(time.Now(), 5*time.Minute, time.Second, false)
+	require.True(t, unhealthy)
+	require.Equal(t, "Task paused by healthy_deadline of 5m0s", desc)
+}
+
 func TestTracker_Succeeded_PostStart_Healthy(t *testing.T) {
 	ci.Parallel(t)
 
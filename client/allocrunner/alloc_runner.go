@@ -3,6 +3,7 @@ package allocrunner
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -342,9 +343,14 @@ func (ar *allocRunner) Run() {
 			ar.logger.Error("prerun failed", "error", err)
 
 			for _, tr := range ar.tasks {
+				// Poststop tasks still run so they can clean up
+				if tr.IsPoststopTask() {
+					continue
+				}
 				tr.MarkFailedDead(fmt.Sprintf("failed to setup alloc: %v", err))
 			}
 
+			ar.runPoststopTasks()
 			goto POST
 		}
 	}
@@ -399,6 +405,21 @@ func (ar *allocRunner) runTasks() {
 	}
 }
 
+// runPoststopTasks runs only the poststop task runners and blocks until they
+// exit. It is used when the other tasks never started.
+func (ar *allocRunner) runPoststopTasks() {
+	for _, task := range ar.tasks {
+		if task.IsPoststopTask() {
+			go task.Run()
+		}
+	}
+	for _, task := range ar.tasks {
+		if task.IsPoststopTask() {
+			<-task.WaitCh()
+		}
+	}
+}
+
 // Alloc returns the current allocation being run by this runner as sent by the
 // server. This view of the allocation does not have updated task states.
 func (ar *allocRunner) Alloc() *structs.Allocation {
@@ -608,6 +629,10 @@ func (ar *allocRunner) handleTaskStateUpdates() {
 			}
 		}
 
+		// Tell poststop tasks how the other tasks ended before the
+		// coordinator allows them to start.
+		ar.setPoststopExitStatus(states)
+
 		ar.taskCoordinator.TaskStateUpdated(states)
 
 		// Get the client allocation
@@ -621,6 +646,51 @@ func (ar *allocRunner) handleTaskStateUpdates() {
 	}
 }
 
+// setPoststopExitStatus sets the exit status of the allocation and the names of
+// its failed tasks on the poststop task runners once every other task is dead.
+func (ar *allocRunner) setPoststopExitStatus(states map[string]*structs.TaskState) {
+	status := 0
+	failed := []string{}
+	for name, tr := range ar.tasks {
+		if tr.IsPoststopTask() {
+			continue
+		}
+		state, ok := states[name]
+		if !ok || state.State != structs.TaskStateDead {
+			return
+		}
+		if state.Failed {
+			failed = append(failed, name)
+		}
+	}
+
+	sort.Strings(failed)
+	if len(failed) > 0 {
+		// Report the exit code of the first failed task, falling back to 1
+		// for tasks which failed without exiting, e.g. setup failures.
+		status = taskExitCode(states[failed[0]])
+		if status == 0 {
+			status = 1
+		}
+	}
+
+	for _, tr := range ar.tasks {
+		if tr.IsPoststopTask() {
+			tr.SetAllocExitStatus(status, failed)
+		}
+	}
+}
+
+// taskExitCode returns the exit code of the last time the task terminated.
+func taskExitCode(state *structs.TaskState) int {
+	for i := len(state.Events) - 1; i >= 0; i-- {
+		if e := state.Events[i]; e.Type == structs.TaskTerminated {
+			return e.ExitCode
+		}
+	}
+	return 0
+}
+
 // hasNonSidecarTasks returns false if all the passed tasks are sidecar tasks
 func hasNonSidecarTasks(tasks []*taskrunner.TaskRunner) bool {
 	for _, tr := range tasks {
@@ -805,7 +875,7 @@ func getClientStatus(taskStates map[string]*structs.TaskState) (status, descript
 	var pending, running, dead, failed bool
 	for _, state := range taskStates {
 		switch state.State {
-		case structs.TaskStateRunning:
+		case structs.TaskStateRunning, structs.TaskStatePaused:
 			running = true
 		case structs.TaskStatePending:
 			pending = true
@@ -1327,6 +1397,51 @@ func (ar *allocRunner) Signal(taskName, signal string) error {
 	return err.ErrorOrNil()
 }
 
+// Pause pauses or, if resume is set, resumes the tasks inside an allocation.
+// If the taskName is empty, then all running tasks, or paused tasks when
+// resuming, are affected.
+func (ar *allocRunner) Pause(taskName string, resume bool) error {
+	eventType := structs.TaskPaused
+	if resume {
+		eventType = structs.TaskResumed
+	}
+	event := structs.NewTaskEvent(eventType)
+
+	pause := func(tr *taskrunner.TaskRunner, event *structs.TaskEvent) error {
+		if resume {
+			return tr.Resume(event)
+		}
+		return tr.Pause(event)
+	}
+
+	if taskName != "" {
+		tr, ok := ar.tasks[taskName]
+		if !ok {
+			return fmt.Errorf("Task not found")
+		}
+
+		return pause(tr, event)
+	}
+
+	var err *multierror.Error
+
+	for tn, tr := range ar.tasks {
+		// skip the tasks not in the state to pause or resume
+		switch state := tr.TaskState().State; {
+		case resume && state != structs.TaskStatePaused:
+			continue
+		case !resume && state != structs.TaskStateRunning:
+			continue
+		}
+
+		if rerr := pause(tr, event.Copy()); rerr != nil {
+			err = multierror.Append(err, fmt.Errorf("Failed to pause or resume task: %s, err: %v", tn, rerr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
 // Reconnect logs a reconnect event for each task in the allocation and syncs the current alloc state with the server.
 func (ar *allocRunner) Reconnect(update *structs.Allocation) (err error) {
 	event := structs.NewTaskEvent(structs.TaskClientReconnected)
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -399,6 +400,73 @@ func TestAllocRunner_TaskMain_KillTG(t *testing.T) {
 	})
 }
 
+// TestAllocRunner_Lifecycle_Poststop_Failed asserts that poststop tasks run
+// when the main task fails, see how it ended in their environment, and are
+// killed once their timeout elapses.
+func TestAllocRunner_Lifecycle_Poststop_Failed(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.LifecycleAlloc()
+	tr := alloc.AllocatedResources.Tasks[alloc.Job.TaskGroups[0].Tasks[0].Name]
+
+	alloc.Job.Type = structs.JobTypeService
+	mainTask := alloc.Job.TaskGroups[0].Tasks[0]
+	mainTask.Config["run_for"] = "10ms"
+	mainTask.Config["exit_code"] = 3
+
+	poststopTask := alloc.Job.TaskGroups[0].Tasks[1]
+	poststopTask.Name = "cleanup"
+	poststopTask.Lifecycle.Hook = structs.TaskLifecycleHookPoststop
+	poststopTask.Lifecycle.Sidecar = false
+	poststopTask.Lifecycle.Timeout = 2 * time.Second
+	poststopTask.Config["run_for"] = "100s"
+	poststopTask.Config["stdout_string"] = "${NOMAD_ALLOC_EXIT_STATUS}:${NOMAD_ALLOC_FAILED_TASKS}"
+
+	alloc.Job.TaskGroups[0].Tasks = []*structs.Task{mainTask, poststopTask}
+	alloc.AllocatedResources.Tasks = map[string]*structs.AllocatedTaskResources{
+		mainTask.Name:     tr,
+		poststopTask.Name: tr,
+	}
+
+	conf, cleanup := testAllocRunnerConfig(t, alloc)
+	defer cleanup()
+	ar, err := NewAllocRunner(conf)
+	require.NoError(t, err)
+	defer destroy(ar)
+	go ar.Run()
+
+	select {
+	case <-ar.WaitCh():
+	case <-time.After(time.Duration(testutil.TestMultiplier()*15) * time.Second):
+		t.Fatalf("timed out waiting for alloc to finish")
+	}
+
+	upd := conf.StateUpdater.(*MockStateUpdater)
+	last := upd.Last()
+	require.Equal(t, structs.AllocClientStatusFailed, last.ClientStatus)
+
+	mainState := last.TaskStates[mainTask.Name]
+	require.True(t, mainState.Failed)
+
+	// The poststop task must have started and been killed by its timeout
+	postState := last.TaskStates[poststopTask.Name]
+	require.Equal(t, structs.TaskStateDead, postState.State)
+	require.False(t, postState.StartedAt.IsZero(), "poststop task never started")
+	require.True(t, postState.Failed)
+	var timedOut bool
+	for _, e := range postState.Events {
+		if e.Type == structs.TaskKilling && strings.Contains(e.KillReason, "timeout") {
+			timedOut = true
+		}
+	}
+	require.True(t, timedOut, "expected poststop timeout kill event")
+
+	logFile := filepath.Join(ar.allocDir.SharedDir, "logs", poststopTask.Name+".stdout.0")
+	out, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+	require.Equal(t, "3:"+mainTask.Name, string(out))
+}
+
 // TestAllocRunner_Lifecycle_Poststop asserts that a service job with 1
 // postop lifecycle hook starts all 3 tasks, only
 // the ephemeral one finishes, and the other 2 exit when the alloc is stopped.
@@ -1658,6 +1726,62 @@ func TestAllocRunner_SimpleRun(t *testing.T) {
 
 }
 
+// TestAllocRunner_Pause asserts that pausing and resuming an alloc applies to
+// its running tasks, and that the alloc stays running while paused.
+func TestAllocRunner_Pause(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10m",
+	}
+	task2 := task.Copy()
+	task2.Name = "task2"
+	alloc.Job.TaskGroups[0].Tasks = append(alloc.Job.TaskGroups[0].Tasks, task2)
+	alloc.AllocatedResources.Tasks[task2.Name] = alloc.AllocatedResources.Tasks[task.Name]
+
+	conf, cleanup := testAllocRunnerConfig(t, alloc)
+	defer cleanup()
+	ar, err := NewAllocRunner(conf)
+	require.NoError(t, err)
+	go ar.Run()
+	defer destroy(ar)
+
+	waitForTasks := func(state string) {
+		testutil.WaitForResult(func() (bool, error) {
+			for name, tr := range ar.tasks {
+				if s := tr.TaskState().State; s != state {
+					return false, fmt.Errorf("task %q in state %q; want %q", name, s, state)
+				}
+			}
+			return true, nil
+		}, func(err error) {
+			require.NoError(t, err)
+		})
+	}
+	waitForTasks(structs.TaskStateRunning)
+
+	// Unknown tasks are rejected
+	require.EqualError(t, ar.Pause("missing", false), "Task not found")
+
+	// Pause a single task
+	require.NoError(t, ar.Pause(task.Name, false))
+	require.Equal(t, structs.TaskStatePaused, ar.tasks[task.Name].TaskState().State)
+	require.Equal(t, structs.TaskStateRunning, ar.tasks[task2.Name].TaskState().State)
+
+	// Pausing the alloc skips the already paused task
+	require.NoError(t, ar.Pause("", false))
+	waitForTasks(structs.TaskStatePaused)
+	require.Equal(t, structs.AllocClientStatusRunning, ar.AllocState().ClientStatus)
+
+	// Resume every paused task
+	require.NoError(t, ar.Pause("", true))
+	waitForTasks(structs.TaskStateRunning)
+	require.Equal(t, structs.AllocClientStatusRunning, ar.AllocState().ClientStatus)
+}
+
 // TestAllocRunner_MoveAllocDir asserts that a rescheduled
 // allocation copies ephemeral disk content from previous alloc run
 func TestAllocRunner_MoveAllocDir(t *testing.T) {
@@ -2398,3 +2522,71 @@ func TestHasSidecarTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestAllocRunner_getClientStatus(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name     string
+		states   []*structs.TaskState
+		expected string
+	}{
+		{
+			name: "pending",
+			states: []*structs.TaskState{
+				{State: structs.TaskStatePending},
+			},
+			expected: structs.AllocClientStatusPending,
+		},
+		{
+			name: "running",
+			states: []*structs.TaskState{
+				{State: structs.TaskStatePending},
+				{State: structs.TaskStateRunning},
+			},
+			expected: structs.AllocClientStatusRunning,
+		},
+		{
+			name: "paused",
+			states: []*structs.TaskState{
+				{State: structs.TaskStatePaused},
+			},
+			expected: structs.AllocClientStatusRunning,
+		},
+		{
+			name: "paused and dead",
+			states: []*structs.TaskState{
+				{State: structs.TaskStatePaused},
+				{State: structs.TaskStateDead},
+			},
+			expected: structs.AllocClientStatusRunning,
+		},
+		{
+			name: "paused and failed",
+			states: []*structs.TaskState{
+				{State: structs.TaskStatePaused},
+				{State: structs.TaskStateDead, Failed: true},
+			},
+			expected: structs.AllocClientStatusFailed,
+		},
+		{
+			name: "complete",
+			states: []*structs.TaskState{
+				{State: structs.TaskStateDead},
+			},
+			expected: structs.AllocClientStatusComplete,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			states := map[string]*structs.TaskState{}
+			for i, state := range tc.states {
+				states[fmt.Sprintf("task%d", i)] = state
+			}
+
+			status, _ := getClientStatus(states)
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
@@ -286,7 +286,7 @@ func (c *Coordinator) isInitDone(states map[string]*structs.TaskState) bool {
 //   - there is at least one prestart task
 //   - all ephemeral prestart tasks are successful.
 //   - no ephemeral prestart task has failed.
-//   - all prestart sidecar tasks are running.
+//   - all prestart sidecar tasks are running or paused.
 func (c *Coordinator) isPrestartDone(states map[string]*structs.TaskState) bool {
 	if !c.hasPrestart() {
 		return true
@@ -298,7 +298,8 @@ func (c *Coordinator) isPrestartDone(states map[string]*structs.TaskState) bool
 		}
 	}
 	for _, task := range c.tasksByLifecycle[lifecycleStagePrestartSidecar] {
-		if states[task].State != structs.TaskStateRunning {
+		// a paused sidecar has started, so it does not block main tasks
+		if state := states[task].State; state != structs.TaskStateRunning && state != structs.TaskStatePaused {
 			return false
 		}
 	}
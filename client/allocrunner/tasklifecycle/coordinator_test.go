@@ -356,6 +356,70 @@ func TestCoordinator_SidecarNeverStarts(t *testing.T) {
 	RequireTaskBlocked(t, coord, mainTask)
 }
 
+func TestCoordinator_PausedSidecar(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+
+	alloc := mock.LifecycleAlloc()
+	tasks := alloc.Job.TaskGroups[0].Tasks
+
+	mainTask := tasks[0]
+	sideTask := tasks[1]
+	initTask := tasks[2]
+
+	// Only use the tasks that we care about.
+	tasks = []*structs.Task{mainTask, sideTask, initTask}
+
+	shutdownCh := make(chan struct{})
+	defer close(shutdownCh)
+	coord := NewCoordinator(logger, tasks, shutdownCh)
+
+	// Set initial state, prestart tasks are allowed to run, main is blocked.
+	states := map[string]*structs.TaskState{
+		initTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+		sideTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+		mainTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+	}
+	coord.TaskStateUpdated(states)
+	RequireTaskAllowed(t, coord, initTask)
+	RequireTaskAllowed(t, coord, sideTask)
+	RequireTaskBlocked(t, coord, mainTask)
+
+	// Init completes and the sidecar is paused after it started, which
+	// allows main to run.
+	states = map[string]*structs.TaskState{
+		initTask.Name: {
+			State:      structs.TaskStateDead,
+			Failed:     false,
+			StartedAt:  time.Now(),
+			FinishedAt: time.Now(),
+		},
+		sideTask.Name: {
+			State:     structs.TaskStatePaused,
+			Failed:    false,
+			StartedAt: time.Now(),
+		},
+		mainTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+	}
+	coord.TaskStateUpdated(states)
+	RequireTaskBlocked(t, coord, initTask)
+	RequireTaskAllowed(t, coord, sideTask)
+	RequireTaskAllowed(t, coord, mainTask)
+}
+
 func TestCoordinator_PoststartStartsAfterMain(t *testing.T) {
 	ci.Parallel(t)
 
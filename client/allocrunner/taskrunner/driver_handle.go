@@ -54,6 +54,33 @@ func (h *DriverHandle) Kill() error {
 	return h.driver.StopTask(h.taskID, h.killTimeout, h.killSignal)
 }
 
+// Checkpoint checkpoints the task, stopping it, if the driver supports it.
+func (h *DriverHandle) Checkpoint() error {
+	d, ok := h.driver.(drivers.CheckpointDriver)
+	if !ok {
+		return fmt.Errorf("driver does not support checkpointing")
+	}
+	return d.CheckpointTask(h.taskID)
+}
+
+// Pause freezes the processes of the task, if the driver supports it.
+func (h *DriverHandle) Pause() error {
+	d, ok := h.driver.(drivers.PauseDriver)
+	if !ok {
+		return fmt.Errorf("driver does not support pausing tasks")
+	}
+	return d.PauseTask(h.taskID)
+}
+
+// Resume thaws the processes of a paused task.
+func (h *DriverHandle) Resume() error {
+	d, ok := h.driver.(drivers.PauseDriver)
+	if !ok {
+		return fmt.Errorf("driver does not support pausing tasks")
+	}
+	return d.ResumeTask(h.taskID)
+}
+
 func (h *DriverHandle) Stats(ctx context.Context, interval time.Duration) (<-chan *cstructs.TaskResourceUsage, error) {
 	return h.driver.TaskStats(ctx, h.taskID, interval)
 }
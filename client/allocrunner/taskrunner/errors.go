@@ -8,10 +8,12 @@ import (
 
 const (
 	errTaskNotRunning = "Task not running"
+	errTaskNotPaused  = "Task not paused"
 )
 
 var (
 	ErrTaskNotRunning = errors.New(errTaskNotRunning)
+	ErrTaskNotPaused  = errors.New(errTaskNotPaused)
 )
 
 // NewHookError contains an underlying err and a pre-formatted task event.
@@ -2,6 +2,7 @@ package taskrunner
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -141,6 +142,47 @@ func (tr *TaskRunner) Signal(event *structs.TaskEvent, s string) error {
 	return handle.Signal(s)
 }
 
+// Pause freezes the processes of a running task, which is left in the paused
+// state until resumed. Returns an error if the task is not running or the
+// driver does not support pausing tasks.
+func (tr *TaskRunner) Pause(event *structs.TaskEvent) error {
+	tr.logger.Trace("Pause requested")
+
+	handle := tr.getDriverHandle()
+	if handle == nil || tr.TaskState().State != structs.TaskStateRunning {
+		return ErrTaskNotRunning
+	}
+
+	if tr.driverCapabilities == nil || !tr.driverCapabilities.Pause {
+		return fmt.Errorf("driver %q does not support pausing tasks", tr.Task().Driver)
+	}
+
+	if err := handle.Pause(); err != nil {
+		return err
+	}
+
+	tr.UpdateState(structs.TaskStatePaused, event)
+	return nil
+}
+
+// Resume thaws the processes of a paused task. Returns an error if the task
+// is not paused.
+func (tr *TaskRunner) Resume(event *structs.TaskEvent) error {
+	tr.logger.Trace("Resume requested")
+
+	handle := tr.getDriverHandle()
+	if handle == nil || tr.TaskState().State != structs.TaskStatePaused {
+		return ErrTaskNotPaused
+	}
+
+	if err := handle.Resume(); err != nil {
+		return err
+	}
+
+	tr.UpdateState(structs.TaskStateRunning, event)
+	return nil
+}
+
 // Kill a task. Blocks until task exits or context is canceled. State is set to
 // dead.
 func (tr *TaskRunner) Kill(ctx context.Context, event *structs.TaskEvent) error {
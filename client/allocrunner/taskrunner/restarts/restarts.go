@@ -18,6 +18,7 @@ const (
 	ReasonUnrecoverableError = "Error was unrecoverable"
 	ReasonWithinPolicy       = "Restart within policy"
 	ReasonDelay              = "Exceeded allowed attempts, applying a delay"
+	ReasonBackoff            = "Restart within policy, backing off after %d consecutive failures"
 )
 
 func NewRestartTracker(policy *structs.RestartPolicy, jobType string, tlc *structs.TaskLifecycleConfig) *RestartTracker {
@@ -43,11 +44,13 @@ func NewRestartTracker(policy *structs.RestartPolicy, jobType string, tlc *struc
 		onSuccess = false
 	}
 
+	now := time.Now()
 	return &RestartTracker{
-		startTime: time.Now(),
-		onSuccess: onSuccess,
-		policy:    policy,
-		rand:      rand.New(rand.NewSource(time.Now().Unix())),
+		startTime:   now,
+		runningFrom: now,
+		onSuccess:   onSuccess,
+		policy:      policy,
+		rand:        rand.New(rand.NewSource(now.Unix())),
 	}
 }
 
@@ -60,6 +63,8 @@ type RestartTracker struct {
 	count            int       // Current number of attempts.
 	onSuccess        bool      // Whether to restart on successful exit code.
 	startTime        time.Time // When the interval began
+	backoff          int       // Consecutive failures used for exponential backoff
+	runningFrom      time.Time // When the task is expected to have started running
 	reason           string    // The reason for the last state
 	policy           *structs.RestartPolicy
 	rand             *rand.Rand
@@ -169,6 +174,7 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 	// Hot path if a restart was triggered
 	if r.restartTriggered {
 		r.reason = ""
+		r.runningFrom = time.Now()
 		return structs.TaskRestarting, 0
 	}
 
@@ -193,6 +199,9 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 	if now.After(end) {
 		r.count = 0
 		r.startTime = now
+		if r.policy.ResetAfter == 0 {
+			r.backoff = 0
+		}
 	}
 
 	r.count++
@@ -228,12 +237,47 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 			return structs.TaskNotRestarting, 0
 		} else {
 			r.reason = ReasonDelay
-			return structs.TaskRestarting, r.getDelay()
+			delay := r.getDelay()
+			r.runningFrom = now.Add(delay)
+			return structs.TaskRestarting, delay
 		}
 	}
 
+	if r.policy.Backoff == structs.RestartBackoffExponential {
+		delay := r.exponentialDelay(now)
+		r.reason = fmt.Sprintf(ReasonBackoff, r.backoff)
+		return structs.TaskRestarting, delay
+	}
+
 	r.reason = ReasonWithinPolicy
-	return structs.TaskRestarting, r.jitter()
+	delay := r.jitter()
+	r.runningFrom = now.Add(delay)
+	return structs.TaskRestarting, delay
+}
+
+// exponentialDelay returns the delay before the next restart, doubling the
+// policy's delay for each consecutive failure up to the max delay. Jitter is
+// subtracted from the delay so the max delay is never exceeded.
+func (r *RestartTracker) exponentialDelay(now time.Time) time.Duration {
+	// Reset the backoff if the task ran for long enough since it last started
+	if r.policy.ResetAfter > 0 && now.Sub(r.runningFrom) >= r.policy.ResetAfter {
+		r.backoff = 0
+	}
+
+	d := r.policy.Delay
+	for i := 0; i < r.backoff && d < r.policy.MaxDelay; i++ {
+		d *= 2
+	}
+	if r.policy.MaxDelay > 0 && d > r.policy.MaxDelay {
+		d = r.policy.MaxDelay
+	}
+	r.backoff++
+
+	if d > 0 {
+		d -= time.Duration(float64(r.rand.Int63n(int64(d))) * jitter)
+	}
+	r.runningFrom = now.Add(d)
+	return d
 }
 
 // getDelay returns the delay time to enter the next interval.
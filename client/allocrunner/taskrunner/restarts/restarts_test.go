@@ -314,3 +314,47 @@ func TestClient_RestartTracker_Lifecycle(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_RestartTracker_ExponentialBackoff(t *testing.T) {
+	ci.Parallel(t)
+	p := testPolicy(true, structs.RestartPolicyModeDelay)
+	p.Attempts = 10
+	p.Interval = time.Hour
+	p.Backoff = structs.RestartBackoffExponential
+	p.MaxDelay = 5 * time.Second
+	rt := NewRestartTracker(p, structs.JobTypeService, nil)
+
+	// Delays double from 1s and are capped to 5s, minus jitter
+	for _, expected := range []time.Duration{1, 2, 4, 5, 5} {
+		expected *= time.Second
+		state, when := rt.SetExitResult(testExitResult(127)).GetState()
+		require.Equal(t, structs.TaskRestarting, state)
+		require.LessOrEqual(t, when, expected)
+		require.GreaterOrEqual(t, when, time.Duration(float64(expected)*(1-jitter)))
+	}
+	require.Equal(t, fmt.Sprintf(ReasonBackoff, 5), rt.GetReason())
+}
+
+func TestClient_RestartTracker_ExponentialBackoff_Reset(t *testing.T) {
+	ci.Parallel(t)
+	p := testPolicy(true, structs.RestartPolicyModeDelay)
+	p.Attempts = 10
+	p.Interval = time.Hour
+	p.Backoff = structs.RestartBackoffExponential
+	p.MaxDelay = time.Minute
+	p.ResetAfter = time.Minute
+	rt := NewRestartTracker(p, structs.JobTypeService, nil)
+
+	for i := 0; i < 3; i++ {
+		state, _ := rt.SetExitResult(testExitResult(127)).GetState()
+		require.Equal(t, structs.TaskRestarting, state)
+	}
+
+	// Pretend the task has been running for longer than the reset duration
+	rt.runningFrom = time.Now().Add(-2 * time.Minute)
+
+	state, when := rt.SetExitResult(testExitResult(127)).GetState()
+	require.Equal(t, structs.TaskRestarting, state)
+	require.LessOrEqual(t, when, p.Delay)
+	require.Equal(t, fmt.Sprintf(ReasonBackoff, 1), rt.GetReason())
+}
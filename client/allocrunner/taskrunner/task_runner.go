@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -57,6 +58,10 @@ const (
 	// giving up and potentially leaking resources.
 	killFailureLimit = 5
 
+	// preStopOutputLimit is the number of bytes of prestop command output
+	// recorded in the task event.
+	preStopOutputLimit = 512
+
 	// triggerUpdateChCap is the capacity for the triggerUpdateCh used for
 	// triggering updates. It should be exactly 1 as even if multiple
 	// updates have come in since the last one was handled, we only need to
@@ -561,6 +566,13 @@ func (tr *TaskRunner) Run() {
 	timer, stop := helper.NewSafeTimer(0) // timer duration calculated JIT
 	defer stop()
 
+	var poststopTimer *time.Timer
+	defer func() {
+		if poststopTimer != nil {
+			poststopTimer.Stop()
+		}
+	}()
+
 MAIN:
 	for !tr.shouldShutdown() {
 		if dead {
@@ -578,6 +590,12 @@ MAIN:
 			// yay proceed
 		}
 
+		// Bound the total time a poststop task may run, including restarts,
+		// so the allocation finishes terminating.
+		if poststopTimer == nil {
+			poststopTimer = tr.startPoststopTimeout()
+		}
+
 		// Run the prestart hooks
 		if err := tr.prestart(); err != nil {
 			tr.logger.Error("prestart failed", "error", err)
@@ -990,6 +1008,17 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 		}
 	}
 
+	// Checkpoint tasks of migrating allocations so they resume on the new
+	// client, falling back to killing them if that fails.
+	if result, ok := tr.checkpoint(resultCh); ok {
+		return result
+	}
+
+	// Run the prestop command inside the task before signalling it
+	if result := tr.runPreStop(resultCh); result != nil {
+		return result
+	}
+
 	// Tell the restart tracker that the task has been killed so it doesn't
 	// attempt to restart it.
 	tr.restartTracker.SetKilled()
@@ -1043,6 +1072,155 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 	}
 }
 
+// startPoststopTimeout kills a poststop task once its lifecycle timeout has
+// elapsed. Returns nil if the task has no timeout.
+func (tr *TaskRunner) startPoststopTimeout() *time.Timer {
+	lc := tr.Task().Lifecycle
+	if !tr.IsPoststopTask() || lc.Timeout == 0 {
+		return nil
+	}
+
+	timeout := lc.Timeout
+	return time.AfterFunc(timeout, func() {
+		tr.logger.Warn("poststop task exceeded its timeout; killing", "timeout", timeout)
+		event := structs.NewTaskEvent(structs.TaskKilling).
+			SetKillReason(fmt.Sprintf("Poststop task exceeded its timeout of %v", timeout)).
+			SetFailsTask()
+		if err := tr.Kill(context.Background(), event); err != nil && err != ErrTaskNotRunning {
+			tr.logger.Error("failed to kill poststop task after timeout", "error", err)
+		}
+	})
+}
+
+// shouldCheckpoint returns whether the task should be checkpointed rather than
+// killed: its allocation is migrating along with its ephemeral disk and the
+// driver supports checkpointing.
+func (tr *TaskRunner) shouldCheckpoint() bool {
+	if tr.driverCapabilities == nil || !tr.driverCapabilities.Checkpoint {
+		return false
+	}
+
+	alloc := tr.Alloc()
+	if !alloc.DesiredTransition.ShouldMigrate() {
+		return false
+	}
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	return tg != nil && tg.EphemeralDisk != nil && tg.EphemeralDisk.Migrate
+}
+
+// checkpoint checkpoints the task if it should be, returning true along with
+// its exit result if the task was stopped by the checkpoint.
+func (tr *TaskRunner) checkpoint(resultCh <-chan *drivers.ExitResult) (*drivers.ExitResult, bool) {
+	if !tr.shouldCheckpoint() {
+		return nil, false
+	}
+
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return nil, false
+	}
+
+	tr.logger.Debug("checkpointing task")
+	if err := handle.Checkpoint(); err != nil {
+		tr.logger.Warn("failed to checkpoint task, killing it instead", "error", err)
+		tr.EmitEvent(structs.NewTaskEvent(structs.TaskCheckpointed).
+			SetMessage(fmt.Sprintf("Failed to checkpoint task: %v", err)))
+		return nil, false
+	}
+
+	tr.restartTracker.SetKilled()
+	tr.EmitEvent(structs.NewTaskEvent(structs.TaskCheckpointed))
+
+	if resultCh == nil {
+		var err error
+		resultCh, err = handle.WaitCh(tr.shutdownCtx)
+		if err != nil {
+			return nil, true
+		}
+	}
+
+	select {
+	case result := <-resultCh:
+		return result, true
+	case <-tr.shutdownCtx.Done():
+		return nil, true
+	}
+}
+
+// runPreStop executes the task's prestop command, if any, through the driver
+// and records its outcome as a task event. Failures and timeouts are not fatal:
+// the task is killed as normal afterwards. If the task exits while the command
+// is running its exit result is returned.
+func (tr *TaskRunner) runPreStop(resultCh <-chan *drivers.ExitResult) *drivers.ExitResult {
+	lc := tr.Task().Lifecycle
+	if lc == nil || lc.PreStop == nil {
+		return nil
+	}
+	preStop := lc.PreStop
+
+	handle := tr.getDriverHandle()
+	if handle == nil {
+		return nil
+	}
+
+	timeout := preStop.Timeout
+	if timeout == 0 {
+		timeout = structs.DefaultPreStopTimeout
+	}
+
+	type execResult struct {
+		output []byte
+		code   int
+		err    error
+	}
+	execCh := make(chan execResult, 1)
+	go func() {
+		out, code, err := handle.Exec(timeout, preStop.Command, preStop.Args)
+		execCh <- execResult{out, code, err}
+	}()
+
+	tr.logger.Debug("running prestop command", "command", preStop.Command, "timeout", timeout)
+
+	event := structs.NewTaskEvent(structs.TaskPreStop)
+	select {
+	case result := <-resultCh:
+		return result
+	case <-tr.shutdownCtx.Done():
+		return nil
+	case <-time.After(timeout):
+		tr.logger.Warn("prestop command timed out", "timeout", timeout)
+		event.SetMessage(fmt.Sprintf("Prestop command timed out after %v", timeout))
+	case res := <-execCh:
+		switch {
+		case res.err != nil:
+			tr.logger.Warn("prestop command failed", "error", res.err)
+			event.SetMessage(fmt.Sprintf("Prestop command failed: %v", res.err))
+		case res.code != 0:
+			tr.logger.Warn("prestop command exited non-zero", "exit_code", res.code)
+			event.SetExitCode(res.code).
+				SetMessage(fmt.Sprintf("Prestop command exited with code %d: %s",
+					res.code, truncatePreStopOutput(res.output)))
+		default:
+			event.SetExitCode(0).
+				SetMessage(fmt.Sprintf("Prestop command completed: %s",
+					truncatePreStopOutput(res.output)))
+		}
+	}
+
+	tr.EmitEvent(event)
+	return nil
+}
+
+// truncatePreStopOutput trims prestop command output so it fits in a task
+// event.
+func truncatePreStopOutput(out []byte) string {
+	s := strings.TrimSpace(string(out))
+	if len(s) > preStopOutputLimit {
+		s = s[:preStopOutputLimit] + "..."
+	}
+	return s
+}
+
 // killTask kills the task handle. In the case that killing fails,
 // killTask will retry with an exponential backoff and will give up at a
 // given limit. Returns an error if the task could not be killed.
@@ -1209,7 +1387,7 @@ func (tr *TaskRunner) restoreHandle(taskHandle *drivers.TaskHandle, net *drivers
 	}
 
 	if err := tr.driver.RecoverTask(taskHandle); err != nil {
-		if tr.TaskState().State != structs.TaskStateRunning {
+		if state := tr.TaskState().State; state != structs.TaskStateRunning && state != structs.TaskStatePaused {
 			// RecoverTask should fail if the Task wasn't running
 			return true
 		}
@@ -1281,7 +1459,7 @@ func (tr *TaskRunner) updateStateImpl(state string) error {
 	switch state {
 	case structs.TaskStateRunning:
 		// Capture the start time if it is just starting
-		if oldState != structs.TaskStateRunning {
+		if oldState != structs.TaskStateRunning && oldState != structs.TaskStatePaused {
 			taskState.StartedAt = time.Now().UTC()
 			metrics.IncrCounterWithLabels([]string{"client", "allocs", "running"}, 1, tr.baseLabels)
 		}
@@ -1358,6 +1536,9 @@ func (tr *TaskRunner) appendEvent(event *structs.TaskEvent) error {
 		metrics.IncrCounterWithLabels([]string{"client", "allocs", "restart"}, 1, tr.baseLabels)
 		tr.state.Restarts++
 		tr.state.LastRestart = time.Unix(0, event.Time)
+		tr.state.NextRestart = tr.state.LastRestart.Add(time.Duration(event.StartDelay))
+	} else if event.Type == structs.TaskStarted {
+		tr.state.NextRestart = time.Time{}
 	}
 
 	// Append event to slice
@@ -1453,6 +1634,10 @@ func (tr *TaskRunner) LatestResourceUsage() *cstructs.TaskResourceUsage {
 
 // UpdateStats updates and emits the latest stats from the driver.
 func (tr *TaskRunner) UpdateStats(ru *cstructs.TaskResourceUsage) {
+	if ru != nil {
+		redactProcessArgs(ru.Processes, tr.clientConfig.RedactProcessArgs)
+	}
+
 	tr.resourceUsageLock.Lock()
 	tr.resourceUsage = ru
 	tr.resourceUsageLock.Unlock()
@@ -1461,6 +1646,52 @@ func (tr *TaskRunner) UpdateStats(ru *cstructs.TaskResourceUsage) {
 	}
 }
 
+// redactedArg replaces the redacted values of process arguments.
+const redactedArg = "<redacted>"
+
+// redactProcessArgs redacts the values of the process arguments matching one
+// of the patterns. The value of a "key=value" argument is redacted in place,
+// while the argument following a matching "-flag" is assumed to be its value.
+// Any other matching argument is redacted entirely. The executable name is
+// never redacted.
+func redactProcessArgs(processes map[string]*cstructs.ProcessInfo, patterns []*regexp.Regexp) {
+	if len(patterns) == 0 {
+		return
+	}
+
+	matches := func(arg string) bool {
+		for _, re := range patterns {
+			if re.MatchString(arg) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, p := range processes {
+		if p == nil {
+			continue
+		}
+		for i := 1; i < len(p.Cmdline); i++ {
+			arg := p.Cmdline[i]
+			if !matches(arg) {
+				continue
+			}
+			switch {
+			case strings.Contains(arg, "="):
+				p.Cmdline[i] = arg[:strings.Index(arg, "=")+1] + redactedArg
+			case strings.HasPrefix(arg, "-"):
+				if i+1 < len(p.Cmdline) && !strings.HasPrefix(p.Cmdline[i+1], "-") {
+					i++
+					p.Cmdline[i] = redactedArg
+				}
+			default:
+				p.Cmdline[i] = redactedArg
+			}
+		}
+	}
+}
+
 // TODO Remove Backwardscompat or use tr.Alloc()?
 func (tr *TaskRunner) setGaugeForMemory(ru *cstructs.TaskResourceUsage) {
 	alloc := tr.Alloc()
@@ -38,6 +38,13 @@ func (tr *TaskRunner) IsSidecarTask() bool {
 	return tr.Task().Lifecycle != nil && tr.Task().Lifecycle.Sidecar
 }
 
+// SetAllocExitStatus exposes how the allocation's other tasks ended to this
+// task's environment. It must be called before a poststop task is allowed to
+// start.
+func (tr *TaskRunner) SetAllocExitStatus(status int, failedTasks []string) {
+	tr.envBuilder.SetAllocExitStatus(status, failedTasks)
+}
+
 func (tr *TaskRunner) Task() *structs.Task {
 	tr.taskLock.RLock()
 	defer tr.taskLock.RUnlock()
@@ -26,6 +26,7 @@ import (
 	regMock "github.com/hashicorp/nomad/client/serviceregistration/mock"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
 	cstate "github.com/hashicorp/nomad/client/state"
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	ctestutil "github.com/hashicorp/nomad/client/testutil"
 	"github.com/hashicorp/nomad/client/vaultclient"
 	agentconsul "github.com/hashicorp/nomad/command/agent/consul"
@@ -1133,6 +1134,108 @@ WAIT:
 	}
 }
 
+// TestTaskRunner_PreStop asserts the prestop command is run through the driver
+// before the task is killed and its output is recorded as a task event.
+func TestTaskRunner_PreStop(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "1000s",
+	}
+	task.Lifecycle = &structs.TaskLifecycleConfig{
+		PreStop: &structs.TaskPreStopConfig{
+			Command: "/bin/drain",
+			Args:    []string{"-wait"},
+			Timeout: 5 * time.Second,
+		},
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+
+	testWaitForTaskToStart(t, tr)
+
+	require.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("test")))
+
+	select {
+	case <-tr.WaitCh():
+	case <-time.After(time.Duration(testutil.TestMultiplier()*15) * time.Second):
+		t.Fatalf("timeout")
+	}
+
+	state := tr.TaskState()
+	var preStop, killing int
+	for i, e := range state.Events {
+		switch e.Type {
+		case structs.TaskPreStop:
+			preStop = i
+			require.Equal(t, 0, e.ExitCode)
+			require.Contains(t, e.Message, `Exec("web", ["/bin/drain" "-wait"])`)
+		case structs.TaskKilling:
+			killing = i
+		}
+	}
+	require.NotZero(t, preStop, "expected prestop event: %s", pretty.Sprint(state.Events))
+	require.Less(t, killing, preStop, "prestop must run after the killing event")
+}
+
+// TestTaskRunner_ShouldCheckpoint asserts tasks are only checkpointed when
+// their allocation migrates with its ephemeral disk on a driver supporting
+// it.
+func TestTaskRunner_ShouldCheckpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+
+	conf, cleanup := testTaskRunnerConfig(t, alloc, task.Name)
+	defer cleanup()
+
+	tr, err := NewTaskRunner(conf)
+	require.NoError(t, err)
+
+	setup := func(checkpoint, migrate, diskMigrate bool) {
+		a := alloc.Copy()
+		a.DesiredTransition.Migrate = pointer.Of(migrate)
+		a.Job.TaskGroups[0].EphemeralDisk.Migrate = diskMigrate
+		tr.setAlloc(a, tr.Task())
+		tr.driverCapabilities = &drivers.Capabilities{Checkpoint: checkpoint}
+	}
+
+	setup(true, true, true)
+	require.True(t, tr.shouldCheckpoint())
+
+	setup(false, true, true)
+	require.False(t, tr.shouldCheckpoint(), "driver without checkpoint support")
+
+	setup(true, false, true)
+	require.False(t, tr.shouldCheckpoint(), "allocation not migrating")
+
+	setup(true, true, false)
+	require.False(t, tr.shouldCheckpoint(), "ephemeral disk not migrated")
+}
+
+func TestTaskRunner_RedactProcessArgs(t *testing.T) {
+	ci.Parallel(t)
+
+	processes := map[string]*cstructs.ProcessInfo{
+		"1": {Cmdline: []string{"/bin/app", "-password=hunter2", "--token", "abc", "-v"}},
+		"2": {Cmdline: []string{"/bin/env", "AWS_SECRET_ACCESS_KEY=abc", "my-secret-value"}},
+		"3": {Cmdline: []string{"/bin/secret-manager", "--api-key", "-x"}},
+		"4": {},
+	}
+	redactProcessArgs(processes, config.DefaultRedactProcessArgs)
+
+	require.Equal(t, []string{"/bin/app", "-password=<redacted>", "--token", "<redacted>", "-v"}, processes["1"].Cmdline)
+	require.Equal(t, []string{"/bin/env", "AWS_SECRET_ACCESS_KEY=<redacted>", "<redacted>"}, processes["2"].Cmdline)
+	require.Equal(t, []string{"/bin/secret-manager", "--api-key", "-x"}, processes["3"].Cmdline)
+	require.Empty(t, processes["4"].Cmdline)
+}
+
 // TestTaskRunner_NoShutdownDelay asserts services are removed from
 // Consul and tasks are killed without waiting for ${shutdown_delay}
 // when the alloc has the NoShutdownDelay transition flag set.
@@ -1277,6 +1380,55 @@ func TestTaskRunner_SignalFailure(t *testing.T) {
 	require.EqualError(t, tr.Signal(&structs.TaskEvent{}, "SIGINT"), errMsg)
 }
 
+// TestTaskRunner_PauseResume asserts that running tasks can be paused and
+// resumed, and that paused tasks can be killed.
+func TestTaskRunner_PauseResume(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.Alloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10m",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+
+	testWaitForTaskToStart(t, tr)
+	startedAt := tr.TaskState().StartedAt
+
+	// Only paused tasks can be resumed
+	require.Equal(t, ErrTaskNotPaused, tr.Resume(structs.NewTaskEvent(structs.TaskResumed)))
+
+	require.NoError(t, tr.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+	state := tr.TaskState()
+	require.Equal(t, structs.TaskStatePaused, state.State)
+	require.Equal(t, structs.TaskPaused, state.Events[len(state.Events)-1].Type)
+	status, err := tr.driver.InspectTask(tr.getDriverHandle().ID())
+	require.NoError(t, err)
+	require.Equal(t, "true", status.DriverAttributes["paused"])
+
+	// Only running tasks can be paused
+	require.Equal(t, ErrTaskNotRunning, tr.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+
+	// Resuming keeps the start time of the task
+	require.NoError(t, tr.Resume(structs.NewTaskEvent(structs.TaskResumed)))
+	state = tr.TaskState()
+	require.Equal(t, structs.TaskStateRunning, state.State)
+	require.Equal(t, structs.TaskResumed, state.Events[len(state.Events)-1].Type)
+	require.Equal(t, startedAt, state.StartedAt)
+	status, err = tr.driver.InspectTask(tr.getDriverHandle().ID())
+	require.NoError(t, err)
+	require.Empty(t, status.DriverAttributes["paused"])
+
+	// Paused tasks can be killed
+	require.NoError(t, tr.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+	require.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent(structs.TaskKilling)))
+	require.Equal(t, structs.TaskStateDead, tr.TaskState().State)
+	require.Equal(t, ErrTaskNotRunning, tr.Pause(structs.NewTaskEvent(structs.TaskPaused)))
+}
+
 // TestTaskRunner_RestartTask asserts that restarting a task works and emits a
 // Restarting event.
 func TestTaskRunner_RestartTask(t *testing.T) {
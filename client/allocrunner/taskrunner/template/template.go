@@ -41,6 +41,7 @@ const (
 var (
 	sourceEscapesErr = errors.New("template source path escapes alloc directory")
 	destEscapesErr   = errors.New("template destination path escapes alloc directory")
+	sourceCycleErr   = errors.New("template source depends on its own destination")
 )
 
 // TaskTemplateManager is used to run a set of templates for a given task
@@ -48,11 +49,27 @@ type TaskTemplateManager struct {
 	// config holds the template managers configuration
 	config *TaskTemplateManagerConfig
 
-	// lookup allows looking up the set of Nomad templates by their consul-template ID
-	lookup map[string][]*structs.Template
+	// runners are the consul-template runners of the templates. The first
+	// runner renders every template which isn't chained, and every chained
+	// template has its own runner.
+	runners []*stageRunner
 
-	// runner is the consul-template runner
-	runner *manager.Runner
+	// chained maps a chained template to its runner, once it is started
+	chained map[*structs.Template]*stageRunner
+
+	// stages groups the templates by the order in which they must first be
+	// rendered. A template whose source is the destination of another
+	// template is rendered in a later stage than the template it depends on.
+	stages [][]*structs.Template
+
+	// upstream maps a chained template to the template rendering its source
+	upstream map[*structs.Template]*structs.Template
+
+	// renderedCh, renderEventCh and errCh receive the template rendered
+	// notifications, render event notifications and errors of all runners
+	renderedCh    chan struct{}
+	renderEventCh chan struct{}
+	errCh         chan error
 
 	// handle is used to execute scripts
 	handle     interfaces.ScriptExecutor
@@ -70,6 +87,32 @@ type TaskTemplateManager struct {
 	shutdownLock sync.Mutex
 }
 
+// stageRunner is a consul-template runner for a subset of the templates of a
+// task. Chained templates only read their source when their runner is
+// created, so each chained template has its own runner which is recreated
+// when its upstream template renders new content, without restarting the
+// runners of the other templates.
+type stageRunner struct {
+	runner *manager.Runner
+
+	// lookup allows looking up the set of Nomad templates by their consul-template ID
+	lookup map[string][]*structs.Template
+
+	// created is when the runner was created, and so when the templates of
+	// the runner read their source
+	created time.Time
+
+	// stopCh stops forwarding the notifications of the runner
+	stopCh chan struct{}
+}
+
+// renderKey identifies a template of a runner by its consul-template ID.
+// Templates with the same content have the same ID in different runners.
+type renderKey struct {
+	runner *stageRunner
+	id     string
+}
+
 // TaskTemplateManagerConfig is used to configure an instance of the
 // TaskTemplateManager
 type TaskTemplateManagerConfig struct {
@@ -144,8 +187,12 @@ func NewTaskTemplateManager(config *TaskTemplateManagerConfig) (*TaskTemplateMan
 	}
 
 	tm := &TaskTemplateManager{
-		config:     config,
-		shutdownCh: make(chan struct{}),
+		config:        config,
+		chained:       make(map[*structs.Template]*stageRunner),
+		renderedCh:    make(chan struct{}, 1),
+		renderEventCh: make(chan struct{}, 1),
+		errCh:         make(chan error),
+		shutdownCh:    make(chan struct{}),
 	}
 
 	// Parse the signals that we need
@@ -166,13 +213,23 @@ func NewTaskTemplateManager(config *TaskTemplateManagerConfig) (*TaskTemplateMan
 		tm.signals[tmpl.ChangeSignal] = sig
 	}
 
-	// Build the consul-template runner
-	runner, lookup, err := templateRunner(config)
+	// Order the templates so chained templates are created once their source
+	// has been rendered
+	stages, upstream, err := templateStages(config)
 	if err != nil {
 		return nil, err
 	}
-	tm.runner = runner
-	tm.lookup = lookup
+	tm.stages = stages
+	tm.upstream = upstream
+
+	// Build the consul-template runner for the templates which aren't chained
+	if len(stages) != 0 {
+		runner, err := tm.newStageRunner(stages[0])
+		if err != nil {
+			return nil, err
+		}
+		tm.runners = append(tm.runners, runner)
+	}
 
 	go tm.run()
 	return tm, nil
@@ -190,9 +247,9 @@ func (tm *TaskTemplateManager) Stop() {
 	close(tm.shutdownCh)
 	tm.shutdown = true
 
-	// Stop the consul-template runner
-	if tm.runner != nil {
-		tm.runner.Stop()
+	// Stop the consul-template runners
+	for _, r := range tm.runners {
+		r.runner.Stop()
 	}
 }
 
@@ -206,18 +263,59 @@ func (tm *TaskTemplateManager) SetDriverHandle(executor interfaces.ScriptExecuto
 
 // run is the long lived loop that handles errors and templates being rendered
 func (tm *TaskTemplateManager) run() {
-	// Runner is nil if there are no templates
-	if tm.runner == nil {
+	// There are no runners if there are no templates
+	if len(tm.runners) == 0 {
 		// Unblock the start if there is nothing to do
 		close(tm.config.UnblockCh)
 		return
 	}
 
 	// Start the runner
-	go tm.runner.Start()
+	tm.startRunner(tm.runners[0], nil)
+
+	// Block till all the templates have been rendered, one stage at a time
+	dirty := tm.handleFirstRender(tm.runners)
+	for i := 1; i < len(tm.stages); i++ {
+		select {
+		case <-tm.shutdownCh:
+			return
+		default:
+		}
+
+		pending, err := tm.startStage(i)
+		if err != nil {
+			tm.config.Lifecycle.Kill(context.Background(),
+				structs.NewTaskEvent(structs.TaskKilling).
+					SetFailsTask().
+					SetDisplayMessage(fmt.Sprintf("Template failed: %v", err)))
+			return
+		}
+		dirty = tm.handleFirstRender(pending) || dirty
+	}
+
+	// Upstream templates may have rendered new content while the templates
+	// chained to them were first rendered
+	for {
+		refreshed, err := tm.refreshChained()
+		if err != nil {
+			tm.config.Lifecycle.Kill(context.Background(),
+				structs.NewTaskEvent(structs.TaskKilling).
+					SetFailsTask().
+					SetDisplayMessage(fmt.Sprintf("Template failed: %v", err)))
+			return
+		}
+		if len(refreshed) == 0 {
+			break
+		}
+		dirty = tm.handleFirstRender(refreshed) || dirty
+	}
 
-	// Block till all the templates have been rendered
-	tm.handleFirstRender()
+	// if there's a driver handle then the task is already running and
+	// that changes how we want to behave on first render
+	if dirty && tm.config.Lifecycle.IsRunning() {
+		handledRenders := make(map[renderKey]time.Time, len(tm.config.Templates))
+		tm.onTemplateRendered(handledRenders, time.Time{})
+	}
 
 	// Detect if there was a shutdown.
 	select {
@@ -241,7 +339,9 @@ func (tm *TaskTemplateManager) run() {
 	close(tm.config.UnblockCh)
 
 	// If all our templates are change mode no-op, then we can exit here
-	if tm.allTemplatesNoop() {
+	// unless chained templates must be rendered again when their upstream
+	// template changes
+	if tm.allTemplatesNoop() && len(tm.stages) <= 1 {
 		return
 	}
 
@@ -249,8 +349,9 @@ func (tm *TaskTemplateManager) run() {
 	tm.handleTemplateRerenders(time.Now())
 }
 
-// handleFirstRender blocks till all templates have been rendered
-func (tm *TaskTemplateManager) handleFirstRender() {
+// handleFirstRender blocks till all templates of the pending runners have
+// been rendered. It returns whether any of them rendered new content.
+func (tm *TaskTemplateManager) handleFirstRender(pending []*stageRunner) bool {
 	// missingDependencies is the set of missing dependencies.
 	var missingDependencies map[string]struct{}
 
@@ -270,55 +371,47 @@ WAIT:
 	for {
 		select {
 		case <-tm.shutdownCh:
-			return
-		case err, ok := <-tm.runner.ErrCh:
-			if !ok {
-				continue
-			}
-
+			return false
+		case err := <-tm.errCh:
 			tm.config.Lifecycle.Kill(context.Background(),
 				structs.NewTaskEvent(structs.TaskKilling).
 					SetFailsTask().
 					SetDisplayMessage(fmt.Sprintf("Template failed: %v", err)))
-		case <-tm.runner.TemplateRenderedCh():
+		case <-tm.renderedCh:
 			// A template has been rendered, figure out what to do
-			events := tm.runner.RenderEvents()
-
-			// Not all templates have been rendered yet
-			if len(events) < len(tm.lookup) {
-				continue
-			}
-
 			dirty := false
-			for _, event := range events {
-				// This template hasn't been rendered
-				if event.LastWouldRender.IsZero() {
+			for _, r := range pending {
+				events := r.runner.RenderEvents()
+
+				// Not all templates have been rendered yet
+				if len(events) < len(r.lookup) {
 					continue WAIT
 				}
-				if event.WouldRender && event.DidRender {
-					dirty = true
-				}
-			}
 
-			// if there's a driver handle then the task is already running and
-			// that changes how we want to behave on first render
-			if dirty && tm.config.Lifecycle.IsRunning() {
-				handledRenders := make(map[string]time.Time, len(tm.config.Templates))
-				tm.onTemplateRendered(handledRenders, time.Time{})
+				for _, event := range events {
+					// This template hasn't been rendered
+					if event.LastWouldRender.IsZero() {
+						continue WAIT
+					}
+					if event.WouldRender && event.DidRender {
+						dirty = true
+					}
+				}
 			}
 
-			break WAIT
-		case <-tm.runner.RenderEventCh():
-			events := tm.runner.RenderEvents()
+			return dirty
+		case <-tm.renderEventCh:
 			joinedSet := make(map[string]struct{})
-			for _, event := range events {
-				missing := event.MissingDeps
-				if missing == nil {
-					continue
-				}
+			for _, r := range pending {
+				for _, event := range r.runner.RenderEvents() {
+					missing := event.MissingDeps
+					if missing == nil {
+						continue
+					}
 
-				for _, dep := range missing.List() {
-					joinedSet[dep.String()] = struct{}{}
+					for _, dep := range missing.List() {
+						joinedSet[dep.String()] = struct{}{}
+					}
 				}
 			}
 
@@ -379,56 +472,74 @@ WAIT:
 // This is used to avoid signaling the task for any render event before hand.
 func (tm *TaskTemplateManager) handleTemplateRerenders(allRenderedTime time.Time) {
 	// A lookup for the last time the template was handled
-	handledRenders := make(map[string]time.Time, len(tm.config.Templates))
+	handledRenders := make(map[renderKey]time.Time, len(tm.config.Templates))
 
 	for {
 		select {
 		case <-tm.shutdownCh:
 			return
-		case err, ok := <-tm.runner.ErrCh:
-			if !ok {
-				continue
-			}
-
+		case err := <-tm.errCh:
 			tm.config.Lifecycle.Kill(context.Background(),
 				structs.NewTaskEvent(structs.TaskKilling).
 					SetFailsTask().
 					SetDisplayMessage(fmt.Sprintf("Template failed: %v", err)))
-		case <-tm.runner.TemplateRenderedCh():
+		case <-tm.renderedCh:
 			tm.onTemplateRendered(handledRenders, allRenderedTime)
+
+			// Render chained templates again if their source changed
+			if _, err := tm.refreshChained(); err != nil {
+				tm.config.Lifecycle.Kill(context.Background(),
+					structs.NewTaskEvent(structs.TaskKilling).
+						SetFailsTask().
+						SetDisplayMessage(fmt.Sprintf("Template failed: %v", err)))
+				return
+			}
 		}
 	}
 }
 
-func (tm *TaskTemplateManager) onTemplateRendered(handledRenders map[string]time.Time, allRenderedTime time.Time) {
+func (tm *TaskTemplateManager) onTemplateRendered(handledRenders map[renderKey]time.Time, allRenderedTime time.Time) {
 
-	var handling []string
+	var handling []renderKey
 	signals := make(map[string]struct{})
 	scripts := []*structs.ChangeScript{}
 	restart := false
 	var splay time.Duration
 
-	events := tm.runner.RenderEvents()
-	for id, event := range events {
+	events := make(map[renderKey]*manager.RenderEvent)
+	for _, r := range tm.runners {
+		for id, event := range r.runner.RenderEvents() {
+			events[renderKey{runner: r, id: id}] = event
+		}
+	}
+
+	// Forget the templates of runners which have been replaced
+	for key := range handledRenders {
+		if _, ok := events[key]; !ok {
+			delete(handledRenders, key)
+		}
+	}
+
+	for key, event := range events {
 
 		// First time through
 		if allRenderedTime.After(event.LastDidRender) || allRenderedTime.Equal(event.LastDidRender) {
-			handledRenders[id] = allRenderedTime
+			handledRenders[key] = allRenderedTime
 			continue
 		}
 
 		// We have already handled this one
-		if htime := handledRenders[id]; htime.After(event.LastDidRender) || htime.Equal(event.LastDidRender) {
+		if htime := handledRenders[key]; htime.After(event.LastDidRender) || htime.Equal(event.LastDidRender) {
 			continue
 		}
 
 		// Lookup the template and determine what to do
-		tmpls, ok := tm.lookup[id]
+		tmpls, ok := key.runner.lookup[key.id]
 		if !ok {
 			tm.config.Lifecycle.Kill(context.Background(),
 				structs.NewTaskEvent(structs.TaskKilling).
 					SetFailsTask().
-					SetDisplayMessage(fmt.Sprintf("Template runner returned unknown template id %q", id)))
+					SetDisplayMessage(fmt.Sprintf("Template runner returned unknown template id %q", key.id)))
 			return
 		}
 
@@ -460,7 +571,7 @@ func (tm *TaskTemplateManager) onTemplateRendered(handledRenders map[string]time
 			}
 		}
 
-		handling = append(handling, id)
+		handling = append(handling, key)
 	}
 
 	if restart || len(signals) != 0 {
@@ -477,8 +588,8 @@ func (tm *TaskTemplateManager) onTemplateRendered(handledRenders map[string]time
 		}
 
 		// Update handle time
-		for _, id := range handling {
-			handledRenders[id] = events[id].LastDidRender
+		for _, key := range handling {
+			handledRenders[key] = events[key].LastDidRender
 		}
 
 		if restart {
@@ -518,6 +629,162 @@ func (tm *TaskTemplateManager) onTemplateRendered(handledRenders map[string]time
 	wg.Wait()
 }
 
+// startStage creates and starts a runner for each template of the given
+// stage. Templates only read their source when their runner is created, so
+// this is done once the templates of the previous stage have been rendered.
+func (tm *TaskTemplateManager) startStage(stage int) ([]*stageRunner, error) {
+	var started []*stageRunner
+	for _, tmpl := range tm.stages[stage] {
+		r, err := tm.newStageRunner([]*structs.Template{tmpl})
+		if err != nil {
+			return nil, err
+		}
+		if !tm.startRunner(r, nil) {
+			return started, nil
+		}
+		tm.chained[tmpl] = r
+		started = append(started, r)
+	}
+	return started, nil
+}
+
+// refreshChained replaces the runner of every chained template whose upstream
+// template rendered after the runner was created, so that it renders the new
+// source. It returns the runners which have been started.
+func (tm *TaskTemplateManager) refreshChained() ([]*stageRunner, error) {
+	if len(tm.stages) <= 1 {
+		return nil, nil
+	}
+
+	var refreshed []*stageRunner
+	for _, stage := range tm.stages[1:] {
+		for _, tmpl := range stage {
+			old, ok := tm.chained[tmpl]
+			if !ok || !tm.lastDidRender(tm.upstream[tmpl]).After(old.created) {
+				continue
+			}
+
+			r, err := tm.newStageRunner([]*structs.Template{tmpl})
+			if err != nil {
+				return nil, err
+			}
+			if !tm.startRunner(r, old) {
+				return nil, nil
+			}
+			tm.chained[tmpl] = r
+			refreshed = append(refreshed, r)
+		}
+	}
+	return refreshed, nil
+}
+
+// lastDidRender returns when the given template last rendered new content.
+func (tm *TaskTemplateManager) lastDidRender(tmpl *structs.Template) time.Time {
+	r, ok := tm.chained[tmpl]
+	if !ok {
+		r = tm.runners[0]
+	}
+
+	events := r.runner.RenderEvents()
+	for id, tmpls := range r.lookup {
+		for _, t := range tmpls {
+			if event, ok := events[id]; ok && t == tmpl {
+				return event.LastDidRender
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// newStageRunner returns a runner for the given templates.
+func (tm *TaskTemplateManager) newStageRunner(templates []*structs.Template) (*stageRunner, error) {
+	config := *tm.config
+	config.Templates = templates
+
+	// The source of the templates is read when the runner is created
+	created := time.Now()
+	runner, lookup, err := templateRunner(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stageRunner{
+		runner:  runner,
+		lookup:  lookup,
+		created: created,
+		stopCh:  make(chan struct{}),
+	}, nil
+}
+
+// startRunner starts the given runner in place of the old runner, if any. It
+// returns false without starting the runner if the manager has been stopped.
+func (tm *TaskTemplateManager) startRunner(r, old *stageRunner) bool {
+	tm.shutdownLock.Lock()
+	defer tm.shutdownLock.Unlock()
+
+	if tm.shutdown {
+		r.runner.Stop()
+		return false
+	}
+
+	found := false
+	for i, cur := range tm.runners {
+		if cur == r || cur == old {
+			tm.runners[i] = r
+			found = true
+		}
+	}
+	if !found {
+		tm.runners = append(tm.runners, r)
+	}
+
+	if old != nil {
+		old.runner.Stop()
+		close(old.stopCh)
+	}
+
+	go r.runner.Start()
+	go tm.forward(r)
+	return true
+}
+
+// forward passes the notifications and errors of a runner to the manager
+// until the runner is replaced or the manager is stopped.
+func (tm *TaskTemplateManager) forward(r *stageRunner) {
+	errCh := r.runner.ErrCh
+	for {
+		select {
+		case <-tm.shutdownCh:
+			return
+		case <-r.stopCh:
+			return
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+
+			select {
+			case tm.errCh <- err:
+			case <-r.stopCh:
+				return
+			case <-tm.shutdownCh:
+				return
+			}
+		case <-r.runner.TemplateRenderedCh():
+			select {
+			case tm.renderedCh <- struct{}{}:
+			default:
+			}
+		case <-r.runner.RenderEventCh():
+			select {
+			case tm.renderEventCh <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
 // handleScriptError is a helper function that produces a TaskKilling event and
 // emits a message
 func (tm *TaskTemplateManager) handleScriptError(script *structs.ChangeScript, msg string) {
@@ -588,6 +855,55 @@ func (tm *TaskTemplateManager) allTemplatesNoop() bool {
 	return true
 }
 
+// templateStages groups the templates by the order in which they have to be
+// rendered, so that a template sourcing the destination of another template is
+// only created once that destination has been rendered. It also returns the
+// template rendering the source of each chained template.
+func templateStages(config *TaskTemplateManagerConfig) (
+	[][]*structs.Template, map[*structs.Template]*structs.Template, error) {
+
+	taskEnv := config.EnvBuilder.Build()
+
+	destinations := make(map[string]*structs.Template, len(config.Templates))
+	for _, tmpl := range config.Templates {
+		if tmpl.DestPath == "" {
+			continue
+		}
+		dest, _ := taskEnv.ClientPath(tmpl.DestPath, true)
+		destinations[dest] = tmpl
+	}
+
+	// deps maps a template to the template rendering its source
+	deps := make(map[*structs.Template]*structs.Template)
+	for _, tmpl := range config.Templates {
+		if tmpl.SourcePath == "" {
+			continue
+		}
+		src, _ := taskEnv.ClientPath(tmpl.SourcePath, false)
+		if dep, ok := destinations[src]; ok {
+			deps[tmpl] = dep
+		}
+	}
+
+	var stages [][]*structs.Template
+	for _, tmpl := range config.Templates {
+		depth := 0
+		for dep, ok := deps[tmpl]; ok; dep, ok = deps[dep] {
+			depth++
+			if depth > len(config.Templates) {
+				return nil, nil, sourceCycleErr
+			}
+		}
+
+		for len(stages) <= depth {
+			stages = append(stages, nil)
+		}
+		stages[depth] = append(stages[depth], tmpl)
+	}
+
+	return stages, deps, nil
+}
+
 // templateRunner returns a consul-template runner for the given templates and a
 // lookup by destination to the template. If no templates are in the config, a
 // nil template runner and lookup is returned.
@@ -682,6 +998,7 @@ func parseTemplateConfigs(config *TaskTemplateManagerConfig) (map[*ctconf.Templa
 		ct.LeftDelim = &tmpl.LeftDelim
 		ct.RightDelim = &tmpl.RightDelim
 		ct.FunctionDenylist = config.ClientConfig.TemplateConfig.FunctionDenylist
+		ct.ErrMissingKey = pointer.Of(tmpl.ErrMissingKey)
 		if sandboxEnabled {
 			ct.SandboxPath = &config.TaskDir
 		}
@@ -2439,3 +2439,166 @@ func TestTaskTemplateManager_writeToFile(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "hello", string(r))
 }
+
+// TestTaskTemplateManager_Chained asserts a template can use the destination of
+// another template as its source, and is rendered from the first render of
+// that template.
+func TestTaskTemplateManager_Chained(t *testing.T) {
+	ci.Parallel(t)
+
+	// The downstream template is listed first to assert the ordering
+	downstream := &structs.Template{
+		SourcePath: "local/app.conf.tpl",
+		DestPath:   "local/app.conf",
+		ChangeMode: structs.TemplateChangeModeRestart,
+	}
+	upstream := &structs.Template{
+		// EmbeddedTmpl set below as it needs the taskDir
+		DestPath:   "local/app.conf.tpl",
+		ChangeMode: structs.TemplateChangeModeNoop,
+	}
+
+	harness := newTestHarness(t, []*structs.Template{downstream, upstream}, false, false)
+
+	input := filepath.Join(harness.taskDir, "input")
+	must.NoError(t, os.WriteFile(input, []byte("first"), 0644))
+	upstream.EmbeddedTmpl = fmt.Sprintf(`{{ file %q }} {{ "{{ env \"NOMAD_TASK_NAME\" }}" }}`, input)
+
+	harness.start(t)
+	defer harness.stop()
+
+	select {
+	case <-harness.mockHooks.UnblockCh:
+	case e := <-harness.mockHooks.KillCh:
+		t.Fatalf("Task should not have been killed: %v", e.DisplayMessage)
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task unblock should have been called")
+	}
+
+	path := filepath.Join(harness.taskDir, "local/app.conf")
+	raw, err := os.ReadFile(path)
+	must.NoError(t, err)
+	must.Eq(t, "first "+TestTaskName, string(raw))
+
+	// Updating the upstream template re-renders the downstream one. The file
+	// dependency uses the read time in seconds as its index, so a change made
+	// within the same second as the first read would be dropped.
+	time.Sleep(time.Second)
+	must.NoError(t, os.WriteFile(input, []byte("second"), 0644))
+
+	select {
+	case <-harness.mockHooks.RestartCh:
+	case e := <-harness.mockHooks.KillCh:
+		t.Fatalf("Task should not have been killed: %v", e.DisplayMessage)
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task should have been restarted")
+	}
+
+	raw, err = os.ReadFile(path)
+	must.NoError(t, err)
+	must.Eq(t, "second "+TestTaskName, string(raw))
+}
+
+func TestTaskTemplateManager_Chained_Cascade(t *testing.T) {
+	ci.Parallel(t)
+
+	last := &structs.Template{
+		SourcePath: "local/b.tpl",
+		DestPath:   "local/c",
+		ChangeMode: structs.TemplateChangeModeRestart,
+	}
+	middle := &structs.Template{
+		SourcePath: "local/a.tpl",
+		DestPath:   "local/b.tpl",
+		ChangeMode: structs.TemplateChangeModeNoop,
+	}
+	first := &structs.Template{
+		// EmbeddedTmpl set below as it needs the taskDir
+		DestPath:   "local/a.tpl",
+		ChangeMode: structs.TemplateChangeModeNoop,
+	}
+
+	harness := newTestHarness(t, []*structs.Template{last, middle, first}, false, false)
+
+	input := filepath.Join(harness.taskDir, "input")
+	must.NoError(t, os.WriteFile(input, []byte("first"), 0644))
+	first.EmbeddedTmpl = fmt.Sprintf(`{{ file %q }} {{ "{{ \"{{ env \\\"NOMAD_TASK_NAME\\\" }}\" }}" }}`, input)
+
+	harness.start(t)
+	defer harness.stop()
+
+	select {
+	case <-harness.mockHooks.UnblockCh:
+	case e := <-harness.mockHooks.KillCh:
+		t.Fatalf("Task should not have been killed: %v", e.DisplayMessage)
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task unblock should have been called")
+	}
+
+	path := filepath.Join(harness.taskDir, "local/c")
+	raw, err := os.ReadFile(path)
+	must.NoError(t, err)
+	must.Eq(t, "first "+TestTaskName, string(raw))
+
+	// Updating the first template re-renders every template chained to it
+	time.Sleep(time.Second)
+	must.NoError(t, os.WriteFile(input, []byte("second"), 0644))
+
+	select {
+	case <-harness.mockHooks.RestartCh:
+	case e := <-harness.mockHooks.KillCh:
+		t.Fatalf("Task should not have been killed: %v", e.DisplayMessage)
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task should have been restarted")
+	}
+
+	raw, err = os.ReadFile(path)
+	must.NoError(t, err)
+	must.Eq(t, "second "+TestTaskName, string(raw))
+}
+
+func TestTaskTemplateManager_Chained_Cycle(t *testing.T) {
+	ci.Parallel(t)
+
+	templates := []*structs.Template{
+		{
+			SourcePath: "local/a",
+			DestPath:   "local/b",
+			ChangeMode: structs.TemplateChangeModeNoop,
+		},
+		{
+			SourcePath: "local/b",
+			DestPath:   "local/a",
+			ChangeMode: structs.TemplateChangeModeNoop,
+		},
+	}
+
+	harness := newTestHarness(t, templates, false, false)
+	must.ErrorIs(t, harness.startWithErr(), sourceCycleErr)
+}
+
+// TestTaskTemplateManager_ErrMissingKey asserts that a template with
+// error_on_missing_key fails the task instead of rendering "<no value>".
+func TestTaskTemplateManager_ErrMissingKey(t *testing.T) {
+	ci.Parallel(t)
+
+	template := &structs.Template{
+		EmbeddedTmpl:  `{{ $m := parseJSON "{\"a\": 1}" }}{{ $m.missing }}`,
+		DestPath:      "local/missing",
+		ChangeMode:    structs.TemplateChangeModeNoop,
+		ErrMissingKey: true,
+	}
+
+	harness := newTestHarness(t, []*structs.Template{template}, false, false)
+	harness.start(t)
+	defer harness.stop()
+
+	select {
+	case <-harness.mockHooks.UnblockCh:
+		t.Fatalf("Task unblock should not have been called")
+	case e := <-harness.mockHooks.KillCh:
+		must.StrContains(t, e.DisplayMessage, "map has no entry for key")
+	case <-time.After(time.Duration(5*testutil.TestMultiplier()) * time.Second):
+		t.Fatalf("Task should have been killed")
+	}
+}
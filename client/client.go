@@ -155,6 +155,7 @@ type AllocRunner interface {
 	DestroyCh() <-chan struct{}
 	ShutdownCh() <-chan struct{}
 	Signal(taskName, signal string) error
+	Pause(taskName string, resume bool) error
 	GetTaskEventHandler(taskName string) drivermanager.EventHandler
 	PersistState() error
 
@@ -896,6 +897,18 @@ func (c *Client) SignalAllocation(allocID, task, signal string) error {
 	return ar.Signal(task, signal)
 }
 
+// PauseAllocation pauses the tasks within an allocation, or resumes them if
+// resume is set. If the provided task is empty, then every running task, or
+// paused task when resuming, will be affected.
+func (c *Client) PauseAllocation(allocID, task string, resume bool) error {
+	ar, err := c.getAllocRunner(allocID)
+	if err != nil {
+		return err
+	}
+
+	return ar.Pause(task, resume)
+}
+
 // CollectAllocation garbage collects a single allocation on a node. Returns
 // true if alloc was found and garbage collected; otherwise false.
 func (c *Client) CollectAllocation(allocID string) bool {
@@ -908,6 +921,41 @@ func (c *Client) CollectAllAllocs() {
 	c.garbageCollector.CollectAll()
 }
 
+// PinAllocation prevents the garbage collector from destroying an
+// allocation's directory, or removes a previous pin if unpin is set.
+func (c *Client) PinAllocation(allocID string, unpin bool) error {
+	if _, err := c.getPinnableAlloc(allocID); err != nil {
+		return err
+	}
+
+	if unpin {
+		c.garbageCollector.Unpin(allocID)
+	} else {
+		c.garbageCollector.Pin(allocID)
+	}
+	return nil
+}
+
+// getPinnableAlloc returns an allocation which can be pinned or unpinned,
+// either because the client runs it or because its directory is retained by
+// the garbage collector after the servers garbage collected it.
+func (c *Client) getPinnableAlloc(allocID string) (*structs.Allocation, error) {
+	alloc, err := c.GetAlloc(allocID)
+	if err == nil {
+		return alloc, nil
+	}
+	if alloc := c.garbageCollector.Alloc(allocID); alloc != nil {
+		return alloc, nil
+	}
+	return nil, err
+}
+
+// AllocGCStatus returns the terminal allocations whose directories have not
+// been garbage collected yet.
+func (c *Client) AllocGCStatus() []*cstructs.AllocGCStatus {
+	return c.garbageCollector.Status()
+}
+
 func (c *Client) RestartAllocation(allocID, taskName string, allTasks bool) error {
 	if allTasks && taskName != "" {
 		return fmt.Errorf("task name cannot be set when restarting all tasks")
@@ -2461,8 +2509,9 @@ func (c *Client) removeAlloc(allocID string) {
 	// applies rate limiting
 	c.garbageCollector.MarkForCollection(allocID, ar)
 
-	// GC immediately since the server has GC'd it
-	go c.garbageCollector.Collect(allocID)
+	// GC immediately since the server has GC'd it, unless the alloc is
+	// pinned or retained by its job's GC policy
+	go c.garbageCollector.Release(allocID)
 }
 
 // updateAlloc is invoked when we should update an allocation
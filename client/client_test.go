@@ -12,6 +12,7 @@ import (
 
 	memdb "github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocrunner"
 	trstate "github.com/hashicorp/nomad/client/allocrunner/taskrunner/state"
 	"github.com/hashicorp/nomad/client/config"
 	"github.com/hashicorp/nomad/client/fingerprint"
@@ -647,6 +648,46 @@ func TestClient_SaveRestoreState(t *testing.T) {
 	}
 }
 
+func TestClient_PinAllocation_ServerGC(t *testing.T) {
+	ci.Parallel(t)
+
+	c1, cleanup := TestClient(t, nil)
+	defer cleanup()
+
+	ar, cleanupAR := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanupAR()
+	allocID := ar.Alloc().ID
+
+	c1.allocLock.Lock()
+	c1.allocs[allocID] = ar
+	c1.allocLock.Unlock()
+
+	go ar.Run()
+	require.NoError(t, c1.PinAllocation(allocID, false))
+	exitAllocRunner(ar)
+
+	// Once the servers garbage collect the pinned alloc, only the garbage
+	// collector tracks it
+	c1.removeAlloc(allocID)
+	_, err := c1.GetAlloc(allocID)
+	require.True(t, structs.IsErrUnknownAllocation(err))
+	require.Eventually(t, func() bool {
+		pq := c1.garbageCollector.allocRunners
+		pq.pqLock.Lock()
+		defer pq.pqLock.Unlock()
+		return pq.pinned[allocID] != nil && pq.pinned[allocID].released
+	}, 5*time.Second, 10*time.Millisecond)
+	require.False(t, ar.IsDestroyed())
+
+	// It can still be unpinned, after which it is collected
+	require.NoError(t, c1.PinAllocation(allocID, true))
+	require.Eventually(t, ar.IsDestroyed, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, c1.AllocGCStatus())
+
+	err = c1.PinAllocation(allocID, true)
+	require.True(t, structs.IsErrUnknownAllocation(err))
+}
+
 func TestClient_AddAllocError(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
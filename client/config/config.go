@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -69,6 +70,12 @@ var (
 	DefaultTemplateMaxStale = 87600 * time.Hour
 
 	DefaultTemplateFunctionDenylist = []string{"plugin", "writeToFile"}
+
+	// DefaultRedactProcessArgs matches the process arguments that commonly
+	// carry credentials, whose values are redacted from process stats.
+	DefaultRedactProcessArgs = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(passw(or)?d|secret|token|credential|api[-_]?key|private[-_]?key)`),
+	}
 )
 
 // RPCHandler can be provided to the Client if there is a local server
@@ -223,6 +230,10 @@ type Config struct {
 	// TemplateConfig includes configuration for template rendering
 	TemplateConfig *ClientTemplateConfig
 
+	// RedactProcessArgs are the patterns of process arguments whose values
+	// are redacted from the process stats of tasks
+	RedactProcessArgs []*regexp.Regexp
+
 	// RPCHoldTimeout is how long an RPC can be "held" before it is errored.
 	// This is used to paper over a loss of leadership by instead holding RPCs,
 	// so that the caller experiences a slow response rather than an error.
@@ -713,6 +724,7 @@ func (c *Config) Copy() *Config {
 	nc.TemplateConfig = c.TemplateConfig.Copy()
 	nc.ReservableCores = slices.Clone(c.ReservableCores)
 	nc.Artifact = c.Artifact.Copy()
+	nc.RedactProcessArgs = slices.Clone(c.RedactProcessArgs)
 	return &nc
 }
 
@@ -734,6 +746,7 @@ func DefaultConfig() *Config {
 		GCMaxAllocs:             50,
 		NoHostUUID:              true,
 		DisableRemoteExec:       false,
+		RedactProcessArgs:       DefaultRedactProcessArgs,
 		TemplateConfig: &ClientTemplateConfig{
 			FunctionDenylist:   DefaultTemplateFunctionDenylist,
 			DisableSandbox:     false,
@@ -897,6 +910,7 @@ func (c *Config) NomadPluginConfig() *base.AgentConfig {
 		Driver: &base.ClientDriverConfig{
 			ClientMinPort: c.ClientMinPort,
 			ClientMaxPort: c.ClientMaxPort,
+			DataDir:       c.StateDir,
 		},
 	}
 }
@@ -3,11 +3,13 @@ package client
 import (
 	"container/heap"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/stats"
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -97,12 +99,27 @@ func (a *AllocGarbageCollector) Run() {
 			return
 		}
 
+		a.collectReleased()
+
 		if err := a.keepUsageBelowThreshold(); err != nil {
 			a.logger.Error("error garbage collecting allocations", "error", err)
 		}
 	}
 }
 
+// collectReleased destroys allocations that have already been garbage
+// collected by the servers once their job's retention window has passed.
+func (a *AllocGarbageCollector) collectReleased() {
+	for _, gcAlloc := range a.allocRunners.PopReleased(time.Now()) {
+		select {
+		case <-a.shutdownCh:
+			return
+		default:
+		}
+		a.destroyAllocRunner(gcAlloc.allocID, gcAlloc.allocRunner, "retention window expired")
+	}
+}
+
 // Trigger forces the garbage collector to run.
 func (a *AllocGarbageCollector) Trigger() {
 	select {
@@ -155,9 +172,9 @@ func (a *AllocGarbageCollector) keepUsageBelowThreshold() error {
 		}
 
 		// Collect an allocation
-		gcAlloc := a.allocRunners.Pop()
+		gcAlloc := a.allocRunners.PopEligible(time.Now())
 		if gcAlloc == nil {
-			logf("garbage collection skipped because no terminal allocations", "reason", reason)
+			logf("garbage collection skipped because no terminal allocations are eligible", "reason", reason)
 			break
 		}
 
@@ -187,6 +204,10 @@ func (a *AllocGarbageCollector) destroyAllocRunner(allocID string, ar AllocRunne
 	case <-a.shutdownCh:
 	}
 
+	// A pin added while the alloc was being collected would never be
+	// released otherwise
+	a.allocRunners.Untrack(allocID)
+
 	a.logger.Debug("alloc garbage collected", "alloc_id", allocID)
 
 	// Release the lock
@@ -197,7 +218,8 @@ func (a *AllocGarbageCollector) Stop() {
 	close(a.shutdownCh)
 }
 
-// Collect garbage collects a single allocation on a node. Returns true if
+// Collect garbage collects a single allocation on a node, ignoring any
+// retention policy. Pinned allocations are not collected. Returns true if
 // alloc was found and garbage collected; otherwise false.
 func (a *AllocGarbageCollector) Collect(allocID string) bool {
 	gcAlloc := a.allocRunners.Remove(allocID)
@@ -210,7 +232,84 @@ func (a *AllocGarbageCollector) Collect(allocID string) bool {
 	return true
 }
 
-// CollectAll garbage collects all terminated allocations on a node
+// Release is called when the servers have garbage collected an allocation.
+// The allocation is destroyed immediately unless it is pinned or its job's
+// retention window has not yet passed, in which case it is destroyed by the
+// periodic garbage collector once it becomes eligible.
+func (a *AllocGarbageCollector) Release(allocID string) {
+	if a.allocRunners.Release(allocID, time.Now()) {
+		a.logger.Debug("retaining allocation released by servers", "alloc_id", allocID)
+		return
+	}
+
+	a.Collect(allocID)
+}
+
+// Pin prevents an allocation from being garbage collected until it is
+// unpinned. Allocations may be pinned before they become terminal. Pins are
+// held in memory and do not survive a client restart.
+func (a *AllocGarbageCollector) Pin(allocID string) {
+	if a.allocRunners.Pin(allocID) {
+		a.logger.Info("pinned allocation", "alloc_id", allocID)
+	}
+}
+
+// Unpin makes a previously pinned allocation eligible for garbage collection
+// again.
+func (a *AllocGarbageCollector) Unpin(allocID string) {
+	if a.allocRunners.Unpin(allocID) {
+		a.logger.Info("unpinned allocation", "alloc_id", allocID)
+		a.Trigger()
+	}
+}
+
+// Alloc returns the allocation of an alloc runner tracked by the garbage
+// collector, or nil if it isn't tracked. Allocations which the servers have
+// garbage collected are only known to the garbage collector while they are
+// retained.
+func (a *AllocGarbageCollector) Alloc(allocID string) *structs.Allocation {
+	ar := a.allocRunners.Get(allocID)
+	if ar == nil {
+		return nil
+	}
+	return ar.Alloc()
+}
+
+// Status returns the terminal allocations that are tracked by the garbage
+// collector and whose directories are still on disk.
+func (a *AllocGarbageCollector) Status() []*cstructs.AllocGCStatus {
+	tracked := a.allocRunners.List()
+	out := make([]*cstructs.AllocGCStatus, 0, len(tracked))
+	for _, gcAlloc := range tracked {
+		status := &cstructs.AllocGCStatus{
+			AllocID:       gcAlloc.allocID,
+			Pinned:        gcAlloc.pinned,
+			TerminalSince: gcAlloc.timeStamp.UnixNano(),
+		}
+		if !gcAlloc.pinned && gcAlloc.retainUntil.After(gcAlloc.timeStamp) {
+			status.RetainUntil = gcAlloc.retainUntil.UnixNano()
+		}
+
+		alloc := gcAlloc.allocRunner.Alloc()
+		status.Namespace = alloc.Namespace
+		status.JobID = alloc.JobID
+		status.TaskGroup = alloc.TaskGroup
+		status.ClientStatus = alloc.ClientStatus
+
+		if allocDir := gcAlloc.allocRunner.GetAllocDir(); allocDir != nil {
+			size, err := allocDir.DiskUsage()
+			if err != nil {
+				a.logger.Debug("failed to compute alloc dir size", "alloc_id", gcAlloc.allocID, "error", err)
+			}
+			status.DiskBytes = size
+		}
+		out = append(out, status)
+	}
+	return out
+}
+
+// CollectAll garbage collects all terminated allocations on a node, ignoring
+// any retention policy. Pinned allocations are not collected.
 func (a *AllocGarbageCollector) CollectAll() {
 	for {
 		select {
@@ -245,7 +344,7 @@ func (a *AllocGarbageCollector) MakeRoomFor(allocations []*structs.Allocation) e
 		default:
 		}
 
-		gcAlloc := a.allocRunners.Pop()
+		gcAlloc := a.allocRunners.PopEligible(time.Now())
 		if gcAlloc == nil {
 			// It's fine if we can't lower below the limit here as
 			// we'll keep trying to drop below the limit with each
@@ -310,7 +409,7 @@ func (a *AllocGarbageCollector) MakeRoomFor(allocations []*structs.Allocation) e
 			}
 		}
 
-		gcAlloc := a.allocRunners.Pop()
+		gcAlloc := a.allocRunners.PopEligible(time.Now())
 		if gcAlloc == nil {
 			break
 		}
@@ -348,6 +447,18 @@ type GCAlloc struct {
 	allocID     string
 	allocRunner AllocRunner
 	index       int
+
+	// retainUntil is the earliest time the allocation may be garbage
+	// collected, as determined by its job's GC policy.
+	retainUntil time.Time
+
+	// released is set once the servers have garbage collected the
+	// allocation, which means it must be destroyed as soon as it is
+	// eligible rather than only when the node is over its thresholds.
+	released bool
+
+	// pinned is set while the allocation is held outside of the PQ.
+	pinned bool
 }
 
 type GCAllocPQImpl []*GCAlloc
@@ -357,7 +468,7 @@ func (pq GCAllocPQImpl) Len() int {
 }
 
 func (pq GCAllocPQImpl) Less(i, j int) bool {
-	return pq[i].timeStamp.Before(pq[j].timeStamp)
+	return pq[i].retainUntil.Before(pq[j].retainUntil)
 }
 
 func (pq GCAllocPQImpl) Swap(i, j int) {
@@ -383,18 +494,24 @@ func (pq *GCAllocPQImpl) Pop() interface{} {
 }
 
 // IndexedGCAllocPQ is an indexed PQ which maintains a list of allocation runner
-// based on their termination time.
+// based on the time they become eligible for garbage collection.
 type IndexedGCAllocPQ struct {
 	index map[string]*GCAlloc
 	heap  GCAllocPQImpl
 
+	// pinned is the set of pinned allocation IDs. Pinned allocations are
+	// held here rather than in the heap once they are pushed, and the
+	// value is nil until then.
+	pinned map[string]*GCAlloc
+
 	pqLock sync.Mutex
 }
 
 func NewIndexedGCAllocPQ() *IndexedGCAllocPQ {
 	return &IndexedGCAllocPQ{
-		index: make(map[string]*GCAlloc),
-		heap:  make(GCAllocPQImpl, 0),
+		index:  make(map[string]*GCAlloc),
+		heap:   make(GCAllocPQImpl, 0),
+		pinned: make(map[string]*GCAlloc),
 	}
 }
 
@@ -408,16 +525,29 @@ func (i *IndexedGCAllocPQ) Push(allocID string, ar AllocRunner) bool {
 		// No work to do
 		return false
 	}
+	if gcAlloc, ok := i.pinned[allocID]; ok && gcAlloc != nil {
+		return false
+	}
+
+	now := time.Now()
 	gcAlloc := &GCAlloc{
-		timeStamp:   time.Now(),
+		timeStamp:   now,
+		retainUntil: now.Add(allocRetention(ar.Alloc())),
 		allocID:     allocID,
 		allocRunner: ar,
 	}
+	if _, ok := i.pinned[allocID]; ok {
+		gcAlloc.pinned = true
+		i.pinned[allocID] = gcAlloc
+		return true
+	}
 	i.index[allocID] = gcAlloc
 	heap.Push(&i.heap, gcAlloc)
 	return true
 }
 
+// Pop removes and returns the alloc runner which became eligible for garbage
+// collection first, regardless of whether its retention window has passed.
 func (i *IndexedGCAllocPQ) Pop() *GCAlloc {
 	i.pqLock.Lock()
 	defer i.pqLock.Unlock()
@@ -427,11 +557,146 @@ func (i *IndexedGCAllocPQ) Pop() *GCAlloc {
 	}
 
 	gcAlloc := heap.Pop(&i.heap).(*GCAlloc)
-	delete(i.index, gcAlloc.allocRunner.Alloc().ID)
+	delete(i.index, gcAlloc.allocID)
+	return gcAlloc
+}
+
+// PopEligible removes and returns the alloc runner which became eligible for
+// garbage collection first. Returns nil if no alloc runner has passed its
+// retention window by now.
+func (i *IndexedGCAllocPQ) PopEligible(now time.Time) *GCAlloc {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	if len(i.heap) == 0 || i.heap[0].retainUntil.After(now) {
+		return nil
+	}
+
+	gcAlloc := heap.Pop(&i.heap).(*GCAlloc)
+	delete(i.index, gcAlloc.allocID)
 	return gcAlloc
 }
 
-// Remove alloc from GC. Returns nil if alloc doesn't exist.
+// PopReleased removes and returns all alloc runners which have been released
+// by the servers and have passed their retention window by now.
+func (i *IndexedGCAllocPQ) PopReleased(now time.Time) []*GCAlloc {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	var released []*GCAlloc
+	for _, gcAlloc := range i.heap {
+		if gcAlloc.released && !gcAlloc.retainUntil.After(now) {
+			released = append(released, gcAlloc)
+		}
+	}
+	for _, gcAlloc := range released {
+		heap.Remove(&i.heap, gcAlloc.index)
+		delete(i.index, gcAlloc.allocID)
+	}
+	return released
+}
+
+// Release marks an alloc runner as released by the servers. Returns true if
+// the alloc runner must be retained, either because it is pinned or because
+// its retention window has not passed by now.
+func (i *IndexedGCAllocPQ) Release(allocID string, now time.Time) bool {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	if gcAlloc, ok := i.pinned[allocID]; ok {
+		if gcAlloc != nil {
+			gcAlloc.released = true
+		}
+		return true
+	}
+
+	gcAlloc, ok := i.index[allocID]
+	if !ok {
+		return false
+	}
+	gcAlloc.released = true
+	return gcAlloc.retainUntil.After(now)
+}
+
+// Pin removes an alloc from the GC queue until it is unpinned. Allocations
+// which have not been pushed yet are held once they are. Returns true if the
+// alloc was not already pinned.
+func (i *IndexedGCAllocPQ) Pin(allocID string) bool {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	if _, ok := i.pinned[allocID]; ok {
+		return false
+	}
+
+	gcAlloc, ok := i.index[allocID]
+	if ok {
+		heap.Remove(&i.heap, gcAlloc.index)
+		delete(i.index, allocID)
+		gcAlloc.pinned = true
+	}
+	i.pinned[allocID] = gcAlloc
+	return true
+}
+
+// Unpin returns a pinned alloc to the GC queue. Returns true if the alloc was
+// pinned.
+func (i *IndexedGCAllocPQ) Unpin(allocID string) bool {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	gcAlloc, ok := i.pinned[allocID]
+	if !ok {
+		return false
+	}
+	delete(i.pinned, allocID)
+
+	if gcAlloc != nil {
+		gcAlloc.pinned = false
+		i.index[allocID] = gcAlloc
+		heap.Push(&i.heap, gcAlloc)
+	}
+	return true
+}
+
+// Get returns the alloc runner of a tracked alloc, including pinned allocs
+// which have been pushed. Returns nil if the alloc isn't tracked.
+func (i *IndexedGCAllocPQ) Get(allocID string) AllocRunner {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	if gcAlloc, ok := i.index[allocID]; ok {
+		return gcAlloc.allocRunner
+	}
+	if gcAlloc := i.pinned[allocID]; gcAlloc != nil {
+		return gcAlloc.allocRunner
+	}
+	return nil
+}
+
+// Untrack removes every reference to an alloc, including its pin. It is
+// called once the alloc runner is destroyed.
+func (i *IndexedGCAllocPQ) Untrack(allocID string) {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	if gcAlloc, ok := i.index[allocID]; ok {
+		heap.Remove(&i.heap, gcAlloc.index)
+		delete(i.index, allocID)
+	}
+	delete(i.pinned, allocID)
+}
+
+// IsPinned returns whether an alloc is pinned.
+func (i *IndexedGCAllocPQ) IsPinned(allocID string) bool {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	_, ok := i.pinned[allocID]
+	return ok
+}
+
+// Remove alloc from GC. Returns nil if alloc doesn't exist or is pinned.
 func (i *IndexedGCAllocPQ) Remove(allocID string) *GCAlloc {
 	i.pqLock.Lock()
 	defer i.pqLock.Unlock()
@@ -445,9 +710,39 @@ func (i *IndexedGCAllocPQ) Remove(allocID string) *GCAlloc {
 	return nil
 }
 
+// List returns a copy of every tracked alloc, including pinned allocs which
+// have been pushed, ordered by the time they become eligible for collection.
+func (i *IndexedGCAllocPQ) List() []GCAlloc {
+	i.pqLock.Lock()
+	defer i.pqLock.Unlock()
+
+	out := make([]GCAlloc, 0, len(i.heap)+len(i.pinned))
+	for _, gcAlloc := range i.heap {
+		out = append(out, *gcAlloc)
+	}
+	for _, gcAlloc := range i.pinned {
+		if gcAlloc != nil {
+			out = append(out, *gcAlloc)
+		}
+	}
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].retainUntil.Before(out[b].retainUntil)
+	})
+	return out
+}
+
 func (i *IndexedGCAllocPQ) Length() int {
 	i.pqLock.Lock()
 	defer i.pqLock.Unlock()
 
 	return len(i.heap)
 }
+
+// allocRetention returns how long a terminal allocation must be retained
+// according to its job's GC policy.
+func allocRetention(alloc *structs.Allocation) time.Duration {
+	if alloc == nil || alloc.Job == nil {
+		return 0
+	}
+	return alloc.Job.GC.Retention(alloc.ClientStatus)
+}
@@ -82,6 +82,99 @@ func TestIndexedGCAllocPQ(t *testing.T) {
 	}
 }
 
+func TestIndexedGCAllocPQ_Retention(t *testing.T) {
+	ci.Parallel(t)
+
+	pq := NewIndexedGCAllocPQ()
+
+	retained := mock.Alloc()
+	retained.ClientStatus = structs.AllocClientStatusFailed
+	retained.Job.GC = &structs.GCPolicy{RetainFailed: time.Hour}
+	ar1, cleanup1 := allocrunner.TestAllocRunnerFromAlloc(t, retained)
+	defer cleanup1()
+	ar2, cleanup2 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup2()
+
+	require.True(t, pq.Push(ar1.Alloc().ID, ar1))
+	require.True(t, pq.Push(ar2.Alloc().ID, ar2))
+
+	// The retained alloc is ordered after the unretained one even though it
+	// was pushed first
+	gcAlloc := pq.PopEligible(time.Now())
+	require.NotNil(t, gcAlloc)
+	require.Equal(t, ar2.Alloc().ID, gcAlloc.allocID)
+
+	// The retained alloc is not eligible until its window has passed
+	require.Nil(t, pq.PopEligible(time.Now()))
+	gcAlloc = pq.PopEligible(time.Now().Add(2 * time.Hour))
+	require.NotNil(t, gcAlloc)
+	require.Equal(t, ar1.Alloc().ID, gcAlloc.allocID)
+}
+
+func TestIndexedGCAllocPQ_Pin(t *testing.T) {
+	ci.Parallel(t)
+
+	pq := NewIndexedGCAllocPQ()
+
+	ar1, cleanup1 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup1()
+	ar2, cleanup2 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup2()
+
+	// Pin one alloc before it is pushed and the other after
+	require.True(t, pq.Pin(ar1.Alloc().ID))
+	require.False(t, pq.Pin(ar1.Alloc().ID))
+	require.True(t, pq.Push(ar1.Alloc().ID, ar1))
+	require.True(t, pq.Push(ar2.Alloc().ID, ar2))
+	require.True(t, pq.Pin(ar2.Alloc().ID))
+
+	require.Equal(t, 0, pq.Length())
+	require.Nil(t, pq.Pop())
+	require.Nil(t, pq.Remove(ar1.Alloc().ID))
+	require.Len(t, pq.List(), 2)
+
+	// Releasing a pinned alloc retains it
+	require.True(t, pq.Release(ar2.Alloc().ID, time.Now()))
+	require.Empty(t, pq.PopReleased(time.Now()))
+
+	// Once unpinned, the released alloc is collected
+	require.True(t, pq.Unpin(ar2.Alloc().ID))
+	require.False(t, pq.Unpin(ar2.Alloc().ID))
+	released := pq.PopReleased(time.Now())
+	require.Len(t, released, 1)
+	require.Equal(t, ar2.Alloc().ID, released[0].allocID)
+
+	require.True(t, pq.Unpin(ar1.Alloc().ID))
+	gcAlloc := pq.Pop()
+	require.NotNil(t, gcAlloc)
+	require.Equal(t, ar1.Alloc().ID, gcAlloc.allocID)
+	require.Nil(t, pq.Pop())
+}
+
+func TestIndexedGCAllocPQ_Untrack(t *testing.T) {
+	ci.Parallel(t)
+
+	pq := NewIndexedGCAllocPQ()
+
+	ar1, cleanup1 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup1()
+	ar2, cleanup2 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup2()
+
+	// An alloc pinned but never pushed has no alloc runner
+	require.True(t, pq.Pin(ar1.Alloc().ID))
+	require.Nil(t, pq.Get(ar1.Alloc().ID))
+	pq.Untrack(ar1.Alloc().ID)
+	require.False(t, pq.IsPinned(ar1.Alloc().ID))
+
+	require.True(t, pq.Push(ar2.Alloc().ID, ar2))
+	require.Equal(t, ar2, pq.Get(ar2.Alloc().ID))
+	pq.Untrack(ar2.Alloc().ID)
+	require.Nil(t, pq.Get(ar2.Alloc().ID))
+	require.Zero(t, pq.Length())
+	require.Empty(t, pq.List())
+}
+
 // MockAllocCounter implements AllocCounter interface.
 type MockAllocCounter struct {
 	allocs int
@@ -167,6 +260,79 @@ func TestAllocGarbageCollector_Collect(t *testing.T) {
 	}
 }
 
+func TestAllocGarbageCollector_Release(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	gc := NewAllocGarbageCollector(logger, &MockStatsCollector{}, &MockAllocCounter{}, gcConfig())
+
+	retained := mock.Alloc()
+	retained.Job.GC = &structs.GCPolicy{RetainSuccessful: time.Hour}
+	ar1, cleanup1 := allocrunner.TestAllocRunnerFromAlloc(t, retained)
+	defer cleanup1()
+	ar2, cleanup2 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup2()
+
+	go ar1.Run()
+	go ar2.Run()
+
+	gc.MarkForCollection(ar1.Alloc().ID, ar1)
+	gc.MarkForCollection(ar2.Alloc().ID, ar2)
+
+	// Exit the alloc runners
+	exitAllocRunner(ar1, ar2)
+
+	gc.Release(ar1.Alloc().ID)
+	gc.Release(ar2.Alloc().ID)
+
+	// Only the alloc without a retention policy was destroyed
+	require.True(t, ar2.IsDestroyed())
+	require.False(t, ar1.IsDestroyed())
+	require.Equal(t, 1, gc.allocRunners.Length())
+
+	status := gc.Status()
+	require.Len(t, status, 1)
+	require.Equal(t, ar1.Alloc().ID, status[0].AllocID)
+	require.NotZero(t, status[0].RetainUntil)
+}
+
+func TestAllocGarbageCollector_Pin(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	gc := NewAllocGarbageCollector(logger, &MockStatsCollector{}, &MockAllocCounter{}, gcConfig())
+
+	ar1, cleanup1 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup1()
+	ar2, cleanup2 := allocrunner.TestAllocRunnerFromAlloc(t, mock.Alloc())
+	defer cleanup2()
+
+	go ar1.Run()
+	go ar2.Run()
+
+	gc.Pin(ar1.Alloc().ID)
+	gc.MarkForCollection(ar1.Alloc().ID, ar1)
+	exitAllocRunner(ar1, ar2)
+
+	// A pinned alloc released by the servers is still known to the GC
+	gc.Release(ar1.Alloc().ID)
+	require.False(t, ar1.IsDestroyed())
+	require.Equal(t, ar1.Alloc(), gc.Alloc(ar1.Alloc().ID))
+
+	gc.Unpin(ar1.Alloc().ID)
+	require.True(t, gc.Collect(ar1.Alloc().ID))
+	require.True(t, ar1.IsDestroyed())
+	require.Nil(t, gc.Alloc(ar1.Alloc().ID))
+
+	// An alloc pinned while it is being collected is not pinned once it is
+	// destroyed
+	gc.MarkForCollection(ar2.Alloc().ID, ar2)
+	require.NotNil(t, gc.allocRunners.Remove(ar2.Alloc().ID))
+	gc.Pin(ar2.Alloc().ID)
+	gc.destroyAllocRunner(ar2.Alloc().ID, ar2, "test")
+	require.False(t, gc.allocRunners.IsPinned(ar2.Alloc().ID))
+}
+
 func TestAllocGarbageCollector_CollectAll(t *testing.T) {
 	ci.Parallel(t)
 
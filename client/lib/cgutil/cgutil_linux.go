@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/opencontainers/runc/libcontainer/cgroups/fscommon"
 	lcc "github.com/opencontainers/runc/libcontainer/configs"
 )
 
@@ -133,3 +135,28 @@ func CopyCpuset(source, destination string) error {
 
 	return nil
 }
+
+// OOMKills returns the number of processes in the v2 cgroup at path that have
+// been killed by the kernel OOM killer, according to the oom_kill counter of
+// memory.events.
+func OOMKills(path string) (uint64, error) {
+	content, err := cgroups.ReadFile(path, "memory.events")
+	if err != nil {
+		return 0, err
+	}
+	return parseOOMKills(content)
+}
+
+func parseOOMKills(content string) (uint64, error) {
+	for _, line := range strings.Split(content, "\n") {
+		if !strings.HasPrefix(line, "oom_kill ") {
+			continue
+		}
+		_, value, err := fscommon.ParseKeyValue(line)
+		if err != nil {
+			return 0, err
+		}
+		return value, nil
+	}
+	return 0, nil
+}
@@ -127,3 +127,19 @@ func TestUtil_CopyCpuset(t *testing.T) {
 		require.Equal(t, "0-1", strings.TrimSpace(value))
 	})
 }
+
+func TestUtil_parseOOMKills(t *testing.T) {
+	ci.Parallel(t)
+
+	count, err := parseOOMKills("low 0\nhigh 0\nmax 4\noom 2\noom_kill 1\noom_group_kill 0\n")
+	must.NoError(t, err)
+	must.Eq(t, 1, count)
+
+	// older kernels do not report oom_kill at all
+	count, err = parseOOMKills("low 0\nhigh 0\nmax 0\noom 0\n")
+	must.NoError(t, err)
+	must.Eq(t, 0, count)
+
+	_, err = parseOOMKills("oom_kill one\n")
+	must.Error(t, err)
+}
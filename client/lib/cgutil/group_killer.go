@@ -66,13 +66,8 @@ func (d *killer) v1(cgroup *configs.Cgroup) error {
 	// move executor PID into the init freezer cgroup so we can kill the task
 	// pids without killing the executor (which is the process running this code,
 	// doing the killing)
-	initPath, err := cgroups.GetInitCgroupPath(freezer)
-	if err != nil {
-		return fmt.Errorf("failed to find init cgroup: %w", err)
-	}
-	m := map[string]string{freezer: initPath}
-	if err = cgroups.EnterPid(m, d.pid); err != nil {
-		return fmt.Errorf("failed to add executor pid to init cgroup: %w", err)
+	if err := MoveToInitCgroup(d.pid); err != nil {
+		return err
 	}
 
 	// ability to freeze the cgroup
@@ -86,7 +81,7 @@ func (d *killer) v1(cgroup *configs.Cgroup) error {
 	}
 
 	// do the common kill logic
-	if err = d.kill(path, freeze, thaw); err != nil {
+	if err := d.kill(path, freeze, thaw); err != nil {
 		return err
 	}
 
@@ -100,8 +95,7 @@ func (d *killer) v2(cgroup *configs.Cgroup) error {
 	}
 
 	// move executor (d.PID) into init.scope
-	editSelf := &editor{"init.scope"}
-	if err := editSelf.write("cgroup.procs", strconv.Itoa(d.pid)); err != nil {
+	if err := MoveToInitCgroup(d.pid); err != nil {
 		return err
 	}
 
@@ -116,6 +110,26 @@ func (d *killer) v2(cgroup *configs.Cgroup) error {
 	return nil
 }
 
+// MoveToInitCgroup moves pid into the init cgroup, which in v1 is the init
+// freezer cgroup and in v2 is init.scope. The executor uses this to leave the
+// cgroup of its task before freezing or killing the task processes.
+func MoveToInitCgroup(pid int) error {
+	if UseV2 {
+		editSelf := &editor{"init.scope"}
+		return editSelf.write("cgroup.procs", strconv.Itoa(pid))
+	}
+
+	initPath, err := cgroups.GetInitCgroupPath(freezer)
+	if err != nil {
+		return fmt.Errorf("failed to find init cgroup: %w", err)
+	}
+	m := map[string]string{freezer: initPath}
+	if err = cgroups.EnterPid(m, pid); err != nil {
+		return fmt.Errorf("failed to add executor pid to init cgroup: %w", err)
+	}
+	return nil
+}
+
 // kill is used to SIGKILL all processes in cgroup
 //
 // The order of operations is
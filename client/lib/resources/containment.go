@@ -10,4 +10,10 @@ type Containment interface {
 
 	// GetPIDs will return the processes overseen by the Containment
 	GetPIDs() PIDs
+
+	// Freeze stops all processes under containment until thawed.
+	Freeze() error
+
+	// Thaw resumes the processes stopped by Freeze.
+	Thaw() error
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/client/lib/cgutil"
 	"github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/opencontainers/runc/libcontainer/cgroups/fs"
 	"github.com/opencontainers/runc/libcontainer/cgroups/fs2"
 	"github.com/opencontainers/runc/libcontainer/configs"
 )
@@ -105,3 +106,37 @@ func (c *containment) GetPIDs() PIDs {
 
 	return m
 }
+
+func (c *containment) Freeze() error {
+	// the executor is in the cgroup of the task it started and must leave it
+	// so as not to freeze itself
+	if err := cgutil.MoveToInitCgroup(os.Getpid()); err != nil {
+		return fmt.Errorf("failed to move executor out of cgroup: %w", err)
+	}
+	return c.setFreezer(configs.Frozen)
+}
+
+func (c *containment) Thaw() error {
+	return c.setFreezer(configs.Thawed)
+}
+
+// setFreezer sets the freezer state of the cgroup under containment, which in
+// v1 is the freezer cgroup created for it.
+func (c *containment) setFreezer(state configs.FreezerState) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.cgroup == nil {
+		return fmt.Errorf("no cgroup under containment")
+	}
+
+	if cgutil.UseV2 {
+		mgr, err := fs2.NewManager(c.cgroup, "")
+		if err != nil {
+			return fmt.Errorf("failed to create v2 cgroup manager: %w", err)
+		}
+		return mgr.Freeze(state)
+	}
+
+	return new(fs.FreezerGroup).Set(c.cgroup.Path, &configs.Resources{Freezer: state})
+}
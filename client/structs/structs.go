@@ -196,6 +196,55 @@ type AllocChecksResponse struct {
 	Results map[structs.CheckID]*structs.CheckQueryResult
 }
 
+// AllocPinRequest is used to pin an allocation so that the client does not
+// garbage collect its directory, or to unpin a previously pinned allocation.
+type AllocPinRequest struct {
+	// AllocID is the allocation to pin
+	AllocID string
+
+	// Unpin removes a previous pin instead of adding one
+	Unpin bool
+
+	// NodeID is the node the allocation is placed on. When set, the request
+	// is routed to the node even if the servers have already garbage
+	// collected the allocation.
+	NodeID string
+
+	structs.QueryOptions
+}
+
+// ClientGCStatusResponse is used to return the terminal allocations whose
+// directories are still present on a client.
+type ClientGCStatusResponse struct {
+	Allocations []*AllocGCStatus
+	structs.QueryMeta
+}
+
+// AllocGCStatus describes a terminal allocation that has not yet been
+// garbage collected by the client.
+type AllocGCStatus struct {
+	AllocID      string
+	Namespace    string
+	JobID        string
+	TaskGroup    string
+	ClientStatus string
+
+	// Pinned is true if the allocation was pinned and will not be garbage
+	// collected until it is unpinned.
+	Pinned bool
+
+	// TerminalSince is the time the client started tracking the allocation
+	// for garbage collection, as a UnixNano.
+	TerminalSince int64
+
+	// RetainUntil is the time until which the job's GC policy retains the
+	// allocation, as a UnixNano. It is zero if no policy applies.
+	RetainUntil int64
+
+	// DiskBytes is the size of the allocation directory.
+	DiskBytes int64
+}
+
 // AllocStatsRequest is used to request the resource usage of a given
 // allocation, potentially filtering by task
 type AllocStatsRequest struct {
@@ -292,6 +341,15 @@ type TaskResourceUsage struct {
 	ResourceUsage *ResourceUsage
 	Timestamp     int64 // UnixNano
 	Pids          map[string]*ResourceUsage
+
+	// Processes describes the individual pids
+	Processes map[string]*ProcessInfo
+}
+
+// ProcessInfo describes a process of a task
+type ProcessInfo struct {
+	PPID    int
+	Cmdline []string
 }
 
 // AllocResourceUsage holds the aggregated task resource usage of the
@@ -85,6 +85,14 @@ const (
 	// Region is the environment variable for passing the region in which the alloc is running.
 	Region = "NOMAD_REGION"
 
+	// AllocExitStatus is the environment variable for passing poststop tasks
+	// the exit status of the allocation's other tasks.
+	AllocExitStatus = "NOMAD_ALLOC_EXIT_STATUS"
+
+	// AllocFailedTasks is the environment variable for passing poststop tasks
+	// a comma separated list of the allocation's failed tasks.
+	AllocFailedTasks = "NOMAD_ALLOC_FAILED_TASKS"
+
 	// AddrPrefix is the prefix for passing both dynamic and static port
 	// allocations to tasks.
 	// E.g $NOMAD_ADDR_http=127.0.0.1:80
@@ -426,6 +434,11 @@ type Builder struct {
 	jobName          string
 	jobParentID      string
 
+	// allocExitStatus and allocFailedTasks describe how the allocation's
+	// other tasks ended; only set for poststop tasks
+	allocExitStatus  *int
+	allocFailedTasks []string
+
 	// otherPorts for tasks in the same alloc
 	otherPorts map[string]string
 
@@ -545,6 +558,10 @@ func (b *Builder) buildEnv(allocDir, localDir, secretsDir string,
 	if b.region != "" {
 		envMap[Region] = b.region
 	}
+	if b.allocExitStatus != nil {
+		envMap[AllocExitStatus] = strconv.Itoa(*b.allocExitStatus)
+		envMap[AllocFailedTasks] = strings.Join(b.allocFailedTasks, ",")
+	}
 
 	// Build the network related env vars
 	buildNetworkEnv(envMap, b.networks, b.driverNetwork)
@@ -1009,6 +1026,17 @@ func (b *Builder) SetTemplateEnv(m map[string]string) *Builder {
 	return b
 }
 
+// SetAllocExitStatus sets the exit status of the allocation and the names of
+// its failed tasks. It is used to tell poststop tasks why the allocation
+// stopped.
+func (b *Builder) SetAllocExitStatus(status int, failedTasks []string) *Builder {
+	b.mu.Lock()
+	b.allocExitStatus = &status
+	b.allocFailedTasks = failedTasks
+	b.mu.Unlock()
+	return b
+}
+
 func (b *Builder) SetVaultToken(token, namespace string, inject bool) *Builder {
 	b.mu.Lock()
 	b.vaultToken = token
@@ -538,6 +538,27 @@ func TestEnvironment_Envvars(t *testing.T) {
 	}
 }
 
+func TestEnvironment_AllocExitStatus(t *testing.T) {
+	ci.Parallel(t)
+
+	n := mock.Node()
+	a := mock.Alloc()
+	task := a.Job.TaskGroups[0].Tasks[0]
+
+	b := NewBuilder(n, a, task, "global")
+	act := b.Build().All()
+	require.NotContains(t, act, AllocExitStatus)
+	require.NotContains(t, act, AllocFailedTasks)
+
+	act = b.SetAllocExitStatus(0, nil).Build().All()
+	require.Equal(t, "0", act[AllocExitStatus])
+	require.Equal(t, "", act[AllocFailedTasks])
+
+	act = b.SetAllocExitStatus(2, []string{"api", "web"}).Build().All()
+	require.Equal(t, "2", act[AllocExitStatus])
+	require.Equal(t, "api,web", act[AllocFailedTasks])
+}
+
 // TestEnvironment_HookVars asserts hook env vars are LWW and deletes of later
 // writes allow earlier hook's values to be visible.
 func TestEnvironment_HookVars(t *testing.T) {
@@ -0,0 +1,139 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+)
+
+// Ensure ACLAuthMethodCommand satisfies the cli.Command interface.
+var _ cli.Command = &ACLAuthMethodCommand{}
+
+// ACLAuthMethodCommand implements cli.Command.
+type ACLAuthMethodCommand struct {
+	Meta
+}
+
+// Help satisfies the cli.Command Help function.
+func (a *ACLAuthMethodCommand) Help() string {
+	helpText := `
+Usage: nomad acl auth-method <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with ACL auth methods.
+  Auth methods allow users to log in to Nomad with an identity provider using
+  "nomad login", and are granted ACL roles and policies by binding rules.
+
+  Create an ACL auth method:
+
+      $ nomad acl auth-method create -name="name" -type=OIDC \
+          -max-token-ttl=1h -token-locality=local -config=config.json
+
+  Create an ACL auth method for the JWTs of a CI system:
+
+      $ nomad acl auth-method create -name="ci" -type=JWT \
+          -max-token-ttl=15m -config=jwt-config.json
+
+  List all ACL auth methods:
+
+      $ nomad acl auth-method list
+
+  Lookup a specific ACL auth method:
+
+      $ nomad acl auth-method info <acl_auth_method_name>
+
+  Update an ACL auth method:
+
+      $ nomad acl auth-method update -max-token-ttl=2h <acl_auth_method_name>
+
+  Delete an ACL auth method:
+
+      $ nomad acl auth-method delete <acl_auth_method_name>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+// Synopsis satisfies the cli.Command Synopsis function.
+func (a *ACLAuthMethodCommand) Synopsis() string { return "Interact with ACL auth methods" }
+
+// Name returns the name of this command.
+func (a *ACLAuthMethodCommand) Name() string { return "acl auth-method" }
+
+// Run satisfies the cli.Command Run function.
+func (a *ACLAuthMethodCommand) Run(_ []string) int { return cli.RunResultHelp }
+
+// formatACLAuthMethod formats and converts the ACL auth method API object
+// into a string KV representation suitable for console output. The config
+// fields shown depend on the type of the method, and the client secret is not
+// included.
+func formatACLAuthMethod(authMethod *api.ACLAuthMethod) string {
+	out := formatKV([]string{
+		fmt.Sprintf("Name|%s", authMethod.Name),
+		fmt.Sprintf("Type|%s", authMethod.Type),
+		fmt.Sprintf("Locality|%s", authMethod.TokenLocality),
+		fmt.Sprintf("Max Token TTL|%s", authMethod.MaxTokenTTL),
+		fmt.Sprintf("Default|%t", authMethod.Default),
+		fmt.Sprintf("Create Index|%d", authMethod.CreateIndex),
+		fmt.Sprintf("Modify Index|%d", authMethod.ModifyIndex),
+	})
+
+	config := authMethod.Config
+	switch {
+	case config == nil:
+	case authMethod.Type == api.ACLAuthMethodTypeJWT:
+		out += "\n\n" + formatKV([]string{
+			fmt.Sprintf("JWKS URL|%s", config.JWKSURL),
+			fmt.Sprintf("JWT Validation Public Keys|%d", len(config.JWTValidationPubKeys)),
+			fmt.Sprintf("Bound Issuer|%s", config.BoundIssuer),
+			fmt.Sprintf("Bound Audiences|%s", strings.Join(config.BoundAudiences, ",")),
+			fmt.Sprintf("Signing Algorithms|%s", strings.Join(config.SigningAlgs, ",")),
+			fmt.Sprintf("Clock Skew Leeway|%s", config.ClockSkewLeeway),
+			fmt.Sprintf("Claim Mappings|%s", formatClaimMappings(config.ClaimMappings)),
+			fmt.Sprintf("List Claim Mappings|%s", formatClaimMappings(config.ListClaimMappings)),
+		})
+	default:
+		out += "\n\n" + formatKV([]string{
+			fmt.Sprintf("OIDC Discovery URL|%s", config.OIDCDiscoveryURL),
+			fmt.Sprintf("OIDC Client ID|%s", config.OIDCClientID),
+			fmt.Sprintf("OIDC Scopes|%s", strings.Join(config.OIDCScopes, ",")),
+			fmt.Sprintf("Bound Audiences|%s", strings.Join(config.BoundAudiences, ",")),
+			fmt.Sprintf("Allowed Redirect URIs|%s", strings.Join(config.AllowedRedirectURIs, ",")),
+			fmt.Sprintf("Signing Algorithms|%s", strings.Join(config.SigningAlgs, ",")),
+			fmt.Sprintf("Claim Mappings|%s", formatClaimMappings(config.ClaimMappings)),
+			fmt.Sprintf("List Claim Mappings|%s", formatClaimMappings(config.ListClaimMappings)),
+		})
+	}
+	return out
+}
+
+// formatClaimMappings formats the claim mappings of an auth method config as
+// a sorted list of claim=name pairs.
+func formatClaimMappings(mappings map[string]string) string {
+	pairs := make([]string, 0, len(mappings))
+	for claim, name := range mappings {
+		pairs = append(pairs, claim+"="+name)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// readACLAuthMethodConfig reads the auth method config from the JSON file at
+// the path.
+func readACLAuthMethodConfig(path string) (*api.ACLAuthMethodConfig, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	var config api.ACLAuthMethodConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %v", err)
+	}
+	return &config, nil
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+// Ensure ACLAuthMethodCreateCommand satisfies the cli.Command interface.
+var _ cli.Command = &ACLAuthMethodCreateCommand{}
+
+// ACLAuthMethodCreateCommand implements cli.Command.
+type ACLAuthMethodCreateCommand struct {
+	Meta
+
+	name          string
+	methodType    string
+	tokenLocality string
+	maxTokenTTL   time.Duration
+	isDefault     bool
+	configFile    string
+	json          bool
+	tmpl          string
+}
+
+// Help satisfies the cli.Command Help function.
+func (a *ACLAuthMethodCreateCommand) Help() string {
+	helpText := `
+Usage: nomad acl auth-method create [options]
+
+  Create is used to create new ACL auth methods. Use requires a management
+  token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+ACL Auth Method Create Options:
+
+  -name
+    Sets the name of the ACL auth method. The name is used to refer to the
+    method when logging in, and is a required parameter.
+
+  -type
+    Sets the type of the auth method. Either "OIDC" for interactive logins
+    with an OIDC provider, or "JWT" for logins with the JWTs of a third party
+    such as a CI system. Defaults to "OIDC".
+
+  -max-token-ttl
+    Sets the duration of the ACL tokens created by logging in with the auth
+    method, such as "1h". This is a required parameter.
+
+  -token-locality
+    Sets whether the ACL tokens created by the auth method are "local" to
+    the region they are created in, or "global" to all federated regions.
+    Defaults to "local".
+
+  -default
+    Sets the auth method as the default method of its type, which is used by
+    "nomad login" when no method is named.
+
+  -config
+    The path to a JSON file holding the configuration of the auth method,
+    such as the OIDC discovery URL and client credentials, or the JWKS URL
+    JWTs are validated with. This is a required parameter.
+
+  -json
+    Output the ACL auth method in a JSON format.
+
+  -t
+    Format and display the ACL auth method using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (a *ACLAuthMethodCreateCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(a.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-name":           complete.PredictAnything,
+			"-type":           complete.PredictSet(api.ACLAuthMethodTypeOIDC, api.ACLAuthMethodTypeJWT),
+			"-max-token-ttl":  complete.PredictAnything,
+			"-token-locality": complete.PredictSet(api.ACLAuthMethodTokenLocalityLocal, api.ACLAuthMethodTokenLocalityGlobal),
+			"-default":        complete.PredictNothing,
+			"-config":         complete.PredictFiles("*.json"),
+			"-json":           complete.PredictNothing,
+			"-t":              complete.PredictAnything,
+		})
+}
+
+func (a *ACLAuthMethodCreateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+// Synopsis satisfies the cli.Command Synopsis function.
+func (a *ACLAuthMethodCreateCommand) Synopsis() string { return "Create a new ACL auth method" }
+
+// Name returns the name of this command.
+func (a *ACLAuthMethodCreateCommand) Name() string { return "acl auth-method create" }
+
+// Run satisfies the cli.Command Run function.
+func (a *ACLAuthMethodCreateCommand) Run(args []string) int {
+
+	flags := a.Meta.FlagSet(a.Name(), FlagSetClient)
+	flags.Usage = func() { a.Ui.Output(a.Help()) }
+	flags.StringVar(&a.name, "name", "", "")
+	flags.StringVar(&a.methodType, "type", api.ACLAuthMethodTypeOIDC, "")
+	flags.StringVar(&a.tokenLocality, "token-locality", api.ACLAuthMethodTokenLocalityLocal, "")
+	flags.DurationVar(&a.maxTokenTTL, "max-token-ttl", 0, "")
+	flags.BoolVar(&a.isDefault, "default", false, "")
+	flags.StringVar(&a.configFile, "config", "", "")
+	flags.BoolVar(&a.json, "json", false, "")
+	flags.StringVar(&a.tmpl, "t", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments.
+	if len(flags.Args()) != 0 {
+		a.Ui.Error("This command takes no arguments")
+		a.Ui.Error(commandErrorText(a))
+		return 1
+	}
+
+	// Perform some basic validation on the submitted auth method information
+	// to avoid sending API and RPC requests which will fail basic validation.
+	if a.name == "" {
+		a.Ui.Error("ACL auth method name must be specified using the -name flag")
+		return 1
+	}
+	if a.maxTokenTTL == 0 {
+		a.Ui.Error("ACL auth method max token TTL must be specified using the -max-token-ttl flag")
+		return 1
+	}
+	if a.configFile == "" {
+		a.Ui.Error("ACL auth method config must be specified using the -config flag")
+		return 1
+	}
+
+	config, err := readACLAuthMethodConfig(a.configFile)
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error reading ACL auth method config: %s", err))
+		return 1
+	}
+
+	// Set up the auth method with the passed parameters.
+	aclAuthMethod := api.ACLAuthMethod{
+		Name:          a.name,
+		Type:          a.methodType,
+		TokenLocality: a.tokenLocality,
+		MaxTokenTTL:   a.maxTokenTTL,
+		Default:       a.isDefault,
+		Config:        config,
+	}
+
+	// Get the HTTP client.
+	client, err := a.Meta.Client()
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Create the ACL auth method via the API.
+	authMethod, _, err := client.ACLAuthMethods().Create(&aclAuthMethod, nil)
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error creating ACL auth method: %s", err))
+		return 1
+	}
+
+	if a.json || len(a.tmpl) > 0 {
+		out, err := Format(a.json, a.tmpl, authMethod)
+		if err != nil {
+			a.Ui.Error(err.Error())
+			return 1
+		}
+
+		a.Ui.Output(out)
+		return 0
+	}
+
+	a.Ui.Output(formatACLAuthMethod(authMethod))
+	return 0
+}
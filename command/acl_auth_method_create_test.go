@@ -0,0 +1,82 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACLAuthMethodCreateCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Build a test server with ACLs enabled.
+	srv, _, url := testServer(t, false, func(c *agent.Config) {
+		c.ACL.Enabled = true
+	})
+	defer srv.Shutdown()
+
+	// Wait for the server to start fully and ensure we have a bootstrap token.
+	testutil.WaitForLeader(t, srv.Agent.RPC)
+	rootACLToken := srv.RootToken
+	require.NotNil(t, rootACLToken)
+
+	ui := cli.NewMockUi()
+	cmd := &ACLAuthMethodCreateCommand{
+		Meta: Meta{
+			Ui:          ui,
+			flagAddress: url,
+		},
+	}
+
+	// Test the basic validation on the command.
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url, "this-command-does-not-take-args"}))
+	require.Contains(t, ui.ErrorWriter.String(), "This command takes no arguments")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url}))
+	require.Contains(t, ui.ErrorWriter.String(), "ACL auth method name must be specified using the -name flag")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url, "-name=acl-auth-method-cli-test", "-max-token-ttl=1h"}))
+	require.Contains(t, ui.ErrorWriter.String(), "ACL auth method config must be specified using the -config flag")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	// Write the auth method config.
+	configFile := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{
+  "OIDCDiscoveryURL": "https://example.com",
+  "OIDCClientID": "nomad",
+  "OIDCClientSecret": "secret",
+  "AllowedRedirectURIs": ["http://localhost:4649/oidc/callback"],
+  "ListClaimMappings": {"groups": "roles"}
+}`), 0644))
+
+	// Create an ACL auth method.
+	args := []string{
+		"-address=" + url, "-token=" + rootACLToken.SecretID, "-name=acl-auth-method-cli-test",
+		"-max-token-ttl=1h", "-default", "-config=" + configFile,
+	}
+	require.Equal(t, 0, cmd.Run(args))
+	s := ui.OutputWriter.String()
+	require.Contains(t, s, "Name          = acl-auth-method-cli-test")
+	require.Contains(t, s, "Type          = OIDC")
+	require.Contains(t, s, "Max Token TTL = 1h0m0s")
+	require.Contains(t, s, "Default       = true")
+	require.Contains(t, s, "List Claim Mappings   = groups=roles")
+	require.NotContains(t, s, "secret")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+}
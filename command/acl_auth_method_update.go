@@ -0,0 +1,180 @@
+package command
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+// Ensure ACLAuthMethodUpdateCommand satisfies the cli.Command interface.
+var _ cli.Command = &ACLAuthMethodUpdateCommand{}
+
+// ACLAuthMethodUpdateCommand implements cli.Command.
+type ACLAuthMethodUpdateCommand struct {
+	Meta
+
+	tokenLocality string
+	maxTokenTTL   time.Duration
+	isDefault     bool
+	configFile    string
+	json          bool
+	tmpl          string
+}
+
+// Help satisfies the cli.Command Help function.
+func (a *ACLAuthMethodUpdateCommand) Help() string {
+	helpText := `
+Usage: nomad acl auth-method update [options] <acl_auth_method_name>
+
+  Update is used to update an existing ACL auth method. Only the fields set
+  by the given flags are changed. Requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+ACL Auth Method Update Options:
+
+  -max-token-ttl
+    Sets the duration of the ACL tokens created by logging in with the auth
+    method, such as "1h".
+
+  -token-locality
+    Sets whether the ACL tokens created by the auth method are "local" to
+    the region they are created in, or "global" to all federated regions.
+
+  -default
+    Sets whether the auth method is the default method of its type.
+
+  -config
+    The path to a JSON file holding the configuration of the auth method,
+    which replaces the current configuration.
+
+  -json
+    Output the ACL auth method in a JSON format.
+
+  -t
+    Format and display the ACL auth method using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (a *ACLAuthMethodUpdateCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(a.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-max-token-ttl":  complete.PredictAnything,
+			"-token-locality": complete.PredictSet(api.ACLAuthMethodTokenLocalityLocal, api.ACLAuthMethodTokenLocalityGlobal),
+			"-default":        complete.PredictSet("true", "false"),
+			"-config":         complete.PredictFiles("*.json"),
+			"-json":           complete.PredictNothing,
+			"-t":              complete.PredictAnything,
+		})
+}
+
+func (a *ACLAuthMethodUpdateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+// Synopsis satisfies the cli.Command Synopsis function.
+func (a *ACLAuthMethodUpdateCommand) Synopsis() string { return "Update an existing ACL auth method" }
+
+// Name returns the name of this command.
+func (*ACLAuthMethodUpdateCommand) Name() string { return "acl auth-method update" }
+
+// Run satisfies the cli.Command Run function.
+func (a *ACLAuthMethodUpdateCommand) Run(args []string) int {
+
+	flags := a.Meta.FlagSet(a.Name(), FlagSetClient)
+	flags.Usage = func() { a.Ui.Output(a.Help()) }
+	flags.StringVar(&a.tokenLocality, "token-locality", "", "")
+	flags.DurationVar(&a.maxTokenTTL, "max-token-ttl", 0, "")
+	flags.BoolVar(&a.isDefault, "default", false, "")
+	flags.StringVar(&a.configFile, "config", "", "")
+	flags.BoolVar(&a.json, "json", false, "")
+	flags.StringVar(&a.tmpl, "t", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument which is expected to be the ACL
+	// auth method name.
+	if len(flags.Args()) != 1 {
+		a.Ui.Error("This command takes one argument: <acl_auth_method_name>")
+		a.Ui.Error(commandErrorText(a))
+		return 1
+	}
+
+	// The default flag is a boolean, so track whether it was set to tell
+	// unsetting the default apart from leaving it as is.
+	var defaultSet bool
+	flags.Visit(func(f *flag.Flag) {
+		defaultSet = defaultSet || f.Name == "default"
+	})
+
+	// Check that the operator specified at least one flag to update the ACL
+	// auth method with.
+	if a.tokenLocality == "" && a.maxTokenTTL == 0 && !defaultSet && a.configFile == "" {
+		a.Ui.Error("Please provide at least one flag to update the ACL auth method")
+		a.Ui.Error(commandErrorText(a))
+		return 1
+	}
+
+	// Get the HTTP client.
+	client, err := a.Meta.Client()
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Read the current auth method, so we can fail better if not found and
+	// only change the fields set by the flags.
+	updatedAuthMethod, _, err := client.ACLAuthMethods().Get(flags.Args()[0], nil)
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error when retrieving ACL auth method: %v", err))
+		return 1
+	}
+
+	if a.tokenLocality != "" {
+		updatedAuthMethod.TokenLocality = a.tokenLocality
+	}
+	if a.maxTokenTTL != 0 {
+		updatedAuthMethod.MaxTokenTTL = a.maxTokenTTL
+	}
+	if defaultSet {
+		updatedAuthMethod.Default = a.isDefault
+	}
+	if a.configFile != "" {
+		config, err := readACLAuthMethodConfig(a.configFile)
+		if err != nil {
+			a.Ui.Error(fmt.Sprintf("Error reading ACL auth method config: %s", err))
+			return 1
+		}
+		updatedAuthMethod.Config = config
+	}
+
+	// Update the ACL auth method with the new information via the API.
+	authMethod, _, err := client.ACLAuthMethods().Update(updatedAuthMethod, nil)
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error updating ACL auth method: %s", err))
+		return 1
+	}
+
+	if a.json || len(a.tmpl) > 0 {
+		out, err := Format(a.json, a.tmpl, authMethod)
+		if err != nil {
+			a.Ui.Error(err.Error())
+			return 1
+		}
+
+		a.Ui.Output(out)
+		return 0
+	}
+
+	a.Ui.Output(formatACLAuthMethod(authMethod))
+	return 0
+}
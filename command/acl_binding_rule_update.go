@@ -0,0 +1,180 @@
+package command
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+// Ensure ACLBindingRuleUpdateCommand satisfies the cli.Command interface.
+var _ cli.Command = &ACLBindingRuleUpdateCommand{}
+
+// ACLBindingRuleUpdateCommand implements cli.Command.
+type ACLBindingRuleUpdateCommand struct {
+	Meta
+
+	description string
+	selector    string
+	bindType    string
+	bindName    string
+	json        bool
+	tmpl        string
+}
+
+// Help satisfies the cli.Command Help function.
+func (a *ACLBindingRuleUpdateCommand) Help() string {
+	helpText := `
+Usage: nomad acl binding-rule update [options] <acl_binding_rule_id>
+
+  Update is used to update an existing ACL binding rule. Only the fields set
+  by the given flags are changed, and the auth method of a binding rule can't
+  be changed. Requires a management token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+ACL Binding Rule Update Options:
+
+  -description
+    A free form text description of the binding rule that must not exceed
+    256 characters.
+
+  -selector
+    An expression matched against the identity of the user logging in. Set
+    it to an empty string for the rule to apply to all users.
+
+  -bind-type
+    Sets what the rule binds to the ACL token of the user: a "role", a
+    "policy", or "management" for a management token.
+
+  -bind-name
+    The name of the role or policy to bind, which may refer to the claims of
+    the user as "${value.name}".
+
+  -json
+    Output the ACL binding rule in a JSON format.
+
+  -t
+    Format and display the ACL binding rule using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (a *ACLBindingRuleUpdateCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(a.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-description": complete.PredictAnything,
+			"-selector":    complete.PredictAnything,
+			"-bind-type": complete.PredictSet(api.ACLBindingRuleBindTypeRole,
+				api.ACLBindingRuleBindTypePolicy, api.ACLBindingRuleBindTypeManagement),
+			"-bind-name": complete.PredictAnything,
+			"-json":      complete.PredictNothing,
+			"-t":         complete.PredictAnything,
+		})
+}
+
+func (a *ACLBindingRuleUpdateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+// Synopsis satisfies the cli.Command Synopsis function.
+func (a *ACLBindingRuleUpdateCommand) Synopsis() string { return "Update an existing ACL binding rule" }
+
+// Name returns the name of this command.
+func (*ACLBindingRuleUpdateCommand) Name() string { return "acl binding-rule update" }
+
+// Run satisfies the cli.Command Run function.
+func (a *ACLBindingRuleUpdateCommand) Run(args []string) int {
+
+	flags := a.Meta.FlagSet(a.Name(), FlagSetClient)
+	flags.Usage = func() { a.Ui.Output(a.Help()) }
+	flags.StringVar(&a.description, "description", "", "")
+	flags.StringVar(&a.selector, "selector", "", "")
+	flags.StringVar(&a.bindType, "bind-type", "", "")
+	flags.StringVar(&a.bindName, "bind-name", "", "")
+	flags.BoolVar(&a.json, "json", false, "")
+	flags.StringVar(&a.tmpl, "t", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one argument which is expected to be the ACL
+	// binding rule ID.
+	if len(flags.Args()) != 1 {
+		a.Ui.Error("This command takes one argument: <acl_binding_rule_id>")
+		a.Ui.Error(commandErrorText(a))
+		return 1
+	}
+
+	// The selector may be set to an empty string to match all users, so
+	// track which flags were set rather than checking for empty values.
+	setFlags := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+
+	// Check that the operator specified at least one flag to update the ACL
+	// binding rule with.
+	if !setFlags["description"] && !setFlags["selector"] && !setFlags["bind-type"] && !setFlags["bind-name"] {
+		a.Ui.Error("Please provide at least one flag to update the ACL binding rule")
+		a.Ui.Error(commandErrorText(a))
+		return 1
+	}
+
+	// Get the HTTP client.
+	client, err := a.Meta.Client()
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Read the current binding rule, so we can fail better if not found and
+	// only change the fields set by the flags.
+	updatedBindingRule, _, err := client.ACLBindingRules().Get(flags.Args()[0], nil)
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error when retrieving ACL binding rule: %v", err))
+		return 1
+	}
+
+	if setFlags["description"] {
+		updatedBindingRule.Description = a.description
+	}
+	if setFlags["selector"] {
+		updatedBindingRule.Selector = a.selector
+	}
+	if setFlags["bind-type"] {
+		updatedBindingRule.BindType = a.bindType
+
+		// Management bindings don't have a name.
+		if a.bindType == api.ACLBindingRuleBindTypeManagement {
+			updatedBindingRule.BindName = ""
+		}
+	}
+	if setFlags["bind-name"] {
+		updatedBindingRule.BindName = a.bindName
+	}
+
+	// Update the ACL binding rule with the new information via the API.
+	bindingRule, _, err := client.ACLBindingRules().Update(updatedBindingRule, nil)
+	if err != nil {
+		a.Ui.Error(fmt.Sprintf("Error updating ACL binding rule: %s", err))
+		return 1
+	}
+
+	if a.json || len(a.tmpl) > 0 {
+		out, err := Format(a.json, a.tmpl, bindingRule)
+		if err != nil {
+			a.Ui.Error(err.Error())
+			return 1
+		}
+
+		a.Ui.Output(out)
+		return 0
+	}
+
+	a.Ui.Output(formatACLBindingRule(bindingRule))
+	return 0
+}
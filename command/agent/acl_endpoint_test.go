@@ -5,9 +5,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/lib/auth/jwt"
+	"github.com/hashicorp/nomad/lib/auth/oidc"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/stretchr/testify/assert"
@@ -994,3 +997,463 @@ func TestHTTPServer_ACLRoleSpecificRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPServer_ACLAuthMethodListRequest(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		testFn func(srv *TestAgent)
+	}{
+		{
+			name: "invalid method",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodConnect, "/v1/acl/auth-methods", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodListRequest(respW, req)
+				require.ErrorContains(t, err, "Invalid method")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "no auth methods in state",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/auth-methods", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodListRequest(respW, req)
+				require.NoError(t, err)
+				require.Empty(t, obj.([]*structs.ACLAuthMethodStub))
+			},
+		},
+		{
+			name: "auth methods in state without token",
+			testFn: func(srv *TestAgent) {
+
+				// Listing auth methods does not need a token, so logins can
+				// find the method to use.
+				authMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()}
+				require.NoError(t, srv.server.State().UpsertACLAuthMethods(
+					structs.MsgTypeTestSetup, 10, authMethods))
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/auth-methods", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodListRequest(respW, req)
+				require.NoError(t, err)
+				require.Len(t, obj.([]*structs.ACLAuthMethodStub), 2)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpACLTest(t, nil, tc.testFn)
+		})
+	}
+}
+
+func TestHTTPServer_ACLAuthMethodRequest(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		testFn func(srv *TestAgent)
+	}{
+		{
+			name: "no auth token set",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodPut, "/v1/acl/auth-method", encodeReq(mock.ACLAuthMethod()))
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodRequest(respW, req)
+				require.ErrorContains(t, err, "Permission denied")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "invalid method",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/auth-method", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Ensure we have a token set.
+				setToken(req, srv.RootToken)
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodRequest(respW, req)
+				require.ErrorContains(t, err, "Invalid method")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "successful upsert",
+			testFn: func(srv *TestAgent) {
+
+				// Create a mock auth method to use in the request body.
+				mockACLAuthMethod := mock.ACLAuthMethod()
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodPut, "/v1/acl/auth-method", encodeReq(mockACLAuthMethod))
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Ensure we have a token set.
+				setToken(req, srv.RootToken)
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodRequest(respW, req)
+				require.NoError(t, err)
+				require.NotNil(t, obj)
+				require.Equal(t, mockACLAuthMethod.Hash, obj.(*structs.ACLAuthMethod).Hash)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpACLTest(t, nil, tc.testFn)
+		})
+	}
+}
+
+func TestHTTPServer_ACLAuthMethodSpecificRequest(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		testFn func(srv *TestAgent)
+	}{
+		{
+			name: "missing auth method name",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/auth-method/", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodSpecificRequest(respW, req)
+				require.ErrorContains(t, err, "missing ACL auth method name")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "get auth method not found",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/auth-method/unknown", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Ensure we have a token set.
+				setToken(req, srv.RootToken)
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLAuthMethodSpecificRequest(respW, req)
+				require.ErrorContains(t, err, "ACL auth method not found")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "get, update and delete auth method",
+			testFn: func(srv *TestAgent) {
+
+				mockACLAuthMethod := mock.ACLAuthMethod()
+				require.NoError(t, srv.server.State().UpsertACLAuthMethods(
+					structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{mockACLAuthMethod}))
+				url := "/v1/acl/auth-method/" + mockACLAuthMethod.Name
+
+				// Read the auth method.
+				req, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(t, err)
+				setToken(req, srv.RootToken)
+
+				obj, err := srv.Server.ACLAuthMethodSpecificRequest(httptest.NewRecorder(), req)
+				require.NoError(t, err)
+				require.Equal(t, mockACLAuthMethod.Hash, obj.(*structs.ACLAuthMethod).Hash)
+
+				// The name of the path and the body must match on updates.
+				update := mockACLAuthMethod.Copy()
+				update.Name = "other"
+				req, err = http.NewRequest(http.MethodPost, url, encodeReq(update))
+				require.NoError(t, err)
+				setToken(req, srv.RootToken)
+
+				_, err = srv.Server.ACLAuthMethodSpecificRequest(httptest.NewRecorder(), req)
+				require.ErrorContains(t, err, "does not match request path")
+
+				update.Name = mockACLAuthMethod.Name
+				update.MaxTokenTTL = 2 * time.Hour
+				req, err = http.NewRequest(http.MethodPost, url, encodeReq(update))
+				require.NoError(t, err)
+				setToken(req, srv.RootToken)
+
+				obj, err = srv.Server.ACLAuthMethodSpecificRequest(httptest.NewRecorder(), req)
+				require.NoError(t, err)
+				require.Equal(t, 2*time.Hour, obj.(*structs.ACLAuthMethod).MaxTokenTTL)
+
+				// Delete the auth method.
+				req, err = http.NewRequest(http.MethodDelete, url, nil)
+				require.NoError(t, err)
+				setToken(req, srv.RootToken)
+
+				obj, err = srv.Server.ACLAuthMethodSpecificRequest(httptest.NewRecorder(), req)
+				require.NoError(t, err)
+				require.Nil(t, obj)
+
+				authMethod, err := srv.server.State().GetACLAuthMethodByName(nil, mockACLAuthMethod.Name)
+				require.NoError(t, err)
+				require.Nil(t, authMethod)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpACLTest(t, nil, tc.testFn)
+		})
+	}
+}
+
+func TestHTTPServer_ACLBindingRuleListRequest(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name   string
+		testFn func(srv *TestAgent)
+	}{
+		{
+			name: "no auth token set",
+			testFn: func(srv *TestAgent) {
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/binding-rules", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLBindingRuleListRequest(respW, req)
+				require.ErrorContains(t, err, "Permission denied")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "binding rules in state",
+			testFn: func(srv *TestAgent) {
+
+				// Create the auth method the binding rules are linked to.
+				authMethod := mock.ACLAuthMethod()
+				require.NoError(t, srv.server.State().UpsertACLAuthMethods(
+					structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+				bindingRules := []*structs.ACLBindingRule{mock.ACLBindingRule(), mock.ACLBindingRule()}
+				for _, rule := range bindingRules {
+					rule.AuthMethod = authMethod.Name
+				}
+				require.NoError(t, srv.server.State().UpsertACLBindingRules(
+					structs.MsgTypeTestSetup, 20, bindingRules, false))
+
+				// Build the HTTP request.
+				req, err := http.NewRequest(http.MethodGet, "/v1/acl/binding-rules", nil)
+				require.NoError(t, err)
+				respW := httptest.NewRecorder()
+
+				// Ensure we have a token set.
+				setToken(req, srv.RootToken)
+
+				// Send the HTTP request.
+				obj, err := srv.Server.ACLBindingRuleListRequest(respW, req)
+				require.NoError(t, err)
+				require.Len(t, obj.([]*structs.ACLBindingRuleListStub), 2)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpACLTest(t, nil, tc.testFn)
+		})
+	}
+}
+
+func TestHTTPServer_ACLBindingRuleRequest(t *testing.T) {
+	ci.Parallel(t)
+
+	httpACLTest(t, nil, func(srv *TestAgent) {
+
+		// Create the auth method the binding rule is linked to.
+		authMethod := mock.ACLAuthMethod()
+		require.NoError(t, srv.server.State().UpsertACLAuthMethods(
+			structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+		mockACLBindingRule := mock.ACLBindingRule()
+		mockACLBindingRule.ID = ""
+		mockACLBindingRule.AuthMethod = authMethod.Name
+
+		// Create the binding rule, which is given an ID by the server.
+		req, err := http.NewRequest(http.MethodPut, "/v1/acl/binding-rule", encodeReq(mockACLBindingRule))
+		require.NoError(t, err)
+		setToken(req, srv.RootToken)
+
+		obj, err := srv.Server.ACLBindingRuleRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		bindingRule := obj.(*structs.ACLBindingRule)
+		require.NotEmpty(t, bindingRule.ID)
+		url := "/v1/acl/binding-rule/" + bindingRule.ID
+
+		// Update the binding rule.
+		update := bindingRule.Copy()
+		update.Description = "updated"
+		req, err = http.NewRequest(http.MethodPost, url, encodeReq(update))
+		require.NoError(t, err)
+		setToken(req, srv.RootToken)
+
+		_, err = srv.Server.ACLBindingRuleSpecificRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+
+		// Read the binding rule.
+		req, err = http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		setToken(req, srv.RootToken)
+
+		obj, err = srv.Server.ACLBindingRuleSpecificRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		require.Equal(t, "updated", obj.(*structs.ACLBindingRule).Description)
+
+		// Delete the binding rule.
+		req, err = http.NewRequest(http.MethodDelete, url, nil)
+		require.NoError(t, err)
+		setToken(req, srv.RootToken)
+
+		_, err = srv.Server.ACLBindingRuleSpecificRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+
+		req, err = http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		setToken(req, srv.RootToken)
+
+		_, err = srv.Server.ACLBindingRuleSpecificRequest(httptest.NewRecorder(), req)
+		require.ErrorContains(t, err, "ACL binding rule not found")
+	})
+}
+
+func TestHTTPServer_ACLOIDCLogin(t *testing.T) {
+	ci.Parallel(t)
+
+	httpACLTest(t, nil, func(srv *TestAgent) {
+
+		// Start the fake OIDC provider and create an auth method using it,
+		// with a binding rule giving all its users management tokens.
+		const redirectURI = "http://localhost:4649/oidc/callback"
+		oidcProvider := oidc.NewTestProvider(t)
+
+		authMethod := mock.ACLAuthMethod()
+		authMethod.Config = oidcProvider.AuthMethodConfig(redirectURI)
+		authMethod.SetHash()
+		require.NoError(t, srv.server.State().UpsertACLAuthMethods(
+			structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+		bindingRule := mock.ACLBindingRule()
+		bindingRule.AuthMethod = authMethod.Name
+		bindingRule.Selector = ""
+		bindingRule.BindType = structs.ACLBindingRuleBindTypeManagement
+		bindingRule.BindName = ""
+		require.NoError(t, srv.server.State().UpsertACLBindingRules(
+			structs.MsgTypeTestSetup, 20, []*structs.ACLBindingRule{bindingRule}, false))
+
+		// Get the URL to authenticate at.
+		req, err := http.NewRequest(http.MethodPut, "/v1/acl/oidc/auth-url", encodeReq(&structs.ACLOIDCAuthURLRequest{
+			AuthMethodName: authMethod.Name,
+			RedirectURI:    redirectURI,
+			ClientNonce:    "nonce",
+		}))
+		require.NoError(t, err)
+
+		obj, err := srv.Server.ACLOIDCAuthURLRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		authURL := obj.(structs.ACLOIDCAuthURLResponse).AuthURL
+
+		// Complete the login with the code of the provider.
+		code, state := oidcProvider.Authorize(authURL)
+		req, err = http.NewRequest(http.MethodPut, "/v1/acl/oidc/complete-auth", encodeReq(&structs.ACLOIDCCompleteAuthRequest{
+			AuthMethodName: authMethod.Name,
+			ClientNonce:    "nonce",
+			State:          state,
+			Code:           code,
+			RedirectURI:    redirectURI,
+		}))
+		require.NoError(t, err)
+
+		obj, err = srv.Server.ACLOIDCCompleteAuthRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		token := obj.(*structs.ACLToken)
+		require.Equal(t, structs.ACLManagementToken, token.Type)
+		require.NotNil(t, token.ExpirationTime)
+	})
+}
+
+func TestHTTPServer_ACLLoginRequest(t *testing.T) {
+	ci.Parallel(t)
+
+	httpACLTest(t, nil, func(srv *TestAgent) {
+
+		// Start the fake JWT issuer and create an auth method using it, with
+		// a binding rule giving all its users management tokens.
+		issuer := jwt.NewTestIssuer(t)
+
+		authMethod := mock.ACLAuthMethod()
+		authMethod.Type = structs.ACLAuthMethodTypeJWT
+		authMethod.Config = issuer.AuthMethodConfig()
+		authMethod.SetHash()
+		require.NoError(t, srv.server.State().UpsertACLAuthMethods(
+			structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+		bindingRule := mock.ACLBindingRule()
+		bindingRule.AuthMethod = authMethod.Name
+		bindingRule.Selector = ""
+		bindingRule.BindType = structs.ACLBindingRuleBindTypeManagement
+		bindingRule.BindName = ""
+		require.NoError(t, srv.server.State().UpsertACLBindingRules(
+			structs.MsgTypeTestSetup, 20, []*structs.ACLBindingRule{bindingRule}, false))
+
+		// Only PUT and POST requests are supported.
+		req, err := http.NewRequest(http.MethodGet, "/v1/acl/login", nil)
+		require.NoError(t, err)
+		_, err = srv.Server.ACLLoginRequest(httptest.NewRecorder(), req)
+		require.EqualError(t, err, ErrInvalidMethod)
+
+		req, err = http.NewRequest(http.MethodPut, "/v1/acl/login", encodeReq(&structs.ACLLoginRequest{
+			AuthMethodName: authMethod.Name,
+			LoginToken:     issuer.Sign(nil),
+		}))
+		require.NoError(t, err)
+
+		obj, err := srv.Server.ACLLoginRequest(httptest.NewRecorder(), req)
+		require.NoError(t, err)
+		token := obj.(*structs.ACLToken)
+		require.Equal(t, structs.ACLManagementToken, token.Type)
+		require.NotNil(t, token.ExpirationTime)
+	})
+}
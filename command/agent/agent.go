@@ -9,6 +9,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
@@ -452,6 +453,57 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		return nil, fmt.Errorf("deploy_query_rate_limit must be greater than 0")
 	}
 
+	// Set the event log configuration.
+	if eventLogConf := agentConfig.Server.EventLog; eventLogConf != nil {
+		if eventLogConf.Enabled != nil {
+			conf.EventLogEnabled = *eventLogConf.Enabled
+		}
+		if eventLogConf.RetentionCount != nil {
+			if *eventLogConf.RetentionCount < 0 {
+				return nil, fmt.Errorf("event_log.retention_count must be 0 or greater")
+			}
+			conf.EventLogRetentionCount = *eventLogConf.RetentionCount
+		}
+		if eventLogConf.RetentionAgeHCL != "" {
+			if eventLogConf.RetentionAge < 0 {
+				return nil, fmt.Errorf("event_log.retention_age must be 0 or greater")
+			}
+			conf.EventLogRetentionAge = eventLogConf.RetentionAge
+		}
+		if conf.EventLogEnabled && !conf.EnableEventBroker {
+			return nil, fmt.Errorf("event_log requires enable_event_broker")
+		}
+	}
+
+	// Set the scheduled snapshots configuration.
+	if snapshotConf := agentConfig.Server.Snapshot; snapshotConf != nil {
+		if snapshotConf.Enabled != nil {
+			conf.SnapshotAgentEnabled = *snapshotConf.Enabled
+		}
+		if snapshotConf.LeaderOnly != nil {
+			conf.SnapshotAgentLeaderOnly = *snapshotConf.LeaderOnly
+		}
+		conf.SnapshotAgentPath = snapshotConf.Path
+		if snapshotConf.IntervalHCL != "" {
+			if snapshotConf.Interval <= 0 {
+				return nil, fmt.Errorf("snapshot.interval must be greater than 0")
+			}
+			conf.SnapshotAgentInterval = snapshotConf.Interval
+		}
+		if snapshotConf.RetainCount != nil {
+			if *snapshotConf.RetainCount < 0 {
+				return nil, fmt.Errorf("snapshot.retain_count must be 0 or greater")
+			}
+			conf.SnapshotAgentRetainCount = *snapshotConf.RetainCount
+		}
+		if snapshotConf.RetainAgeHCL != "" {
+			if snapshotConf.RetainAge < 0 {
+				return nil, fmt.Errorf("snapshot.retain_age must be 0 or greater")
+			}
+			conf.SnapshotAgentRetainAge = snapshotConf.RetainAge
+		}
+	}
+
 	// Set plan rejection tracker configuration.
 	if planRejectConf := agentConfig.Server.PlanRejectionTracker; planRejectConf != nil {
 		if planRejectConf.Enabled != nil {
@@ -634,6 +686,17 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	conf.MinDynamicPort = agentConfig.Client.MinDynamicPort
 	conf.DisableRemoteExec = agentConfig.Client.DisableRemoteExec
 
+	if len(agentConfig.Client.RedactProcessArgs) > 0 {
+		conf.RedactProcessArgs = make([]*regexp.Regexp, 0, len(agentConfig.Client.RedactProcessArgs))
+		for _, pattern := range agentConfig.Client.RedactProcessArgs {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing redact_process_args pattern %q: %v", pattern, err)
+			}
+			conf.RedactProcessArgs = append(conf.RedactProcessArgs, re)
+		}
+	}
+
 	if agentConfig.Client.TemplateConfig != nil {
 		conf.TemplateConfig = agentConfig.Client.TemplateConfig.Copy()
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/docker/docker/pkg/ioutils"
 	log "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-msgpack/codec"
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/api"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/command/agent/host"
@@ -487,10 +488,10 @@ func (s *HTTPServer) KeyringOperationRequest(resp http.ResponseWriter, req *http
 	var secret string
 	s.parseToken(req, &secret)
 
-	// Check agent write permissions
+	// Check gossip keyring permissions
 	if aclObj, err := srv.ResolveToken(secret); err != nil {
 		return nil, err
-	} else if aclObj != nil && !aclObj.AllowAgentWrite() {
+	} else if aclObj != nil && !aclObj.AllowAgentOperation(acl.AgentCapabilityGossipKeyring) {
 		return nil, structs.ErrPermissionDenied
 	}
 
@@ -973,6 +973,19 @@ func TestHTTP_AgentListKeys_ACL(t *testing.T) {
 			require.Contains(kresp.Keys, key1)
 		}
 
+		// Try request with a token only granting the gossip keyring capability
+		{
+			respW := httptest.NewRecorder()
+			token := mock.CreatePolicyAndToken(t, state, 1009, "keyring",
+				mock.CapabilityPolicy("agent", acl.AgentCapabilityGossipKeyring))
+			setToken(req, token)
+			out, err := s.Server.KeyringOperationRequest(respW, req)
+			require.Nil(err)
+			kresp := out.(structs.KeyringResponse)
+			require.Len(kresp.Keys, 1)
+			require.Contains(kresp.Keys, key1)
+		}
+
 		// Try request with a root token
 		{
 			respW := httptest.NewRecorder()
@@ -4,6 +4,8 @@
 package agent
 
 import (
+	"fmt"
+
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 )
@@ -14,11 +16,22 @@ type EnterpriseAgent struct{}
 
 func (a *Agent) setupEnterpriseAgent(log hclog.Logger) error {
 	// configure eventer
-	a.auditor = &noOpAuditor{}
+	fileAuditor, err := newAuditor(a.config.Audit, a.config.DataDir, log)
+	if err != nil {
+		return fmt.Errorf("failed to configure audit logging: %v", err)
+	}
+	a.auditor = fileAuditor
 
 	return nil
 }
 
 func (a *Agent) entReloadEventer(cfg *config.AuditConfig) error {
+	fileAuditor, ok := a.auditor.(*auditor)
+	if !ok {
+		return nil
+	}
+	if err := fileAuditor.setConfig(cfg, a.config.DataDir); err != nil {
+		return fmt.Errorf("failed to reload audit logging: %v", err)
+	}
 	return nil
 }
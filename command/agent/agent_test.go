@@ -444,6 +444,156 @@ func TestAgent_ServerConfig_PlanRejectionTracker(t *testing.T) {
 	}
 }
 
+func TestAgent_ServerConfig_EventLog(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name           string
+		eventLogConfig *EventLog
+		expectEnabled  bool
+		expectCount    int
+		expectAge      time.Duration
+		expectedErr    string
+	}{
+		{
+			name:        "default",
+			expectCount: 10000,
+			expectAge:   24 * time.Hour,
+		},
+		{
+			name: "valid config",
+			eventLogConfig: &EventLog{
+				Enabled:         pointer.Of(true),
+				RetentionCount:  pointer.Of(0),
+				RetentionAge:    time.Hour,
+				RetentionAgeHCL: "1h",
+			},
+			expectEnabled: true,
+			expectCount:   0,
+			expectAge:     time.Hour,
+		},
+		{
+			name: "invalid retention count",
+			eventLogConfig: &EventLog{
+				RetentionCount: pointer.Of(-1),
+			},
+			expectedErr: "event_log.retention_count must be 0 or greater",
+		},
+		{
+			name: "invalid retention age",
+			eventLogConfig: &EventLog{
+				RetentionAge:    -time.Hour,
+				RetentionAgeHCL: "-1h",
+			},
+			expectedErr: "event_log.retention_age must be 0 or greater",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DevConfig(nil)
+			require.NoError(t, config.normalizeAddrs())
+			config.Server.EventLog = tc.eventLogConfig
+
+			serverConfig, err := convertServerConfig(config)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectEnabled, serverConfig.EventLogEnabled)
+			require.Equal(t, tc.expectCount, serverConfig.EventLogRetentionCount)
+			require.Equal(t, tc.expectAge, serverConfig.EventLogRetentionAge)
+		})
+	}
+}
+
+func TestAgent_ServerConfig_Snapshot(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name              string
+		snapshotConfig    *ServerSnapshot
+		expectEnabled     bool
+		expectLeaderOnly  bool
+		expectPath        string
+		expectInterval    time.Duration
+		expectRetainCount int
+		expectRetainAge   time.Duration
+		expectedErr       string
+	}{
+		{
+			name:              "default",
+			expectLeaderOnly:  true,
+			expectInterval:    time.Hour,
+			expectRetainCount: 24,
+		},
+		{
+			name: "valid config",
+			snapshotConfig: &ServerSnapshot{
+				Enabled:      pointer.Of(true),
+				LeaderOnly:   pointer.Of(false),
+				Path:         "/opt/nomad/snapshots",
+				Interval:     30 * time.Minute,
+				IntervalHCL:  "30m",
+				RetainCount:  pointer.Of(0),
+				RetainAge:    72 * time.Hour,
+				RetainAgeHCL: "72h",
+			},
+			expectEnabled:     true,
+			expectPath:        "/opt/nomad/snapshots",
+			expectInterval:    30 * time.Minute,
+			expectRetainCount: 0,
+			expectRetainAge:   72 * time.Hour,
+		},
+		{
+			name: "invalid interval",
+			snapshotConfig: &ServerSnapshot{
+				IntervalHCL: "0s",
+			},
+			expectedErr: "snapshot.interval must be greater than 0",
+		},
+		{
+			name: "invalid retain count",
+			snapshotConfig: &ServerSnapshot{
+				RetainCount: pointer.Of(-1),
+			},
+			expectedErr: "snapshot.retain_count must be 0 or greater",
+		},
+		{
+			name: "invalid retain age",
+			snapshotConfig: &ServerSnapshot{
+				RetainAge:    -time.Hour,
+				RetainAgeHCL: "-1h",
+			},
+			expectedErr: "snapshot.retain_age must be 0 or greater",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DevConfig(nil)
+			require.NoError(t, config.normalizeAddrs())
+			config.Server.Snapshot = tc.snapshotConfig
+
+			serverConfig, err := convertServerConfig(config)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectEnabled, serverConfig.SnapshotAgentEnabled)
+			require.Equal(t, tc.expectLeaderOnly, serverConfig.SnapshotAgentLeaderOnly)
+			require.Equal(t, tc.expectPath, serverConfig.SnapshotAgentPath)
+			require.Equal(t, tc.expectInterval, serverConfig.SnapshotAgentInterval)
+			require.Equal(t, tc.expectRetainCount, serverConfig.SnapshotAgentRetainCount)
+			require.Equal(t, tc.expectRetainAge, serverConfig.SnapshotAgentRetainAge)
+		})
+	}
+}
+
 func TestAgent_ServerConfig_RaftMultiplier_Ok(t *testing.T) {
 	ci.Parallel(t)
 
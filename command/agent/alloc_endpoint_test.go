@@ -2,6 +2,7 @@ package agent
 
 import (
 	"archive/tar"
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -388,6 +389,147 @@ func TestHTTP_AllocRestart_ACL(t *testing.T) {
 	})
 }
 
+func TestHTTP_AllocPause(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	httpTest(t, func(c *Config) {
+		// Disable the schedulers
+		c.Server.NumSchedulers = pointer.Of(0)
+	}, func(s *TestAgent) {
+		// Only writes are allowed
+		{
+			req, err := http.NewRequest("GET", fmt.Sprintf("/v1/client/allocation/%s/pause", uuid.Generate()), nil)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.Error(err)
+			require.Equal(405, err.(HTTPCodedError).Code())
+		}
+
+		// Invalid body
+		{
+			buf := bytes.NewBufferString("{")
+			req, err := http.NewRequest("POST", fmt.Sprintf("/v1/client/allocation/%s/pause", uuid.Generate()), buf)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.Error(err)
+			require.Equal(400, err.(HTTPCodedError).Code())
+		}
+
+		// Local node, local resp
+		{
+			buf := encodeReq(map[string]string{})
+			req, err := http.NewRequest("POST", fmt.Sprintf("/v1/client/allocation/%s/pause", uuid.Generate()), buf)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.Error(err)
+			require.True(structs.IsErrUnknownAllocation(err), "(%T) %v", err, err)
+		}
+
+		// Local node, server resp
+		{
+			srv := s.server
+			s.server = nil
+
+			buf := encodeReq(map[string]string{})
+			req, err := http.NewRequest("POST", fmt.Sprintf("/v1/client/allocation/%s/pause", uuid.Generate()), buf)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.Error(err)
+			require.True(structs.IsErrUnknownAllocation(err), "(%T) %v", err, err)
+
+			s.server = srv
+		}
+
+		// no client, server resp
+		{
+			c := s.client
+			s.client = nil
+
+			testutil.WaitForResult(func() (bool, error) {
+				n, err := s.server.State().NodeByID(nil, c.NodeID())
+				if err != nil {
+					return false, err
+				}
+				return n != nil, nil
+			}, func(err error) {
+				t.Fatalf("should have client: %v", err)
+			})
+
+			buf := encodeReq(map[string]string{})
+			req, err := http.NewRequest("POST", fmt.Sprintf("/v1/client/allocation/%s/pause", uuid.Generate()), buf)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.Error(err)
+			require.True(structs.IsErrUnknownAllocation(err), "(%T) %v", err, err)
+
+			s.client = c
+		}
+
+		// Create a running alloc
+		state := s.server.State()
+		alloc := mock.Alloc()
+		alloc.Job.TaskGroups[0].Tasks[0].Driver = "mock_driver"
+		alloc.Job.TaskGroups[0].Tasks[0].Config = map[string]interface{}{
+			"run_for": "30s",
+		}
+		alloc.NodeID = s.client.NodeID()
+		require.NoError(state.UpsertJobSummary(998, mock.JobSummary(alloc.JobID)))
+		require.NoError(state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, []*structs.Allocation{alloc.Copy()}))
+
+		waitForTaskState := func(expected string) {
+			testutil.WaitForResult(func() (bool, error) {
+				allocState, err := s.client.GetAllocState(alloc.ID)
+				if err != nil {
+					return false, err
+				}
+				taskState := allocState.TaskStates["web"]
+				if taskState == nil || taskState.State != expected {
+					return false, fmt.Errorf("task state: %#v", taskState)
+				}
+				return true, nil
+			}, func(err error) {
+				t.Fatalf("task not %s: %v", expected, err)
+			})
+		}
+		waitForTaskState(structs.TaskStateRunning)
+
+		// Pause the task named in the body
+		{
+			buf := encodeReq(map[string]interface{}{"Task": "web"})
+			req, err := http.NewRequest("POST", fmt.Sprintf("/v1/client/allocation/%s/pause", alloc.ID), buf)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.NoError(err)
+			waitForTaskState(structs.TaskStatePaused)
+		}
+
+		// Resume it
+		{
+			buf := encodeReq(map[string]interface{}{"Task": "web", "Resume": true})
+			req, err := http.NewRequest("PUT", fmt.Sprintf("/v1/client/allocation/%s/pause", alloc.ID), buf)
+			require.NoError(err)
+
+			respW := httptest.NewRecorder()
+			_, err = s.Server.ClientAllocRequest(respW, req)
+			require.NoError(err)
+			waitForTaskState(structs.TaskStateRunning)
+		}
+	})
+}
+
 func TestHTTP_AllocStop(t *testing.T) {
 	ci.Parallel(t)
 	httpTest(t, nil, func(s *TestAgent) {
@@ -0,0 +1,460 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/ryanuber/go-glob"
+
+	"github.com/hashicorp/nomad/command/agent/event"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+)
+
+const (
+	// auditDeliveryEnforced fails requests whose audit events could not be
+	// written, while auditDeliveryBestEffort only logs the failure
+	auditDeliveryEnforced   = "enforced"
+	auditDeliveryBestEffort = "best-effort"
+
+	auditSinkTypeFile   = "file"
+	auditSinkFormatJSON = "json"
+
+	defaultAuditSinkName       = "audit"
+	defaultAuditFileMode       = "0600"
+	defaultAuditRotateDuration = 24 * time.Hour
+
+	// maxAuditBodySize is the size above which request bodies are not
+	// recorded in audit events
+	maxAuditBodySize = 64 * 1024
+
+	// auditRedacted replaces secrets in the recorded request bodies
+	auditRedacted = "<redacted>"
+)
+
+// errAuditFailed is returned for requests failed because their audit event
+// could not be written to an enforced sink
+var errAuditFailed = errors.New("failed to write audit log")
+
+// auditRedactedKeys are the keys of JSON objects in request bodies whose
+// values are redacted from audit events, compared case insensitively.
+var auditRedactedKeys = map[string]struct{}{
+	"authtoken":        {},
+	"clientnonce":      {},
+	"clientsecret":     {},
+	"code":             {},
+	"consultoken":      {},
+	"encryptkey":       {},
+	"items":            {},
+	"key":              {},
+	"logintoken":       {},
+	"oidcclientsecret": {},
+	"password":         {},
+	"replicationtoken": {},
+	"secretid":         {},
+	"token":            {},
+	"vaulttoken":       {},
+}
+
+// auditor is an event.Auditor writing audit events as JSON lines to a file
+// sink, which is rotated like the agent log file.
+type auditor struct {
+	logger hclog.Logger
+
+	lock     sync.RWMutex
+	enabled  bool
+	enforced bool
+	filters  []*config.AuditFilter
+	sink     *logFile
+}
+
+// Ensure auditor is an Auditor
+var _ event.Auditor = &auditor{}
+
+// newAuditor returns an auditor for the audit config. Sinks without a path
+// write to the audit directory of the data dir.
+func newAuditor(cfg *config.AuditConfig, dataDir string, logger hclog.Logger) (*auditor, error) {
+	a := &auditor{logger: logger.Named("audit")}
+	if err := a.setConfig(cfg, dataDir); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// setConfig validates the audit config and replaces the sink and filters of
+// the auditor.
+func (a *auditor) setConfig(cfg *config.AuditConfig, dataDir string) error {
+	var (
+		enabled  = cfg != nil && cfg.Enabled != nil && *cfg.Enabled
+		enforced bool
+		sink     *logFile
+	)
+	if enabled {
+		if err := validateAuditFilters(cfg.Filters); err != nil {
+			return err
+		}
+
+		sinkCfg, err := auditSinkConfig(cfg.Sinks, dataDir)
+		if err != nil {
+			return err
+		}
+		mode, err := strconv.ParseUint(sinkCfg.Mode, 8, 32)
+		if err != nil {
+			return fmt.Errorf("invalid audit sink %q mode %q: %v", sinkCfg.Name, sinkCfg.Mode, err)
+		}
+		dir, file := filepath.Split(sinkCfg.Path)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("failed to create audit log directory: %v", err)
+		}
+
+		enforced = sinkCfg.DeliveryGuarantee == auditDeliveryEnforced
+		sink = &logFile{
+			fileName: file,
+			logPath:  dir,
+			duration: sinkCfg.RotateDuration,
+			MaxBytes: sinkCfg.RotateBytes,
+			MaxFiles: sinkCfg.RotateMaxFiles,
+			fileMode: os.FileMode(mode),
+		}
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.sink != nil {
+		if err := a.sink.reopen(); err != nil {
+			a.logger.Warn("failed to close audit log", "error", err)
+		}
+	}
+	a.enabled = enabled
+	a.enforced = enforced
+	a.sink = sink
+	if enabled {
+		a.filters = cfg.Filters
+	} else {
+		a.filters = nil
+	}
+	return nil
+}
+
+// auditSinkConfig returns the validated config of the single supported sink,
+// with its defaults set.
+func auditSinkConfig(sinks []*config.AuditSink, dataDir string) (*config.AuditSink, error) {
+	if len(sinks) > 1 {
+		return nil, errors.New("only a single audit sink is supported")
+	}
+
+	sink := &config.AuditSink{Name: defaultAuditSinkName}
+	if len(sinks) == 1 {
+		sink = sinks[0].Copy()
+	}
+
+	if sink.Type == "" {
+		sink.Type = auditSinkTypeFile
+	}
+	if sink.Format == "" {
+		sink.Format = auditSinkFormatJSON
+	}
+	if sink.DeliveryGuarantee == "" {
+		sink.DeliveryGuarantee = auditDeliveryEnforced
+	}
+	if sink.Mode == "" {
+		sink.Mode = defaultAuditFileMode
+	}
+	if sink.RotateDuration == 0 {
+		sink.RotateDuration = defaultAuditRotateDuration
+	}
+	if sink.Path == "" {
+		if dataDir == "" {
+			return nil, fmt.Errorf("audit sink %q requires a path when the agent has no data_dir", sink.Name)
+		}
+		sink.Path = filepath.Join(dataDir, "audit", "audit.log")
+	}
+
+	switch {
+	case sink.Type != auditSinkTypeFile:
+		return nil, fmt.Errorf("audit sink %q has unsupported type %q", sink.Name, sink.Type)
+	case sink.Format != auditSinkFormatJSON:
+		return nil, fmt.Errorf("audit sink %q has unsupported format %q", sink.Name, sink.Format)
+	case sink.DeliveryGuarantee != auditDeliveryEnforced && sink.DeliveryGuarantee != auditDeliveryBestEffort:
+		return nil, fmt.Errorf("audit sink %q has invalid delivery guarantee %q", sink.Name, sink.DeliveryGuarantee)
+	case sink.RotateDuration < 0 || sink.RotateBytes < 0 || sink.RotateMaxFiles < 0:
+		return nil, fmt.Errorf("audit sink %q rotation settings must not be negative", sink.Name)
+	}
+	return sink, nil
+}
+
+// validateAuditFilters checks that the filters are for HTTP events and match
+// endpoints.
+func validateAuditFilters(filters []*config.AuditFilter) error {
+	for _, f := range filters {
+		if f.Type != event.HTTPEvent {
+			return fmt.Errorf("audit filter %q has unsupported type %q", f.Name, f.Type)
+		}
+		if len(f.Endpoints) == 0 {
+			return fmt.Errorf("audit filter %q must match at least one endpoint", f.Name)
+		}
+	}
+	return nil
+}
+
+// Event writes the audit event to the sink unless it is filtered out. An
+// error is only returned if the event could not be written and delivery is
+// enforced. Events are written without buffering and aren't synced to disk.
+func (a *auditor) Event(_ context.Context, eventType string, payload interface{}) error {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	if !a.enabled || a.sink == nil {
+		return nil
+	}
+	if ev, ok := payload.(*event.AuditEvent); ok && a.filtered(ev) {
+		return nil
+	}
+
+	buf, err := json.Marshal(&event.AuditEntry{
+		CreatedAt: time.Now(),
+		EventType: eventType,
+		Payload:   payload,
+	})
+	if err == nil {
+		_, err = a.sink.Write(append(buf, '\n'))
+	}
+	if err != nil {
+		if a.enforced {
+			a.logger.Error("failed to write audit event", "error", err)
+			return err
+		}
+		a.logger.Warn("failed to write audit event", "error", err)
+	}
+	return nil
+}
+
+// filtered returns true if one of the filters matches the endpoint, stage
+// and operation of the event. Query parameters are ignored and filters
+// without stages or operations match all of them.
+func (a *auditor) filtered(ev *event.AuditEvent) bool {
+	if ev.Request == nil {
+		return false
+	}
+	endpoint, _, _ := strings.Cut(ev.Request.Endpoint, "?")
+
+	matches := func(patterns []string, value string) bool {
+		for _, pattern := range patterns {
+			if glob.Glob(pattern, value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, f := range a.filters {
+		if !matches(f.Endpoints, endpoint) {
+			continue
+		}
+		if len(f.Stages) != 0 && !matches(f.Stages, string(ev.Stage)) {
+			continue
+		}
+		if len(f.Operations) != 0 && !matches(f.Operations, ev.Request.Operation) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// Enabled returns whether audit events are written.
+func (a *auditor) Enabled() bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.enabled
+}
+
+// SetEnabled enables or disables writing audit events.
+func (a *auditor) SetEnabled(enabled bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.enabled = enabled
+}
+
+// DeliveryEnforced returns whether requests fail if their audit events
+// can't be written.
+func (a *auditor) DeliveryEnforced() bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.enforced
+}
+
+// Reopen closes the audit log file, so that it is recreated by the next
+// event if it was moved away.
+func (a *auditor) Reopen() error {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	if a.sink == nil {
+		return nil
+	}
+	return a.sink.reopen()
+}
+
+// auditRequest emits the OperationReceived audit event of the request and
+// returns it, so it can be completed once the request is processed. The
+// event is nil if audit logging is disabled.
+func (s *HTTPServer) auditRequest(req *http.Request) (*event.AuditEvent, error) {
+	if !s.agent.auditor.Enabled() {
+		return nil, nil
+	}
+
+	var namespace string
+	parseNamespace(req, &namespace)
+
+	ev := &event.AuditEvent{
+		ID:        uuid.Generate(),
+		Stage:     event.OperationReceived,
+		Type:      event.AuditEventType,
+		Timestamp: time.Now(),
+		Version:   event.AuditEventVersion,
+		Auth:      s.auditAuth(req),
+		Request: &event.AuditRequest{
+			ID:        uuid.Generate(),
+			Operation: req.Method,
+			Endpoint:  req.URL.String(),
+			Namespace: &event.AuditNamespace{ID: namespace},
+			RequestMeta: &event.AuditRequestMeta{
+				RemoteAddress: req.RemoteAddr,
+				UserAgent:     req.UserAgent(),
+			},
+			NodeMeta: &event.AuditNodeMeta{IP: s.Addr},
+			Body:     auditRequestBody(req),
+		},
+	}
+
+	if err := s.agent.auditor.Event(req.Context(), event.AuditEventType, ev); err != nil {
+		return nil, CodedError(500, errAuditFailed.Error())
+	}
+	return ev, nil
+}
+
+// auditResponse emits the OperationComplete audit event of a request which
+// was audited by auditRequest. The response must not have been sent yet for
+// an error to fail the request.
+func (s *HTTPServer) auditResponse(req *http.Request, ev *event.AuditEvent, code int, errMsg string) error {
+	if ev == nil {
+		return nil
+	}
+
+	complete := *ev
+	complete.Stage = event.OperationComplete
+	complete.Response = &event.AuditResponse{
+		StatusCode: code,
+		Error:      errMsg,
+	}
+
+	if err := s.agent.auditor.Event(req.Context(), event.AuditEventType, &complete); err != nil {
+		return CodedError(500, errAuditFailed.Error())
+	}
+	return nil
+}
+
+// auditAuth returns the identity of the token the request was made with, or
+// nil if ACLs are disabled or the token can't be resolved.
+func (s *HTTPServer) auditAuth(req *http.Request) *event.AuditAuth {
+	var secret string
+	s.parseToken(req, &secret)
+
+	var token *structs.ACLToken
+	var err error
+	if srv := s.agent.Server(); srv != nil {
+		token, err = srv.ResolveSecretToken(secret)
+	} else {
+		token, err = s.agent.Client().ResolveSecretToken(secret)
+	}
+	if err != nil || token == nil {
+		return nil
+	}
+
+	var roles []string
+	for _, link := range token.Roles {
+		if link.Name != "" {
+			roles = append(roles, link.Name)
+		} else {
+			roles = append(roles, link.ID)
+		}
+	}
+
+	return &event.AuditAuth{
+		AccessorID: token.AccessorID,
+		Name:       token.Name,
+		Policies:   token.Policies,
+		Roles:      roles,
+		Global:     token.Global,
+		CreateTime: token.CreateTime,
+	}
+}
+
+// auditRequestBody returns the JSON body of the request with its secrets
+// redacted. The body is left readable for the handler. Bodies which are too
+// large or not JSON are not recorded.
+func auditRequestBody(req *http.Request) interface{} {
+	if req.Method == http.MethodGet || req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, maxAuditBodySize+1))
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), req.Body), req.Body}
+	if err != nil || len(buf) > maxAuditBodySize {
+		return nil
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(buf, &body); err != nil {
+		return nil
+	}
+	return redactAuditBody(body)
+}
+
+// redactAuditBody replaces the values of secret keys in the decoded JSON.
+func redactAuditBody(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := auditRedactedKeys[strings.ToLower(key)]; ok && value != nil {
+				v[key] = auditRedacted
+				continue
+			}
+			v[key] = redactAuditBody(value)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactAuditBody(v[i])
+		}
+	}
+	return v
+}
+
+// auditStatusRecorder records the status code written by http.Handlers
+type auditStatusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *auditStatusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
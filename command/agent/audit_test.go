@@ -0,0 +1,274 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/shoenig/test/must"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent/event"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/structs/config"
+)
+
+// readAuditLog returns the payloads of the entries of the audit log.
+func readAuditLog(t *testing.T, path string) []*event.AuditEvent {
+	t.Helper()
+
+	f, err := os.Open(path)
+	must.NoError(t, err)
+	defer f.Close()
+
+	var events []*event.AuditEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry struct {
+			EventType string            `json:"event_type"`
+			Payload   *event.AuditEvent `json:"payload"`
+		}
+		must.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		must.Eq(t, event.AuditEventType, entry.EventType)
+		events = append(events, entry.Payload)
+	}
+	must.NoError(t, scanner.Err())
+	return events
+}
+
+func testAuditEvent(stage event.Stage, method, endpoint string) *event.AuditEvent {
+	return &event.AuditEvent{
+		ID:    "test",
+		Stage: stage,
+		Type:  event.AuditEventType,
+		Request: &event.AuditRequest{
+			Operation: method,
+			Endpoint:  endpoint,
+		},
+	}
+}
+
+func TestAuditor_Event(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	a, err := newAuditor(&config.AuditConfig{
+		Enabled: pointer.Of(true),
+		Filters: []*config.AuditFilter{
+			{
+				Name:      "metrics",
+				Type:      event.HTTPEvent,
+				Endpoints: []string{"/v1/metrics"},
+			},
+			{
+				Name:       "received reads",
+				Type:       event.HTTPEvent,
+				Endpoints:  []string{"/v1/job/*"},
+				Stages:     []string{string(event.OperationReceived)},
+				Operations: []string{"GET"},
+			},
+		},
+	}, dir, hclog.NewNullLogger())
+	must.NoError(t, err)
+	must.True(t, a.Enabled())
+	must.True(t, a.DeliveryEnforced())
+
+	ctx := context.Background()
+	emit := func(stage event.Stage, method, endpoint string) {
+		must.NoError(t, a.Event(ctx, event.AuditEventType, testAuditEvent(stage, method, endpoint)))
+	}
+	emit(event.OperationReceived, "GET", "/v1/metrics?format=prometheus")
+	emit(event.OperationComplete, "GET", "/v1/metrics")
+	emit(event.OperationReceived, "GET", "/v1/job/example")
+	emit(event.OperationComplete, "GET", "/v1/job/example")
+	emit(event.OperationReceived, "POST", "/v1/job/example")
+
+	events := readAuditLog(t, filepath.Join(dir, "audit", "audit.log"))
+	must.Len(t, 2, events)
+	must.Eq(t, event.OperationComplete, events[0].Stage)
+	must.Eq(t, "GET", events[0].Request.Operation)
+	must.Eq(t, event.OperationReceived, events[1].Stage)
+	must.Eq(t, "POST", events[1].Request.Operation)
+
+	// Disabled auditors don't write events
+	a.SetEnabled(false)
+	emit(event.OperationReceived, "POST", "/v1/job/example")
+	must.Len(t, 2, readAuditLog(t, filepath.Join(dir, "audit", "audit.log")))
+}
+
+func TestAuditor_DeliveryGuarantee(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, guarantee := range []string{auditDeliveryEnforced, auditDeliveryBestEffort} {
+		t.Run(guarantee, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "audit")
+			a, err := newAuditor(&config.AuditConfig{
+				Enabled: pointer.Of(true),
+				Sinks: []*config.AuditSink{{
+					Name:              "audit",
+					DeliveryGuarantee: guarantee,
+					Path:              filepath.Join(dir, "audit.log"),
+				}},
+			}, "", hclog.NewNullLogger())
+			must.NoError(t, err)
+
+			// Writes fail once the directory of the sink is gone
+			must.NoError(t, os.RemoveAll(dir))
+			err = a.Event(context.Background(), event.AuditEventType,
+				testAuditEvent(event.OperationReceived, "GET", "/v1/jobs"))
+			if guarantee == auditDeliveryEnforced {
+				must.Error(t, err)
+			} else {
+				must.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuditor_InvalidConfig(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name string
+		cfg  *config.AuditConfig
+		err  string
+	}{
+		{
+			name: "no path",
+			cfg:  &config.AuditConfig{Enabled: pointer.Of(true)},
+			err:  `audit sink "audit" requires a path when the agent has no data_dir`,
+		},
+		{
+			name: "multiple sinks",
+			cfg: &config.AuditConfig{
+				Enabled: pointer.Of(true),
+				Sinks:   []*config.AuditSink{{Name: "a"}, {Name: "b"}},
+			},
+			err: "only a single audit sink is supported",
+		},
+		{
+			name: "delivery guarantee",
+			cfg: &config.AuditConfig{
+				Enabled: pointer.Of(true),
+				Sinks:   []*config.AuditSink{{Name: "a", Path: "/tmp/audit.log", DeliveryGuarantee: "sometimes"}},
+			},
+			err: `audit sink "a" has invalid delivery guarantee "sometimes"`,
+		},
+		{
+			name: "filter type",
+			cfg: &config.AuditConfig{
+				Enabled: pointer.Of(true),
+				Filters: []*config.AuditFilter{{Name: "f", Type: "RPCEvent", Endpoints: []string{"*"}}},
+			},
+			err: `audit filter "f" has unsupported type "RPCEvent"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newAuditor(tc.cfg, "", hclog.NewNullLogger())
+			must.EqError(t, err, tc.err)
+		})
+	}
+
+	// Invalid settings are not checked while audit logging is disabled
+	_, err := newAuditor(&config.AuditConfig{}, "", hclog.NewNullLogger())
+	must.NoError(t, err)
+}
+
+func TestRedactAuditBody(t *testing.T) {
+	ci.Parallel(t)
+
+	var body interface{}
+	must.NoError(t, json.Unmarshal([]byte(`{
+		"Job": {"ID": "example", "VaultToken": "s.vault", "Meta": {"owner": "ops"}},
+		"Variable": {"Path": "app/db", "Items": {"password": "hunter2"}},
+		"Tokens": [{"Name": "ci", "SecretID": "secret"}]
+	}`), &body))
+
+	redacted := redactAuditBody(body).(map[string]interface{})
+	job := redacted["Job"].(map[string]interface{})
+	must.Eq(t, "example", job["ID"].(string))
+	must.Eq(t, auditRedacted, job["VaultToken"].(string))
+	must.Eq(t, "ops", job["Meta"].(map[string]interface{})["owner"].(string))
+	must.Eq(t, auditRedacted, redacted["Variable"].(map[string]interface{})["Items"].(string))
+	token := redacted["Tokens"].([]interface{})[0].(map[string]interface{})
+	must.Eq(t, "ci", token["Name"].(string))
+	must.Eq(t, auditRedacted, token["SecretID"].(string))
+
+	// The code and nonce of OIDC logins can be exchanged for a token
+	must.NoError(t, json.Unmarshal([]byte(`{
+		"AuthMethodName": "okta",
+		"ClientNonce": "nonce",
+		"State": "state",
+		"Code": "code",
+		"RedirectURI": "http://localhost:4649/oidc/callback"
+	}`), &body))
+
+	redacted = redactAuditBody(body).(map[string]interface{})
+	must.Eq(t, "okta", redacted["AuthMethodName"].(string))
+	must.Eq(t, auditRedacted, redacted["ClientNonce"].(string))
+	must.Eq(t, auditRedacted, redacted["Code"].(string))
+}
+
+func TestHTTP_Audit(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	httpACLTest(t, func(c *Config) {
+		c.Audit = &config.AuditConfig{
+			Enabled: pointer.Of(true),
+			Sinks:   []*config.AuditSink{{Name: "audit", Path: path}},
+		}
+	}, func(s *TestAgent) {
+		body := `{"Name": "ci", "Type": "client", "Policies": ["deploy"], "SecretID": "not-logged"}`
+		req, err := http.NewRequest("PUT", "/v1/acl/token?namespace=prod", strings.NewReader(body))
+		must.NoError(t, err)
+		setToken(req, s.RootToken)
+		req.RemoteAddr = "10.0.0.1:4000"
+
+		respW := httptest.NewRecorder()
+		s.Server.mux.ServeHTTP(respW, req)
+		must.Eq(t, http.StatusOK, respW.Code)
+
+		events := readAuditLog(t, path)
+		must.Len(t, 2, events)
+
+		received, complete := events[0], events[1]
+		must.Eq(t, event.OperationReceived, received.Stage)
+		must.Nil(t, received.Response)
+		must.Eq(t, event.OperationComplete, complete.Stage)
+		must.Eq(t, received.ID, complete.ID)
+		must.Eq(t, http.StatusOK, complete.Response.StatusCode)
+
+		must.Eq(t, s.RootToken.AccessorID, complete.Auth.AccessorID)
+		must.Eq(t, "PUT", complete.Request.Operation)
+		must.Eq(t, "/v1/acl/token?namespace=prod", complete.Request.Endpoint)
+		must.Eq(t, "prod", complete.Request.Namespace.ID)
+		must.Eq(t, "10.0.0.1:4000", complete.Request.RequestMeta.RemoteAddress)
+
+		requestBody := complete.Request.Body.(map[string]interface{})
+		must.Eq(t, "ci", requestBody["Name"].(string))
+		must.Eq(t, auditRedacted, requestBody["SecretID"].(string))
+
+		// Failed requests record the error
+		req, err = http.NewRequest("GET", "/v1/jobs", nil)
+		must.NoError(t, err)
+		respW = httptest.NewRecorder()
+		s.Server.mux.ServeHTTP(respW, req)
+		must.Eq(t, http.StatusForbidden, respW.Code)
+
+		events = readAuditLog(t, path)
+		must.Len(t, 4, events)
+		must.Eq(t, http.StatusForbidden, events[3].Response.StatusCode)
+		must.Eq(t, "Permission denied", events[3].Response.Error)
+		must.Eq(t, "anonymous", events[3].Auth.AccessorID)
+	})
+}
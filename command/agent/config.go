@@ -281,6 +281,11 @@ type ClientConfig struct {
 	// DisableRemoteExec disables remote exec targeting tasks on this client
 	DisableRemoteExec bool `hcl:"disable_remote_exec"`
 
+	// RedactProcessArgs are regular expressions matching the process
+	// arguments redacted from the process stats of tasks. If unset the
+	// client's default patterns are used.
+	RedactProcessArgs []string `hcl:"redact_process_args"`
+
 	// TemplateConfig includes configuration for template rendering
 	TemplateConfig *client.ClientTemplateConfig `hcl:"template"`
 
@@ -580,6 +585,12 @@ type ServerConfig struct {
 	// for the EventBufferSize is 1.
 	EventBufferSize *int `hcl:"event_buffer_size"`
 
+	// EventLog configures the on-disk log of the events of the event stream.
+	EventLog *EventLog `hcl:"event_log"`
+
+	// Snapshot configures the scheduled snapshots of the raft state.
+	Snapshot *ServerSnapshot `hcl:"snapshot"`
+
 	// LicensePath is the path to search for an enterprise license.
 	LicensePath string `hcl:"license_path"`
 
@@ -621,6 +632,8 @@ func (s *ServerConfig) Copy() *ServerConfig {
 	ns.PlanRejectionTracker = s.PlanRejectionTracker.Copy()
 	ns.EnableEventBroker = pointer.Copy(s.EnableEventBroker)
 	ns.EventBufferSize = pointer.Copy(s.EventBufferSize)
+	ns.EventLog = s.EventLog.Copy()
+	ns.Snapshot = s.Snapshot.Copy()
 	ns.licenseAdditionalPublicKeys = slices.Clone(s.licenseAdditionalPublicKeys)
 	ns.ExtraKeysHCL = slices.Clone(s.ExtraKeysHCL)
 	ns.Search = s.Search.Copy()
@@ -706,6 +719,140 @@ func (p *PlanRejectionTracker) Merge(b *PlanRejectionTracker) *PlanRejectionTrac
 	return &result
 }
 
+// EventLog is used in servers to configure the on-disk event log, which
+// serves event stream subscribers whose index is older than the in-memory
+// event buffer.
+type EventLog struct {
+	// Enabled controls if published events are written to the event log.
+	Enabled *bool `hcl:"enabled"`
+
+	// RetentionCount is the number of raft indexes whose events are
+	// retained. Zero retains any number of indexes.
+	RetentionCount *int `hcl:"retention_count"`
+
+	// RetentionAge is how long events are retained. Zero retains events
+	// regardless of their age.
+	RetentionAge    time.Duration
+	RetentionAgeHCL string `hcl:"retention_age" json:"-"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (e *EventLog) Copy() *EventLog {
+	if e == nil {
+		return nil
+	}
+
+	ne := *e
+	ne.Enabled = pointer.Copy(e.Enabled)
+	ne.RetentionCount = pointer.Copy(e.RetentionCount)
+	ne.ExtraKeysHCL = slices.Clone(e.ExtraKeysHCL)
+	return &ne
+}
+
+func (e *EventLog) Merge(b *EventLog) *EventLog {
+	if e == nil {
+		return b
+	}
+
+	result := *e
+
+	if b == nil {
+		return &result
+	}
+
+	if b.Enabled != nil {
+		result.Enabled = b.Enabled
+	}
+	if b.RetentionCount != nil {
+		result.RetentionCount = b.RetentionCount
+	}
+	if b.RetentionAge != 0 || b.RetentionAgeHCL != "" {
+		result.RetentionAge = b.RetentionAge
+		result.RetentionAgeHCL = b.RetentionAgeHCL
+	}
+	return &result
+}
+
+// ServerSnapshot is used in servers to configure scheduled snapshots of the
+// raft state.
+type ServerSnapshot struct {
+	// Enabled controls if the server takes scheduled snapshots.
+	Enabled *bool `hcl:"enabled"`
+
+	// LeaderOnly controls if snapshots are only taken while the server is
+	// the leader. Defaults to true.
+	LeaderOnly *bool `hcl:"leader_only"`
+
+	// Path is the directory snapshots are written to. Defaults to the
+	// snapshots directory of the server's data directory.
+	Path string `hcl:"path"`
+
+	// Interval is the interval between snapshots.
+	Interval    time.Duration `hcl:"-"`
+	IntervalHCL string        `hcl:"interval" json:"-"`
+
+	// RetainCount is the number of snapshots retained. Zero retains any
+	// number of snapshots.
+	RetainCount *int `hcl:"retain_count"`
+
+	// RetainAge is how long snapshots are retained. Zero retains snapshots
+	// regardless of their age.
+	RetainAge    time.Duration
+	RetainAgeHCL string `hcl:"retain_age" json:"-"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+func (s *ServerSnapshot) Copy() *ServerSnapshot {
+	if s == nil {
+		return nil
+	}
+
+	ns := *s
+	ns.Enabled = pointer.Copy(s.Enabled)
+	ns.LeaderOnly = pointer.Copy(s.LeaderOnly)
+	ns.RetainCount = pointer.Copy(s.RetainCount)
+	ns.ExtraKeysHCL = slices.Clone(s.ExtraKeysHCL)
+	return &ns
+}
+
+func (s *ServerSnapshot) Merge(b *ServerSnapshot) *ServerSnapshot {
+	if s == nil {
+		return b
+	}
+
+	result := *s
+
+	if b == nil {
+		return &result
+	}
+
+	if b.Enabled != nil {
+		result.Enabled = b.Enabled
+	}
+	if b.LeaderOnly != nil {
+		result.LeaderOnly = b.LeaderOnly
+	}
+	if b.Path != "" {
+		result.Path = b.Path
+	}
+	if b.Interval != 0 || b.IntervalHCL != "" {
+		result.Interval = b.Interval
+		result.IntervalHCL = b.IntervalHCL
+	}
+	if b.RetainCount != nil {
+		result.RetainCount = b.RetainCount
+	}
+	if b.RetainAge != 0 || b.RetainAgeHCL != "" {
+		result.RetainAge = b.RetainAge
+		result.RetainAgeHCL = b.RetainAgeHCL
+	}
+	return &result
+}
+
 // Search is used in servers to configure search API options.
 type Search struct {
 	// FuzzyEnabled toggles whether the FuzzySearch API is enabled. If not
@@ -1894,6 +2041,14 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.EventBufferSize = b.EventBufferSize
 	}
 
+	if b.EventLog != nil {
+		result.EventLog = result.EventLog.Merge(b.EventLog)
+	}
+
+	if b.Snapshot != nil {
+		result.Snapshot = result.Snapshot.Merge(b.Snapshot)
+	}
+
 	if b.PlanRejectionTracker != nil {
 		result.PlanRejectionTracker = result.PlanRejectionTracker.Merge(b.PlanRejectionTracker)
 	}
@@ -2021,6 +2176,10 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 		result.DisableRemoteExec = b.DisableRemoteExec
 	}
 
+	if len(b.RedactProcessArgs) > 0 {
+		result.RedactProcessArgs = b.RedactProcessArgs
+	}
+
 	if b.TemplateConfig != nil {
 		result.TemplateConfig = b.TemplateConfig
 	}
@@ -146,6 +146,20 @@ func ParseConfigFile(path string) (*Config, error) {
 			fmt.Sprintf("audit.sink.%d", i), &sink.RotateDuration, &sink.RotateDurationHCL, nil})
 	}
 
+	// Add the event log retention for time.Duration parsing
+	if c.Server.EventLog != nil {
+		tds = append(tds, durationConversionMap{
+			"server.event_log.retention_age", &c.Server.EventLog.RetentionAge, &c.Server.EventLog.RetentionAgeHCL, nil})
+	}
+
+	// Add the scheduled snapshots durations for time.Duration parsing
+	if c.Server.Snapshot != nil {
+		tds = append(tds,
+			durationConversionMap{"server.snapshot.interval", &c.Server.Snapshot.Interval, &c.Server.Snapshot.IntervalHCL, nil},
+			durationConversionMap{"server.snapshot.retain_age", &c.Server.Snapshot.RetainAge, &c.Server.Snapshot.RetainAgeHCL, nil},
+		)
+	}
+
 	// convert strings to time.Durations
 	err = convertDurations(tds)
 	if err != nil {
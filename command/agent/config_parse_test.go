@@ -84,6 +84,7 @@ var basicConfig = &Config{
 		GCMaxAllocs:           50,
 		NoHostUUID:            pointer.Of(false),
 		DisableRemoteExec:     true,
+		RedactProcessArgs:     []string{"(?i)api_key"},
 		HostVolumes: []*structs.ClientHostVolumeConfig{
 			{Name: "tmp", Path: "/tmp"},
 		},
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"time"
+)
+
+const (
+	// AuditEventType is the event type of the entries of the audit log.
+	AuditEventType = "audit"
+
+	// AuditEventVersion is the version of the audit event format.
+	AuditEventVersion = 1
+
+	// HTTPEvent is the filter type matching audit events of HTTP requests.
+	HTTPEvent = "HTTPEvent"
+)
+
+// Stage is the stage of a request an audit event is emitted for.
+type Stage string
+
+const (
+	// OperationReceived is the stage of requests which are about to be
+	// processed.
+	OperationReceived Stage = "OperationReceived"
+
+	// OperationComplete is the stage of requests which have been processed
+	// but whose response has not been returned yet.
+	OperationComplete Stage = "OperationComplete"
+)
+
+// AuditEvent is the payload of an audit log entry for an HTTP request.
+type AuditEvent struct {
+	ID        string    `json:"id"`
+	Stage     Stage     `json:"stage"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Version   int       `json:"version"`
+
+	// Auth is the identity the request was made with. It is nil if ACLs are
+	// disabled or the token of the request could not be resolved.
+	Auth *AuditAuth `json:"auth,omitempty"`
+
+	Request  *AuditRequest  `json:"request"`
+	Response *AuditResponse `json:"response,omitempty"`
+}
+
+// AuditAuth is the ACL token a request was made with.
+type AuditAuth struct {
+	AccessorID string    `json:"accessor_id"`
+	Name       string    `json:"name"`
+	Policies   []string  `json:"policies,omitempty"`
+	Roles      []string  `json:"roles,omitempty"`
+	Global     bool      `json:"global,omitempty"`
+	CreateTime time.Time `json:"create_time"`
+}
+
+// AuditRequest describes the HTTP request an audit event is emitted for.
+type AuditRequest struct {
+	ID          string            `json:"id"`
+	Operation   string            `json:"operation"`
+	Endpoint    string            `json:"endpoint"`
+	Namespace   *AuditNamespace   `json:"namespace"`
+	RequestMeta *AuditRequestMeta `json:"request_meta"`
+	NodeMeta    *AuditNodeMeta    `json:"node_meta"`
+	Body        interface{}       `json:"body,omitempty"`
+}
+
+// AuditNamespace is the namespace targeted by a request.
+type AuditNamespace struct {
+	ID string `json:"id"`
+}
+
+// AuditRequestMeta describes the client which made a request.
+type AuditRequestMeta struct {
+	RemoteAddress string `json:"remote_address"`
+	UserAgent     string `json:"user_agent"`
+}
+
+// AuditNodeMeta describes the agent which received a request.
+type AuditNodeMeta struct {
+	IP string `json:"ip"`
+}
+
+// AuditResponse is the outcome of a processed request.
+type AuditResponse struct {
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+}
+
+// AuditEntry is the envelope audit events are written to sinks in.
+type AuditEntry struct {
+	CreatedAt time.Time   `json:"created_at"`
+	EventType string      `json:"event_type"`
+	Payload   interface{} `json:"payload"`
+}
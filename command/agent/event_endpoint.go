@@ -162,3 +162,100 @@ func parseTopic(topic string) (string, string, error) {
 func allTopics() map[structs.Topic][]string {
 	return map[structs.Topic][]string{"*": {"*"}}
 }
+
+func (s *HTTPServer) EventSinksRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+
+	args := structs.EventSinkListRequest{}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.EventSinkListResponse
+	if err := s.agent.RPC("Event.ListSinks", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Sinks == nil {
+		out.Sinks = make([]*structs.EventSink, 0)
+	}
+	return out.Sinks, nil
+}
+
+func (s *HTTPServer) EventSinkSpecificRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	id := strings.TrimPrefix(req.URL.Path, "/v1/event/sink/")
+	if len(id) == 0 {
+		return nil, CodedError(http.StatusBadRequest, "Missing event sink ID")
+	}
+	switch req.Method {
+	case http.MethodGet:
+		return s.eventSinkQuery(resp, req, id)
+	case http.MethodPut, http.MethodPost:
+		return s.eventSinkUpdate(resp, req, id)
+	case http.MethodDelete:
+		return s.eventSinkDelete(resp, req, id)
+	default:
+		return nil, CodedError(http.StatusMethodNotAllowed, ErrInvalidMethod)
+	}
+}
+
+func (s *HTTPServer) eventSinkQuery(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	args := structs.EventSinkSpecificRequest{
+		ID: id,
+	}
+	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
+		return nil, nil
+	}
+
+	var out structs.EventSinkResponse
+	if err := s.agent.RPC("Event.GetSink", &args, &out); err != nil {
+		return nil, err
+	}
+
+	setMeta(resp, &out.QueryMeta)
+	if out.Sink == nil {
+		return nil, CodedError(http.StatusNotFound, "event sink not found")
+	}
+	return out.Sink, nil
+}
+
+func (s *HTTPServer) eventSinkUpdate(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	var sink structs.EventSink
+	if err := decodeBody(req, &sink); err != nil {
+		return nil, CodedError(http.StatusBadRequest, err.Error())
+	}
+
+	// Ensure the sink ID matches
+	if sink.ID != id {
+		return nil, CodedError(http.StatusBadRequest, "Event sink ID does not match request path")
+	}
+
+	args := structs.EventSinkUpsertRequest{
+		Sink: &sink,
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.GenericResponse
+	if err := s.agent.RPC("Event.UpsertSink", &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+	return nil, nil
+}
+
+func (s *HTTPServer) eventSinkDelete(resp http.ResponseWriter, req *http.Request, id string) (interface{}, error) {
+	args := structs.EventSinkDeleteRequest{
+		IDs: []string{id},
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	var out structs.GenericResponse
+	if err := s.agent.RPC("Event.DeleteSink", &args, &out); err != nil {
+		return nil, err
+	}
+	setIndex(resp, out.Index)
+	return nil, nil
+}
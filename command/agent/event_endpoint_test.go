@@ -202,3 +202,59 @@ func TestEventStream_QueryParse(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTP_EventSinkCRUD(t *testing.T) {
+	ci.Parallel(t)
+
+	httpTest(t, nil, func(s *TestAgent) {
+		sink := &structs.EventSink{
+			ID:        "audit",
+			Type:      structs.SinkWebhook,
+			Namespace: "*",
+			Topics:    map[structs.Topic][]string{structs.TopicJob: {"*"}},
+			Address:   "http://127.0.0.1:8080/events",
+		}
+
+		// The ID of the sink must match the path
+		req, err := http.NewRequest(http.MethodPut, "/v1/event/sink/other", encodeReq(sink))
+		require.NoError(t, err)
+		respW := httptest.NewRecorder()
+		_, err = s.Server.EventSinkSpecificRequest(respW, req)
+		require.EqualError(t, err, "Event sink ID does not match request path")
+
+		req, err = http.NewRequest(http.MethodPut, "/v1/event/sink/audit", encodeReq(sink))
+		require.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.EventSinkSpecificRequest(respW, req)
+		require.NoError(t, err)
+		require.NotEmpty(t, respW.Header().Get("X-Nomad-Index"))
+
+		req, err = http.NewRequest(http.MethodGet, "/v1/event/sink/audit", nil)
+		require.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err := s.Server.EventSinkSpecificRequest(respW, req)
+		require.NoError(t, err)
+		out := obj.(*structs.EventSink)
+		require.Equal(t, sink.Address, out.Address)
+		require.Equal(t, sink.Topics, out.Topics)
+
+		req, err = http.NewRequest(http.MethodGet, "/v1/event/sinks", nil)
+		require.NoError(t, err)
+		respW = httptest.NewRecorder()
+		obj, err = s.Server.EventSinksRequest(respW, req)
+		require.NoError(t, err)
+		require.Len(t, obj.([]*structs.EventSink), 1)
+
+		req, err = http.NewRequest(http.MethodDelete, "/v1/event/sink/audit", nil)
+		require.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.EventSinkSpecificRequest(respW, req)
+		require.NoError(t, err)
+
+		req, err = http.NewRequest(http.MethodGet, "/v1/event/sink/audit", nil)
+		require.NoError(t, err)
+		respW = httptest.NewRecorder()
+		_, err = s.Server.EventSinkSpecificRequest(respW, req)
+		require.EqualError(t, err, "event sink not found")
+	})
+}
@@ -27,17 +27,64 @@ func (s *HTTPServer) entOnly(resp http.ResponseWriter, req *http.Request) (inter
 	return nil, CodedError(501, ErrEntOnly)
 }
 
-// auditHandler wraps the passed handlerFn
+// auditHandler wraps the passed handlerFn, emitting the audit events of the
+// request before it is processed and before its response is written
 func (s *HTTPServer) auditHandler(h handlerFn) handlerFn {
-	return h
+	return func(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+		ev, err := s.auditRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, rspErr := h(resp, req)
+		code, errMsg := errCodeFromHandler(rspErr)
+		if rspErr == nil {
+			code = http.StatusOK
+		}
+		if err := s.auditResponse(req, ev, code, errMsg); err != nil {
+			return nil, err
+		}
+		return obj, rspErr
+	}
 }
 
-// auditHTTPHandler wraps  the passed handlerByteFn
+// auditNonJSONHandler wraps the passed handlerByteFn like auditHandler
 func (s *HTTPServer) auditNonJSONHandler(h handlerByteFn) handlerByteFn {
-	return h
+	return func(resp http.ResponseWriter, req *http.Request) ([]byte, error) {
+		ev, err := s.auditRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, rspErr := h(resp, req)
+		code, errMsg := errCodeFromHandler(rspErr)
+		if rspErr == nil {
+			code = http.StatusOK
+		}
+		if err := s.auditResponse(req, ev, code, errMsg); err != nil {
+			return nil, err
+		}
+		return obj, rspErr
+	}
 }
 
-// auditHTTPHandler wraps the passed http.Handler
+// auditHTTPHandler wraps the passed http.Handler. The handler writes its
+// response itself, so only failures to audit the received request can fail
+// it.
 func (s *HTTPServer) auditHTTPHandler(h http.Handler) http.Handler {
-	return h
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ev, err := s.auditRequest(req)
+		if err != nil {
+			code, errMsg := errCodeFromHandler(err)
+			w.WriteHeader(code)
+			w.Write([]byte(errMsg))
+			return
+		}
+
+		rec := &auditStatusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, req)
+		if err := s.auditResponse(req, ev, rec.status, ""); err != nil {
+			s.logger.Error("failed to audit response", "method", req.Method, "path", req.URL.Path, "error", err)
+		}
+	})
 }
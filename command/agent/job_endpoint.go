@@ -958,6 +958,13 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		}
 	}
 
+	if job.GC != nil {
+		j.GC = &structs.GCPolicy{
+			RetainFailed:     *job.GC.RetainFailed,
+			RetainSuccessful: *job.GC.RetainSuccessful,
+		}
+	}
+
 	if job.Multiregion != nil {
 		j.Multiregion = &structs.Multiregion{}
 		j.Multiregion.Strategy = &structs.MultiregionStrategy{
@@ -997,12 +1004,7 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 	tg.Services = ApiServicesToStructs(taskGroup.Services, true)
 	tg.Consul = apiConsulToStructs(taskGroup.Consul)
 
-	tg.RestartPolicy = &structs.RestartPolicy{
-		Attempts: *taskGroup.RestartPolicy.Attempts,
-		Interval: *taskGroup.RestartPolicy.Interval,
-		Delay:    *taskGroup.RestartPolicy.Delay,
-		Mode:     *taskGroup.RestartPolicy.Mode,
-	}
+	tg.RestartPolicy = apiRestartPolicyToStructs(taskGroup.RestartPolicy)
 
 	if taskGroup.ShutdownDelay != nil {
 		tg.ShutdownDelay = taskGroup.ShutdownDelay
@@ -1141,12 +1143,7 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 	structsTask.CSIPluginConfig = ApiCSIPluginConfigToStructsCSIPluginConfig(apiTask.CSIPluginConfig)
 
 	if apiTask.RestartPolicy != nil {
-		structsTask.RestartPolicy = &structs.RestartPolicy{
-			Attempts: *apiTask.RestartPolicy.Attempts,
-			Interval: *apiTask.RestartPolicy.Interval,
-			Delay:    *apiTask.RestartPolicy.Delay,
-			Mode:     *apiTask.RestartPolicy.Mode,
-		}
+		structsTask.RestartPolicy = apiRestartPolicyToStructs(apiTask.RestartPolicy)
 	}
 
 	if len(apiTask.VolumeMounts) > 0 {
@@ -1211,21 +1208,22 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 		for _, template := range apiTask.Templates {
 			structsTask.Templates = append(structsTask.Templates,
 				&structs.Template{
-					SourcePath:   *template.SourcePath,
-					DestPath:     *template.DestPath,
-					EmbeddedTmpl: *template.EmbeddedTmpl,
-					ChangeMode:   *template.ChangeMode,
-					ChangeSignal: *template.ChangeSignal,
-					ChangeScript: apiChangeScriptToStructsChangeScript(template.ChangeScript),
-					Splay:        *template.Splay,
-					Perms:        *template.Perms,
-					Uid:          template.Uid,
-					Gid:          template.Gid,
-					LeftDelim:    *template.LeftDelim,
-					RightDelim:   *template.RightDelim,
-					Envvars:      *template.Envvars,
-					VaultGrace:   *template.VaultGrace,
-					Wait:         apiWaitConfigToStructsWaitConfig(template.Wait),
+					SourcePath:    *template.SourcePath,
+					DestPath:      *template.DestPath,
+					EmbeddedTmpl:  *template.EmbeddedTmpl,
+					ChangeMode:    *template.ChangeMode,
+					ChangeSignal:  *template.ChangeSignal,
+					ChangeScript:  apiChangeScriptToStructsChangeScript(template.ChangeScript),
+					Splay:         *template.Splay,
+					Perms:         *template.Perms,
+					Uid:           template.Uid,
+					Gid:           template.Gid,
+					LeftDelim:     *template.LeftDelim,
+					RightDelim:    *template.RightDelim,
+					Envvars:       *template.Envvars,
+					VaultGrace:    *template.VaultGrace,
+					Wait:          apiWaitConfigToStructsWaitConfig(template.Wait),
+					ErrMissingKey: *template.ErrMissingKey,
 				})
 		}
 	}
@@ -1241,9 +1239,46 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 			Hook:    apiTask.Lifecycle.Hook,
 			Sidecar: apiTask.Lifecycle.Sidecar,
 		}
+		if apiTask.Lifecycle.Timeout != nil {
+			structsTask.Lifecycle.Timeout = *apiTask.Lifecycle.Timeout
+		}
+		if ps := apiTask.Lifecycle.PreStop; ps != nil {
+			structsTask.Lifecycle.PreStop = &structs.TaskPreStopConfig{
+				Command: ps.Command,
+				Args:    helper.CopySliceString(ps.Args),
+				Timeout: structs.DefaultPreStopTimeout,
+			}
+			if ps.Timeout != nil {
+				structsTask.Lifecycle.PreStop.Timeout = *ps.Timeout
+			}
+		}
 	}
 }
 
+// apiRestartPolicyToStructs is a copy and type conversion between the API
+// representation of a RestartPolicy and the struct representation. The backoff
+// fields are optional so that clients predating them remain supported.
+func apiRestartPolicyToStructs(rp *api.RestartPolicy) *structs.RestartPolicy {
+	policy := &structs.RestartPolicy{
+		Attempts: *rp.Attempts,
+		Interval: *rp.Interval,
+		Delay:    *rp.Delay,
+		Mode:     *rp.Mode,
+	}
+
+	if rp.Backoff != nil {
+		policy.Backoff = *rp.Backoff
+	}
+	if rp.MaxDelay != nil {
+		policy.MaxDelay = *rp.MaxDelay
+	}
+	if rp.ResetAfter != nil {
+		policy.ResetAfter = *rp.ResetAfter
+	}
+
+	return policy
+}
+
 // apiWaitConfigToStructsWaitConfig is a copy and type conversion between the API
 // representation of a WaitConfig from a struct representation of a WaitConfig.
 func apiWaitConfigToStructsWaitConfig(waitConfig *api.WaitConfig) *structs.WaitConfig {
@@ -2419,6 +2419,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 			MetaRequired: []string{"a", "b"},
 			MetaOptional: []string{"c", "d"},
 		},
+		GC: &api.GCPolicy{
+			RetainFailed:     pointer.Of(24 * time.Hour),
+			RetainSuccessful: pointer.Of(1 * time.Hour),
+		},
 		Payload: []byte("payload"),
 		Meta: map[string]string{
 			"foo": "bar",
@@ -2457,10 +2461,13 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 					},
 				},
 				RestartPolicy: &api.RestartPolicy{
-					Interval: pointer.Of(1 * time.Second),
-					Attempts: pointer.Of(5),
-					Delay:    pointer.Of(10 * time.Second),
-					Mode:     pointer.Of("delay"),
+					Interval:   pointer.Of(1 * time.Second),
+					Attempts:   pointer.Of(5),
+					Delay:      pointer.Of(10 * time.Second),
+					Mode:       pointer.Of("delay"),
+					Backoff:    pointer.Of("exponential"),
+					MaxDelay:   pointer.Of(time.Minute),
+					ResetAfter: pointer.Of(10 * time.Minute),
 				},
 				ReschedulePolicy: &api.ReschedulePolicy{
 					Interval:      pointer.Of(12 * time.Hour),
@@ -2736,13 +2743,14 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 									Timeout:     pointer.Of(5 * time.Second),
 									FailOnError: pointer.Of(false),
 								},
-								Splay:      pointer.Of(1 * time.Minute),
-								Perms:      pointer.Of("666"),
-								Uid:        pointer.Of(1000),
-								Gid:        pointer.Of(1000),
-								LeftDelim:  pointer.Of("abc"),
-								RightDelim: pointer.Of("def"),
-								Envvars:    pointer.Of(true),
+								Splay:         pointer.Of(1 * time.Minute),
+								Perms:         pointer.Of("666"),
+								Uid:           pointer.Of(1000),
+								Gid:           pointer.Of(1000),
+								LeftDelim:     pointer.Of("abc"),
+								RightDelim:    pointer.Of("def"),
+								Envvars:       pointer.Of(true),
+								ErrMissingKey: pointer.Of(true),
 								Wait: &api.WaitConfig{
 									Min: pointer.Of(5 * time.Second),
 									Max: pointer.Of(10 * time.Second),
@@ -2752,6 +2760,12 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 						DispatchPayload: &api.DispatchPayloadConfig{
 							File: "fileA",
 						},
+						Lifecycle: &api.TaskLifecycle{
+							PreStop: &api.TaskPreStopConfig{
+								Command: "/bin/drain",
+								Args:    []string{"-wait"},
+							},
+						},
 					},
 				},
 			},
@@ -2821,6 +2835,10 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 			MetaRequired: []string{"a", "b"},
 			MetaOptional: []string{"c", "d"},
 		},
+		GC: &structs.GCPolicy{
+			RetainFailed:     24 * time.Hour,
+			RetainSuccessful: 1 * time.Hour,
+		},
 		Payload: []byte("payload"),
 		Meta: map[string]string{
 			"foo": "bar",
@@ -2859,10 +2877,13 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 					},
 				},
 				RestartPolicy: &structs.RestartPolicy{
-					Interval: 1 * time.Second,
-					Attempts: 5,
-					Delay:    10 * time.Second,
-					Mode:     "delay",
+					Interval:   1 * time.Second,
+					Attempts:   5,
+					Delay:      10 * time.Second,
+					Mode:       "delay",
+					Backoff:    "exponential",
+					MaxDelay:   time.Minute,
+					ResetAfter: 10 * time.Minute,
 				},
 				Spreads: []*structs.Spread{
 					{
@@ -3004,10 +3025,13 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 							},
 						},
 						RestartPolicy: &structs.RestartPolicy{
-							Interval: 2 * time.Second,
-							Attempts: 10,
-							Delay:    20 * time.Second,
-							Mode:     "delay",
+							Interval:   2 * time.Second,
+							Attempts:   10,
+							Delay:      20 * time.Second,
+							Mode:       "delay",
+							Backoff:    "exponential",
+							MaxDelay:   time.Minute,
+							ResetAfter: 10 * time.Minute,
 						},
 						Services: []*structs.Service{
 							{
@@ -3149,13 +3173,14 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 									Timeout:     5 * time.Second,
 									FailOnError: false,
 								},
-								Splay:      1 * time.Minute,
-								Perms:      "666",
-								Uid:        pointer.Of(1000),
-								Gid:        pointer.Of(1000),
-								LeftDelim:  "abc",
-								RightDelim: "def",
-								Envvars:    true,
+								Splay:         1 * time.Minute,
+								Perms:         "666",
+								Uid:           pointer.Of(1000),
+								Gid:           pointer.Of(1000),
+								LeftDelim:     "abc",
+								RightDelim:    "def",
+								Envvars:       true,
+								ErrMissingKey: true,
 								Wait: &structs.WaitConfig{
 									Min: pointer.Of(5 * time.Second),
 									Max: pointer.Of(10 * time.Second),
@@ -3165,6 +3190,13 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 						DispatchPayload: &structs.DispatchPayloadConfig{
 							File: "fileA",
 						},
+						Lifecycle: &structs.TaskLifecycleConfig{
+							PreStop: &structs.TaskPreStopConfig{
+								Command: "/bin/drain",
+								Args:    []string{"-wait"},
+								Timeout: 30 * time.Second,
+							},
+						},
 					},
 				},
 			},
@@ -46,6 +46,9 @@ type logFile struct {
 	// Max rotated files to keep before removing them.
 	MaxFiles int
 
+	// fileMode is the mode log files are created with, 0640 if unset
+	fileMode os.FileMode
+
 	//acquire is the mutex utilized to ensure we have no concurrency issues
 	acquire sync.Mutex
 }
@@ -67,7 +70,11 @@ func (l *logFile) openNew() error {
 	// Try creating or opening the active log file. Since the active log file
 	// always has the same name, append log entries to prevent overwriting
 	// previous log data.
-	filePointer, err := os.OpenFile(newfilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	mode := l.fileMode
+	if mode == 0 {
+		mode = 0640
+	}
+	filePointer, err := os.OpenFile(newfilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, mode)
 	if err != nil {
 		return err
 	}
@@ -135,7 +142,7 @@ func (l *logFile) pruneFiles() error {
 // Write is used to implement io.Writer
 func (l *logFile) Write(b []byte) (int, error) {
 	// Filter out log entries that do not match log level criteria
-	if !l.logFilter.Check(b) {
+	if l.logFilter != nil && !l.logFilter.Check(b) {
 		return 0, nil
 	}
 
@@ -156,3 +163,17 @@ func (l *logFile) Write(b []byte) (int, error) {
 	l.BytesWritten += int64(n)
 	return n, err
 }
+
+// reopen closes the active log file, so that it is opened again by the next
+// write. This lets files moved away by external log rotation be recreated.
+func (l *logFile) reopen() error {
+	l.acquire.Lock()
+	defer l.acquire.Unlock()
+
+	if l.FileInfo == nil {
+		return nil
+	}
+	err := l.FileInfo.Close()
+	l.FileInfo = nil
+	return err
+}
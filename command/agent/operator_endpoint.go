@@ -304,6 +304,26 @@ func (s *HTTPServer) SnapshotRequest(resp http.ResponseWriter, req *http.Request
 
 }
 
+// SnapshotStatusRequest is used to get the status of the scheduled snapshots
+func (s *HTTPServer) SnapshotStatusRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+
+	var args structs.GenericRequest
+	if done := s.parse(resp, req, &args.Region, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.SnapshotStatusResponse
+	if err := s.agent.RPC("Operator.SnapshotStatus", &args, &reply); err != nil {
+		return nil, err
+	}
+	setMeta(resp, &reply.QueryMeta)
+
+	return reply.Status, nil
+}
+
 func (s *HTTPServer) snapshotSaveRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	args := &structs.SnapshotSaveRequest{}
 	if s.parse(resp, req, &args.Region, &args.QueryOptions) {
@@ -634,7 +634,7 @@ func TestHTTP_PrefixSearch_Variables(t *testing.T) {
 
 		state := s.Agent.server.State()
 		sv.Path = testPath
-		setResp := state.VarSet(8000, &structs.VarApplyStateRequest{
+		setResp := state.VarSet(structs.MsgTypeTestSetup, 8000, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: sv,
 		})
@@ -667,7 +667,7 @@ func TestHTTP_FuzzySearch_Variables(t *testing.T) {
 		state := s.Agent.server.State()
 		sv := mock.VariableEncrypted()
 		sv.Path = testPath
-		setResp := state.VarSet(8000, &structs.VarApplyStateRequest{
+		setResp := state.VarSet(structs.MsgTypeTestSetup, 8000, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: sv,
 		})
@@ -707,13 +707,13 @@ func TestHTTP_PrefixSearch_Variables_ACL(t *testing.T) {
 		sv2 := sv1.Copy()
 		sv2.Namespace = ns.Name
 
-		require.NoError(t, state.UpsertNamespaces(7000, []*structs.Namespace{ns}))
-		setResp := state.VarSet(8000, &structs.VarApplyStateRequest{
+		require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 7000, []*structs.Namespace{ns}))
+		setResp := state.VarSet(structs.MsgTypeTestSetup, 8000, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: sv1,
 		})
 		require.NoError(t, setResp.Error)
-		setResp = state.VarSet(8001, &structs.VarApplyStateRequest{
+		setResp = state.VarSet(structs.MsgTypeTestSetup, 8001, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: &sv2,
 		})
@@ -821,13 +821,13 @@ func TestHTTP_FuzzySearch_Variables_ACL(t *testing.T) {
 		sv2 := sv1.Copy()
 		sv2.Namespace = ns.Name
 
-		require.NoError(t, state.UpsertNamespaces(7000, []*structs.Namespace{ns}))
-		setResp := state.VarSet(8000, &structs.VarApplyStateRequest{
+		require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 7000, []*structs.Namespace{ns}))
+		setResp := state.VarSet(structs.MsgTypeTestSetup, 8000, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: sv1,
 		})
 		require.NoError(t, setResp.Error)
-		setResp = state.VarSet(8001, &structs.VarApplyStateRequest{
+		setResp = state.VarSet(structs.MsgTypeTestSetup, 8001, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: &sv2,
 		})
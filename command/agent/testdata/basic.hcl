@@ -91,6 +91,7 @@ client {
   gc_max_allocs            = 50
   no_host_uuid             = false
   disable_remote_exec      = true
+  redact_process_args      = ["(?i)api_key"]
 
   host_volume "tmp" {
     path = "/tmp"
@@ -0,0 +1,180 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+)
+
+type AllocPauseCommand struct {
+	Meta
+}
+
+func (c *AllocPauseCommand) Help() string {
+	helpText := `
+Usage: nomad alloc pause [options] <allocation> <task>
+
+  Pause an existing allocation. This command freezes the processes of the
+  allocation's running tasks, which stop consuming CPU but keep their memory
+  and state until they are resumed with the '-resume' option. If no task is
+  provided then all of the allocation's running tasks are paused. Pausing
+  tasks requires a task driver supporting it, such as exec, java or raw_exec.
+
+  When ACLs are enabled, this command requires a token with the
+  'alloc-lifecycle', 'read-job', and 'list-jobs' capabilities for the
+  allocation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Pause Specific Options:
+
+  -resume
+    Resume the paused tasks instead of pausing them.
+
+  -task <task-name>
+    Specify the individual task to pause or resume. If task name is given
+    with both an argument and the '-task' option, preference is given to the
+    '-task' option.
+
+  -verbose
+    Show full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AllocPauseCommand) Name() string { return "alloc pause" }
+
+func (c *AllocPauseCommand) Run(args []string) int {
+	var resume, verbose bool
+	var task string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&resume, "resume", false, "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&task, "task", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one alloc
+	args = flags.Args()
+	if len(args) < 1 || len(args) > 2 {
+		c.Ui.Error("This command takes up to two arguments: <alloc-id> <task>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	allocID := args[0]
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Query the allocation info
+	if len(allocID) == 1 {
+		c.Ui.Error("Alloc ID must contain at least two characters.")
+		return 1
+	}
+
+	allocID = sanitizeUUIDPrefix(allocID)
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	allocs, _, err := client.Allocations().PrefixList(allocID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %v", err))
+		return 1
+	}
+
+	if len(allocs) == 0 {
+		c.Ui.Error(fmt.Sprintf("No allocation(s) with prefix or id %q found", allocID))
+		return 1
+	}
+
+	if len(allocs) > 1 {
+		// Format the allocs
+		out := formatAllocListStubs(allocs, verbose, length)
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple allocations\n\n%s", out))
+		return 1
+	}
+
+	// Prefix lookup matched a single allocation
+	q := &api.QueryOptions{Namespace: allocs[0].Namespace}
+	alloc, _, err := client.Allocations().Info(allocs[0].ID, q)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %s", err))
+		return 1
+	}
+
+	// If -task isn't provided fallback to reading the task name
+	// from args.
+	if task == "" && len(args) >= 2 {
+		task = args[1]
+	}
+
+	if task != "" {
+		err := validateTaskExistsInAllocation(task, alloc)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+	}
+
+	if resume {
+		if err := client.Allocations().Resume(alloc, q, task); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error resuming allocation: %s", err))
+			return 1
+		}
+		c.Ui.Output(fmt.Sprintf("Resumed allocation %q", limit(alloc.ID, length)))
+		return 0
+	}
+
+	if err := client.Allocations().Pause(alloc, q, task); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error pausing allocation: %s", err))
+		return 1
+	}
+	c.Ui.Output(fmt.Sprintf("Paused allocation %q", limit(alloc.ID, length)))
+	return 0
+}
+
+func (c *AllocPauseCommand) Synopsis() string {
+	return "Pause or resume the tasks of a running allocation"
+}
+
+func (c *AllocPauseCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-resume":  complete.PredictNothing,
+			"-task":    complete.PredictAnything,
+			"-verbose": complete.PredictNothing,
+		})
+}
+
+func (c *AllocPauseCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Allocs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Allocs]
+	})
+}
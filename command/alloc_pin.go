@@ -0,0 +1,185 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+)
+
+type AllocPinCommand struct {
+	Meta
+}
+
+func (c *AllocPinCommand) Help() string {
+	helpText := `
+Usage: nomad alloc pin [options] <allocation>
+
+  Pin an allocation so that the client does not garbage collect its allocation
+  directory once the allocation is terminal, regardless of the job's gc
+  policy. This is useful to keep the logs and data of a failed allocation
+  around for debugging. Pinned allocations are kept until they are unpinned
+  with the '-unpin' option. Pins are held in memory by the client and are
+  lost when the client restarts.
+
+  Once the servers have garbage collected a pinned allocation, it can only be
+  unpinned by its full ID along with the '-node' option. The pinned
+  allocations of a node are listed by 'nomad node status'.
+
+  When ACLs are enabled, this command requires a token with the 'submit-job',
+  'read-job', and 'list-jobs' capabilities for the allocation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Pin Specific Options:
+
+  -unpin
+    Remove a previous pin, making the allocation eligible for garbage
+    collection again.
+
+  -node <node-id>
+    The full ID of the node the allocation is placed on. Required to unpin an
+    allocation which the servers have garbage collected.
+
+  -verbose
+    Show full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AllocPinCommand) Name() string { return "alloc pin" }
+
+func (c *AllocPinCommand) Run(args []string) int {
+	var unpin, verbose bool
+	var nodeID string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&unpin, "unpin", false, "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&nodeID, "node", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one alloc
+	args = flags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("This command takes one argument: <alloc-id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	allocID := args[0]
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Query the allocation info
+	if len(allocID) == 1 {
+		c.Ui.Error("Alloc ID must contain at least two characters.")
+		return 1
+	}
+
+	allocID = sanitizeUUIDPrefix(allocID)
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	allocs, _, err := client.Allocations().PrefixList(allocID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %v", err))
+		return 1
+	}
+
+	if len(allocs) == 0 {
+		// The client retains pinned allocations the servers no longer know
+		// about, which can only be found through their node
+		if unpin && nodeID != "" {
+			return c.unpin(client, &api.Allocation{ID: allocID, NodeID: nodeID}, nil, length)
+		}
+		c.Ui.Error(fmt.Sprintf("No allocation(s) with prefix or id %q found", allocID))
+		return 1
+	}
+
+	if len(allocs) > 1 {
+		// Format the allocs
+		out := formatAllocListStubs(allocs, verbose, length)
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple allocations\n\n%s", out))
+		return 1
+	}
+
+	// Prefix lookup matched a single allocation
+	q := &api.QueryOptions{Namespace: allocs[0].Namespace}
+	alloc, _, err := client.Allocations().Info(allocs[0].ID, q)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %s", err))
+		return 1
+	}
+
+	if nodeID != "" && nodeID != alloc.NodeID {
+		c.Ui.Error(fmt.Sprintf("Allocation %q is not placed on node %q", limit(alloc.ID, length), nodeID))
+		return 1
+	}
+
+	if unpin {
+		return c.unpin(client, alloc, q, length)
+	}
+
+	if err := client.Allocations().Pin(alloc, q); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error pinning allocation: %s", err))
+		return 1
+	}
+	c.Ui.Output(fmt.Sprintf("Pinned allocation %q", limit(alloc.ID, length)))
+	return 0
+}
+
+// unpin removes the pin of the allocation.
+func (c *AllocPinCommand) unpin(client *api.Client, alloc *api.Allocation, q *api.QueryOptions, length int) int {
+	if err := client.Allocations().Unpin(alloc, q); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error unpinning allocation: %s", err))
+		return 1
+	}
+	c.Ui.Output(fmt.Sprintf("Unpinned allocation %q", limit(alloc.ID, length)))
+	return 0
+}
+
+func (c *AllocPinCommand) Synopsis() string {
+	return "Prevent an allocation directory from being garbage collected"
+}
+
+func (c *AllocPinCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-unpin":   complete.PredictNothing,
+			"-node":    complete.PredictAnything,
+			"-verbose": complete.PredictNothing,
+		})
+}
+
+func (c *AllocPinCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Allocs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Allocs]
+	})
+}
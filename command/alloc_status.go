@@ -439,6 +439,12 @@ func (c *AllocStatusCommand) outputTaskStatus(state *api.TaskState) {
 		fmt.Sprintf("Total Restarts|%d", state.Restarts),
 		fmt.Sprintf("Last Restart|%s", formatTaskTimes(state.LastRestart))}
 
+	// Show when a task waiting on its restart delay is expected to start
+	if state.State == "pending" && !state.NextRestart.IsZero() {
+		basic = append(basic, fmt.Sprintf("Next Restart|%s (%s)",
+			formatTime(state.NextRestart), prettyTimeDiff(state.NextRestart, time.Now())))
+	}
+
 	c.Ui.Output("Task Events:")
 	c.Ui.Output(formatKV(basic))
 	c.Ui.Output("")
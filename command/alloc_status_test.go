@@ -399,7 +399,7 @@ func TestAllocStatusCommand_CSIVolumes(t *testing.T) {
 			Segments: map[string]string{"foo": "bar"},
 		}},
 	}}
-	err = state.UpsertCSIVolume(1002, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, 1002, vols)
 	must.NoError(t, err)
 
 	// Upsert the job and alloc
@@ -0,0 +1,317 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/api/contexts"
+	"github.com/posener/complete"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	// allocTopCmdlineLength is the length command lines are truncated to
+	// unless verbose output is requested.
+	allocTopCmdlineLength = 60
+
+	// clearScreen moves the cursor to the top left corner of the terminal
+	// and clears it.
+	clearScreen = "\033[H\033[2J"
+)
+
+type AllocTopCommand struct {
+	Meta
+}
+
+func (c *AllocTopCommand) Help() string {
+	helpText := `
+Usage: nomad alloc top [options] <allocation> <task>
+
+  Display the processes of an allocation's tasks and their resource usage,
+  refreshed periodically like top. If no task is provided then the processes
+  of all of the allocation's tasks are displayed. Process arguments matching
+  the client's redact_process_args patterns are redacted.
+
+  When ACLs are enabled, this command requires a token with the 'read-job'
+  capability for the allocation's namespace.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault) + `
+
+Top Specific Options:
+
+  -interval <duration>
+    Interval between refreshes. Defaults to 2s.
+
+  -n <count>
+    Number of refreshes before exiting. Defaults to 0, which refreshes until
+    interrupted.
+
+  -sort <cpu|memory>
+    Sort the processes by CPU or memory usage. Defaults to cpu.
+
+  -task <task-name>
+    Specify the individual task to display. If task name is given with both
+    an argument and the '-task' option, preference is given to the '-task'
+    option.
+
+  -verbose
+    Show full information, including complete command lines.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AllocTopCommand) Name() string { return "alloc top" }
+
+func (c *AllocTopCommand) Run(args []string) int {
+	var verbose bool
+	var task, sortBy string
+	var interval time.Duration
+	var count int
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&verbose, "verbose", false, "")
+	flags.StringVar(&task, "task", "", "")
+	flags.StringVar(&sortBy, "sort", "cpu", "")
+	flags.DurationVar(&interval, "interval", 2*time.Second, "")
+	flags.IntVar(&count, "n", 0, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got exactly one alloc
+	args = flags.Args()
+	if len(args) < 1 || len(args) > 2 {
+		c.Ui.Error("This command takes up to two arguments: <alloc-id> <task>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if sortBy != "cpu" && sortBy != "memory" {
+		c.Ui.Error(fmt.Sprintf("Invalid -sort %q, must be cpu or memory", sortBy))
+		return 1
+	}
+	if interval <= 0 {
+		c.Ui.Error("Invalid -interval, must be greater than zero")
+		return 1
+	}
+	if count < 0 {
+		c.Ui.Error("Invalid -n, must not be negative")
+		return 1
+	}
+
+	allocID := args[0]
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	// Query the allocation info
+	if len(allocID) == 1 {
+		c.Ui.Error("Alloc ID must contain at least two characters.")
+		return 1
+	}
+
+	allocID = sanitizeUUIDPrefix(allocID)
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	allocs, _, err := client.Allocations().PrefixList(allocID)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %v", err))
+		return 1
+	}
+
+	if len(allocs) == 0 {
+		c.Ui.Error(fmt.Sprintf("No allocation(s) with prefix or id %q found", allocID))
+		return 1
+	}
+
+	if len(allocs) > 1 {
+		// Format the allocs
+		out := formatAllocListStubs(allocs, verbose, length)
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple allocations\n\n%s", out))
+		return 1
+	}
+
+	// Prefix lookup matched a single allocation
+	q := &api.QueryOptions{Namespace: allocs[0].Namespace}
+	alloc, _, err := client.Allocations().Info(allocs[0].ID, q)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error querying allocation: %s", err))
+		return 1
+	}
+
+	// If -task isn't provided fallback to reading the task name
+	// from args.
+	if task == "" && len(args) >= 2 {
+		task = args[1]
+	}
+
+	if task != "" {
+		err := validateTaskExistsInAllocation(task, alloc)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+	}
+
+	// Only redraw in place when writing to a terminal
+	refresh := count != 1 && terminal.IsTerminal(int(os.Stdout.Fd()))
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+
+	for i := 1; ; i++ {
+		stats, err := client.Allocations().Stats(alloc, q)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error querying allocation stats: %s", err))
+			return 1
+		}
+
+		out := fmt.Sprintf("Allocation %q at %s\n\n%s",
+			limit(alloc.ID, length), formatTime(time.Now()),
+			formatAllocTop(stats, task, sortBy, verbose))
+		if refresh {
+			out = clearScreen + out
+		} else if i > 1 {
+			out = "\n" + out
+		}
+		c.Ui.Output(out)
+
+		if count > 0 && i >= count {
+			return 0
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-signalCh:
+			return 0
+		}
+	}
+}
+
+// allocTopProcess is a process displayed by alloc top.
+type allocTopProcess struct {
+	task  string
+	pid   int
+	usage *api.ResourceUsage
+	info  *api.ProcessInfo
+}
+
+// formatAllocTop formats the processes of the tasks of the allocation, or of
+// the given task only, sorted by cpu or memory usage.
+func formatAllocTop(stats *api.AllocResourceUsage, task, sortBy string, verbose bool) string {
+	var processes []allocTopProcess
+	for name, ts := range stats.Tasks {
+		if task != "" && name != task {
+			continue
+		}
+		for pidStr, usage := range ts.Pids {
+			pid, err := strconv.Atoi(pidStr)
+			if err != nil || usage == nil {
+				continue
+			}
+			processes = append(processes, allocTopProcess{
+				task:  name,
+				pid:   pid,
+				usage: usage,
+				info:  ts.Processes[pidStr],
+			})
+		}
+	}
+
+	if len(processes) == 0 {
+		return "No processes found"
+	}
+
+	cpu := func(p allocTopProcess) float64 {
+		if p.usage.CpuStats == nil {
+			return 0
+		}
+		return p.usage.CpuStats.Percent
+	}
+	mem := func(p allocTopProcess) uint64 {
+		if p.usage.MemoryStats == nil {
+			return 0
+		}
+		return p.usage.MemoryStats.RSS
+	}
+
+	sort.Slice(processes, func(i, j int) bool {
+		a, b := processes[i], processes[j]
+		switch {
+		case sortBy == "memory" && mem(a) != mem(b):
+			return mem(a) > mem(b)
+		case sortBy == "cpu" && cpu(a) != cpu(b):
+			return cpu(a) > cpu(b)
+		}
+		return a.pid < b.pid
+	})
+
+	rows := make([]string, 0, len(processes)+1)
+	rows = append(rows, "Task|PID|PPID|CPU|Memory|Command")
+	for _, p := range processes {
+		ppid, cmdline := "", ""
+		if p.info != nil {
+			ppid = strconv.Itoa(p.info.PPID)
+			// Pipes would split the command into several columns
+			cmdline = strings.ReplaceAll(strings.Join(p.info.Cmdline, " "), "|", "¦")
+		}
+		if runes := []rune(cmdline); !verbose && len(runes) > allocTopCmdlineLength {
+			cmdline = string(runes[:allocTopCmdlineLength-3]) + "..."
+		}
+		rows = append(rows, fmt.Sprintf("%s|%d|%s|%.2f%%|%s|%s",
+			p.task, p.pid, ppid, cpu(p), humanize.IBytes(mem(p)), cmdline))
+	}
+	return formatList(rows)
+}
+
+func (c *AllocTopCommand) Synopsis() string {
+	return "Display the processes of an allocation and their resource usage"
+}
+
+func (c *AllocTopCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-interval": complete.PredictAnything,
+			"-n":        complete.PredictAnything,
+			"-sort":     complete.PredictSet("cpu", "memory"),
+			"-task":     complete.PredictAnything,
+			"-verbose":  complete.PredictNothing,
+		})
+}
+
+func (c *AllocTopCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := c.Meta.Client()
+		if err != nil {
+			return nil
+		}
+
+		resp, _, err := client.Search().PrefixSearch(a.Last, contexts.Allocs, nil)
+		if err != nil {
+			return []string{}
+		}
+		return resp.Matches[contexts.Allocs]
+	})
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestAllocTopCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &AllocTopCommand{}
+}
+
+func TestAllocTopCommand_Fails(t *testing.T) {
+	ci.Parallel(t)
+	srv, _, url := testServer(t, false, nil)
+	defer stopTestAgent(srv)
+
+	ui := cli.NewMockUi()
+	cmd := &AllocTopCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on lack of alloc ID
+	code := cmd.Run([]string{})
+	must.One(t, code)
+
+	out := ui.ErrorWriter.String()
+	must.StrContains(t, out, "This command takes up to two arguments")
+
+	ui.ErrorWriter.Reset()
+
+	// Fails on invalid sort
+	code = cmd.Run([]string{"-sort=disk", "foobar"})
+	must.One(t, code)
+
+	out = ui.ErrorWriter.String()
+	must.StrContains(t, out, "Invalid -sort")
+
+	ui.ErrorWriter.Reset()
+
+	// Fails on connection failure
+	code = cmd.Run([]string{"-address=nope", "foobar"})
+	must.One(t, code)
+
+	out = ui.ErrorWriter.String()
+	must.StrContains(t, out, "Error querying allocation")
+
+	ui.ErrorWriter.Reset()
+
+	// Fails on missing alloc
+	code = cmd.Run([]string{"-address=" + url, "26470238-5CF2-438F-8772-DC67CFB0705C"})
+	must.One(t, code)
+
+	out = ui.ErrorWriter.String()
+	must.StrContains(t, out, "No allocation(s) with prefix or id")
+}
+
+func TestAllocTopCommand_formatAllocTop(t *testing.T) {
+	ci.Parallel(t)
+
+	usage := func(percent float64, rss uint64) *api.ResourceUsage {
+		return &api.ResourceUsage{
+			CpuStats:    &api.CpuStats{Percent: percent},
+			MemoryStats: &api.MemoryStats{RSS: rss},
+		}
+	}
+
+	stats := &api.AllocResourceUsage{
+		Tasks: map[string]*api.TaskResourceUsage{
+			"web": {
+				Pids: map[string]*api.ResourceUsage{
+					"10": usage(1, 300<<20),
+					"11": usage(50, 10<<20),
+				},
+				Processes: map[string]*api.ProcessInfo{
+					"10": {PPID: 1, Cmdline: []string{"/bin/web", "-port=8080"}},
+					"11": {PPID: 10, Cmdline: []string{"/bin/worker", strings.Repeat("x", 80)}},
+				},
+			},
+			"sidecar": {
+				Pids: map[string]*api.ResourceUsage{
+					"20": usage(5, 20<<20),
+				},
+			},
+		},
+	}
+
+	lines := strings.Split(formatAllocTop(stats, "", "cpu", false), "\n")
+	must.Len(t, 4, lines)
+	must.StrContains(t, lines[0], "Command")
+	must.StrContains(t, lines[1], "/bin/worker")
+	must.StrContains(t, lines[1], "...")
+	must.StrContains(t, lines[2], "sidecar")
+	must.StrContains(t, lines[3], "/bin/web -port=8080")
+
+	lines = strings.Split(formatAllocTop(stats, "", "memory", true), "\n")
+	must.StrContains(t, lines[1], "300 MiB")
+	must.StrContains(t, lines[2], "20 MiB")
+	must.StrContains(t, lines[3], strings.Repeat("x", 80))
+
+	lines = strings.Split(formatAllocTop(stats, "sidecar", "cpu", false), "\n")
+	must.Len(t, 2, lines)
+
+	must.Eq(t, "No processes found", formatAllocTop(stats, "missing", "cpu", false))
+
+	// Pipes don't split the command into columns, and long commands are
+	// truncated without splitting multi-byte characters
+	stats = &api.AllocResourceUsage{
+		Tasks: map[string]*api.TaskResourceUsage{
+			"web": {
+				Pids: map[string]*api.ResourceUsage{
+					"10": usage(1, 1<<20),
+					"11": usage(1, 1<<20),
+				},
+				Processes: map[string]*api.ProcessInfo{
+					"10": {PPID: 1, Cmdline: []string{"sh", "-c", "ps | grep web"}},
+					"11": {PPID: 1, Cmdline: []string{"/bin/echo", strings.Repeat("é", 80)}},
+				},
+			},
+		},
+	}
+
+	lines = strings.Split(formatAllocTop(stats, "", "cpu", false), "\n")
+	must.Len(t, 3, lines)
+	must.StrContains(t, lines[1], "sh -c ps ¦ grep web")
+	must.StrNotContains(t, lines[1], "<none>")
+	must.True(t, utf8.ValidString(lines[2]))
+	must.StrContains(t, lines[2], "/bin/echo "+strings.Repeat("é", allocTopCmdlineLength-13)+"...")
+}
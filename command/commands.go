@@ -107,6 +107,66 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"acl auth-method": func() (cli.Command, error) {
+			return &ACLAuthMethodCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method create": func() (cli.Command, error) {
+			return &ACLAuthMethodCreateCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method delete": func() (cli.Command, error) {
+			return &ACLAuthMethodDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method info": func() (cli.Command, error) {
+			return &ACLAuthMethodInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method list": func() (cli.Command, error) {
+			return &ACLAuthMethodListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl auth-method update": func() (cli.Command, error) {
+			return &ACLAuthMethodUpdateCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule": func() (cli.Command, error) {
+			return &ACLBindingRuleCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule create": func() (cli.Command, error) {
+			return &ACLBindingRuleCreateCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule delete": func() (cli.Command, error) {
+			return &ACLBindingRuleDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule info": func() (cli.Command, error) {
+			return &ACLBindingRuleInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule list": func() (cli.Command, error) {
+			return &ACLBindingRuleListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"acl binding-rule update": func() (cli.Command, error) {
+			return &ACLBindingRuleUpdateCommand{
+				Meta: meta,
+			}, nil
+		},
 		"acl role": func() (cli.Command, error) {
 			return &ACLRoleCommand{
 				Meta: meta,
@@ -182,6 +242,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"alloc pause": func() (cli.Command, error) {
+			return &AllocPauseCommand{
+				Meta: meta,
+			}, nil
+		},
+		"alloc pin": func() (cli.Command, error) {
+			return &AllocPinCommand{
+				Meta: meta,
+			}, nil
+		},
 		"alloc signal": func() (cli.Command, error) {
 			return &AllocSignalCommand{
 				Meta: meta,
@@ -217,6 +287,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"alloc top": func() (cli.Command, error) {
+			return &AllocTopCommand{
+				Meta: meta,
+			}, nil
+		},
 		"alloc-status": func() (cli.Command, error) {
 			return &AllocStatusCommand{
 				Meta: meta,
@@ -320,6 +395,31 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"event": func() (cli.Command, error) {
+			return &EventCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink": func() (cli.Command, error) {
+			return &EventSinkCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink deregister": func() (cli.Command, error) {
+			return &EventSinkDeregisterCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink list": func() (cli.Command, error) {
+			return &EventSinkListCommand{
+				Meta: meta,
+			}, nil
+		},
+		"event sink register": func() (cli.Command, error) {
+			return &EventSinkRegisterCommand{
+				Meta: meta,
+			}, nil
+		},
 		"exec": func() (cli.Command, error) {
 			return &AllocExecCommand{
 				Meta: meta,
@@ -445,6 +545,11 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"login": func() (cli.Command, error) {
+			return &LoginCommand{
+				Meta: meta,
+			}, nil
+		},
 		"logs": func() (cli.Command, error) {
 			return &AllocLogsCommand{
 				Meta: meta,
@@ -684,6 +789,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"operator snapshot status": func() (cli.Command, error) {
+			return &OperatorSnapshotStatusCommand{
+				Meta: meta,
+			}, nil
+		},
+		"operator snapshot query": func() (cli.Command, error) {
+			return &OperatorSnapshotQueryCommand{
+				Meta: meta,
+			}, nil
+		},
 		"operator snapshot state": func() (cli.Command, error) {
 			return &OperatorSnapshotStateCommand{
 				Meta: meta,
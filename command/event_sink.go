@@ -0,0 +1,48 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+type EventSinkCommand struct {
+	Meta
+}
+
+func (c *EventSinkCommand) Help() string {
+	helpText := `
+Usage: nomad event sink <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with event sinks. Event
+  sinks deliver the events of the event stream to external destinations, such
+  as webhooks. The leader delivers the events of each sink at least once, and
+  resumes delivery where it left off after a leader election.
+
+  Register an event sink:
+
+      $ nomad event sink register <path>
+
+  List event sinks:
+
+      $ nomad event sink list
+
+  Deregister an event sink:
+
+      $ nomad event sink deregister <id>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkCommand) Synopsis() string {
+	return "Interact with event sinks"
+}
+
+func (c *EventSinkCommand) Name() string { return "event sink" }
+
+func (c *EventSinkCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
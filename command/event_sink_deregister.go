@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/posener/complete"
+)
+
+type EventSinkDeregisterCommand struct {
+	Meta
+}
+
+func (c *EventSinkDeregisterCommand) Help() string {
+	helpText := `
+Usage: nomad event sink deregister [options] <id>
+
+  Deregister is used to deregister an event sink. Events are no longer
+  delivered to the sink once it is deregistered.
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace)
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkDeregisterCommand) AutocompleteFlags() complete.Flags {
+	return c.Meta.AutocompleteFlags(FlagSetClient)
+}
+
+func (c *EventSinkDeregisterCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EventSinkDeregisterCommand) Synopsis() string {
+	return "Deregister an event sink"
+}
+
+func (c *EventSinkDeregisterCommand) Name() string { return "event sink deregister" }
+
+func (c *EventSinkDeregisterCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <id>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	id := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if _, err := client.EventSinks().Deregister(id, nil); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error deregistering event sink: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully deregistered event sink %q!", id))
+	return 0
+}
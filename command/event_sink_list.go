@@ -0,0 +1,137 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type EventSinkListCommand struct {
+	Meta
+}
+
+func (c *EventSinkListCommand) Help() string {
+	helpText := `
+Usage: nomad event sink list [options]
+
+  List is used to list the registered event sinks, along with the index of
+  the latest events they acknowledged.
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+List Options:
+
+  -json
+    Output the event sinks in a JSON format.
+
+  -t
+    Format and display the event sinks using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkListCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *EventSinkListCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EventSinkListCommand) Synopsis() string {
+	return "List event sinks"
+}
+
+func (c *EventSinkListCommand) Name() string { return "event sink list" }
+
+func (c *EventSinkListCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments
+	args = flags.Args()
+	if l := len(args); l != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	sinks, _, err := client.EventSinks().List(nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving event sinks: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, sinks)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	c.Ui.Output(formatEventSinks(sinks))
+	return 0
+}
+
+func formatEventSinks(sinks []*api.EventSink) string {
+	if len(sinks) == 0 {
+		return "No event sinks found"
+	}
+
+	rows := make([]string, len(sinks)+1)
+	rows[0] = "ID|Type|Address|Namespace|Topics|Latest Index"
+	for i, sink := range sinks {
+		rows[i+1] = fmt.Sprintf("%s|%s|%s|%s|%s|%d",
+			sink.ID,
+			sink.Type,
+			sink.Address,
+			sink.Namespace,
+			formatEventSinkTopics(sink.Topics),
+			sink.LatestIndex)
+	}
+	return formatList(rows)
+}
+
+// formatEventSinkTopics formats topics in the same format as the topic query
+// parameter of the event stream API.
+func formatEventSinkTopics(topics map[api.Topic][]string) string {
+	var out []string
+	for topic, keys := range topics {
+		for _, key := range keys {
+			out = append(out, fmt.Sprintf("%s:%s", topic, key))
+		}
+	}
+	sort.Strings(out)
+	return strings.Join(out, ",")
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type EventSinkRegisterCommand struct {
+	Meta
+}
+
+func (c *EventSinkRegisterCommand) Help() string {
+	helpText := `
+Usage: nomad event sink register [options] <path>
+
+  Register is used to register a new event sink or update an existing one. The
+  sink specification is read as JSON from the file at the given path, or from
+  stdin by specifying "-".
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Example sink specification:
+
+  {
+    "ID": "audit-webhook",
+    "Type": "webhook",
+    "Address": "https://example.com/nomad/events",
+    "Namespace": "*",
+    "Topics": {
+      "Job": ["*"],
+      "Deployment": ["*"]
+    }
+  }
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EventSinkRegisterCommand) AutocompleteFlags() complete.Flags {
+	return c.Meta.AutocompleteFlags(FlagSetClient)
+}
+
+func (c *EventSinkRegisterCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*.json")
+}
+
+func (c *EventSinkRegisterCommand) Synopsis() string {
+	return "Register or update an event sink"
+}
+
+func (c *EventSinkRegisterCommand) Name() string { return "event sink register" }
+
+func (c *EventSinkRegisterCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <path>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	var raw []byte
+	var err error
+	if path := args[0]; path == "-" {
+		raw, err = ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read stdin: %v", err))
+			return 1
+		}
+	} else {
+		raw, err = ioutil.ReadFile(path)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read file: %v", err))
+			return 1
+		}
+	}
+
+	var sink api.EventSink
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&sink); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to parse event sink: %v", err))
+		return 1
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	if _, err := client.EventSinks().Register(&sink, nil); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error registering event sink: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully registered event sink %q!", sink.ID))
+	return 0
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSinkCommands_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &EventSinkRegisterCommand{}
+	var _ cli.Command = &EventSinkListCommand{}
+	var _ cli.Command = &EventSinkDeregisterCommand{}
+}
+
+func TestEventSinkCommands(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, url := testServer(t, true, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+
+	// Register a sink from a specification file
+	path := filepath.Join(t.TempDir(), "sink.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "ID": "audit",
+  "Type": "webhook",
+  "Address": "http://127.0.0.1:8080/events",
+  "Namespace": "*",
+  "Topics": {"Job": ["example"], "Deployment": ["*"]}
+}`), 0600))
+
+	register := &EventSinkRegisterCommand{Meta: Meta{Ui: ui}}
+	code := register.Run([]string{"-address=" + url, path})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `Successfully registered event sink "audit"!`)
+	ui.OutputWriter.Reset()
+
+	// Unknown fields are rejected
+	require.NoError(t, os.WriteFile(path, []byte(`{"ID": "audit", "Adress": "http://127.0.0.1"}`), 0600))
+	code = register.Run([]string{"-address=" + url, path})
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), `unknown field "Adress"`)
+	ui.ErrorWriter.Reset()
+
+	list := &EventSinkListCommand{Meta: Meta{Ui: ui}}
+	code = list.Run([]string{"-address=" + url})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	out := ui.OutputWriter.String()
+	require.Contains(t, out, "audit")
+	require.Contains(t, out, "http://127.0.0.1:8080/events")
+	require.Contains(t, out, "Deployment:*,Job:example")
+	ui.OutputWriter.Reset()
+
+	deregister := &EventSinkDeregisterCommand{Meta: Meta{Ui: ui}}
+	code = deregister.Run([]string{"-address=" + url, "audit"})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `Successfully deregistered event sink "audit"!`)
+	ui.OutputWriter.Reset()
+
+	code = list.Run([]string{"-address=" + url})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "No event sinks found")
+}
@@ -0,0 +1,330 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+	"github.com/skratchdot/open-golang/open"
+)
+
+const (
+	// defaultOIDCCallbackAddr is the address the OIDC callback server listens
+	// on when logging in, which must be allowed as a redirect URI by the auth
+	// method.
+	defaultOIDCCallbackAddr = "localhost:4649"
+
+	// oidcCallbackPath is the path of the redirect URI of OIDC logins
+	oidcCallbackPath = "/oidc/callback"
+)
+
+// Ensure LoginCommand satisfies the cli.Command interface.
+var _ cli.Command = &LoginCommand{}
+
+// LoginCommand implements cli.Command.
+type LoginCommand struct {
+	Meta
+
+	authMethodType   string
+	authMethodName   string
+	oidcCallbackAddr string
+	loginToken       string
+	json             bool
+	tmpl             string
+
+	// openURL opens the auth URL of the OIDC provider in the browser of the
+	// user, and is overridden by tests.
+	openURL func(string) error
+}
+
+// Help satisfies the cli.Command Help function.
+func (l *LoginCommand) Help() string {
+	helpText := `
+Usage: nomad login [options]
+
+  Login is used to exchange the identity of a user with an SSO provider for a
+  Nomad ACL token, whose roles and policies are granted by the binding rules
+  of the auth method. For OIDC auth methods, the provider is opened in the
+  browser to log in. For JWT auth methods, the JWT given by the -login-token
+  flag is exchanged without any interaction, such as the JWT of a CI job.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Login Options:
+
+  -method
+    The name of the ACL auth method to log in with. The default auth method
+    of the type is used if not set.
+
+  -type
+    The type of the ACL auth method to log in with. Either "OIDC" or "JWT".
+    Defaults to "OIDC".
+
+  -oidc-callback-addr
+    The address the OIDC callback server listens on, which must be allowed
+    by the auth method as part of the "http://<addr>/oidc/callback" redirect
+    URI. Defaults to "localhost:4649".
+
+  -login-token
+    The JWT to log in with when using a JWT auth method. If "-" is given,
+    the JWT is read from stdin, which keeps it out of the process list.
+
+  -json
+    Output the ACL token in a JSON format.
+
+  -t
+    Format and display the ACL token using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (l *LoginCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(l.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-method":             complete.PredictAnything,
+			"-type":               complete.PredictSet(api.ACLAuthMethodTypeOIDC, api.ACLAuthMethodTypeJWT),
+			"-oidc-callback-addr": complete.PredictAnything,
+			"-login-token":        complete.PredictAnything,
+			"-json":               complete.PredictNothing,
+			"-t":                  complete.PredictAnything,
+		})
+}
+
+func (l *LoginCommand) AutocompleteArgs() complete.Predictor { return complete.PredictNothing }
+
+// Synopsis satisfies the cli.Command Synopsis function.
+func (l *LoginCommand) Synopsis() string { return "Login to Nomad using an auth method" }
+
+// Name returns the name of this command.
+func (l *LoginCommand) Name() string { return "login" }
+
+// Run satisfies the cli.Command Run function.
+func (l *LoginCommand) Run(args []string) int {
+
+	flags := l.Meta.FlagSet(l.Name(), FlagSetClient)
+	flags.Usage = func() { l.Ui.Output(l.Help()) }
+	flags.StringVar(&l.authMethodName, "method", "", "")
+	flags.StringVar(&l.authMethodType, "type", api.ACLAuthMethodTypeOIDC, "")
+	flags.StringVar(&l.oidcCallbackAddr, "oidc-callback-addr", defaultOIDCCallbackAddr, "")
+	flags.StringVar(&l.loginToken, "login-token", "", "")
+	flags.BoolVar(&l.json, "json", false, "")
+	flags.StringVar(&l.tmpl, "t", "", "")
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments.
+	if len(flags.Args()) != 0 {
+		l.Ui.Error("This command takes no arguments")
+		l.Ui.Error(commandErrorText(l))
+		return 1
+	}
+
+	switch l.authMethodType {
+	case api.ACLAuthMethodTypeOIDC:
+	case api.ACLAuthMethodTypeJWT:
+		if l.loginToken == "" {
+			l.Ui.Error("Must specify a login token with -login-token for JWT auth methods")
+			return 1
+		}
+		if l.loginToken == "-" {
+			raw, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				l.Ui.Error(fmt.Sprintf("Error reading login token: %s", err))
+				return 1
+			}
+			l.loginToken = strings.TrimSpace(string(raw))
+		}
+	default:
+		l.Ui.Error(fmt.Sprintf("Unsupported auth method type %q", l.authMethodType))
+		return 1
+	}
+
+	// Get the HTTP client.
+	client, err := l.Meta.Client()
+	if err != nil {
+		l.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Use the default auth method of the type if none was named.
+	if l.authMethodName == "" {
+		authMethods, _, err := client.ACLAuthMethods().List(nil)
+		if err != nil {
+			l.Ui.Error(fmt.Sprintf("Error listing ACL auth methods: %s", err))
+			return 1
+		}
+		for _, authMethod := range authMethods {
+			if authMethod.Default && authMethod.Type == l.authMethodType {
+				l.authMethodName = authMethod.Name
+				break
+			}
+		}
+		if l.authMethodName == "" {
+			l.Ui.Error("Must specify an auth method name, no default found")
+			return 1
+		}
+	}
+
+	// Cancel the login if the user interrupts the command while waiting for
+	// the provider.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+	go func() {
+		select {
+		case <-signalCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var token *api.ACLToken
+	if l.authMethodType == api.ACLAuthMethodTypeJWT {
+		token, _, err = client.ACLAuth().Login(&api.ACLLoginRequest{
+			AuthMethodName: l.authMethodName,
+			LoginToken:     l.loginToken,
+		}, nil)
+	} else {
+		token, err = l.loginOIDC(ctx, client)
+	}
+	if err != nil {
+		l.Ui.Error(fmt.Sprintf("Error logging in: %s", err))
+		return 1
+	}
+
+	if l.json || len(l.tmpl) > 0 {
+		out, err := Format(l.json, l.tmpl, token)
+		if err != nil {
+			l.Ui.Error(err.Error())
+			return 1
+		}
+
+		l.Ui.Output(out)
+		return 0
+	}
+
+	l.Ui.Output(fmt.Sprintf("Successfully logged in via %s and %s\n", l.authMethodType, l.authMethodName))
+	outputACLToken(l.Ui, token)
+	return 0
+}
+
+// oidcCallback holds the parameters the OIDC provider redirects the browser
+// of the user to the callback server with.
+type oidcCallback struct {
+	code  string
+	state string
+	err   error
+}
+
+// loginOIDC logs in with the OIDC auth method. The user logs in with the
+// provider in the browser, which is redirected to a local callback server
+// with the code Nomad exchanges for an ACL token.
+func (l *LoginCommand) loginOIDC(ctx context.Context, client *api.Client) (*api.ACLToken, error) {
+
+	listener, err := net.Listen("tcp", l.oidcCallbackAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start OIDC callback server: %v", err)
+	}
+	defer listener.Close()
+
+	redirectURI := "http://" + l.oidcCallbackAddr + oidcCallbackPath
+	nonce := uuid.Generate()
+
+	authURLResp, _, err := client.ACLOIDC().GetAuthURL(&api.ACLOIDCAuthURLRequest{
+		AuthMethodName: l.authMethodName,
+		RedirectURI:    redirectURI,
+		ClientNonce:    nonce,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC auth URL: %v", err)
+	}
+
+	// The provider must redirect with the state of the auth URL, so the
+	// callback can't be made up by another site.
+	authURL, err := url.Parse(authURLResp.AuthURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OIDC auth URL: %v", err)
+	}
+	state := authURL.Query().Get("state")
+
+	callbackCh := make(chan *oidcCallback, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcCallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		cb := &oidcCallback{code: q.Get("code"), state: q.Get("state")}
+		switch {
+		case q.Get("error") != "":
+			cb.err = fmt.Errorf("OIDC provider returned error %q: %s", q.Get("error"), q.Get("error_description"))
+		case cb.state != state:
+			cb.err = errors.New("OIDC callback state does not match the auth URL")
+		case cb.code == "":
+			cb.err = errors.New("OIDC callback is missing the code")
+		}
+
+		if cb.err != nil {
+			http.Error(w, "Login failed: "+cb.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = w.Write([]byte("Login complete, you may close this window and return to your terminal."))
+		}
+
+		select {
+		case callbackCh <- cb:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux}
+	go func() { _ = srv.Serve(listener) }()
+	defer srv.Close()
+
+	openURL := l.openURL
+	if openURL == nil {
+		openURL = open.Start
+	}
+	l.Ui.Output(fmt.Sprintf("Complete the login via your OIDC provider. Launching browser to:\n\n    %s\n", authURLResp.AuthURL))
+	if err := openURL(authURLResp.AuthURL); err != nil {
+		l.Ui.Warn(fmt.Sprintf("Error opening browser, please open the URL manually: %s", err))
+	}
+
+	l.Ui.Output("Waiting for OIDC authentication to complete...")
+
+	var cb *oidcCallback
+	select {
+	case cb = <-callbackCh:
+	case <-ctx.Done():
+		return nil, errors.New("login interrupted")
+	}
+	if cb.err != nil {
+		return nil, cb.err
+	}
+
+	token, _, err := client.ACLOIDC().CompleteAuth(&api.ACLOIDCCompleteAuthRequest{
+		AuthMethodName: l.authMethodName,
+		ClientNonce:    nonce,
+		State:          cb.state,
+		Code:           cb.code,
+		RedirectURI:    redirectURI,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete OIDC login: %v", err)
+	}
+	return token, nil
+}
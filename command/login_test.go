@@ -0,0 +1,173 @@
+package command
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/helper/freeport"
+	"github.com/hashicorp/nomad/lib/auth/jwt"
+	"github.com/hashicorp/nomad/lib/auth/oidc"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	// Build a test server with ACLs enabled.
+	srv, _, url := testServer(t, false, func(c *agent.Config) {
+		c.ACL.Enabled = true
+	})
+	defer srv.Shutdown()
+
+	// Wait for the server to start fully.
+	testutil.WaitForLeader(t, srv.Agent.RPC)
+
+	ui := cli.NewMockUi()
+	cmd := &LoginCommand{
+		Meta: Meta{
+			Ui:          ui,
+			flagAddress: url,
+		},
+	}
+
+	// Without a method name, the default method must exist.
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url}))
+	require.Contains(t, ui.ErrorWriter.String(), "Must specify an auth method name, no default found")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	// Start the fake OIDC provider and create the default auth method using
+	// it, with a binding rule giving engineers the engineering role.
+	ports := freeport.MustTake(1)
+	defer freeport.Return(ports)
+	callbackAddr := fmt.Sprintf("127.0.0.1:%d", ports[0])
+
+	oidcProvider := oidc.NewTestProvider(t)
+	oidcProvider.SetClaims(map[string]interface{}{
+		"groups": []interface{}{"engineering"},
+	})
+
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Default = true
+	authMethod.Config = oidcProvider.AuthMethodConfig("http://" + callbackAddr + "/oidc/callback")
+	authMethod.Config.ListClaimMappings = map[string]string{"groups": "roles"}
+	authMethod.SetHash()
+	state := srv.Agent.Server().State()
+	require.NoError(t, state.UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	policy := mock.ACLPolicy()
+	require.NoError(t, state.UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLPolicy{policy}))
+	role := mock.ACLRole()
+	role.Name = "engineering"
+	role.Policies = []*structs.ACLRolePolicyLink{{Name: policy.Name}}
+	role.SetHash()
+	require.NoError(t, state.UpsertACLRoles(
+		structs.MsgTypeTestSetup, 30, []*structs.ACLRole{role}, false))
+
+	bindingRule := mock.ACLBindingRule()
+	bindingRule.AuthMethod = authMethod.Name
+	bindingRule.BindName = "engineering"
+	bindingRule.SetHash()
+	require.NoError(t, state.UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 40, []*structs.ACLBindingRule{bindingRule}, false))
+
+	// Log in with a browser which is redirected to the callback server once
+	// the provider approved the login.
+	cmd.openURL = func(authURL string) error {
+		resp, err := http.Get(authURL)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	require.Equal(t, 0, cmd.Run([]string{"-address=" + url, "-oidc-callback-addr=" + callbackAddr}))
+	s := ui.OutputWriter.String()
+	require.Contains(t, s, "Successfully logged in via OIDC and "+authMethod.Name)
+	require.Contains(t, s, "Type         = client")
+	require.Contains(t, s, role.ID)
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	// The state of the callback must be the one of the auth URL.
+	cmd.openURL = func(authURL string) error {
+		resp, err := http.Get("http://" + callbackAddr + "/oidc/callback?code=code&state=other")
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url, "-oidc-callback-addr=" + callbackAddr}))
+	require.Contains(t, ui.ErrorWriter.String(), "OIDC callback state does not match the auth URL")
+}
+
+func TestLoginCommand_JWT(t *testing.T) {
+	ci.Parallel(t)
+
+	// Build a test server with ACLs enabled.
+	srv, _, url := testServer(t, false, func(c *agent.Config) {
+		c.ACL.Enabled = true
+	})
+	defer srv.Shutdown()
+
+	// Wait for the server to start fully.
+	testutil.WaitForLeader(t, srv.Agent.RPC)
+
+	ui := cli.NewMockUi()
+	cmd := &LoginCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	// JWT logins need a login token.
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url, "-type=JWT"}))
+	require.Contains(t, ui.ErrorWriter.String(), "Must specify a login token")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	// Start the fake JWT issuer and create an auth method using it, with a
+	// binding rule giving all its JWTs management tokens.
+	issuer := jwt.NewTestIssuer(t)
+
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Type = structs.ACLAuthMethodTypeJWT
+	authMethod.Config = issuer.AuthMethodConfig()
+	authMethod.SetHash()
+	state := srv.Agent.Server().State()
+	require.NoError(t, state.UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	bindingRule := mock.ACLBindingRule()
+	bindingRule.AuthMethod = authMethod.Name
+	bindingRule.Selector = ""
+	bindingRule.BindType = structs.ACLBindingRuleBindTypeManagement
+	bindingRule.BindName = ""
+	bindingRule.SetHash()
+	require.NoError(t, state.UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLBindingRule{bindingRule}, false))
+
+	require.Equal(t, 0, cmd.Run([]string{"-address=" + url, "-type=JWT",
+		"-method=" + authMethod.Name, "-login-token=" + issuer.Sign(nil)}))
+	s := ui.OutputWriter.String()
+	require.Contains(t, s, "Successfully logged in via JWT and "+authMethod.Name)
+	require.Contains(t, s, "Type         = management")
+
+	ui.OutputWriter.Reset()
+	ui.ErrorWriter.Reset()
+
+	// JWTs of other issuers are rejected.
+	other := jwt.NewTestIssuer(t)
+	require.Equal(t, 1, cmd.Run([]string{"-address=" + url, "-type=JWT",
+		"-method=" + authMethod.Name, "-login-token=" + other.Sign(nil)}))
+	require.Contains(t, ui.ErrorWriter.String(), "no JWKS key found")
+}
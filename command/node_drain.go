@@ -31,7 +31,7 @@ Usage: nomad node drain [options] <node>
   -enable or -disable is specified, but not both.  The -self flag is useful to
   drain the local node.
 
-  If ACLs are enabled, this option requires a token with the 'node:write'
+  If ACLs are enabled, this option requires a token with the 'node:drain-node'
   capability.
 
 General Options:
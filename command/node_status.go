@@ -580,9 +580,53 @@ func (c *NodeStatusCommand) formatNode(client *api.Client, node *api.Node) int {
 		return 1
 	}
 
+	// Only query the client for terminal allocations when it was reachable
+	// for stats to avoid waiting on an unreachable client twice.
+	if nodeStatsErr == nil {
+		c.outputTerminalAllocsOnDisk(client, node)
+	}
+
 	return 0
 }
 
+// outputTerminalAllocsOnDisk lists the terminal allocations which have not
+// been garbage collected by the client yet, along with the size of their
+// allocation directories.
+func (c *NodeStatusCommand) outputTerminalAllocsOnDisk(client *api.Client, node *api.Node) {
+	gcStatus, err := client.Nodes().GCStatus(node.ID, nil)
+	if err != nil {
+		c.Ui.Output("")
+		c.Ui.Error(fmt.Sprintf("error fetching terminal allocations: %v", err))
+		return
+	}
+	if len(gcStatus) == 0 {
+		return
+	}
+
+	out := make([]string, len(gcStatus)+1)
+	out[0] = "Alloc ID|Job ID|Task Group|Status|Size|Terminal Since|Retained Until"
+	for i, alloc := range gcStatus {
+		retained := "<none>"
+		if alloc.Pinned {
+			retained = "pinned"
+		} else if alloc.RetainUntil != 0 {
+			retained = formatUnixNanoTime(alloc.RetainUntil)
+		}
+		out[i+1] = fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s",
+			limit(alloc.AllocID, c.length),
+			alloc.JobID,
+			alloc.TaskGroup,
+			alloc.ClientStatus,
+			humanize.IBytes(uint64(alloc.DiskBytes)),
+			formatUnixNanoTime(alloc.TerminalSince),
+			retained,
+		)
+	}
+
+	c.Ui.Output(c.Colorize().Color("\n[bold]Terminal Allocations On Disk[reset]"))
+	c.Ui.Output(formatList(out))
+}
+
 func (c *NodeStatusCommand) outputAllocInfo(node *api.Node, nodeAllocs []*api.Allocation) error {
 	c.Ui.Output(c.Colorize().Color("\n[bold]Allocations[reset]"))
 	c.Ui.Output(formatAllocList(nodeAllocs, c.verbose, c.length))
@@ -25,8 +25,8 @@ Usage: nomad operator gossip keyring list [options]
   reply and there are no errors. If any node fails to reply or reports failure,
   the exit code will be 1.
 
-  If ACLs are enabled, this command requires a token with the 'agent:write'
-  capability.
+  If ACLs are enabled, this command requires a token with the
+  'agent:gossip-keyring' capability.
 
 General Options:
 
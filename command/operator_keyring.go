@@ -31,7 +31,7 @@ Usage: nomad operator keyring [options]
   are no errors. If any node fails to reply or reports failure, the exit code
   will be 1.
 
-  If ACLs are enabled, this command requires a token with the 'agent:write'
+  If ACLs are enabled, this command requires a token with the 'agent:gossip-keyring'
   capability.
 
 General Options:
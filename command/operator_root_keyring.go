@@ -25,7 +25,8 @@ Usage: nomad operator root keyring [options]
   identities. This command may be used to examine active encryption keys
   in the cluster, rotate keys, add new keys from backups, or remove unused keys.
 
-  If ACLs are enabled, all subcommands requires a management token.
+  If ACLs are enabled, all subcommands require a token with the
+  'operator:root-keyring' capability.
 
   Rotate the encryption key:
 
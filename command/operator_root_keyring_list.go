@@ -20,7 +20,8 @@ Usage: nomad operator root keyring list [options]
   List the currently installed keys. This list returns key metadata and not
   sensitive key material.
 
-  If ACLs are enabled, this command requires a management token.
+  If ACLs are enabled, this command requires a token with the
+  'operator:root-keyring' capability.
 
 General Options:
 
@@ -18,8 +18,10 @@ Usage: nomad operator snapshot <subcommand> [options]
   of the Nomad servers for disaster recovery. These are atomic, point-in-time
   snapshots which include jobs, nodes, allocations, periodic jobs, and ACLs.
 
-  If ACLs are enabled, a management token must be supplied in order to perform
-  snapshot operations.
+  If ACLs are enabled, saving and restoring snapshots requires a token with the
+  'operator:snapshot-save' and 'operator:snapshot-restore' capabilities
+  respectively, and displaying the status of the scheduled snapshots a token
+  with the 'operator:read' capability.
 
   Create a snapshot:
 
@@ -29,10 +31,13 @@ Usage: nomad operator snapshot <subcommand> [options]
 
       $ nomad operator snapshot inspect backup.snap
 
-  Run a daemon process that locally saves a snapshot every hour (available only in
-  Nomad Enterprise) :
+  Query the state of a snapshot with the CLI:
 
-      $ nomad operator snapshot agent
+      $ nomad operator snapshot query backup.snap
+
+  Display the status of the snapshots servers take on a schedule:
+
+      $ nomad operator snapshot status
 
   Please see the individual subcommand help for detailed usage information.
 `
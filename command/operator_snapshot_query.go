@@ -0,0 +1,134 @@
+package command
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/hashicorp/nomad/helper/raftutil"
+	"github.com/posener/complete"
+)
+
+type OperatorSnapshotQueryCommand struct {
+	Meta
+
+	// shutdownCh stops the command when closed, in place of an interrupt
+	shutdownCh chan struct{}
+}
+
+func (c *OperatorSnapshotQueryCommand) Help() string {
+	helpText := `
+Usage: nomad operator snapshot query [options] <file>
+
+  Restores the state of a snapshot in memory and serves a read-only subset of
+  the HTTP API from it, until interrupted. The jobs, allocations, nodes,
+  evaluations, deployments and namespaces endpoints are served, so that the
+  CLI can query the state of the cluster at the time of the snapshot by
+  setting its address to the address served. The web UI is not served.
+
+  The HTTP API served does not enforce ACLs, so it only listens on the loopback
+  interface by default.
+
+  To query the file "backup.snap":
+
+    $ nomad operator snapshot query backup.snap
+    $ NOMAD_ADDR=http://127.0.0.1:4747 nomad job status example
+
+Snapshot Query Options:
+
+  -listen=<addr>
+    The address to serve the HTTP API on. Defaults to "127.0.0.1:4747".
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorSnapshotQueryCommand) AutocompleteFlags() complete.Flags {
+	return complete.Flags{
+		"-listen": complete.PredictAnything,
+	}
+}
+
+func (c *OperatorSnapshotQueryCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *OperatorSnapshotQueryCommand) Synopsis() string {
+	return "Serves the state of a Nomad snapshot file through a read-only HTTP API"
+}
+
+func (c *OperatorSnapshotQueryCommand) Name() string { return "operator snapshot query" }
+
+func (c *OperatorSnapshotQueryCommand) Run(args []string) int {
+	var listen string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetNone)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&listen, "listen", "127.0.0.1:4747", "")
+
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	// Check that we got exactly one argument
+	if len(flags.Args()) != 1 {
+		c.Ui.Error("This command takes one argument: <file>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	path := flags.Args()[0]
+	f, err := os.Open(path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error opening snapshot file: %s", err))
+		return 1
+	}
+	defer f.Close()
+
+	state, meta, err := raftutil.RestoreFromArchive(f, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to read archive file: %s", err))
+		return 1
+	}
+
+	srv, err := raftutil.NewQueryServer(state)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to query snapshot state: %s", err))
+		return 1
+	}
+
+	ln, err := net.Listen("tcp", listen)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listening on %q: %s", listen, err))
+		return 1
+	}
+
+	httpServer := &http.Server{Handler: srv}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.Serve(ln)
+	}()
+	defer httpServer.Close()
+
+	addr := "http://" + ln.Addr().String()
+	c.Ui.Output(fmt.Sprintf("Serving snapshot %q at index %d (term %d) on %s",
+		meta.ID, meta.Index, meta.Term, addr))
+	c.Ui.Output(fmt.Sprintf("Query it by setting NOMAD_ADDR=%s, interrupt to stop", addr))
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalCh)
+
+	select {
+	case <-signalCh:
+	case <-c.shutdownCh:
+	case err := <-errCh:
+		c.Ui.Error(fmt.Sprintf("Error serving snapshot: %s", err))
+		return 1
+	}
+	return 0
+}
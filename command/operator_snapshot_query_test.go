@@ -0,0 +1,75 @@
+package command
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperatorSnapshotQuery_Works(t *testing.T) {
+	ci.Parallel(t)
+
+	snapPath := generateSnapshotFile(t, func(srv *agent.TestAgent, client *api.Client, url string) {
+		_, _, err := client.Jobs().Register(testJob("snapshot-query-job"), nil)
+		require.NoError(t, err)
+	})
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSnapshotQueryCommand{Meta: Meta{Ui: ui}, shutdownCh: make(chan struct{})}
+
+	codeCh := make(chan int, 1)
+	go func() {
+		codeCh <- cmd.Run([]string{"-listen=127.0.0.1:0", snapPath})
+	}()
+
+	// Wait for the snapshot to be served
+	addrRe := regexp.MustCompile(`NOMAD_ADDR=(\S+),`)
+	var addr string
+	require.Eventually(t, func() bool {
+		m := addrRe.FindStringSubmatch(ui.OutputWriter.String())
+		if m == nil {
+			return false
+		}
+		addr = m[1]
+		return true
+	}, 10*time.Second, 10*time.Millisecond, ui.ErrorWriter.String())
+
+	// The job of the snapshot is displayed by the CLI
+	statusUi := cli.NewMockUi()
+	statusCmd := &JobStatusCommand{Meta: Meta{Ui: statusUi}}
+	code := statusCmd.Run([]string{"-address=" + addr, "snapshot-query-job"})
+	require.Zero(t, code, statusUi.ErrorWriter.String())
+	require.Contains(t, statusUi.OutputWriter.String(), "snapshot-query-job")
+
+	close(cmd.shutdownCh)
+	select {
+	case code := <-codeCh:
+		require.Zero(t, code)
+	case <-time.After(5 * time.Second):
+		t.Fatal("command did not stop")
+	}
+}
+
+func TestOperatorSnapshotQuery_HandlesFailure(t *testing.T) {
+	ci.Parallel(t)
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSnapshotQueryCommand{Meta: Meta{Ui: ui}}
+
+	code := cmd.Run([]string{})
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), "This command takes one argument")
+
+	ui = cli.NewMockUi()
+	cmd = &OperatorSnapshotQueryCommand{Meta: Meta{Ui: ui}}
+
+	code = cmd.Run([]string{"/does/not/exist.snap"})
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), "no such file")
+}
@@ -25,8 +25,8 @@ Usage: nomad operator snapshot restore [options] <file>
   intended to be used when recovering from a disaster, restoring into a fresh
   cluster of Nomad servers.
 
-  If ACLs are enabled, a management token must be supplied in order to perform
-  snapshot operations.
+  If ACLs are enabled, this command requires a token with the
+  'operator:snapshot-restore' capability.
 
   To restore a snapshot from the file "backup.snap":
 
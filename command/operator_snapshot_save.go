@@ -22,8 +22,8 @@ Usage: nomad operator snapshot save [options] <file>
   Retrieves an atomic, point-in-time snapshot of the state of the Nomad servers
   which includes jobs, nodes, allocations, periodic jobs, and ACLs.
 
-  If ACLs are enabled, a management token must be supplied in order to perform
-  snapshot operations.
+  If ACLs are enabled, this command requires a token with the
+  'operator:snapshot-save' capability.
 
   To create a snapshot from the leader server and save it to "backup.snap":
 
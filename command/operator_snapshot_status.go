@@ -0,0 +1,138 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type OperatorSnapshotStatusCommand struct {
+	Meta
+}
+
+func (c *OperatorSnapshotStatusCommand) Help() string {
+	helpText := `
+Usage: nomad operator snapshot status [options]
+
+  Displays the status of the scheduled snapshots of the leader and the
+  snapshots it retains. Scheduled snapshots are configured with the snapshot
+  block of the server configuration.
+
+  If ACLs are enabled, this command requires a token with the 'operator:read'
+  capability.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Snapshot Status Options:
+
+  -stale=[true|false]
+    The -stale argument defaults to "false" which means the leader provides the
+    result. Set -stale to "true" to get the status of the server the request is
+    sent to, such as a server configured to take snapshots regardless of its
+    leadership.
+
+  -json
+    Output the status in its JSON format.
+
+  -t
+    Format and display the status using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorSnapshotStatusCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-stale": complete.PredictAnything,
+			"-json":  complete.PredictNothing,
+			"-t":     complete.PredictAnything,
+		})
+}
+
+func (c *OperatorSnapshotStatusCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *OperatorSnapshotStatusCommand) Synopsis() string {
+	return "Displays the status of the scheduled snapshots"
+}
+
+func (c *OperatorSnapshotStatusCommand) Name() string { return "operator snapshot status" }
+
+func (c *OperatorSnapshotStatusCommand) Run(args []string) int {
+	var stale, json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&stale, "stale", false, "")
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if len(flags.Args()) != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	status, _, err := client.Operator().SnapshotStatus(&api.QueryOptions{AllowStale: stale})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving snapshot status: %s", err))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, status)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		c.Ui.Output(out)
+		return 0
+	}
+
+	if !status.Enabled {
+		c.Ui.Output(fmt.Sprintf("Scheduled snapshots are not enabled on server %q", status.Server))
+		return 0
+	}
+
+	c.Ui.Output(formatKV([]string{
+		fmt.Sprintf("Server|%s", status.Server),
+		fmt.Sprintf("Active|%t", status.Active),
+		fmt.Sprintf("Storage|%s", status.Storage),
+		fmt.Sprintf("Interval|%s", status.Interval),
+		fmt.Sprintf("Last Success|%s", formatTime(status.LastSuccess)),
+		fmt.Sprintf("Last Snapshot|%s", status.LastSnapshot),
+		fmt.Sprintf("Last Failure|%s", formatTime(status.LastFailure)),
+		fmt.Sprintf("Last Error|%s", status.LastError),
+	}))
+
+	c.Ui.Output(c.Colorize().Color("\n[bold]Snapshots[reset]"))
+	if len(status.Snapshots) == 0 {
+		c.Ui.Output("No snapshots retained")
+		return 0
+	}
+
+	rows := []string{"Name|Index|Time|Size"}
+	for _, snap := range status.Snapshots {
+		rows = append(rows, fmt.Sprintf("%s|%d|%s|%s",
+			snap.Name, snap.Index, formatTime(snap.Time), humanize.IBytes(uint64(snap.Size))))
+	}
+	c.Ui.Output(formatList(rows))
+	return 0
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperatorSnapshotStatusCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &OperatorSnapshotStatusCommand{}
+}
+
+func TestOperatorSnapshotStatusCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	tmpDir := t.TempDir()
+	srv, client, url := testServer(t, false, func(c *agent.Config) {
+		c.DevMode = false
+		c.DataDir = filepath.Join(tmpDir, "server")
+		c.Server.Snapshot = &agent.ServerSnapshot{
+			Enabled:     pointer.Of(true),
+			Interval:    50 * time.Millisecond,
+			IntervalHCL: "50ms",
+		}
+
+		c.AdvertiseAddrs.HTTP = "127.0.0.1"
+		c.AdvertiseAddrs.RPC = "127.0.0.1"
+		c.AdvertiseAddrs.Serf = "127.0.0.1"
+	})
+	defer srv.Shutdown()
+
+	testutil.WaitForResult(func() (bool, error) {
+		status, _, err := client.Operator().SnapshotStatus(nil)
+		if err != nil {
+			return false, err
+		}
+		return status.LastSnapshot != "", nil
+	}, func(err error) {
+		t.Fatalf("no snapshot taken: %v", err)
+	})
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSnapshotStatusCommand{Meta: Meta{Ui: ui}}
+	code := cmd.Run([]string{"-address=" + url})
+	require.Zero(t, code, ui.ErrorWriter.String())
+
+	out := ui.OutputWriter.String()
+	require.Contains(t, out, "Active        = true")
+	require.Contains(t, out, "local:"+filepath.Join(tmpDir, "server", "server", "snapshots"))
+	require.Contains(t, out, "nomad-snapshot-")
+
+	// Check the JSON output
+	ui = cli.NewMockUi()
+	cmd = &OperatorSnapshotStatusCommand{Meta: Meta{Ui: ui}}
+	code = cmd.Run([]string{"-address=" + url, "-json"})
+	require.Zero(t, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `"Enabled": true`)
+
+	// Fails on arguments
+	ui = cli.NewMockUi()
+	cmd = &OperatorSnapshotStatusCommand{Meta: Meta{Ui: ui}}
+	code = cmd.Run([]string{"-address=" + url, "extra"})
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), "This command takes no arguments")
+}
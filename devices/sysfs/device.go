@@ -0,0 +1,265 @@
+package sysfs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper/pluginutils/loader"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/device"
+	"github.com/hashicorp/nomad/plugins/shared/hclspec"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// pluginName is the name of the plugin
+	pluginName = "sysfs"
+)
+
+var (
+	// PluginID is the sysfs plugin metadata registered in the plugin
+	// catalog.
+	PluginID = loader.PluginID{
+		Name:       pluginName,
+		PluginType: base.PluginTypeDevice,
+	}
+
+	// PluginConfig is the sysfs device factory function registered in the
+	// plugin catalog.
+	PluginConfig = &loader.InternalPluginConfig{
+		Config:  map[string]interface{}{},
+		Factory: func(ctx context.Context, l log.Logger) interface{} { return NewSysfsDevice(ctx, l) },
+	}
+
+	// pluginInfo describes the plugin
+	pluginInfo = &base.PluginInfoResponse{
+		Type:              base.PluginTypeDevice,
+		PluginApiVersions: []string{device.ApiVersion010},
+		PluginVersion:     "0.1.0",
+		Name:              pluginName,
+	}
+
+	// configSpec is the specification of the plugin's configuration
+	configSpec = hclspec.NewObject(map[string]*hclspec.Spec{
+		"fingerprint_period": hclspec.NewDefault(
+			hclspec.NewAttr("fingerprint_period", "string", false),
+			hclspec.NewLiteral(`"1m"`),
+		),
+		"sysfs_root": hclspec.NewDefault(
+			hclspec.NewAttr("sysfs_root", "string", false),
+			hclspec.NewLiteral(`"/sys"`),
+		),
+		"dev_root": hclspec.NewDefault(
+			hclspec.NewAttr("dev_root", "string", false),
+			hclspec.NewLiteral(`"/dev"`),
+		),
+		"rule": hclspec.NewBlockList("rule", hclspec.NewObject(map[string]*hclspec.Spec{
+			"bus":        hclspec.NewAttr("bus", "string", true),
+			"vendor_id":  hclspec.NewAttr("vendor_id", "string", true),
+			"product_id": hclspec.NewAttr("product_id", "string", false),
+			"vendor":     hclspec.NewAttr("vendor", "string", true),
+			"type":       hclspec.NewAttr("type", "string", true),
+			"name":       hclspec.NewAttr("name", "string", true),
+			"cgroup_perms": hclspec.NewDefault(
+				hclspec.NewAttr("cgroup_perms", "string", false),
+				hclspec.NewLiteral(`"rwm"`),
+			),
+		})),
+	})
+)
+
+// Config contains configuration information for the plugin.
+type Config struct {
+	FingerprintPeriod string  `codec:"fingerprint_period"`
+	SysfsRoot         string  `codec:"sysfs_root"`
+	DevRoot           string  `codec:"dev_root"`
+	Rules             []*Rule `codec:"rule"`
+}
+
+// SysfsDevice is a device plugin that exposes the USB and PCI devices
+// matching the configured rules to tasks. Devices are discovered and their
+// health is determined from sysfs, and their device nodes are given to the
+// tasks they are reserved for.
+type SysfsDevice struct {
+	logger log.Logger
+
+	// scanner finds the devices in sysfs
+	scanner *scanner
+
+	// fingerprintPeriod is how often devices are scanned for changes
+	fingerprintPeriod time.Duration
+
+	// devices are the last detected devices by ID
+	devices    map[string]*detectedDevice
+	deviceLock sync.RWMutex
+}
+
+// NewSysfsDevice returns a new sysfs device plugin.
+func NewSysfsDevice(_ context.Context, log log.Logger) *SysfsDevice {
+	return &SysfsDevice{
+		logger:  log.Named(pluginName),
+		devices: make(map[string]*detectedDevice),
+	}
+}
+
+// PluginInfo returns information describing the plugin.
+func (d *SysfsDevice) PluginInfo() (*base.PluginInfoResponse, error) {
+	return pluginInfo, nil
+}
+
+// ConfigSchema returns the plugins configuration schema.
+func (d *SysfsDevice) ConfigSchema() (*hclspec.Spec, error) {
+	return configSpec, nil
+}
+
+// SetConfig is used to set the configuration of the plugin.
+func (d *SysfsDevice) SetConfig(c *base.Config) error {
+	var config Config
+	if len(c.PluginConfig) != 0 {
+		if err := base.MsgPackDecode(c.PluginConfig, &config); err != nil {
+			return err
+		}
+	}
+
+	period, err := time.ParseDuration(config.FingerprintPeriod)
+	if err != nil {
+		return fmt.Errorf("failed to parse fingerprint period %q: %v", config.FingerprintPeriod, err)
+	}
+	d.fingerprintPeriod = period
+
+	groups := make(map[string]struct{}, len(config.Rules))
+	for i, r := range config.Rules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("rule %d: %v", i, err)
+		}
+
+		// Devices matched by different rules can't share a device group
+		// since the group's attributes are derived from the rule
+		key := r.Vendor + "/" + r.Type + "/" + r.Name
+		if _, ok := groups[key]; ok {
+			return fmt.Errorf("rule %d: device group %s is used by another rule", i, key)
+		}
+		groups[key] = struct{}{}
+	}
+
+	d.scanner = &scanner{
+		sysfsRoot: config.SysfsRoot,
+		devRoot:   config.DevRoot,
+		rules:     config.Rules,
+	}
+	return nil
+}
+
+// Fingerprint streams detected devices. Messages are emitted when devices
+// are added or removed or their health changes.
+func (d *SysfsDevice) Fingerprint(ctx context.Context) (<-chan *device.FingerprintResponse, error) {
+	if d.scanner == nil {
+		return nil, status.New(codes.Internal, "plugin not configured").Err()
+	}
+
+	outCh := make(chan *device.FingerprintResponse)
+	go d.fingerprint(ctx, outCh)
+	return outCh, nil
+}
+
+// fingerprint is the long running goroutine that detects devices
+func (d *SysfsDevice) fingerprint(ctx context.Context, devices chan *device.FingerprintResponse) {
+	defer close(devices)
+
+	// Create a timer that will fire immediately for the first detection
+	ticker := time.NewTimer(0)
+	defer ticker.Stop()
+
+	var last []*device.DeviceGroup
+	first := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ticker.Reset(d.fingerprintPeriod)
+		}
+
+		detected, err := d.scanner.scan()
+		if err != nil {
+			d.logger.Error("failed to scan devices", "error", err)
+			select {
+			case devices <- device.NewFingerprintError(err):
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		d.deviceLock.Lock()
+		d.devices = detected
+		d.deviceLock.Unlock()
+
+		groups := deviceGroups(d.scanner.rules, detected)
+		if !first && reflect.DeepEqual(groups, last) {
+			continue
+		}
+		first = false
+		last = groups
+
+		select {
+		case devices <- device.NewFingerprint(groups...):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Reserve returns the device nodes and cgroup permissions of the given
+// devices.
+func (d *SysfsDevice) Reserve(deviceIDs []string) (*device.ContainerReservation, error) {
+	if len(deviceIDs) == 0 {
+		return nil, status.New(codes.InvalidArgument, "no device ids given").Err()
+	}
+
+	d.deviceLock.RLock()
+	defer d.deviceLock.RUnlock()
+
+	resp := &device.ContainerReservation{}
+	seen := make(map[string]struct{})
+	for _, id := range deviceIDs {
+		dev, ok := d.devices[id]
+		if !ok {
+			return nil, status.Newf(codes.InvalidArgument, "unknown device %q", id).Err()
+		}
+		if len(dev.nodes) == 0 {
+			return nil, status.Newf(codes.FailedPrecondition, "device %q is unhealthy: %s", id, dev.device.HealthDesc).Err()
+		}
+
+		// Devices in the same IOMMU group share their device nodes
+		for _, node := range dev.nodes {
+			if _, ok := seen[node]; ok {
+				continue
+			}
+			seen[node] = struct{}{}
+			resp.Devices = append(resp.Devices, &device.DeviceSpec{
+				TaskPath:    taskPath(d.scanner.devRoot, node),
+				HostPath:    node,
+				CgroupPerms: dev.rule.CgroupPerms,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// Stats streams statistics for the detected devices. Devices in sysfs do not
+// expose any generic statistics so the stream is only closed when the context
+// is done.
+func (d *SysfsDevice) Stats(ctx context.Context, _ time.Duration) (<-chan *device.StatsResponse, error) {
+	outCh := make(chan *device.StatsResponse)
+	go func() {
+		<-ctx.Done()
+		close(outCh)
+	}()
+	return outCh, nil
+}
@@ -0,0 +1,293 @@
+package sysfs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclspecutils"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/device"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty/msgpack"
+)
+
+// fakeSysfs is a fake sysfs and devtmpfs tree
+type fakeSysfs struct {
+	t       *testing.T
+	sysRoot string
+	devRoot string
+}
+
+func newFakeSysfs(t *testing.T) *fakeSysfs {
+	root := t.TempDir()
+	return &fakeSysfs{
+		t:       t,
+		sysRoot: filepath.Join(root, "sys"),
+		devRoot: filepath.Join(root, "dev"),
+	}
+}
+
+func (f *fakeSysfs) write(path, content string) {
+	require.NoError(f.t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(f.t, os.WriteFile(path, []byte(content+"\n"), 0644))
+}
+
+func (f *fakeSysfs) addUSB(name, vendorID, productID string, busnum, devnum int, authorized bool) {
+	dir := filepath.Join(f.sysRoot, "bus", "usb", "devices", name)
+	f.write(filepath.Join(dir, "idVendor"), vendorID)
+	f.write(filepath.Join(dir, "idProduct"), productID)
+	f.write(filepath.Join(dir, "busnum"), strconv.Itoa(busnum))
+	f.write(filepath.Join(dir, "devnum"), strconv.Itoa(devnum))
+	auth := "1"
+	if !authorized {
+		auth = "0"
+	}
+	f.write(filepath.Join(dir, "authorized"), auth)
+
+	// The interfaces of the device are listed alongside it
+	f.write(filepath.Join(f.sysRoot, "bus", "usb", "devices", name+":1.0", "bInterfaceClass"), "0b")
+}
+
+func (f *fakeSysfs) addUSBNode(busnum, devnum int) {
+	f.write(filepath.Join(f.devRoot, "bus", "usb", fmt.Sprintf("%03d", busnum), fmt.Sprintf("%03d", devnum)), "")
+}
+
+func (f *fakeSysfs) addPCI(name, vendorID, productID, driver, group string) {
+	dir := filepath.Join(f.sysRoot, "bus", "pci", "devices", name)
+	f.write(filepath.Join(dir, "vendor"), vendorID)
+	f.write(filepath.Join(dir, "device"), productID)
+	if driver != "" {
+		require.NoError(f.t, os.Symlink(filepath.Join("..", "..", "drivers", driver), filepath.Join(dir, "driver")))
+	}
+	if group != "" {
+		require.NoError(f.t, os.Symlink(filepath.Join("..", "..", "iommu_groups", group), filepath.Join(dir, "iommu_group")))
+		f.write(filepath.Join(f.devRoot, "vfio", group), "")
+	}
+}
+
+// newTestDevice returns a sysfs device plugin configured with the given
+// rules and the fake tree, with the defaults of the config spec applied.
+func newTestDevice(t *testing.T, f *fakeSysfs, rules ...map[string]interface{}) (*SysfsDevice, error) {
+	ruleList := make([]interface{}, len(rules))
+	for i, r := range rules {
+		ruleList[i] = r
+	}
+	config := map[string]interface{}{
+		"sysfs_root":         f.sysRoot,
+		"dev_root":           f.devRoot,
+		"fingerprint_period": "50ms",
+		"rule":               ruleList,
+	}
+
+	spec, diag := hclspecutils.Convert(configSpec)
+	require.False(t, diag.HasErrors(), diag.Error())
+	val, diag, errs := hclutils.ParseHclInterface(config, spec, nil)
+	if diag.HasErrors() {
+		require.NoError(t, errs[0])
+	}
+	data, err := msgpack.Marshal(val, val.Type())
+	require.NoError(t, err)
+
+	d := NewSysfsDevice(context.Background(), testlog.HCLogger(t))
+	return d, d.SetConfig(&base.Config{PluginConfig: data})
+}
+
+func usbRule() map[string]interface{} {
+	return map[string]interface{}{
+		"bus":        "usb",
+		"vendor_id":  "0x1050",
+		"product_id": "0407",
+		"vendor":     "yubico",
+		"type":       "hsm",
+		"name":       "yubikey",
+	}
+}
+
+func pciRule() map[string]interface{} {
+	return map[string]interface{}{
+		"bus":          "pci",
+		"vendor_id":    "10ee",
+		"vendor":       "xilinx",
+		"type":         "fpga",
+		"name":         "alveo",
+		"cgroup_perms": "rw",
+	}
+}
+
+func nextFingerprint(t *testing.T, ch <-chan *device.FingerprintResponse) *device.FingerprintResponse {
+	select {
+	case resp := <-ch:
+		require.NotNil(t, resp)
+		require.NoError(t, resp.Error)
+		return resp
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for fingerprint")
+	}
+	return nil
+}
+
+func TestSysfsDevice_SetConfig(t *testing.T) {
+	ci.Parallel(t)
+
+	f := newFakeSysfs(t)
+
+	d, err := newTestDevice(t, f, usbRule(), pciRule())
+	require.NoError(t, err)
+	require.Equal(t, 50*time.Millisecond, d.fingerprintPeriod)
+	require.Equal(t, "1050", d.scanner.rules[0].VendorID)
+	require.Equal(t, "rwm", d.scanner.rules[0].CgroupPerms)
+	require.Equal(t, "rw", d.scanner.rules[1].CgroupPerms)
+
+	cases := []struct {
+		name string
+		set  func(r map[string]interface{})
+		err  string
+	}{
+		{"bus", func(r map[string]interface{}) { r["bus"] = "pcie" }, "invalid bus"},
+		{"vendor_id", func(r map[string]interface{}) { r["vendor_id"] = "xyz" }, "invalid vendor_id"},
+		{"product_id", func(r map[string]interface{}) { r["product_id"] = "12345" }, "invalid product_id"},
+		{"perms", func(r map[string]interface{}) { r["cgroup_perms"] = "rx" }, "invalid cgroup_perms"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := usbRule()
+			c.set(r)
+			_, err := newTestDevice(t, f, r)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), c.err)
+		})
+	}
+
+	_, err = newTestDevice(t, f, usbRule(), usbRule())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "used by another rule")
+}
+
+func TestSysfsDevice_Fingerprint(t *testing.T) {
+	ci.Parallel(t)
+
+	f := newFakeSysfs(t)
+	f.addUSB("1-1", "1050", "0407", 1, 4, true)
+	f.addUSBNode(1, 4)
+	f.addUSB("1-2", "1050", "0407", 1, 5, false)
+	f.addUSBNode(1, 5)
+	f.addUSB("1-3", "1050", "0010", 1, 6, true)
+	f.addUSB("2-1", "046d", "c52b", 2, 2, true)
+	f.addPCI("0000:03:00.0", "0x10ee", "0x5000", "vfio-pci", "12")
+	f.addPCI("0000:04:00.0", "0x10ee", "0x5000", "xclmgmt", "")
+
+	d, err := newTestDevice(t, f, usbRule(), pciRule())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := d.Fingerprint(ctx)
+	require.NoError(t, err)
+
+	resp := nextFingerprint(t, ch)
+	require.Len(t, resp.Devices, 2)
+
+	usb := resp.Devices[0]
+	require.NoError(t, usb.Validate())
+	require.Equal(t, "yubico", usb.Vendor)
+	require.Equal(t, "hsm", usb.Type)
+	require.Equal(t, "yubikey", usb.Name)
+	require.Equal(t, "usb", *usb.Attributes["bus"].String)
+	require.Equal(t, "1050", *usb.Attributes["vendor_id"].String)
+	require.Equal(t, "0407", *usb.Attributes["product_id"].String)
+	require.Equal(t, []*device.Device{
+		{ID: "1-1", Healthy: true},
+		{ID: "1-2", HealthDesc: "device is not authorized"},
+	}, usb.Devices)
+
+	pci := resp.Devices[1]
+	require.NoError(t, pci.Validate())
+	require.Equal(t, "alveo", pci.Name)
+	require.NotContains(t, pci.Attributes, "product_id")
+	require.Equal(t, []*device.Device{
+		{
+			ID:         "0000:03:00.0",
+			Healthy:    true,
+			HwLocality: &device.DeviceLocality{PciBusID: "0000:03:00.0"},
+		},
+		{
+			ID:         "0000:04:00.0",
+			HealthDesc: "device is bound to xclmgmt instead of vfio-pci",
+			HwLocality: &device.DeviceLocality{PciBusID: "0000:04:00.0"},
+		},
+	}, pci.Devices)
+
+	// Authorizing the device makes it healthy
+	f.write(filepath.Join(f.sysRoot, "bus", "usb", "devices", "1-2", "authorized"), "1")
+	resp = nextFingerprint(t, ch)
+	require.True(t, resp.Devices[0].Devices[1].Healthy)
+
+	// Unplugging the device removes it
+	require.NoError(t, os.RemoveAll(filepath.Join(f.sysRoot, "bus", "usb", "devices", "1-1")))
+	resp = nextFingerprint(t, ch)
+	require.Len(t, resp.Devices[0].Devices, 1)
+	require.Equal(t, "1-2", resp.Devices[0].Devices[0].ID)
+}
+
+func TestSysfsDevice_Reserve(t *testing.T) {
+	ci.Parallel(t)
+
+	f := newFakeSysfs(t)
+	f.addUSB("1-1", "1050", "0407", 1, 4, true)
+	f.addUSBNode(1, 4)
+	f.addPCI("0000:03:00.0", "10ee", "5000", "vfio-pci", "12")
+	f.addPCI("0000:03:00.1", "10ee", "5001", "vfio-pci", "12")
+	f.addPCI("0000:04:00.0", "10ee", "5000", "", "")
+
+	d, err := newTestDevice(t, f, usbRule(), pciRule())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := d.Fingerprint(ctx)
+	require.NoError(t, err)
+	nextFingerprint(t, ch)
+
+	res, err := d.Reserve([]string{"1-1"})
+	require.NoError(t, err)
+	require.Equal(t, []*device.DeviceSpec{{
+		TaskPath:    "/dev/bus/usb/001/004",
+		HostPath:    filepath.Join(f.devRoot, "bus", "usb", "001", "004"),
+		CgroupPerms: "rwm",
+	}}, res.Devices)
+
+	// Functions of a device share the IOMMU group and its device nodes
+	res, err = d.Reserve([]string{"0000:03:00.0", "0000:03:00.1"})
+	require.NoError(t, err)
+	require.Equal(t, []*device.DeviceSpec{
+		{
+			TaskPath:    "/dev/vfio/vfio",
+			HostPath:    filepath.Join(f.devRoot, "vfio", "vfio"),
+			CgroupPerms: "rw",
+		},
+		{
+			TaskPath:    "/dev/vfio/12",
+			HostPath:    filepath.Join(f.devRoot, "vfio", "12"),
+			CgroupPerms: "rw",
+		},
+	}, res.Devices)
+
+	_, err = d.Reserve([]string{"0000:04:00.0"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is unhealthy")
+
+	_, err = d.Reserve([]string{"1-9"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown device")
+
+	_, err = d.Reserve(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,324 @@
+package sysfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/plugins/device"
+	"github.com/hashicorp/nomad/plugins/shared/structs"
+)
+
+const (
+	// busUSB and busPCI are the buses devices can be matched on
+	busUSB = "usb"
+	busPCI = "pci"
+
+	// vfioDriver is the kernel driver PCI devices must be bound to in order
+	// to be passed through to tasks
+	vfioDriver = "vfio-pci"
+
+	// The attributes of a device group
+	attrBus       = "bus"
+	attrVendorID  = "vendor_id"
+	attrProductID = "product_id"
+)
+
+// Rule matches devices on a bus by their vendor and product IDs and assigns
+// them to a device group.
+type Rule struct {
+	// Bus is the bus the devices are on, either usb or pci.
+	Bus string `codec:"bus"`
+
+	// VendorID and ProductID are the hexadecimal IDs of the devices, such as
+	// 1050 and 0407. An empty ProductID matches all devices of the vendor.
+	VendorID  string `codec:"vendor_id"`
+	ProductID string `codec:"product_id"`
+
+	// Vendor, Type and Name identify the device group the matched devices
+	// are reported in.
+	Vendor string `codec:"vendor"`
+	Type   string `codec:"type"`
+	Name   string `codec:"name"`
+
+	// CgroupPerms are the cgroup device permissions of the device nodes
+	// given to tasks, a combination of r, w and m.
+	CgroupPerms string `codec:"cgroup_perms"`
+}
+
+// validate validates the rule and normalizes its IDs
+func (r *Rule) validate() error {
+	switch r.Bus {
+	case busUSB, busPCI:
+	default:
+		return fmt.Errorf("invalid bus %q, must be %q or %q", r.Bus, busUSB, busPCI)
+	}
+	vendorID, err := normalizeID(r.VendorID)
+	if err != nil || vendorID == "" {
+		return fmt.Errorf("invalid vendor_id %q", r.VendorID)
+	}
+	productID, err := normalizeID(r.ProductID)
+	if err != nil {
+		return fmt.Errorf("invalid product_id %q", r.ProductID)
+	}
+	r.VendorID, r.ProductID = vendorID, productID
+	if r.Vendor == "" || r.Type == "" || r.Name == "" {
+		return errors.New("vendor, type and name must be set")
+	}
+	if r.CgroupPerms == "" || strings.Trim(r.CgroupPerms, "rwm") != "" {
+		return fmt.Errorf("invalid cgroup_perms %q, must be a combination of r, w and m", r.CgroupPerms)
+	}
+	return nil
+}
+
+// matches returns whether the rule matches a device with the given IDs
+func (r *Rule) matches(vendorID, productID string) bool {
+	return r.VendorID == vendorID && (r.ProductID == "" || r.ProductID == productID)
+}
+
+// normalizeID returns the lower case hexadecimal ID without a 0x prefix, as
+// found in the usb attributes in sysfs.
+func normalizeID(id string) (string, error) {
+	id = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(id), "0x"))
+	if id == "" {
+		return "", nil
+	}
+	v, err := strconv.ParseUint(id, 16, 16)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%04x", v), nil
+}
+
+// detectedDevice is a device matched by a rule
+type detectedDevice struct {
+	device *device.Device
+	rule   *Rule
+
+	// nodes are the device nodes the task needs access to
+	nodes []string
+}
+
+// scanner finds the devices matching the rules in sysfs
+type scanner struct {
+	// sysfsRoot and devRoot are the mount points of sysfs and devtmpfs
+	sysfsRoot string
+	devRoot   string
+
+	rules []*Rule
+}
+
+// scan returns the devices matching the rules by ID
+func (s *scanner) scan() (map[string]*detectedDevice, error) {
+	detected := make(map[string]*detectedDevice)
+
+	var usb, pci bool
+	for _, r := range s.rules {
+		usb = usb || r.Bus == busUSB
+		pci = pci || r.Bus == busPCI
+	}
+
+	if usb {
+		if err := s.scanUSB(detected); err != nil {
+			return nil, fmt.Errorf("failed to scan usb devices: %v", err)
+		}
+	}
+	if pci {
+		if err := s.scanPCI(detected); err != nil {
+			return nil, fmt.Errorf("failed to scan pci devices: %v", err)
+		}
+	}
+	return detected, nil
+}
+
+// rule returns the first rule matching the device
+func (s *scanner) rule(bus, vendorID, productID string) *Rule {
+	for _, r := range s.rules {
+		if r.Bus == bus && r.matches(vendorID, productID) {
+			return r
+		}
+	}
+	return nil
+}
+
+// scanUSB detects USB devices. Their device node is /dev/bus/usb/BBB/DDD
+// and they are unhealthy while not authorized.
+func (s *scanner) scanUSB(detected map[string]*detectedDevice) error {
+	dir := filepath.Join(s.sysfsRoot, "bus", "usb", "devices")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, e := range entries {
+		// Interfaces of the devices are listed as well, as in 1-1:1.0
+		if strings.Contains(e.Name(), ":") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+
+		vendorID, _ := normalizeID(readAttr(path, "idVendor"))
+		productID, _ := normalizeID(readAttr(path, "idProduct"))
+		rule := s.rule(busUSB, vendorID, productID)
+		if rule == nil {
+			continue
+		}
+
+		busnum, err1 := strconv.Atoi(readAttr(path, "busnum"))
+		devnum, err2 := strconv.Atoi(readAttr(path, "devnum"))
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		node := filepath.Join(s.devRoot, "bus", "usb", fmt.Sprintf("%03d", busnum), fmt.Sprintf("%03d", devnum))
+
+		d := &device.Device{ID: e.Name(), Healthy: true}
+		if readAttr(path, "authorized") == "0" {
+			d.Healthy = false
+			d.HealthDesc = "device is not authorized"
+		} else if _, err := os.Stat(node); err != nil {
+			d.Healthy = false
+			d.HealthDesc = fmt.Sprintf("device node %s not found", node)
+		}
+
+		detected[d.ID] = &detectedDevice{device: d, rule: rule, nodes: []string{node}}
+	}
+	return nil
+}
+
+// scanPCI detects PCI devices. They can only be passed through once bound to
+// the vfio-pci driver, which exposes the device's IOMMU group as
+// /dev/vfio/GROUP.
+func (s *scanner) scanPCI(detected map[string]*detectedDevice) error {
+	dir := filepath.Join(s.sysfsRoot, "bus", "pci", "devices")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+
+		vendorID, _ := normalizeID(readAttr(path, "vendor"))
+		productID, _ := normalizeID(readAttr(path, "device"))
+		rule := s.rule(busPCI, vendorID, productID)
+		if rule == nil {
+			continue
+		}
+
+		d := &device.Device{
+			ID:         e.Name(),
+			Healthy:    true,
+			HwLocality: &device.DeviceLocality{PciBusID: e.Name()},
+		}
+		dd := &detectedDevice{device: d, rule: rule}
+		detected[d.ID] = dd
+
+		driver := readLink(path, "driver")
+		if driver != vfioDriver {
+			d.Healthy = false
+			d.HealthDesc = fmt.Sprintf("device is not bound to %s", vfioDriver)
+			if driver != "" {
+				d.HealthDesc = fmt.Sprintf("device is bound to %s instead of %s", driver, vfioDriver)
+			}
+			continue
+		}
+
+		group := readLink(path, "iommu_group")
+		if group == "" {
+			d.Healthy = false
+			d.HealthDesc = "device has no iommu group"
+			continue
+		}
+
+		node := filepath.Join(s.devRoot, "vfio", group)
+		if _, err := os.Stat(node); err != nil {
+			d.Healthy = false
+			d.HealthDesc = fmt.Sprintf("device node %s not found", node)
+			continue
+		}
+		dd.nodes = []string{filepath.Join(s.devRoot, "vfio", "vfio"), node}
+	}
+	return nil
+}
+
+// deviceGroups returns the device groups of the detected devices, with the
+// devices sorted by ID.
+func deviceGroups(rules []*Rule, detected map[string]*detectedDevice) []*device.DeviceGroup {
+	groups := make(map[*Rule]*device.DeviceGroup)
+	var out []*device.DeviceGroup
+	for _, r := range rules {
+		g := &device.DeviceGroup{
+			Vendor: r.Vendor,
+			Type:   r.Type,
+			Name:   r.Name,
+			Attributes: map[string]*structs.Attribute{
+				attrBus:      structs.NewStringAttribute(r.Bus),
+				attrVendorID: structs.NewStringAttribute(r.VendorID),
+			},
+		}
+		if r.ProductID != "" {
+			g.Attributes[attrProductID] = structs.NewStringAttribute(r.ProductID)
+		}
+		groups[r] = g
+		out = append(out, g)
+	}
+
+	for _, d := range detected {
+		g := groups[d.rule]
+		g.Devices = append(g.Devices, d.device)
+	}
+
+	// Only report groups with devices
+	n := 0
+	for _, g := range out {
+		if len(g.Devices) == 0 {
+			continue
+		}
+		sort.Slice(g.Devices, func(i, j int) bool {
+			return g.Devices[i].ID < g.Devices[j].ID
+		})
+		out[n] = g
+		n++
+	}
+	return out[:n]
+}
+
+// readAttr returns the trimmed content of a sysfs attribute file, or an
+// empty string if it can't be read.
+func readAttr(dir, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// readLink returns the base name of the target of a sysfs link, or an empty
+// string if there is no such link.
+func readLink(dir, name string) string {
+	target, err := os.Readlink(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(target)
+}
+
+// taskPath returns the path of a device node in the task, which is the path
+// of the node relative to /dev for nodes found in devRoot.
+func taskPath(devRoot, node string) string {
+	rel, err := filepath.Rel(devRoot, node)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return node
+	}
+	return filepath.Join("/dev", rel)
+}
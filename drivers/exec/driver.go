@@ -2,8 +2,10 @@ package exec
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"sync"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/nomad/drivers/shared/capabilities"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/helper/pointer"
@@ -38,6 +41,10 @@ const (
 )
 
 var (
+	// errDefaultSeccompUnsupported is returned when tasks would run without
+	// the seccomp filter enabled by default_seccomp
+	errDefaultSeccompUnsupported = errors.New("default_seccomp is enabled but seccomp is not supported on this client")
+
 	// PluginID is the exec plugin metadata registered in the plugin
 	// catalog.
 	PluginID = loader.PluginID{
@@ -78,17 +85,58 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
+		"default_seccomp": hclspec.NewDefault(
+			hclspec.NewAttr("default_seccomp", "bool", false),
+			hclspec.NewLiteral("true"),
+		),
+		"allowed_seccomp_profiles": hclspec.NewAttr("allowed_seccomp_profiles", "list(string)", false),
+		"default_userns_mode": hclspec.NewDefault(
+			hclspec.NewAttr("default_userns_mode", "string", false),
+			hclspec.NewLiteral(`"host"`),
+		),
+		"userns_host_uid": hclspec.NewDefault(
+			hclspec.NewAttr("userns_host_uid", "number", false),
+			hclspec.NewLiteral("100000"),
+		),
+		"userns_host_gid": hclspec.NewDefault(
+			hclspec.NewAttr("userns_host_gid", "number", false),
+			hclspec.NewLiteral("100000"),
+		),
+		"userns_size": hclspec.NewDefault(
+			hclspec.NewAttr("userns_size", "number", false),
+			hclspec.NewLiteral("65536"),
+		),
+		"image_cache_dir": hclspec.NewAttr("image_cache_dir", "string", false),
+		"image_cache_max_age": hclspec.NewDefault(
+			hclspec.NewAttr("image_cache_max_age", "string", false),
+			hclspec.NewLiteral(`"72h"`),
+		),
+		"checkpoint": hclspec.NewDefault(
+			hclspec.NewAttr("checkpoint", "bool", false),
+			hclspec.NewLiteral("false"),
+		),
+		"criu_path": hclspec.NewDefault(
+			hclspec.NewAttr("criu_path", "string", false),
+			hclspec.NewLiteral(`"criu"`),
+		),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
 	// a task within a job. It is returned in the TaskConfigSchema RPC
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
-		"command":  hclspec.NewAttr("command", "string", true),
-		"args":     hclspec.NewAttr("args", "list(string)", false),
-		"pid_mode": hclspec.NewAttr("pid_mode", "string", false),
-		"ipc_mode": hclspec.NewAttr("ipc_mode", "string", false),
-		"cap_add":  hclspec.NewAttr("cap_add", "list(string)", false),
-		"cap_drop": hclspec.NewAttr("cap_drop", "list(string)", false),
+		"command":         hclspec.NewAttr("command", "string", false),
+		"args":            hclspec.NewAttr("args", "list(string)", false),
+		"pid_mode":        hclspec.NewAttr("pid_mode", "string", false),
+		"ipc_mode":        hclspec.NewAttr("ipc_mode", "string", false),
+		"cap_add":         hclspec.NewAttr("cap_add", "list(string)", false),
+		"cap_drop":        hclspec.NewAttr("cap_drop", "list(string)", false),
+		"seccomp_profile": hclspec.NewAttr("seccomp_profile", "string", false),
+		"userns_mode":     hclspec.NewAttr("userns_mode", "string", false),
+		"image":           hclspec.NewAttr("image", "string", false),
+		"auth": hclspec.NewBlock("auth", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"username": hclspec.NewAttr("username", "string", false),
+			"password": hclspec.NewAttr("password", "string", false),
+		})),
 	})
 
 	// driverCapabilities represents the RPC response for what features are
@@ -102,6 +150,7 @@ var (
 			drivers.NetIsolationModeGroup,
 		},
 		MountConfigs: drivers.MountConfigSupportAll,
+		Pause:        true,
 	}
 )
 
@@ -128,6 +177,9 @@ type Driver struct {
 	// logger will log to the Nomad agent
 	logger hclog.Logger
 
+	// images pulls and caches the images of tasks
+	images *ociimage.Store
+
 	// A tri-state boolean to know if the fingerprinting has happened and
 	// whether it has been successful
 	fingerprintSuccess *bool
@@ -151,6 +203,46 @@ type Config struct {
 	// AllowCaps configures which Linux Capabilities are enabled for tasks
 	// running on this node.
 	AllowCaps []string `codec:"allow_caps"`
+
+	// DefaultSeccomp applies the built-in seccomp profile to tasks that do
+	// not set their own profile.
+	DefaultSeccomp bool `codec:"default_seccomp"`
+
+	// AllowedSeccompProfiles is the list of glob patterns matching the
+	// seccomp profile files tasks may use. The literal "unconfined" allows
+	// tasks to run without a seccomp filter.
+	AllowedSeccompProfiles []string `codec:"allowed_seccomp_profiles"`
+
+	// DefaultModeUser is the default user namespace isolation set for all
+	// tasks using exec-based task drivers.
+	DefaultModeUser string `codec:"default_userns_mode"`
+
+	// UserNSHostUID is the first host UID that root inside a private user
+	// namespace is mapped to.
+	UserNSHostUID int `codec:"userns_host_uid"`
+
+	// UserNSHostGID is the first host GID that root inside a private user
+	// namespace is mapped to.
+	UserNSHostGID int `codec:"userns_host_gid"`
+
+	// UserNSSize is the number of IDs mapped into a private user namespace.
+	UserNSSize int `codec:"userns_size"`
+
+	// ImageCacheDir is the directory the unpacked layers of task images are
+	// cached in. Defaults to a directory under the client's state directory.
+	ImageCacheDir string `codec:"image_cache_dir"`
+
+	// ImageCacheMaxAge is how long cached layers no task uses are kept
+	// after they were last pulled.
+	ImageCacheMaxAge string `codec:"image_cache_max_age"`
+
+	// Checkpoint enables checkpointing tasks with CRIU when their
+	// allocation migrates, and restoring them on the new client.
+	Checkpoint bool `codec:"checkpoint"`
+
+	// CriuPath is the path to the criu binary, looked up in the PATH if not
+	// absolute.
+	CriuPath string `codec:"criu_path"`
 }
 
 func (c *Config) validate() error {
@@ -166,14 +258,120 @@ func (c *Config) validate() error {
 		return fmt.Errorf("default_ipc_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, c.DefaultModeIPC)
 	}
 
+	switch c.DefaultModeUser {
+	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("default_userns_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, c.DefaultModeUser)
+	}
+
 	badCaps := capabilities.Supported().Difference(capabilities.New(c.AllowCaps))
 	if !badCaps.Empty() {
 		return fmt.Errorf("allow_caps configured with capabilities not supported by system: %s", badCaps)
 	}
 
+	for _, pattern := range c.AllowedSeccompProfiles {
+		if pattern == executor.SeccompProfileUnconfined {
+			continue
+		}
+		if !filepath.IsAbs(pattern) {
+			return fmt.Errorf("allowed_seccomp_profiles must contain absolute paths, got %q", pattern)
+		}
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("allowed_seccomp_profiles contains invalid pattern %q: %v", pattern, err)
+		}
+		if !executor.SeccompSupported {
+			return fmt.Errorf("allowed_seccomp_profiles contains %q but seccomp is not supported by this build", pattern)
+		}
+	}
+
+	if c.ImageCacheMaxAge != "" {
+		maxAge, err := time.ParseDuration(c.ImageCacheMaxAge)
+		if err != nil {
+			return fmt.Errorf("image_cache_max_age is not a valid duration: %v", err)
+		}
+		if maxAge <= 0 {
+			return fmt.Errorf("image_cache_max_age must be positive, got %q", c.ImageCacheMaxAge)
+		}
+	}
+
+	if c.UserNSHostUID < 0 || c.UserNSHostGID < 0 || c.UserNSSize < 0 {
+		return fmt.Errorf("userns_host_uid, userns_host_gid and userns_size must not be negative")
+	}
+	if c.DefaultModeUser == executor.IsolationModePrivate {
+		if err := c.validateUserNSRange(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateUserNSRange ensures the host ID range used for private user
+// namespaces never maps the task user to root on the host.
+func (c *Config) validateUserNSRange() error {
+	if c.UserNSHostUID <= 0 || c.UserNSHostGID <= 0 {
+		return fmt.Errorf("userns_host_uid and userns_host_gid must be greater than 0")
+	}
+	if c.UserNSSize <= 0 {
+		return fmt.Errorf("userns_size must be greater than 0, got %d", c.UserNSSize)
+	}
+	// task directories are shifted into the host range by the size of the
+	// range, so the two must not overlap
+	if c.UserNSHostUID < c.UserNSSize || c.UserNSHostGID < c.UserNSSize {
+		return fmt.Errorf("userns_host_uid and userns_host_gid must not be less than userns_size")
+	}
 	return nil
 }
 
+// seccompProfile returns the seccomp profile to apply to the task, enforcing
+// the operator's allowlist.
+func (c *Config) seccompProfile(tc *TaskConfig) (string, error) {
+	switch profile := tc.SeccompProfile; profile {
+	case "":
+		if !c.DefaultSeccomp {
+			return "", nil
+		}
+		if !executor.SeccompSupported {
+			return "", errDefaultSeccompUnsupported
+		}
+		return executor.SeccompProfileDefault, nil
+	case executor.SeccompProfileDefault:
+		if !executor.SeccompSupported {
+			return "", fmt.Errorf("seccomp is not supported on this client")
+		}
+		return profile, nil
+	case executor.SeccompProfileUnconfined:
+		for _, pattern := range c.AllowedSeccompProfiles {
+			if pattern == executor.SeccompProfileUnconfined {
+				return profile, nil
+			}
+		}
+		return "", fmt.Errorf("seccomp_profile %q is not allowed by the client", profile)
+	default:
+		if !executor.SeccompSupported {
+			return "", fmt.Errorf("seccomp is not supported on this client")
+		}
+		for _, pattern := range c.AllowedSeccompProfiles {
+			if ok, _ := filepath.Match(pattern, profile); ok {
+				return profile, nil
+			}
+		}
+		return "", fmt.Errorf("seccomp_profile %q is not allowed by the client", profile)
+	}
+}
+
+// validateUserNS ensures a task running in a private user namespace also runs
+// in private PID and IPC namespaces, which /proc and /dev/mqueue require.
+func (c *Config) validateUserNS(modeUser, modePID, modeIPC string) error {
+	if modeUser != executor.IsolationModePrivate {
+		return nil
+	}
+	if modePID != executor.IsolationModePrivate || modeIPC != executor.IsolationModePrivate {
+		return fmt.Errorf("userns_mode %q requires private pid_mode and ipc_mode", modeUser)
+	}
+	return c.validateUserNSRange()
+}
+
 // TaskConfig is the driver configuration of a task within a job
 type TaskConfig struct {
 	// Command is the thing to exec.
@@ -195,9 +393,29 @@ type TaskConfig struct {
 
 	// CapDrop is a set of linux capabilities to disable.
 	CapDrop []string `codec:"cap_drop"`
+
+	// SeccompProfile is "default", "unconfined" or the absolute path of a
+	// seccomp profile file allowed by the client.
+	SeccompProfile string `codec:"seccomp_profile"`
+
+	// ModeUser indicates whether the task runs in a private user namespace.
+	// Must be "private" or "host" if set.
+	ModeUser string `codec:"userns_mode"`
+
+	// Image is the OCI image used as the root filesystem of the task.
+	Image string `codec:"image"`
+
+	// Auth is the registry credentials used to pull Image.
+	Auth ImageAuth `codec:"auth"`
 }
 
 func (tc *TaskConfig) validate() error {
+	if tc.Image != "" {
+		if _, err := ociimage.ParseReference(tc.Image); err != nil {
+			return err
+		}
+	}
+
 	switch tc.ModePID {
 	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
 	default:
@@ -210,6 +428,21 @@ func (tc *TaskConfig) validate() error {
 		return fmt.Errorf("ipc_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, tc.ModeIPC)
 	}
 
+	switch tc.ModeUser {
+	case "", executor.IsolationModePrivate, executor.IsolationModeHost:
+	default:
+		return fmt.Errorf("userns_mode must be %q or %q, got %q", executor.IsolationModePrivate, executor.IsolationModeHost, tc.ModeUser)
+	}
+
+	switch tc.SeccompProfile {
+	case "", executor.SeccompProfileDefault, executor.SeccompProfileUnconfined:
+	default:
+		if !filepath.IsAbs(tc.SeccompProfile) {
+			return fmt.Errorf("seccomp_profile must be %q, %q or an absolute path, got %q",
+				executor.SeccompProfileDefault, executor.SeccompProfileUnconfined, tc.SeccompProfile)
+		}
+	}
+
 	supported := capabilities.Supported()
 	badAdds := supported.Difference(capabilities.New(tc.CapAdd))
 	if !badAdds.Empty() {
@@ -231,6 +464,12 @@ type TaskState struct {
 	TaskConfig     *drivers.TaskConfig
 	Pid            int
 	StartedAt      time.Time
+
+	// ImageDir is the directory the task image is mounted under, if any.
+	ImageDir string
+
+	// ImageLayers are the cached layer directories of the mounted image.
+	ImageLayers []string
 }
 
 // NewExecDriver returns a new DrivePlugin implementation
@@ -287,6 +526,32 @@ func (d *Driver) SetConfig(cfg *base.Config) error {
 	}
 	d.config = config
 
+	if config.DefaultSeccomp && !executor.SeccompSupported {
+		d.logger.Error("default_seccomp is enabled but seccomp is not supported by this build, the driver is unhealthy")
+	}
+
+	imageCacheDir := config.ImageCacheDir
+	if imageCacheDir == "" && cfg.AgentConfig != nil && cfg.AgentConfig.Driver != nil &&
+		cfg.AgentConfig.Driver.DataDir != "" {
+		imageCacheDir = filepath.Join(cfg.AgentConfig.Driver.DataDir, pluginName, "images")
+	}
+	if imageCacheDir != "" && d.images == nil {
+		maxAge := defaultImageCacheMaxAge
+		if config.ImageCacheMaxAge != "" {
+			maxAge, _ = time.ParseDuration(config.ImageCacheMaxAge)
+		}
+		d.images = ociimage.NewStore(imageCacheDir, d.logger)
+		go d.evictImages(maxAge)
+	}
+
+	if config.Checkpoint {
+		criu, err := exec.LookPath(config.CriuPath)
+		if err != nil {
+			return fmt.Errorf("checkpoint is enabled but criu was not found: %v", err)
+		}
+		d.config.CriuPath = criu
+	}
+
 	if cfg != nil && cfg.AgentConfig != nil {
 		d.nomadConfig = cfg.AgentConfig.Driver
 	}
@@ -300,7 +565,12 @@ func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
 // Capabilities is returned by the Capabilities RPC and indicates what
 // optional features this driver supports
 func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
-	return driverCapabilities, nil
+	if !d.config.Checkpoint {
+		return driverCapabilities, nil
+	}
+	caps := *driverCapabilities
+	caps.Checkpoint = true
+	return &caps, nil
 }
 
 func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
@@ -365,7 +635,18 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 		return fp
 	}
 
+	// tasks must not silently run without the seccomp filter the operator
+	// asked for
+	if d.config.DefaultSeccomp && !executor.SeccompSupported {
+		fp.Health = drivers.HealthStateUnhealthy
+		fp.HealthDescription = errDefaultSeccompUnsupported.Error()
+		d.setFingerprintFailure()
+		return fp
+	}
+
 	fp.Attributes["driver.exec"] = pstructs.NewBoolAttribute(true)
+	fp.Attributes["driver.exec.seccomp"] = pstructs.NewBoolAttribute(executor.SeccompSupported)
+	fp.Attributes["driver.exec.checkpoint"] = pstructs.NewBoolAttribute(d.config.Checkpoint)
 	d.setFingerprintSuccess()
 	return fp
 }
@@ -414,6 +695,8 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		startedAt:    taskState.StartedAt,
 		exitResult:   &drivers.ExitResult{},
 		logger:       d.logger,
+		imageDir:     taskState.ImageDir,
+		imageLayers:  taskState.ImageLayers,
 	}
 
 	d.tasks.Set(taskState.TaskConfig.ID, h)
@@ -435,11 +718,40 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	if err := driverConfig.validate(); err != nil {
 		return nil, nil, fmt.Errorf("failed driver config validation: %v", err)
 	}
+	if driverConfig.Command == "" && driverConfig.Image == "" {
+		return nil, nil, fmt.Errorf("failed driver config validation: command or image must be set")
+	}
 
-	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig))
+	d.logger.Debug("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig.redacted()))
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
 
+	var image *ociimage.Image
+	var imageDir, rootfs string
+	var imageLayers []string
+	if driverConfig.Image != "" {
+		if d.images == nil {
+			return nil, nil, fmt.Errorf("image_cache_dir must be set to run images")
+		}
+
+		var err error
+		image, imageDir, rootfs, err = d.mountImage(cfg, &driverConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to prepare image: %v", err)
+		}
+		imageLayers = image.Layers
+
+		// unmount the image unless the task is started
+		defer func() {
+			if _, ok := d.tasks.Get(cfg.ID); ok {
+				return
+			}
+			if err := ociimage.Unmount(imageDir); err != nil {
+				d.logger.Error("failed to unmount image", "error", err)
+			}
+		}()
+	}
+
 	pluginLogFile := filepath.Join(cfg.TaskDir().Dir, "executor.out")
 	executorConfig := &executor.ExecutorConfig{
 		LogFile:     pluginLogFile,
@@ -454,12 +766,19 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		return nil, nil, fmt.Errorf("failed to create executor: %v", err)
 	}
 
+	// the image user is ignored as it is not checked against the client's
+	// user denylist, only the task user is. Images may not have a passwd
+	// file, so nobody is referred to by its conventional UID in them.
 	user := cfg.User
+	if user == "" && image != nil {
+		user = imageDefaultUser
+	}
 	if user == "" {
 		user = "nobody"
 	}
 
-	if cfg.DNS != nil {
+	// images do not carry the host's resolv.conf, so always provide one
+	if cfg.DNS != nil || image != nil {
 		dnsMount, err := resolvconf.GenerateDNSMount(cfg.TaskDir().Dir, cfg.DNS)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to build mount for resolv.conf: %v", err)
@@ -475,10 +794,36 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	}
 	d.logger.Debug("task capabilities", "capabilities", caps)
 
+	seccompProfile, err := d.config.seccompProfile(&driverConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modePID := executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID)
+	modeIPC := executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC)
+	modeUser := executor.IsolationMode(d.config.DefaultModeUser, driverConfig.ModeUser)
+	if err := d.config.validateUserNS(modeUser, modePID, modeIPC); err != nil {
+		return nil, nil, err
+	}
+
+	command, args, env := driverConfig.Command, driverConfig.Args, cfg.EnvList()
+	mounts := cfg.Mounts
+	var workDir string
+	if image != nil {
+		command, args = imageCommand(image, &driverConfig)
+		if command == "" {
+			pluginClient.Kill()
+			return nil, nil, fmt.Errorf("image %q has no entrypoint or command and none is set", driverConfig.Image)
+		}
+		env = imageEnv(image, env)
+		mounts = append(imageMounts(cfg), mounts...)
+		workDir = image.Config.WorkingDir
+	}
+
 	execCmd := &executor.ExecCommand{
-		Cmd:              driverConfig.Command,
-		Args:             driverConfig.Args,
-		Env:              cfg.EnvList(),
+		Cmd:              command,
+		Args:             args,
+		Env:              env,
 		User:             user,
 		ResourceLimits:   true,
 		NoPivotRoot:      d.config.NoPivotRoot,
@@ -486,15 +831,54 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		TaskDir:          cfg.TaskDir().Dir,
 		StdoutPath:       cfg.StdoutPath,
 		StderrPath:       cfg.StderrPath,
-		Mounts:           cfg.Mounts,
+		Mounts:           mounts,
 		Devices:          cfg.Devices,
 		NetworkIsolation: cfg.NetworkIsolation,
-		ModePID:          executor.IsolationMode(d.config.DefaultModePID, driverConfig.ModePID),
-		ModeIPC:          executor.IsolationMode(d.config.DefaultModeIPC, driverConfig.ModeIPC),
+		ModePID:          modePID,
+		ModeIPC:          modeIPC,
+		ModeUser:         modeUser,
+		UserNSHostUID:    uint32(d.config.UserNSHostUID),
+		UserNSHostGID:    uint32(d.config.UserNSHostGID),
+		UserNSSize:       uint32(d.config.UserNSSize),
+		SeccompProfile:   seccompProfile,
 		Capabilities:     caps,
+		Rootfs:           rootfs,
+		WorkDir:          workDir,
+		CriuPath:         d.config.CriuPath,
+	}
+
+	// restore the task from the checkpoint taken on the previous client,
+	// falling back to a fresh start if restoring fails
+	checkpointDir := cfg.TaskDir().CheckpointDir
+	if d.config.Checkpoint && checkpointDir != "" {
+		if _, err := os.Stat(checkpointDir); err == nil {
+			execCmd.RestoreFrom = checkpointDir
+			defer os.RemoveAll(checkpointDir)
+		}
 	}
 
 	ps, err := exec.Launch(execCmd)
+	if err != nil && execCmd.RestoreFrom != "" {
+		d.logger.Warn("failed to restore task from checkpoint, starting it afresh", "error", err, "task_id", cfg.ID)
+		d.eventer.EmitEvent(&drivers.TaskEvent{
+			TaskID:    cfg.ID,
+			AllocID:   cfg.AllocID,
+			TaskName:  cfg.Name,
+			Timestamp: time.Now(),
+			Message:   "Failed to restore from checkpoint, starting task afresh",
+			Err:       err,
+		})
+		execCmd.RestoreFrom = ""
+		ps, err = exec.Launch(execCmd)
+	} else if err == nil && execCmd.RestoreFrom != "" {
+		d.eventer.EmitEvent(&drivers.TaskEvent{
+			TaskID:    cfg.ID,
+			AllocID:   cfg.AllocID,
+			TaskName:  cfg.Name,
+			Timestamp: time.Now(),
+			Message:   "Restored from checkpoint",
+		})
+	}
 	if err != nil {
 		pluginClient.Kill()
 		return nil, nil, fmt.Errorf("failed to launch command with executor: %v", err)
@@ -508,6 +892,8 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		procState:    drivers.TaskStateRunning,
 		startedAt:    time.Now().Round(time.Millisecond),
 		logger:       d.logger,
+		imageDir:     imageDir,
+		imageLayers:  imageLayers,
 	}
 
 	driverState := TaskState{
@@ -515,6 +901,8 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		Pid:            ps.Pid,
 		TaskConfig:     cfg,
 		StartedAt:      h.startedAt,
+		ImageDir:       imageDir,
+		ImageLayers:    imageLayers,
 	}
 
 	if err := handle.SetDriverState(&driverState); err != nil {
@@ -621,6 +1009,12 @@ func (d *Driver) DestroyTask(taskID string, force bool) error {
 	// workaround for the case where DestroyTask was issued on task restart
 	d.resetCgroup(handle)
 
+	if handle.imageDir != "" {
+		if err := ociimage.Unmount(handle.imageDir); err != nil {
+			handle.logger.Error("failed to unmount image", "error", err)
+		}
+	}
+
 	d.tasks.Delete(taskID)
 	return nil
 }
@@ -647,6 +1041,26 @@ func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, err
 	return d.eventer.TaskEvents(ctx)
 }
 
+// PauseTask freezes the processes of the task.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the processes of a paused task.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Resume()
+}
+
 func (d *Driver) SignalTask(taskID string, signal string) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -663,6 +1077,21 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return handle.exec.Signal(sig)
 }
 
+// CheckpointTask dumps the task into its checkpoint directory, stopping it.
+// The task is restored from there when started again.
+func (d *Driver) CheckpointTask(taskID string) error {
+	if !d.config.Checkpoint {
+		return fmt.Errorf("checkpoint is not enabled")
+	}
+
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Checkpoint(handle.taskConfig.TaskDir().CheckpointDir)
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	if len(cmd) == 0 {
 		return nil, fmt.Errorf("error cmd must have at least one value")
@@ -115,6 +115,38 @@ func TestExecDriver_Fingerprint(t *testing.T) {
 	}
 }
 
+func TestExecDriver_Fingerprint_DefaultSeccompUnsupported(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+	if executor.SeccompSupported {
+		t.Skip("Test requires a build without seccomp support")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewExecDriver(ctx, testlog.HCLogger(t))
+	harness := dtestutil.NewDriverHarness(t, d)
+
+	var data []byte
+	require.NoError(t, basePlug.MsgPackEncode(&data, &Config{
+		DefaultModePID: executor.IsolationModePrivate,
+		DefaultModeIPC: executor.IsolationModePrivate,
+		DefaultSeccomp: true,
+	}))
+	require.NoError(t, harness.SetConfig(&basePlug.Config{PluginConfig: data}))
+
+	fingerCh, err := harness.Fingerprint(context.Background())
+	require.NoError(t, err)
+	select {
+	case finger := <-fingerCh:
+		require.Equal(t, drivers.HealthStateUnhealthy, finger.Health)
+		require.Equal(t, errDefaultSeccompUnsupported.Error(), finger.HealthDescription)
+	case <-time.After(time.Duration(testutil.TestMultiplier()*5) * time.Second):
+		require.Fail(t, "timeout receiving fingerprint")
+	}
+}
+
 func TestExecDriver_StartWait(t *testing.T) {
 	ci.Parallel(t)
 	ctestutils.ExecCompatible(t)
@@ -218,6 +250,62 @@ func TestExecDriver_StartWaitStopKill(t *testing.T) {
 	require.NoError(t, harness.DestroyTask(task.ID, true))
 }
 
+func TestExecDriver_PauseResume(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewExecDriver(ctx, testlog.HCLogger(t))
+	harness := dtestutil.NewDriverHarness(t, d)
+	allocID := uuid.Generate()
+	task := &drivers.TaskConfig{
+		AllocID:   allocID,
+		ID:        uuid.Generate(),
+		Name:      "test",
+		Resources: testResources(allocID, "test"),
+	}
+
+	tc := &TaskConfig{
+		Command: "/bin/sleep",
+		Args:    []string{"600"},
+	}
+	require.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	caps, err := harness.Capabilities()
+	require.NoError(t, err)
+	require.True(t, caps.Pause)
+
+	handle, _, err := harness.StartTask(task)
+	require.NoError(t, err)
+	defer harness.DestroyTask(task.ID, true)
+
+	ch, err := harness.WaitTask(context.Background(), handle.Config.ID)
+	require.NoError(t, err)
+	require.NoError(t, harness.WaitUntilStarted(task.ID, 1*time.Second))
+
+	pd, ok := harness.DriverPlugin.(drivers.PauseDriver)
+	require.True(t, ok)
+	require.NoError(t, pd.PauseTask(task.ID))
+	require.Error(t, pd.PauseTask(task.ID), "task already paused")
+	require.NoError(t, pd.ResumeTask(task.ID))
+	require.NoError(t, pd.PauseTask(task.ID))
+
+	// paused tasks are resumed to handle the kill signal
+	go harness.StopTask(task.ID, 5*time.Second, "SIGINT")
+
+	select {
+	case result := <-ch:
+		require.False(t, result.Successful())
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timeout waiting for task to shutdown")
+	}
+}
+
 func TestExecDriver_StartWaitRecover(t *testing.T) {
 	ci.Parallel(t)
 	ctestutils.ExecCompatible(t)
@@ -774,6 +862,47 @@ func TestExecDriver_NoPivotRoot(t *testing.T) {
 	require.NoError(t, harness.DestroyTask(task.ID, true))
 }
 
+func TestExecDriver_SetConfig_Checkpoint(t *testing.T) {
+	ci.Parallel(t)
+
+	setConfig := func(d *Driver, config *Config) error {
+		config.DefaultModePID = executor.IsolationModePrivate
+		config.DefaultModeIPC = executor.IsolationModePrivate
+		var data []byte
+		require.NoError(t, basePlug.MsgPackEncode(&data, config))
+		return d.SetConfig(&basePlug.Config{PluginConfig: data})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		d := NewExecDriver(context.Background(), testlog.HCLogger(t)).(*Driver)
+		require.NoError(t, setConfig(d, &Config{CriuPath: "/does/not/exist"}))
+
+		caps, err := d.Capabilities()
+		require.NoError(t, err)
+		require.False(t, caps.Checkpoint)
+	})
+
+	t.Run("criu missing", func(t *testing.T) {
+		d := NewExecDriver(context.Background(), testlog.HCLogger(t)).(*Driver)
+		err := setConfig(d, &Config{Checkpoint: true, CriuPath: "/does/not/exist"})
+		require.ErrorContains(t, err, "criu was not found")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		criu := filepath.Join(t.TempDir(), "criu")
+		require.NoError(t, os.WriteFile(criu, []byte("#!/bin/sh\n"), 0755))
+
+		d := NewExecDriver(context.Background(), testlog.HCLogger(t)).(*Driver)
+		require.NoError(t, setConfig(d, &Config{Checkpoint: true, CriuPath: criu}))
+		require.Equal(t, criu, d.config.CriuPath)
+
+		caps, err := d.Capabilities()
+		require.NoError(t, err)
+		require.True(t, caps.Checkpoint)
+		require.False(t, driverCapabilities.Checkpoint)
+	})
+}
+
 func TestDriver_Config_validate(t *testing.T) {
 	ci.Parallel(t)
 	t.Run("pid/ipc", func(t *testing.T) {
@@ -813,6 +942,114 @@ func TestDriver_Config_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("allowed_seccomp_profiles", func(t *testing.T) {
+		// profile files cannot be allowed by builds without seccomp support
+		var unsupported error
+		if !executor.SeccompSupported {
+			unsupported = errors.New(`allowed_seccomp_profiles contains "/etc/nomad/seccomp/*.json" but seccomp is not supported by this build`)
+		}
+
+		for _, tc := range []struct {
+			profiles []string
+			exp      error
+		}{
+			{profiles: nil, exp: nil},
+			{profiles: []string{"unconfined"}, exp: nil},
+			{profiles: []string{"unconfined", "/etc/nomad/seccomp/*.json"}, exp: unsupported},
+			{profiles: []string{"seccomp/*.json"}, exp: errors.New(`allowed_seccomp_profiles must contain absolute paths, got "seccomp/*.json"`)},
+			{profiles: []string{"/etc/[.json"}, exp: errors.New(`allowed_seccomp_profiles contains invalid pattern "/etc/[.json": syntax error in pattern`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:         "private",
+				DefaultModeIPC:         "private",
+				AllowedSeccompProfiles: tc.profiles,
+			}).validate())
+		}
+	})
+
+	t.Run("userns", func(t *testing.T) {
+		for _, tc := range []struct {
+			mode           string
+			uid, gid, size int
+			exp            error
+		}{
+			{mode: "", exp: nil},
+			{mode: "host", exp: nil},
+			{mode: "private", uid: 100000, gid: 100000, size: 65536, exp: nil},
+			{mode: "private", uid: 0, gid: 100000, size: 65536, exp: errors.New("userns_host_uid and userns_host_gid must be greater than 0")},
+			{mode: "private", uid: 100000, gid: 100000, size: 0, exp: errors.New("userns_size must be greater than 0, got 0")},
+			{mode: "private", uid: 100000, gid: 1000, size: 65536, exp: errors.New("userns_host_uid and userns_host_gid must not be less than userns_size")},
+			{mode: "host", uid: -1, exp: errors.New("userns_host_uid, userns_host_gid and userns_size must not be negative")},
+			{mode: "other", exp: errors.New(`default_userns_mode must be "private" or "host", got "other"`)},
+		} {
+			require.Equal(t, tc.exp, (&Config{
+				DefaultModePID:  "private",
+				DefaultModeIPC:  "private",
+				DefaultModeUser: tc.mode,
+				UserNSHostUID:   tc.uid,
+				UserNSHostGID:   tc.gid,
+				UserNSSize:      tc.size,
+			}).validate())
+		}
+	})
+}
+
+func TestDriver_Config_seccompProfile(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &Config{
+		AllowedSeccompProfiles: []string{"/etc/nomad/seccomp/*.json"},
+	}
+
+	profile, err := config.seccompProfile(&TaskConfig{})
+	require.NoError(t, err)
+	require.Empty(t, profile)
+
+	_, err = config.seccompProfile(&TaskConfig{SeccompProfile: "unconfined"})
+	require.EqualError(t, err, `seccomp_profile "unconfined" is not allowed by the client`)
+
+	config.AllowedSeccompProfiles = append(config.AllowedSeccompProfiles, "unconfined")
+	profile, err = config.seccompProfile(&TaskConfig{SeccompProfile: "unconfined"})
+	require.NoError(t, err)
+	require.Equal(t, "unconfined", profile)
+
+	if !executor.SeccompSupported {
+		_, err = config.seccompProfile(&TaskConfig{SeccompProfile: "default"})
+		require.EqualError(t, err, "seccomp is not supported on this client")
+
+		// tasks never run unfiltered when the default profile is enabled
+		config.DefaultSeccomp = true
+		_, err = config.seccompProfile(&TaskConfig{})
+		require.ErrorIs(t, err, errDefaultSeccompUnsupported)
+		return
+	}
+
+	config.DefaultSeccomp = true
+	profile, err = config.seccompProfile(&TaskConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "default", profile)
+
+	profile, err = config.seccompProfile(&TaskConfig{SeccompProfile: "/etc/nomad/seccomp/web.json"})
+	require.NoError(t, err)
+	require.Equal(t, "/etc/nomad/seccomp/web.json", profile)
+
+	_, err = config.seccompProfile(&TaskConfig{SeccompProfile: "/tmp/web.json"})
+	require.EqualError(t, err, `seccomp_profile "/tmp/web.json" is not allowed by the client`)
+}
+
+func TestDriver_Config_validateUserNS(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &Config{UserNSHostUID: 100000, UserNSHostGID: 100000, UserNSSize: 65536}
+	require.NoError(t, config.validateUserNS("host", "host", "host"))
+	require.NoError(t, config.validateUserNS("private", "private", "private"))
+	require.EqualError(t, config.validateUserNS("private", "host", "private"),
+		`userns_mode "private" requires private pid_mode and ipc_mode`)
+
+	config.UserNSSize = 0
+	require.EqualError(t, config.validateUserNS("private", "private", "private"),
+		"userns_size must be greater than 0, got 0")
 }
 
 func TestDriver_TaskConfig_validate(t *testing.T) {
@@ -872,4 +1109,27 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("userns_mode", func(t *testing.T) {
+		require.NoError(t, (&TaskConfig{ModeUser: "private"}).validate())
+		require.EqualError(t, (&TaskConfig{ModeUser: "other"}).validate(),
+			`userns_mode must be "private" or "host", got "other"`)
+	})
+
+	t.Run("seccomp_profile", func(t *testing.T) {
+		for _, tc := range []struct {
+			profile string
+			exp     error
+		}{
+			{profile: "", exp: nil},
+			{profile: "default", exp: nil},
+			{profile: "unconfined", exp: nil},
+			{profile: "/etc/nomad/seccomp/web.json", exp: nil},
+			{profile: "web.json", exp: errors.New(`seccomp_profile must be "default", "unconfined" or an absolute path, got "web.json"`)},
+		} {
+			require.Equal(t, tc.exp, (&TaskConfig{
+				SeccompProfile: tc.profile,
+			}).validate())
+		}
+	})
 }
@@ -18,6 +18,13 @@ type taskHandle struct {
 	pluginClient *plugin.Client
 	logger       hclog.Logger
 
+	// imageDir is the directory the task image is mounted under, if any
+	imageDir string
+
+	// imageLayers are the cached layer directories of the mounted image,
+	// which are not evicted while the task runs
+	imageLayers []string
+
 	// stateLock syncs access to all fields below
 	stateLock sync.RWMutex
 
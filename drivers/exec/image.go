@@ -0,0 +1,165 @@
+package exec
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/client/allocdir"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// imageDirName is the directory of the task directory the image root
+// filesystem and the task's changes to it are mounted under.
+const imageDirName = "image"
+
+const (
+	// defaultImageCacheMaxAge is how long unused layers are cached when
+	// image_cache_max_age is not set.
+	defaultImageCacheMaxAge = 72 * time.Hour
+
+	// imageEvictionInterval is how often unused layers are evicted.
+	imageEvictionInterval = 10 * time.Minute
+
+	// imageDefaultUser is the user tasks running an image run as unless the
+	// task sets a user, the UID of nobody.
+	imageDefaultUser = "65534"
+)
+
+// ImageAuth is the registry credentials used to pull a task's image.
+type ImageAuth struct {
+	Username string `codec:"username"`
+	Password string `codec:"password"`
+}
+
+// redacted returns a copy of the task config whose registry credentials are
+// redacted, so it can be logged.
+func (tc TaskConfig) redacted() TaskConfig {
+	if tc.Auth.Username != "" {
+		tc.Auth.Username = "<redacted>"
+	}
+	if tc.Auth.Password != "" {
+		tc.Auth.Password = "<redacted>"
+	}
+	return tc
+}
+
+// mountImage pulls the task's image and mounts it under the task directory,
+// returning the image and the directory it is mounted under.
+func (d *Driver) mountImage(cfg *drivers.TaskConfig, tc *TaskConfig) (*ociimage.Image, string, string, error) {
+	d.eventer.EmitEvent(&drivers.TaskEvent{
+		TaskID:    cfg.ID,
+		AllocID:   cfg.AllocID,
+		TaskName:  cfg.Name,
+		Timestamp: time.Now(),
+		Message:   "Pulling image",
+		Annotations: map[string]string{
+			"image": tc.Image,
+		},
+	})
+
+	var auth *ociimage.Auth
+	if tc.Auth.Username != "" {
+		auth = &ociimage.Auth{Username: tc.Auth.Username, Password: tc.Auth.Password}
+	}
+
+	image, err := d.images.Pull(d.ctx, tc.Image, auth)
+	if err != nil {
+		return nil, "", "", err
+	}
+	d.logger.Debug("pulled image", "image", image.Reference, "digest", image.Digest, "task_name", cfg.Name)
+
+	imageDir := filepath.Join(cfg.TaskDir().Dir, imageDirName)
+	rootfs, err := image.Mount(imageDir)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return image, imageDir, rootfs, nil
+}
+
+// evictImages periodically removes the cached layers that no task uses and
+// that were last pulled longer than maxAge ago, until the driver shuts down.
+func (d *Driver) evictImages(maxAge time.Duration) {
+	ticker := time.NewTicker(imageEvictionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		removed, err := d.images.Evict(maxAge, d.tasks.imageLayers())
+		if err != nil {
+			d.logger.Warn("failed to evict cached image layers", "error", err)
+		}
+		if removed > 0 {
+			d.logger.Debug("evicted cached image layers", "count", removed)
+		}
+	}
+}
+
+// imageMounts returns the mounts exposing the task directories inside the
+// image root filesystem, where they appear at the same paths as in a chroot.
+func imageMounts(cfg *drivers.TaskConfig) []*drivers.MountConfig {
+	taskDir := cfg.TaskDir()
+	return []*drivers.MountConfig{
+		{TaskPath: "/" + allocdir.SharedAllocName, HostPath: taskDir.SharedAllocDir},
+		{TaskPath: "/" + allocdir.TaskLocal, HostPath: taskDir.LocalDir},
+		{TaskPath: "/" + allocdir.TaskSecrets, HostPath: taskDir.SecretsDir},
+		{TaskPath: "/tmp", HostPath: filepath.Join(taskDir.Dir, "tmp")},
+	}
+}
+
+// imageCommand returns the command and arguments of a task running an image.
+// A task command replaces the image entrypoint and command, while task
+// arguments only replace the image command.
+func imageCommand(image *ociimage.Image, tc *TaskConfig) (string, []string) {
+	if tc.Command != "" {
+		return tc.Command, tc.Args
+	}
+
+	argv := append([]string{}, image.Config.Entrypoint...)
+	if len(tc.Args) > 0 {
+		argv = append(argv, tc.Args...)
+	} else {
+		argv = append(argv, image.Config.Cmd...)
+	}
+	if len(argv) == 0 {
+		return "", nil
+	}
+	return argv[0], argv[1:]
+}
+
+// imageEnv merges the image environment with the task environment. Task
+// variables take precedence, except PATH which is kept from the image as the
+// task's PATH describes the client's filesystem.
+func imageEnv(image *ociimage.Image, env []string) []string {
+	merged := make(map[string]string)
+	keys := []string{}
+	set := func(kv string) {
+		k, v, _ := strings.Cut(kv, "=")
+		if _, ok := merged[k]; !ok {
+			keys = append(keys, k)
+		}
+		merged[k] = v
+	}
+
+	for _, kv := range env {
+		set(kv)
+	}
+	for _, kv := range image.Config.Env {
+		k, _, _ := strings.Cut(kv, "=")
+		if _, ok := merged[k]; !ok || k == "PATH" {
+			set(kv)
+		}
+	}
+
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, k+"="+merged[k])
+	}
+	return out
+}
@@ -0,0 +1,233 @@
+package exec
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	ctestutils "github.com/hashicorp/nomad/client/testutil"
+	"github.com/hashicorp/nomad/drivers/shared/executor"
+	"github.com/hashicorp/nomad/drivers/shared/ociimage"
+	ocitest "github.com/hashicorp/nomad/drivers/shared/ociimage/testutil"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	basePlug "github.com/hashicorp/nomad/plugins/base"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
+	"github.com/hashicorp/nomad/testutil"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// imageTestProgram reports the environment it runs in to /alloc/output.txt.
+const imageTestProgram = `package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	wd, _ := os.Getwd()
+	_, err := os.Stat("/local")
+	out := fmt.Sprintf("%s %s %s %d %v", wd, os.Getenv("IMAGE_VAR"), strings.Join(os.Args[1:], ","), os.Getuid(), err == nil)
+	if err := os.WriteFile("/alloc/output.txt", []byte(out), 0644); err != nil {
+		os.Exit(1)
+	}
+}
+`
+
+// buildImageTestProgram builds a static binary of imageTestProgram.
+func buildImageTestProgram(t *testing.T) []byte {
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("Test requires the go toolchain")
+	}
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(src, []byte(imageTestProgram), 0644))
+
+	cmd := exec.Command(goBin, "build", "-o", filepath.Join(dir, "app"), src)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=0", "GO111MODULE=off", "GOFLAGS=")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	bin, err := os.ReadFile(filepath.Join(dir, "app"))
+	require.NoError(t, err)
+	return bin
+}
+
+func TestExecDriver_Image(t *testing.T) {
+	ci.Parallel(t)
+	ctestutils.ExecCompatible(t)
+
+	layoutDir := t.TempDir()
+	// the image user is ignored, so the task runs as nobody
+	ocitest.WriteLayout(t, layoutDir, "v1", ocispec.ImageConfig{
+		User:       "0",
+		Entrypoint: []string{"/app"},
+		Cmd:        []string{"default"},
+		Env:        []string{"PATH=/", "IMAGE_VAR=from-image"},
+		WorkingDir: "/srv",
+	}, ocitest.Layer(t,
+		ocitest.File{Name: "app", Content: string(buildImageTestProgram(t)), Mode: 0755},
+		ocitest.File{Name: "srv/"},
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewExecDriver(ctx, testlog.HCLogger(t))
+	harness := dtestutil.NewDriverHarness(t, d)
+	defer harness.Kill()
+
+	config := &Config{
+		DefaultModePID: executor.IsolationModePrivate,
+		DefaultModeIPC: executor.IsolationModePrivate,
+		ImageCacheDir:  t.TempDir(),
+	}
+	var data []byte
+	require.NoError(t, basePlug.MsgPackEncode(&data, config))
+	require.NoError(t, harness.SetConfig(&basePlug.Config{PluginConfig: data}))
+
+	allocID := uuid.Generate()
+	task := &drivers.TaskConfig{
+		AllocID:   allocID,
+		ID:        uuid.Generate(),
+		Name:      "test",
+		Env:       map[string]string{"PATH": "/usr/bin:/bin"},
+		Resources: testResources(allocID, "test"),
+	}
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	tc := &TaskConfig{
+		Image: "oci:" + layoutDir + ":v1",
+		Args:  []string{"a", "b"},
+	}
+	require.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	handle, _, err := harness.StartTask(task)
+	require.NoError(t, err)
+
+	waitCh, err := harness.WaitTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	select {
+	case res := <-waitCh:
+		require.True(t, res.Successful(), "task should have exited successfully: %v", res)
+	case <-time.After(time.Duration(testutil.TestMultiplier()*10) * time.Second):
+		require.Fail(t, "timeout waiting for task")
+	}
+
+	out, err := os.ReadFile(filepath.Join(task.TaskDir().SharedAllocDir, "output.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "/srv from-image a,b 65534 true", string(out))
+
+	imageDir := filepath.Join(task.TaskDir().Dir, imageDirName)
+	h, ok := d.(*Driver).tasks.Get(handle.Config.ID)
+	require.True(t, ok)
+	require.Equal(t, imageDir, h.imageDir)
+	require.Len(t, h.imageLayers, 1)
+	require.Contains(t, d.(*Driver).tasks.imageLayers(), h.imageLayers[0])
+
+	// the image is unmounted when the task is destroyed
+	require.NoError(t, harness.DestroyTask(task.ID, true))
+	_, err = os.Stat(filepath.Join(imageDir, "rootfs", "app"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestExecDriver_ImageCacheDir_Default(t *testing.T) {
+	ci.Parallel(t)
+
+	layoutDir := t.TempDir()
+	ocitest.WriteLayout(t, layoutDir, "v1", ocispec.ImageConfig{},
+		ocitest.Layer(t, ocitest.File{Name: "app", Content: "app"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := &Config{
+		DefaultModePID: executor.IsolationModePrivate,
+		DefaultModeIPC: executor.IsolationModePrivate,
+	}
+	var data []byte
+	require.NoError(t, basePlug.MsgPackEncode(&data, config))
+
+	// images cannot be run without a data dir to cache them in
+	d := NewExecDriver(ctx, testlog.HCLogger(t)).(*Driver)
+	require.NoError(t, d.SetConfig(&basePlug.Config{PluginConfig: data}))
+	require.Nil(t, d.images)
+
+	// layers are cached under the client data dir by default
+	dataDir := t.TempDir()
+	d = NewExecDriver(ctx, testlog.HCLogger(t)).(*Driver)
+	require.NoError(t, d.SetConfig(&basePlug.Config{
+		PluginConfig: data,
+		AgentConfig: &basePlug.AgentConfig{
+			Driver: &basePlug.ClientDriverConfig{DataDir: dataDir},
+		},
+	}))
+	require.NotNil(t, d.images)
+
+	image, err := d.images.Pull(ctx, "oci:"+layoutDir+":v1", nil)
+	require.NoError(t, err)
+	require.Len(t, image.Layers, 1)
+	require.Equal(t, filepath.Join(dataDir, pluginName, "images"),
+		filepath.Dir(filepath.Dir(filepath.Dir(image.Layers[0]))))
+}
+
+func TestTaskConfig_Redacted(t *testing.T) {
+	ci.Parallel(t)
+
+	tc := TaskConfig{
+		Image: "registry.example.com/app:1.0",
+		Auth:  ImageAuth{Username: "user", Password: "secret"},
+	}
+	redacted := tc.redacted()
+	require.Equal(t, tc.Image, redacted.Image)
+	require.Equal(t, ImageAuth{Username: "<redacted>", Password: "<redacted>"}, redacted.Auth)
+	require.Equal(t, "secret", tc.Auth.Password)
+
+	require.Empty(t, TaskConfig{}.redacted().Auth)
+}
+
+func TestImageCommand(t *testing.T) {
+	ci.Parallel(t)
+
+	image := &ociimage.Image{Config: ocispec.ImageConfig{
+		Entrypoint: []string{"/entrypoint.sh", "-v"},
+		Cmd:        []string{"serve"},
+	}}
+
+	cmd, args := imageCommand(image, &TaskConfig{})
+	require.Equal(t, "/entrypoint.sh", cmd)
+	require.Equal(t, []string{"-v", "serve"}, args)
+
+	cmd, args = imageCommand(image, &TaskConfig{Args: []string{"migrate"}})
+	require.Equal(t, "/entrypoint.sh", cmd)
+	require.Equal(t, []string{"-v", "migrate"}, args)
+
+	cmd, args = imageCommand(image, &TaskConfig{Command: "/bin/sh", Args: []string{"-c", "true"}})
+	require.Equal(t, "/bin/sh", cmd)
+	require.Equal(t, []string{"-c", "true"}, args)
+
+	cmd, _ = imageCommand(&ociimage.Image{}, &TaskConfig{})
+	require.Empty(t, cmd)
+}
+
+func TestImageEnv(t *testing.T) {
+	ci.Parallel(t)
+
+	image := &ociimage.Image{Config: ocispec.ImageConfig{
+		Env: []string{"PATH=/opt/app/bin", "LANG=C.UTF-8", "MODE=image"},
+	}}
+	env := imageEnv(image, []string{"MODE=task", "NOMAD_TASK_NAME=web", "PATH=/usr/bin"})
+	require.Equal(t, []string{"MODE=task", "NOMAD_TASK_NAME=web", "PATH=/opt/app/bin", "LANG=C.UTF-8"}, env)
+}
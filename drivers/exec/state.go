@@ -31,3 +31,17 @@ func (ts *taskStore) Delete(id string) {
 	defer ts.lock.Unlock()
 	delete(ts.store, id)
 }
+
+// imageLayers returns the cached layer directories of the images mounted by
+// the tasks in the store.
+func (ts *taskStore) imageLayers() map[string]struct{} {
+	ts.lock.RLock()
+	defer ts.lock.RUnlock()
+	layers := make(map[string]struct{})
+	for _, h := range ts.store {
+		for _, layer := range h.imageLayers {
+			layers[layer] = struct{}{}
+		}
+	}
+	return layers
+}
@@ -2,11 +2,14 @@ package java
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/nomad/client/lib/cgutil"
@@ -14,15 +17,19 @@ import (
 
 	"github.com/hashicorp/consul-template/signals"
 	hclog "github.com/hashicorp/go-hclog"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
 	"github.com/hashicorp/nomad/drivers/shared/resolvconf"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclspecutils"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
 	"github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	"github.com/hashicorp/nomad/plugins/drivers/utils"
 	"github.com/hashicorp/nomad/plugins/shared/hclspec"
 	pstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/zclconf/go-cty/cty"
 )
 
 const (
@@ -36,6 +43,11 @@ const (
 	driverAttr        = "driver.java"
 	driverVersionAttr = "driver.java.version"
 
+	// The keys populated in Node Attributes for each JDK found on the client,
+	// formatted with its major version
+	driverJDKPathAttrFmt    = "driver.java.%s.path"
+	driverJDKVersionAttrFmt = "driver.java.%s.version"
+
 	// taskHandleVersion is the version of task handle which this driver sets
 	// and understands how to decode driver state
 	taskHandleVersion = 1
@@ -78,6 +90,7 @@ var (
 			hclspec.NewAttr("allow_caps", "list(string)", false),
 			hclspec.NewLiteral(capabilities.HCLSpecLiteral),
 		),
+		"jdk_search_paths": hclspec.NewAttr("jdk_search_paths", "list(string)", false),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
@@ -95,6 +108,17 @@ var (
 		"ipc_mode":    hclspec.NewAttr("ipc_mode", "string", false),
 		"cap_add":     hclspec.NewAttr("cap_add", "list(string)", false),
 		"cap_drop":    hclspec.NewAttr("cap_drop", "list(string)", false),
+		"jdk_version": hclspec.NewAttr("jdk_version", "string", false),
+		"jvm_memory": hclspec.NewBlock("jvm_memory", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"heap_percent": hclspec.NewDefault(
+				hclspec.NewAttr("heap_percent", "number", false),
+				hclspec.NewLiteral("75"),
+			),
+			"metaspace_percent": hclspec.NewDefault(
+				hclspec.NewAttr("metaspace_percent", "number", false),
+				hclspec.NewLiteral("10"),
+			),
+		})),
 	})
 
 	// driverCapabilities is returned by the Capabilities RPC and indicates what
@@ -117,6 +141,7 @@ func init() {
 	if runtime.GOOS == "linux" {
 		driverCapabilities.FSIsolation = drivers.FSIsolationChroot
 		driverCapabilities.MountConfigs = drivers.MountConfigSupportAll
+		driverCapabilities.Pause = true
 	}
 }
 
@@ -133,6 +158,10 @@ type Config struct {
 	// AllowCaps configures which Linux Capabilities are enabled for tasks
 	// running on this node.
 	AllowCaps []string `codec:"allow_caps"`
+
+	// JDKSearchPaths are the directories searched for installed JDKs, in
+	// addition to the java binary on the PATH.
+	JDKSearchPaths []string `codec:"jdk_search_paths"`
 }
 
 func (c *Config) validate() error {
@@ -186,6 +215,13 @@ type TaskConfig struct {
 
 	// CapDrop is a set of linux capabilities to disable.
 	CapDrop []string `codec:"cap_drop"`
+
+	// JDKVersion is the major version of the JDK to run the task with. If
+	// unset the java binary on the PATH is used.
+	JDKVersion string `codec:"jdk_version"`
+
+	// JVMMemory derives the JVM memory flags from the task resources.
+	JVMMemory *JVMMemory `codec:"jvm_memory"`
 }
 
 func (tc *TaskConfig) validate() error {
@@ -212,9 +248,45 @@ func (tc *TaskConfig) validate() error {
 		return fmt.Errorf("cap_drop configured with capabilities not supported by system: %s", badDrops)
 	}
 
+	if tc.JDKVersion != "" && !jdkVersionRe.MatchString(tc.JDKVersion) {
+		return fmt.Errorf("jdk_version must be a major version such as \"17\", got %q", tc.JDKVersion)
+	}
+
+	if tc.JVMMemory != nil {
+		if err := tc.JVMMemory.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// JDKVersion returns the jdk_version of the given java task driver config,
+// decoded the same way as when the task is started after interpolating it
+// with the given variables.
+func JDKVersion(config map[string]interface{}, vars map[string]cty.Value) (string, error) {
+	spec, diag := hclspecutils.Convert(taskConfigSpec)
+	if diag.HasErrors() {
+		return "", diag
+	}
+
+	val, diag, diagErrs := hclutils.ParseHclInterface(config, spec, vars)
+	if diag.HasErrors() {
+		return "", multierror.Append(errors.New("failed to parse config: "), diagErrs...)
+	}
+
+	var taskConfig drivers.TaskConfig
+	if err := taskConfig.EncodeDriverConfig(val); err != nil {
+		return "", fmt.Errorf("failed to encode driver config: %v", err)
+	}
+
+	var driverConfig TaskConfig
+	if err := taskConfig.DecodeDriverConfig(&driverConfig); err != nil {
+		return "", fmt.Errorf("failed to decode driver config: %v", err)
+	}
+	return driverConfig.JDKVersion, nil
+}
+
 // TaskState is the state which is encoded in the handle returned in
 // StartTask. This information is needed to rebuild the taskConfig state and handler
 // during recovery.
@@ -246,6 +318,11 @@ type Driver struct {
 
 	// logger will log to the Nomad agent
 	logger hclog.Logger
+
+	// jdks are the JDKs found on the client keyed by major version, as of
+	// the last fingerprint
+	jdks     map[string]*jdk
+	jdksLock sync.RWMutex
 }
 
 func NewDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugin {
@@ -370,9 +447,53 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 	fp.Attributes["driver.java.runtime"] = pstructs.NewStringAttribute(jdkJRE)
 	fp.Attributes["driver.java.vm"] = pstructs.NewStringAttribute(vm)
 
+	for major, jdk := range d.discoverJDKs() {
+		fp.Attributes[fmt.Sprintf(driverJDKPathAttrFmt, major)] = pstructs.NewStringAttribute(jdk.Path)
+		fp.Attributes[fmt.Sprintf(driverJDKVersionAttrFmt, major)] = pstructs.NewStringAttribute(jdk.Version)
+	}
+
 	return fp
 }
 
+// discoverJDKs searches the client for installed JDKs and records them for
+// task placement.
+func (d *Driver) discoverJDKs() map[string]*jdk {
+	searchPaths := d.config.JDKSearchPaths
+	if searchPaths == nil {
+		searchPaths = defaultJDKSearchPaths(runtime.GOOS)
+	}
+	jdks := discoverJDKs(searchPaths)
+
+	d.jdksLock.Lock()
+	d.jdks = jdks
+	d.jdksLock.Unlock()
+	return jdks
+}
+
+// javaBinary returns the java binary of the given major version, or of the
+// java on the PATH if unset.
+func (d *Driver) javaBinary(version string) (string, error) {
+	if version == "" {
+		absPath, err := GetAbsolutePath("java")
+		if err != nil {
+			return "", fmt.Errorf("failed to find java binary: %s", err)
+		}
+		return absPath, nil
+	}
+
+	d.jdksLock.RLock()
+	jdk, ok := d.jdks[version]
+	d.jdksLock.RUnlock()
+	if !ok {
+		// the JDK may have been installed since the last fingerprint
+		jdk, ok = d.discoverJDKs()[version]
+	}
+	if !ok {
+		return "", fmt.Errorf("JDK version %s not found", version)
+	}
+	return jdk.Path, nil
+}
+
 func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 	if handle == nil {
 		return fmt.Errorf("handle cannot be nil")
@@ -441,14 +562,25 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		return nil, nil, fmt.Errorf("jar_path or class must be specified")
 	}
 
-	absPath, err := GetAbsolutePath("java")
+	absPath, err := d.javaBinary(driverConfig.JDKVersion)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to find java binary: %s", err)
+		return nil, nil, err
 	}
 
-	args := javaCmdArgs(driverConfig)
+	args := append(jvmMemoryOptions(driverConfig, cfg.Resources), javaCmdArgs(driverConfig)...)
 
+	command := strings.Join(append([]string{absPath}, args...), " ")
 	d.logger.Info("starting java task", "driver_cfg", hclog.Fmt("%+v", driverConfig), "args", args)
+	d.eventer.EmitEvent(&drivers.TaskEvent{
+		TaskID:    cfg.ID,
+		AllocID:   cfg.AllocID,
+		TaskName:  cfg.Name,
+		Timestamp: time.Now(),
+		Message:   fmt.Sprintf("Starting JVM: %s", command),
+		Annotations: map[string]string{
+			"command": command,
+		},
+	})
 
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
@@ -668,6 +800,26 @@ func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, err
 	return d.eventer.TaskEvents(ctx)
 }
 
+// PauseTask freezes the processes of the task.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the processes of a paused task.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Resume()
+}
+
 func (d *Driver) SignalTask(taskID string, signal string) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -22,6 +22,7 @@ import (
 	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
 	"github.com/hashicorp/nomad/testutil"
 	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func javaCompatible(t *testing.T) {
@@ -340,14 +341,24 @@ config {
   jar_path = "/tmp/jar.jar"
   jvm_options = ["-Xmx600"]
   args = ["arg1", "arg2"]
+  jdk_version = "17"
+
+  jvm_memory {
+    heap_percent = 60
+  }
 }`
 
 	expected := &TaskConfig{
-		Class:     "java.main",
-		ClassPath: "/tmp/cp",
-		JarPath:   "/tmp/jar.jar",
-		JvmOpts:   []string{"-Xmx600"},
-		Args:      []string{"arg1", "arg2"},
+		Class:      "java.main",
+		ClassPath:  "/tmp/cp",
+		JarPath:    "/tmp/jar.jar",
+		JvmOpts:    []string{"-Xmx600"},
+		Args:       []string{"arg1", "arg2"},
+		JDKVersion: "17",
+		JVMMemory: &JVMMemory{
+			HeapPercent:      60,
+			MetaspacePercent: 10,
+		},
 	}
 
 	var tc *TaskConfig
@@ -512,4 +523,90 @@ func TestDriver_TaskConfig_validate(t *testing.T) {
 			}).validate())
 		}
 	})
+
+	t.Run("jdk_version", func(t *testing.T) {
+		for _, tc := range []struct {
+			version string
+			exp     error
+		}{
+			{version: "", exp: nil},
+			{version: "8", exp: nil},
+			{version: "17", exp: nil},
+			{version: "1.8", exp: errors.New(`jdk_version must be a major version such as "17", got "1.8"`)},
+			{version: "latest", exp: errors.New(`jdk_version must be a major version such as "17", got "latest"`)},
+		} {
+			require.Equal(t, tc.exp, (&TaskConfig{
+				JDKVersion: tc.version,
+			}).validate())
+		}
+	})
+}
+
+func TestJDKVersion(t *testing.T) {
+	ci.Parallel(t)
+
+	vars := map[string]cty.Value{
+		"NOMAD_META_jdk": cty.StringVal("21"),
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected string
+		err      bool
+	}{
+		{
+			name:   "unset",
+			config: map[string]interface{}{"class": "Hello"},
+		},
+		{
+			name:     "string",
+			config:   map[string]interface{}{"class": "Hello", "jdk_version": "17"},
+			expected: "17",
+		},
+		{
+			name:     "number",
+			config:   map[string]interface{}{"class": "Hello", "jdk_version": 11},
+			expected: "11",
+		},
+		{
+			name:     "interpolated",
+			config:   map[string]interface{}{"class": "Hello", "jdk_version": "${NOMAD_META_jdk}"},
+			expected: "21",
+		},
+		{
+			name:   "unknown variable",
+			config: map[string]interface{}{"class": "Hello", "jdk_version": "${attr.jdk}"},
+			err:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			version, err := JDKVersion(c.config, vars)
+			if c.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, version)
+		})
+	}
+}
+
+func TestDriver_javaBinary(t *testing.T) {
+	ci.Parallel(t)
+
+	d := NewDriver(context.Background(), testlog.HCLogger(t)).(*Driver)
+	d.config.JDKSearchPaths = []string{t.TempDir()}
+	d.jdks = map[string]*jdk{
+		"17": {Path: "/usr/lib/jvm/java-17/bin/java", Version: "17.0.2", Major: "17"},
+	}
+
+	path, err := d.javaBinary("17")
+	require.NoError(t, err)
+	require.Equal(t, "/usr/lib/jvm/java-17/bin/java", path)
+
+	_, err = d.javaBinary("99")
+	require.EqualError(t, err, "JDK version 99 not found")
 }
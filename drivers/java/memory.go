@@ -0,0 +1,88 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// JVMMemory configures the JVM memory flags derived from the memory
+// resources of the task.
+type JVMMemory struct {
+	// HeapPercent is the share of the task memory limit used for the
+	// maximum heap size, and of the memory reservation for the initial heap
+	// size.
+	HeapPercent int `codec:"heap_percent"`
+
+	// MetaspacePercent is the share of the task memory limit used for the
+	// maximum metaspace size. Zero leaves metaspace unbounded.
+	MetaspacePercent int `codec:"metaspace_percent"`
+}
+
+func (m *JVMMemory) validate() error {
+	if m.HeapPercent < 1 || m.HeapPercent > 100 {
+		return fmt.Errorf("jvm_memory heap_percent must be between 1 and 100, got %d", m.HeapPercent)
+	}
+	if m.MetaspacePercent < 0 || m.MetaspacePercent > 100 {
+		return fmt.Errorf("jvm_memory metaspace_percent must be between 0 and 100, got %d", m.MetaspacePercent)
+	}
+	if m.HeapPercent+m.MetaspacePercent > 100 {
+		return fmt.Errorf("jvm_memory heap_percent and metaspace_percent must not exceed 100 combined")
+	}
+	return nil
+}
+
+// jvmMemoryOptions returns the heap and metaspace flags derived from the
+// task resources. The memory limit is memory_max when oversubscription is in
+// use and memory otherwise. Flags already set in jvm_options are not
+// overridden.
+func jvmMemoryOptions(tc TaskConfig, resources *drivers.Resources) []string {
+	if tc.JVMMemory == nil || resources == nil || resources.NomadResources == nil {
+		return nil
+	}
+
+	reserved := resources.NomadResources.Memory.MemoryMB
+	limit := resources.NomadResources.Memory.MemoryMaxMB
+	if limit < reserved {
+		limit = reserved
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	var opts []string
+	percentOf := func(mb int64, percent int) int64 {
+		return mb * int64(percent) / 100
+	}
+
+	if !hasJVMOption(tc.JvmOpts, "-Xmx", "-XX:MaxHeapSize=", "-XX:MaxRAMPercentage=") {
+		if heap := percentOf(limit, tc.JVMMemory.HeapPercent); heap > 0 {
+			opts = append(opts, fmt.Sprintf("-Xmx%dm", heap))
+		}
+	}
+	if !hasJVMOption(tc.JvmOpts, "-Xms", "-XX:InitialHeapSize=", "-XX:InitialRAMPercentage=") {
+		if heap := percentOf(reserved, tc.JVMMemory.HeapPercent); heap > 0 {
+			opts = append(opts, fmt.Sprintf("-Xms%dm", heap))
+		}
+	}
+	if tc.JVMMemory.MetaspacePercent > 0 && !hasJVMOption(tc.JvmOpts, "-XX:MaxMetaspaceSize=") {
+		if metaspace := percentOf(limit, tc.JVMMemory.MetaspacePercent); metaspace > 0 {
+			opts = append(opts, fmt.Sprintf("-XX:MaxMetaspaceSize=%dm", metaspace))
+		}
+	}
+	return opts
+}
+
+// hasJVMOption returns whether any of the options starts with one of the
+// prefixes.
+func hasJVMOption(opts []string, prefixes ...string) bool {
+	for _, opt := range opts {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(opt, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
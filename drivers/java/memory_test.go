@@ -0,0 +1,91 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJVMMemoryOptions(t *testing.T) {
+	ci.Parallel(t)
+
+	resources := func(memory, memoryMax int64) *drivers.Resources {
+		return &drivers.Resources{
+			NomadResources: &structs.AllocatedTaskResources{
+				Memory: structs.AllocatedMemoryResources{
+					MemoryMB:    memory,
+					MemoryMaxMB: memoryMax,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name      string
+		cfg       TaskConfig
+		resources *drivers.Resources
+		expected  []string
+	}{
+		{
+			name:      "disabled",
+			cfg:       TaskConfig{},
+			resources: resources(1024, 0),
+			expected:  nil,
+		},
+		{
+			name:      "memory",
+			cfg:       TaskConfig{JVMMemory: &JVMMemory{HeapPercent: 75, MetaspacePercent: 10}},
+			resources: resources(1024, 0),
+			expected:  []string{"-Xmx768m", "-Xms768m", "-XX:MaxMetaspaceSize=102m"},
+		},
+		{
+			name:      "memory_max",
+			cfg:       TaskConfig{JVMMemory: &JVMMemory{HeapPercent: 50, MetaspacePercent: 10}},
+			resources: resources(1024, 2048),
+			expected:  []string{"-Xmx1024m", "-Xms512m", "-XX:MaxMetaspaceSize=204m"},
+		},
+		{
+			name:      "no_metaspace",
+			cfg:       TaskConfig{JVMMemory: &JVMMemory{HeapPercent: 75}},
+			resources: resources(1024, 0),
+			expected:  []string{"-Xmx768m", "-Xms768m"},
+		},
+		{
+			name: "jvm_options_take_precedence",
+			cfg: TaskConfig{
+				JvmOpts:   []string{"-XX:MaxRAMPercentage=60", "-XX:MaxMetaspaceSize=64m"},
+				JVMMemory: &JVMMemory{HeapPercent: 75, MetaspacePercent: 10},
+			},
+			resources: resources(1024, 0),
+			expected:  []string{"-Xms768m"},
+		},
+		{
+			name:      "no_resources",
+			cfg:       TaskConfig{JVMMemory: &JVMMemory{HeapPercent: 75, MetaspacePercent: 10}},
+			resources: nil,
+			expected:  nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.expected, jvmMemoryOptions(c.cfg, c.resources))
+		})
+	}
+}
+
+func TestJVMMemory_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	require.NoError(t, (&JVMMemory{HeapPercent: 75, MetaspacePercent: 10}).validate())
+	require.NoError(t, (&JVMMemory{HeapPercent: 100}).validate())
+	require.EqualError(t, (&JVMMemory{HeapPercent: 0}).validate(),
+		"jvm_memory heap_percent must be between 1 and 100, got 0")
+	require.EqualError(t, (&JVMMemory{HeapPercent: 75, MetaspacePercent: -1}).validate(),
+		"jvm_memory metaspace_percent must be between 0 and 100, got -1")
+	require.EqualError(t, (&JVMMemory{HeapPercent: 95, MetaspacePercent: 10}).validate(),
+		"jvm_memory heap_percent and metaspace_percent must not exceed 100 combined")
+}
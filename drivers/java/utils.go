@@ -3,7 +3,9 @@ package java
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	rt "runtime"
 	"strings"
@@ -75,3 +77,104 @@ func parseJavaVersionOutput(infoString string) (version, runtime, vm string) {
 
 	return versionString, strings.TrimSpace(lines[1]), strings.TrimSpace(lines[2])
 }
+
+// jdk is a Java installation found on the client.
+type jdk struct {
+	// Path is the resolved path of the java binary.
+	Path string
+
+	// Version is the full version reported by the binary, e.g. 17.0.2 or
+	// 1.8.0_292.
+	Version string
+
+	// Major is the feature release of Version, e.g. 17 or 8.
+	Major string
+}
+
+var (
+	jdkMajorVersionRe = regexp.MustCompile(`^(?:1\.)?(\d+)`)
+	jdkVersionRe      = regexp.MustCompile(`^\d+$`)
+)
+
+// javaMajorVersion returns the feature release of a java version string,
+// handling both the legacy 1.x scheme and the scheme introduced in Java 9.
+func javaMajorVersion(version string) string {
+	if match := jdkMajorVersionRe.FindStringSubmatch(version); len(match) == 2 {
+		return match[1]
+	}
+	return ""
+}
+
+// defaultJDKSearchPaths returns the directories that package managers
+// install JDKs into on the given operating system.
+func defaultJDKSearchPaths(goos string) []string {
+	switch goos {
+	case "darwin":
+		return []string{"/Library/Java/JavaVirtualMachines"}
+	case "windows":
+		return nil
+	default:
+		return []string{"/usr/lib/jvm", "/usr/java"}
+	}
+}
+
+// jdkCandidates returns the java binaries of the JDKs installed in the given
+// directories. Each directory entry is expected to be a JDK home, or a macOS
+// bundle with the home under Contents/Home.
+func jdkCandidates(searchPaths []string) []string {
+	var bins []string
+	for _, dir := range searchPaths {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			for _, home := range []string{
+				filepath.Join(dir, entry.Name()),
+				filepath.Join(dir, entry.Name(), "Contents", "Home"),
+			} {
+				bin := filepath.Join(home, "bin", "java")
+				if info, err := os.Stat(bin); err == nil && !info.IsDir() {
+					bins = append(bins, bin)
+					break
+				}
+			}
+		}
+	}
+	return bins
+}
+
+// discoverJDKs returns the JDKs installed on the client keyed by major
+// version. The java binary on the PATH takes precedence, followed by the
+// JDKs in the search paths in order.
+func discoverJDKs(searchPaths []string) map[string]*jdk {
+	var bins []string
+	if bin, err := exec.LookPath("java"); err == nil {
+		bins = append(bins, bin)
+	}
+	bins = append(bins, jdkCandidates(searchPaths)...)
+
+	jdks := make(map[string]*jdk)
+	seen := make(map[string]bool)
+	for _, bin := range bins {
+		path, err := filepath.EvalSymlinks(bin)
+		if err != nil || seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		out, err := exec.Command(path, "-version").CombinedOutput()
+		if err != nil {
+			continue
+		}
+		version, _, _ := parseJavaVersionOutput(string(out))
+		major := javaMajorVersion(version)
+		if major == "" {
+			continue
+		}
+		if _, ok := jdks[major]; !ok {
+			jdks[major] = &jdk{Path: path, Version: version, Major: major}
+		}
+	}
+	return jdks
+}
@@ -2,6 +2,8 @@ package java
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -173,3 +175,56 @@ func TestDriver_javaVersionInfo_JavaVersionFails(t *testing.T) {
 	require.Equal(t, "", jdkJRE)
 	require.Equal(t, "", vm)
 }
+
+func TestDriver_javaMajorVersion(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := map[string]string{
+		"1.7.0_80":  "7",
+		"1.8.0_292": "8",
+		"9":         "9",
+		"11.0.14.1": "11",
+		"17.0.2":    "17",
+		"":          "",
+		"unknown":   "",
+	}
+	for version, major := range cases {
+		require.Equal(t, major, javaMajorVersion(version), version)
+	}
+}
+
+func TestDriver_discoverJDKs(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires sh to run")
+	}
+
+	fakeJDK := func(home, output string) {
+		bin := filepath.Join(home, "bin")
+		require.NoError(t, os.MkdirAll(bin, 0755))
+		script := fmt.Sprintf("#!/bin/sh\nprintf '%%s\\n' '%s' >&2\n", output)
+		require.NoError(t, os.WriteFile(filepath.Join(bin, "java"), []byte(script), 0755))
+	}
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	fakeJDK(filepath.Join(dir, "jdk-13"), `openjdk version "13.0.2" 2020-01-14
+OpenJDK Runtime Environment (build 13.0.2+8)
+OpenJDK 64-Bit Server VM (build 13.0.2+8, mixed mode)`)
+	fakeJDK(filepath.Join(dir, "jdk-1.6", "Contents", "Home"), `java version "1.6.0_45"
+Java(TM) SE Runtime Environment (build 1.6.0_45-b06)
+Java HotSpot(TM) 64-Bit Server VM (build 20.45-b01, mixed mode)`)
+	fakeJDK(filepath.Join(dir, "broken"), "unexpected output")
+	require.NoError(t, os.Symlink(filepath.Join(dir, "jdk-13"), filepath.Join(dir, "default-java")))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "empty"), 0755))
+
+	jdks := discoverJDKs([]string{dir, filepath.Join(dir, "missing")})
+
+	require.Contains(t, jdks, "13")
+	require.Equal(t, "13.0.2", jdks["13"].Version)
+	require.Equal(t, filepath.Join(dir, "jdk-13", "bin", "java"), jdks["13"].Path)
+
+	require.Contains(t, jdks, "6")
+	require.Equal(t, "1.6.0_45", jdks["6"].Version)
+	require.Equal(t, filepath.Join(dir, "jdk-1.6", "Contents", "Home", "bin", "java"), jdks["6"].Path)
+}
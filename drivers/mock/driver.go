@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -106,6 +107,27 @@ var (
 			"stderr_repeat":          hclspec.NewAttr("stderr_repeat", "number", false),
 			"stderr_repeat_duration": hclspec.NewAttr("stderr_repeat_duration", "string", false),
 		})),
+
+		"timeline": hclspec.NewBlockList("timeline", hclspec.NewObject(map[string]*hclspec.Spec{
+			"attempt": hclspec.NewAttr("attempt", "number", false),
+			"event": hclspec.NewBlockList("event", hclspec.NewObject(map[string]*hclspec.Spec{
+				"after":       hclspec.NewAttr("after", "string", false),
+				"type":        hclspec.NewAttr("type", "string", true),
+				"message":     hclspec.NewAttr("message", "string", false),
+				"exit_code":   hclspec.NewAttr("exit_code", "number", false),
+				"exit_signal": hclspec.NewAttr("exit_signal", "number", false),
+				"memory_rss":  hclspec.NewAttr("memory_rss", "number", false),
+				"cpu_percent": hclspec.NewAttr("cpu_percent", "number", false),
+			})),
+		})),
+		"on_signal": hclspec.NewBlockList("on_signal", hclspec.NewObject(map[string]*hclspec.Spec{
+			"signal":    hclspec.NewAttr("signal", "string", true),
+			"message":   hclspec.NewAttr("message", "string", false),
+			"error":     hclspec.NewAttr("error", "string", false),
+			"exit":      hclspec.NewAttr("exit", "bool", false),
+			"exit_code": hclspec.NewAttr("exit_code", "number", false),
+		})),
+		"replay": hclspec.NewAttr("replay", "string", false),
 	})
 )
 
@@ -140,6 +162,11 @@ type Driver struct {
 	// lastMu guards access to last[Driver]TaskConfig
 	lastMu sync.Mutex
 
+	// attempts counts the starts of each task, keyed by allocation ID and
+	// task name, to select the timeline of the task
+	attempts     map[string]int
+	attemptsLock sync.Mutex
+
 	// logger will log to the Nomad agent
 	logger hclog.Logger
 }
@@ -151,6 +178,7 @@ func NewMockDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugi
 	capabilities := &drivers.Capabilities{
 		SendSignals:  true,
 		Exec:         true,
+		Pause:        true,
 		FSIsolation:  drivers.FSIsolationNone,
 		MountConfigs: drivers.MountConfigSupportNone,
 	}
@@ -160,6 +188,7 @@ func NewMockDriver(ctx context.Context, logger hclog.Logger) drivers.DriverPlugi
 		capabilities: capabilities,
 		config:       &Config{},
 		tasks:        newTaskStore(),
+		attempts:     map[string]int{},
 		ctx:          ctx,
 		logger:       logger,
 	}
@@ -259,10 +288,26 @@ type TaskConfig struct {
 	// DriverPortMap will parse a label:number pair and return it in
 	// DriverNetwork.PortMap from Start().
 	DriverPortMap string `codec:"driver_port_map"`
+
+	// Timeline scripts the behaviour of the task instead of the command
+	// knobs, optionally per attempt of the task.
+	Timeline []*Timeline `codec:"timeline"`
+
+	// SignalReactions script how a task running a timeline reacts to
+	// signals.
+	SignalReactions []*SignalReaction `codec:"on_signal"`
+
+	// Replay is the path of a JSON file of recorded task events, as returned
+	// by the API, from which the timelines of the task are built. Relative
+	// paths are relative to the task directory.
+	Replay string `codec:"replay"`
 }
 
 type MockTaskState struct {
 	StartedAt time.Time
+
+	// Attempt is the start of the task the handle belongs to
+	Attempt int
 }
 
 func (d *Driver) PluginInfo() (*base.PluginInfoResponse, error) {
@@ -370,6 +415,13 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 
 	h := newTaskHandle(handle.Config, driverCfg, d.logger)
 	h.Recovered = true
+	if taskState.Attempt > 0 {
+		if err := d.setTimeline(h, driverCfg, taskState.Attempt); err != nil {
+			return fmt.Errorf("failed to set timeline: %v", err)
+		}
+		h.timelineElapsed = now.Sub(taskState.StartedAt)
+		d.recoverAttempt(handle.Config, taskState.Attempt)
+	}
 	d.tasks.Set(handle.Config.ID, h)
 	go h.run()
 	return nil
@@ -417,15 +469,80 @@ func parseDriverConfig(cfg *drivers.TaskConfig) (*TaskConfig, error) {
 		}
 	}
 
+	if len(driverConfig.Timeline) > 0 && driverConfig.Replay != "" {
+		return nil, fmt.Errorf("timeline and replay are mutually exclusive")
+	}
+	for _, t := range driverConfig.Timeline {
+		if err = t.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &driverConfig, nil
 }
 
+// timelines returns the timelines of the task, loading them from the replay
+// file if set.
+func (c *TaskConfig) timelines(cfg *drivers.TaskConfig) ([]*Timeline, error) {
+	if c.Replay == "" {
+		return c.Timeline, nil
+	}
+
+	path := c.Replay
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(cfg.TaskDir().Dir, path)
+	}
+	return loadReplay(path)
+}
+
+// nextAttempt returns the attempt of a task being started.
+func (d *Driver) nextAttempt(cfg *drivers.TaskConfig) int {
+	d.attemptsLock.Lock()
+	defer d.attemptsLock.Unlock()
+	key := cfg.AllocID + "/" + cfg.Name
+	d.attempts[key]++
+	return d.attempts[key]
+}
+
+// recoverAttempt ensures attempts of a recovered task continue to be counted
+// from its attempt.
+func (d *Driver) recoverAttempt(cfg *drivers.TaskConfig, attempt int) {
+	d.attemptsLock.Lock()
+	defer d.attemptsLock.Unlock()
+	key := cfg.AllocID + "/" + cfg.Name
+	if d.attempts[key] < attempt {
+		d.attempts[key] = attempt
+	}
+}
+
+// setTimeline sets the timeline of the attempt on the task handle.
+func (d *Driver) setTimeline(h *taskHandle, driverConfig *TaskConfig, attempt int) error {
+	timelines, err := driverConfig.timelines(h.taskConfig)
+	if err != nil {
+		return err
+	}
+
+	h.timeline = selectTimeline(timelines, attempt)
+	h.emitEvent = func(message string) {
+		d.eventer.EmitEvent(&drivers.TaskEvent{
+			TaskID:    h.taskConfig.ID,
+			TaskName:  h.taskConfig.Name,
+			AllocID:   h.taskConfig.AllocID,
+			Timestamp: time.Now(),
+			Message:   message,
+		})
+	}
+	return nil
+}
+
 func newTaskHandle(cfg *drivers.TaskConfig, driverConfig *TaskConfig, logger hclog.Logger) *taskHandle {
 	killCtx, killCancel := context.WithCancel(context.Background())
 	h := &taskHandle{
 		taskConfig:      cfg,
 		command:         driverConfig.Command,
 		execCommand:     driverConfig.ExecCommand,
+		signalReactions: driverConfig.SignalReactions,
+		signalCh:        make(chan *SignalReaction, 1),
 		pluginExitAfter: driverConfig.pluginExitAfterDuration,
 		killAfter:       driverConfig.killAfterDuration,
 		logger:          logger.With("task_name", cfg.Name),
@@ -475,8 +592,14 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	}
 
 	h := newTaskHandle(cfg, driverConfig, d.logger)
+	attempt := d.nextAttempt(cfg)
+	if err := d.setTimeline(h, driverConfig, attempt); err != nil {
+		return nil, nil, err
+	}
+
 	driverState := MockTaskState{
 		StartedAt: h.startedAt,
+		Attempt:   attempt,
 	}
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
@@ -524,6 +647,7 @@ func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) e
 	}
 
 	d.logger.Debug("killing task", "task_name", h.taskConfig.Name, "kill_after", h.killAfter)
+	h.signal(signal)
 
 	select {
 	case <-h.waitCh:
@@ -560,17 +684,23 @@ func (d *Driver) InspectTask(taskID string) (*drivers.TaskStatus, error) {
 }
 
 func (d *Driver) TaskStats(ctx context.Context, taskID string, interval time.Duration) (<-chan *drivers.TaskResourceUsage, error) {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return nil, drivers.ErrTaskNotFound
+	}
+
 	ch := make(chan *drivers.TaskResourceUsage)
-	go d.handleStats(ctx, ch)
+	go d.handleStats(ctx, h, interval, ch)
 	return ch, nil
 }
 
-func (d *Driver) handleStats(ctx context.Context, ch chan<- *drivers.TaskResourceUsage) {
+func (d *Driver) handleStats(ctx context.Context, h *taskHandle, interval time.Duration, ch chan<- *drivers.TaskResourceUsage) {
 	timer := time.NewTimer(0)
 	for {
 		select {
 		case <-timer.C:
-			// Generate random value for the memory usage
+			// Report the sample set by the timeline, or generate random value
+			// for the memory usage
 			s := &drivers.TaskResourceUsage{
 				ResourceUsage: &drivers.ResourceUsage{
 					MemoryStats: &drivers.MemoryStats{
@@ -580,6 +710,16 @@ func (d *Driver) handleStats(ctx context.Context, ch chan<- *drivers.TaskResourc
 				},
 				Timestamp: time.Now().UTC().UnixNano(),
 			}
+			if sample := h.getStatsSample(); sample != nil {
+				s.ResourceUsage.MemoryStats.RSS = sample.rss
+				s.ResourceUsage.CpuStats = &drivers.CpuStats{
+					Percent:  sample.cpuPercent,
+					Measured: []string{"Percent"},
+				}
+			}
+			if h.timeline != nil {
+				timer.Reset(interval)
+			}
 			select {
 			case <-ctx.Done():
 				return
@@ -602,11 +742,32 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 		return drivers.ErrTaskNotFound
 	}
 
-	if h.command.SignalErr == "" {
-		return nil
+	if h.command.SignalErr != "" {
+		return errors.New(h.command.SignalErr)
+	}
+
+	if r := findSignalReaction(h.signalReactions, signal); r != nil && r.Error != "" {
+		return errors.New(r.Error)
+	}
+
+	h.signal(signal)
+	return nil
+}
+
+func (d *Driver) PauseTask(taskID string) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
 	}
+	return h.setPaused(true)
+}
 
-	return errors.New(h.command.SignalErr)
+func (d *Driver) ResumeTask(taskID string) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+	return h.setPaused(false)
 }
 
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
@@ -623,6 +784,7 @@ func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*
 }
 
 var _ drivers.ExecTaskStreamingDriver = (*Driver)(nil)
+var _ drivers.PauseDriver = (*Driver)(nil)
 
 func (d *Driver) ExecTaskStreaming(ctx context.Context, taskID string, execOpts *drivers.ExecOptions) (*drivers.ExitResult, error) {
 	h, ok := d.tasks.Get(taskID)
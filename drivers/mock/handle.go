@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -22,10 +23,32 @@ type taskHandle struct {
 	command     Command
 	execCommand *Command
 
-	// stateLock guards the procState field
+	// timeline is run instead of the command if set, skipping the events
+	// that were due before timelineElapsed
+	timeline        *Timeline
+	timelineElapsed time.Duration
+
+	// signalReactions script the reactions to signals, which are delivered
+	// on signalCh
+	signalReactions []*SignalReaction
+	signalCh        chan *SignalReaction
+
+	// emitEvent emits a driver event for the task
+	emitEvent func(message string)
+
+	// statsSample is the resource usage set by the timeline, guarded by
+	// statsLock
+	statsLock   sync.Mutex
+	statsSample *timelineStatsSample
+
+	// stateLock guards the procState and paused fields
 	stateLock sync.RWMutex
 	procState drivers.TaskState
 
+	// paused is set while the task is paused. Paused tasks keep running
+	// their command, only the state is tracked.
+	paused bool
+
 	startedAt   time.Time
 	completedAt time.Time
 	exitResult  *drivers.ExitResult
@@ -42,6 +65,11 @@ func (h *taskHandle) TaskStatus() *drivers.TaskStatus {
 	h.stateLock.RLock()
 	defer h.stateLock.RUnlock()
 
+	attrs := map[string]string{}
+	if h.paused {
+		attrs["paused"] = "true"
+	}
+
 	return &drivers.TaskStatus{
 		ID:               h.taskConfig.ID,
 		Name:             h.taskConfig.Name,
@@ -49,7 +77,7 @@ func (h *taskHandle) TaskStatus() *drivers.TaskStatus {
 		StartedAt:        h.startedAt,
 		CompletedAt:      h.completedAt,
 		ExitResult:       h.exitResult,
-		DriverAttributes: map[string]string{},
+		DriverAttributes: attrs,
 	}
 }
 
@@ -59,6 +87,18 @@ func (h *taskHandle) IsRunning() bool {
 	return h.procState == drivers.TaskStateRunning
 }
 
+// setPaused pauses or resumes the task, which must not have exited.
+func (h *taskHandle) setPaused(paused bool) error {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+
+	if h.procState == drivers.TaskStateExited {
+		return fmt.Errorf("task is not running")
+	}
+	h.paused = paused
+	return nil
+}
+
 func (h *taskHandle) run() {
 	defer func() {
 		h.stateLock.Lock()
@@ -93,5 +133,40 @@ func (h *taskHandle) run() {
 		return
 	}
 
+	if h.timeline != nil {
+		h.exitResult = h.runTimeline(h.timeline, h.timelineElapsed, stdout, stderr, pluginExitTimer)
+		return
+	}
+
 	h.exitResult = runCommand(h.command, stdout, stderr, h.killCh, pluginExitTimer, h.logger)
 }
+
+// signal delivers the signal to the task if it runs a timeline and has a
+// reaction to it.
+func (h *taskHandle) signal(signal string) {
+	if h.timeline == nil {
+		return
+	}
+	r := findSignalReaction(h.signalReactions, signal)
+	if r == nil {
+		return
+	}
+	select {
+	case h.signalCh <- r:
+	case <-h.waitCh:
+	default:
+		h.logger.Warn("dropping signal, previous signals not handled yet", "signal", signal)
+	}
+}
+
+func (h *taskHandle) setStatsSample(s *timelineStatsSample) {
+	h.statsLock.Lock()
+	defer h.statsLock.Unlock()
+	h.statsSample = s
+}
+
+func (h *taskHandle) getStatsSample() *timelineStatsSample {
+	h.statsLock.Lock()
+	defer h.statsLock.Unlock()
+	return h.statsSample
+}
@@ -0,0 +1,305 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+	bstructs "github.com/hashicorp/nomad/plugins/base/structs"
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+// The types of the events of a timeline
+const (
+	timelineStdout = "stdout"
+	timelineStderr = "stderr"
+	timelineStats  = "stats"
+	timelineEvent  = "event"
+	timelineExit   = "exit"
+	timelineOOM    = "oom"
+)
+
+// oomExitCode is the exit code of a task killed by the OOM killer, which
+// sends it SIGKILL.
+const oomExitCode = 137
+
+// Timeline is a scripted sequence of events run by a task instead of its
+// command. Timelines are selected by the attempt of the task, which is one on
+// the first start and incremented on every restart of the task.
+type Timeline struct {
+	// Attempt is the start of the task the timeline applies to. Zero applies
+	// the timeline to every attempt without a timeline of its own.
+	Attempt int `codec:"attempt"`
+
+	// Events are run in order. Once the last event has run the task keeps
+	// running until it is stopped.
+	Events []*TimelineEvent `codec:"event"`
+}
+
+// TimelineEvent is a single event of a timeline
+type TimelineEvent struct {
+	// After is the duration to wait after the previous event, or after the
+	// start of the task for the first event.
+	After string `codec:"after"`
+	after time.Duration
+
+	// Type is one of stdout, stderr, stats, event, exit or oom.
+	Type string `codec:"type"`
+
+	// Message is the line written by stdout and stderr events, the message of
+	// driver events and the error message of exit events.
+	Message string `codec:"message"`
+
+	// ExitCode and ExitSignal are the exit code and signal of exit and oom
+	// events.
+	ExitCode   int `codec:"exit_code"`
+	ExitSignal int `codec:"exit_signal"`
+
+	// MemoryRSS and CPUPercent are the resource usage reported by the task
+	// from a stats event onwards.
+	MemoryRSS  int64   `codec:"memory_rss"`
+	CPUPercent float64 `codec:"cpu_percent"`
+}
+
+// SignalReaction scripts how a task running a timeline reacts to a signal
+type SignalReaction struct {
+	// Signal is the name of the signal, such as SIGHUP.
+	Signal string `codec:"signal"`
+
+	// Message is written to stdout when the signal is received.
+	Message string `codec:"message"`
+
+	// Error is returned by the signal request instead of delivering the
+	// signal.
+	Error string `codec:"error"`
+
+	// Exit causes the task to exit with ExitCode when the signal is received.
+	Exit     bool `codec:"exit"`
+	ExitCode int  `codec:"exit_code"`
+}
+
+// timelineStatsSample is the resource usage set by the last stats event
+type timelineStatsSample struct {
+	rss        uint64
+	cpuPercent float64
+}
+
+func (t *Timeline) validate() error {
+	for i, e := range t.Events {
+		var err error
+		if e.after, err = parseDuration(e.After); err != nil {
+			return fmt.Errorf("timeline event %d: after %v not a valid duration: %v", i, e.After, err)
+		}
+		switch e.Type {
+		case timelineStdout, timelineStderr, timelineStats, timelineEvent, timelineExit, timelineOOM:
+		default:
+			return fmt.Errorf("timeline event %d: invalid type %q", i, e.Type)
+		}
+		if e.MemoryRSS < 0 || e.CPUPercent < 0 {
+			return fmt.Errorf("timeline event %d: stats must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// selectTimeline returns the timeline of the given attempt, falling back to a
+// timeline without an attempt, or nil if there is none.
+func selectTimeline(timelines []*Timeline, attempt int) *Timeline {
+	var fallback *Timeline
+	for _, t := range timelines {
+		switch t.Attempt {
+		case attempt:
+			return t
+		case 0:
+			if fallback == nil {
+				fallback = t
+			}
+		}
+	}
+	return fallback
+}
+
+// recordedEvent is a task event as returned by the API, such as in the task
+// states of an allocation.
+type recordedEvent struct {
+	Type          string
+	Time          int64
+	ExitCode      int
+	Signal        int
+	DriverMessage string
+	Details       map[string]string
+}
+
+// loadReplay builds timelines from the task events recorded in the JSON file
+// at path. Each Started event begins the timeline of the next attempt, in
+// which driver messages are replayed and Terminated events exit the task
+// with the recorded result. Other events are generated by the client and are
+// not replayed.
+func loadReplay(path string) ([]*Timeline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replay file: %v", err)
+	}
+
+	var events []*recordedEvent
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, fmt.Errorf("failed to parse replay file: %v", err)
+	}
+
+	var timelines []*Timeline
+	var current *Timeline
+	var last int64
+	for _, e := range events {
+		if e.Type == structs.TaskStarted {
+			current = &Timeline{Attempt: len(timelines) + 1}
+			timelines = append(timelines, current)
+			last = e.Time
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		var te *TimelineEvent
+		switch e.Type {
+		case structs.TaskDriverMessage:
+			te = &TimelineEvent{Type: timelineEvent, Message: e.DriverMessage}
+		case structs.TaskTerminated:
+			te = &TimelineEvent{Type: timelineExit, ExitCode: e.ExitCode, ExitSignal: e.Signal}
+			if e.Details["oom_killed"] == "true" {
+				te.Type = timelineOOM
+			}
+		default:
+			continue
+		}
+		if e.Time > last {
+			te.after = time.Duration(e.Time - last)
+			te.After = te.after.String()
+		}
+		last = e.Time
+		current.Events = append(current.Events, te)
+	}
+
+	if len(timelines) == 0 {
+		return nil, errors.New("replay file has no started task")
+	}
+	return timelines, nil
+}
+
+// findSignalReaction returns the reaction to the signal, if any.
+func findSignalReaction(reactions []*SignalReaction, signal string) *SignalReaction {
+	for _, r := range reactions {
+		if strings.EqualFold(r.Signal, signal) {
+			return r
+		}
+	}
+	return nil
+}
+
+// runTimeline runs the events of the timeline until the task exits. Events
+// that were due before elapsed, such as when recovering a task, only update
+// the task's state without emitting output or events.
+func (h *taskHandle) runTimeline(t *Timeline, elapsed time.Duration, stdout, stderr io.WriteCloser,
+	pluginExitTimer <-chan time.Time) *drivers.ExitResult {
+
+	defer stdout.Close()
+	defer stderr.Close()
+
+	writeLine := func(w io.Writer, s string) {
+		if !strings.HasSuffix(s, "\n") {
+			s += "\n"
+		}
+		if _, err := io.WriteString(w, s); err != nil {
+			h.logger.Error("failed to write timeline output", "error", err)
+		}
+	}
+
+	// wait blocks until d elapsed or returns the result of the task exiting
+	// in the meantime
+	wait := func(d time.Duration) *drivers.ExitResult {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C:
+				return nil
+			case <-h.killCh:
+				h.logger.Debug("killed; exiting")
+				return &drivers.ExitResult{
+					ExitCode: h.command.ExitCode,
+					Signal:   h.command.ExitSignal,
+				}
+			case <-pluginExitTimer:
+				h.logger.Debug("exiting plugin")
+				return &drivers.ExitResult{Err: bstructs.ErrPluginShutdown}
+			case r := <-h.signalCh:
+				if r.Message != "" {
+					writeLine(stdout, r.Message)
+				}
+				if r.Exit {
+					h.logger.Debug("exiting on signal", "signal", r.Signal)
+					return &drivers.ExitResult{ExitCode: r.ExitCode}
+				}
+			}
+		}
+	}
+
+	for _, e := range t.Events {
+		past := e.after < elapsed
+		if past {
+			elapsed -= e.after
+		} else {
+			if res := wait(e.after - elapsed); res != nil {
+				return res
+			}
+			elapsed = 0
+		}
+
+		switch e.Type {
+		case timelineStdout, timelineStderr:
+			if past {
+				continue
+			}
+			w := stdout
+			if e.Type == timelineStderr {
+				w = stderr
+			}
+			writeLine(w, e.Message)
+		case timelineStats:
+			h.setStatsSample(&timelineStatsSample{rss: uint64(e.MemoryRSS), cpuPercent: e.CPUPercent})
+		case timelineEvent:
+			if !past && h.emitEvent != nil {
+				h.emitEvent(e.Message)
+			}
+		case timelineExit:
+			h.logger.Debug("timeline exit", "exit_code", e.ExitCode)
+			res := &drivers.ExitResult{ExitCode: e.ExitCode, Signal: e.ExitSignal}
+			if e.Message != "" {
+				res.Err = errors.New(e.Message)
+			}
+			return res
+		case timelineOOM:
+			h.logger.Debug("timeline oom")
+			res := &drivers.ExitResult{ExitCode: oomExitCode, Signal: 9, OOMKilled: true}
+			if e.ExitCode != 0 {
+				res.ExitCode = e.ExitCode
+			}
+			if e.ExitSignal != 0 {
+				res.Signal = e.ExitSignal
+			}
+			return res
+		}
+	}
+
+	// keep running until the task is stopped
+	for {
+		if res := wait(time.Hour); res != nil {
+			return res
+		}
+	}
+}
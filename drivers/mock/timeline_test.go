@@ -0,0 +1,270 @@
+package mock
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/pluginutils/hclutils"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/plugins/drivers"
+	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func startTimelineTask(t *testing.T, harness *dtestutil.DriverHarness, allocID string, tc *TaskConfig) (*drivers.TaskConfig, <-chan *drivers.ExitResult, func()) {
+	task := &drivers.TaskConfig{
+		AllocID: allocID,
+		ID:      uuid.Generate(),
+		Name:    "test",
+	}
+	require.NoError(t, task.EncodeConcreteDriverConfig(tc))
+
+	cleanup := harness.MkAllocDir(task, true)
+
+	_, _, err := harness.StartTask(task)
+	require.NoError(t, err)
+
+	ch, err := harness.WaitTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	return task, ch, cleanup
+}
+
+func waitExit(t *testing.T, ch <-chan *drivers.ExitResult) *drivers.ExitResult {
+	select {
+	case res := <-ch:
+		return res
+	case <-time.After(time.Duration(testutil.TestMultiplier()*5) * time.Second):
+		require.Fail(t, "timeout waiting for task to exit")
+	}
+	return nil
+}
+
+func TestTimeline_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewMockDriver(ctx, testlog.HCLogger(t)).(*Driver)
+	harness := dtestutil.NewDriverHarness(t, d)
+
+	events, err := harness.TaskEvents(ctx)
+	require.NoError(t, err)
+
+	task, ch, cleanup := startTimelineTask(t, harness, uuid.Generate(), &TaskConfig{
+		Timeline: []*Timeline{{
+			Events: []*TimelineEvent{
+				{Type: "stdout", Message: "starting"},
+				{Type: "stats", MemoryRSS: 1024, CPUPercent: 50},
+				{Type: "event", Message: "warming up"},
+				{After: "50ms", Type: "oom"},
+			},
+		}},
+	})
+	defer cleanup()
+
+	res := waitExit(t, ch)
+	require.True(t, res.OOMKilled)
+	require.Equal(t, oomExitCode, res.ExitCode)
+	require.Equal(t, 9, res.Signal)
+
+	select {
+	case e := <-events:
+		require.Equal(t, task.ID, e.TaskID)
+		require.Equal(t, "warming up", e.Message)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for task event")
+	}
+
+	sample := d.GetHandle(task.ID).getStatsSample()
+	require.Equal(t, &timelineStatsSample{rss: 1024, cpuPercent: 50}, sample)
+
+	testutil.WaitForResult(func() (bool, error) {
+		out, err := os.ReadFile(filepath.Join(task.TaskDir().LogDir, "test.stdout.0"))
+		if err != nil {
+			return false, err
+		}
+		return string(out) == "starting\n", nil
+	}, func(err error) {
+		require.NoError(t, err)
+	})
+}
+
+func TestTimeline_Attempts(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewMockDriver(ctx, testlog.HCLogger(t))
+	harness := dtestutil.NewDriverHarness(t, d)
+
+	tc := &TaskConfig{
+		Timeline: []*Timeline{
+			{Events: []*TimelineEvent{{Type: "exit", ExitCode: 2}}},
+			{Attempt: 1, Events: []*TimelineEvent{{Type: "exit", ExitCode: 1, Message: "crashed"}}},
+		},
+	}
+
+	allocID := uuid.Generate()
+	_, ch, cleanup := startTimelineTask(t, harness, allocID, tc)
+	defer cleanup()
+	res := waitExit(t, ch)
+	require.Equal(t, 1, res.ExitCode)
+	require.EqualError(t, res.Err, "crashed")
+
+	_, ch, cleanup = startTimelineTask(t, harness, allocID, tc)
+	defer cleanup()
+	res = waitExit(t, ch)
+	require.Equal(t, 2, res.ExitCode)
+	require.NoError(t, res.Err)
+}
+
+func TestTimeline_SignalReactions(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewMockDriver(ctx, testlog.HCLogger(t))
+	harness := dtestutil.NewDriverHarness(t, d)
+
+	task, ch, cleanup := startTimelineTask(t, harness, uuid.Generate(), &TaskConfig{
+		Timeline: []*Timeline{{
+			Events: []*TimelineEvent{{Type: "stdout", Message: "running"}},
+		}},
+		SignalReactions: []*SignalReaction{
+			{Signal: "SIGUSR1", Error: "cannot handle SIGUSR1"},
+			{Signal: "SIGHUP", Message: "reloading"},
+			{Signal: "SIGTERM", Exit: true, ExitCode: 3},
+		},
+	})
+	defer cleanup()
+
+	err := harness.SignalTask(task.ID, "SIGUSR1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot handle SIGUSR1")
+	require.NoError(t, harness.SignalTask(task.ID, "SIGHUP"))
+
+	select {
+	case <-ch:
+		require.Fail(t, "task exited on SIGHUP")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, harness.SignalTask(task.ID, "SIGTERM"))
+	res := waitExit(t, ch)
+	require.Equal(t, 3, res.ExitCode)
+}
+
+func TestTimeline_ParseHCL(t *testing.T) {
+	ci.Parallel(t)
+
+	cfgStr := `
+config {
+  timeline {
+    attempt = 1
+
+    event {
+      type    = "stdout"
+      message = "healthy"
+    }
+
+    event {
+      after      = "30s"
+      type       = "stats"
+      memory_rss = 1048576
+    }
+
+    event {
+      after = "1s"
+      type  = "oom"
+    }
+  }
+
+  timeline {
+    event {
+      type      = "exit"
+      exit_code = 1
+    }
+  }
+
+  on_signal {
+    signal = "SIGHUP"
+    exit   = true
+  }
+}`
+
+	expected := &TaskConfig{
+		Timeline: []*Timeline{
+			{
+				Attempt: 1,
+				Events: []*TimelineEvent{
+					{Type: "stdout", Message: "healthy"},
+					{After: "30s", Type: "stats", MemoryRSS: 1048576},
+					{After: "1s", Type: "oom"},
+				},
+			},
+			{
+				Events: []*TimelineEvent{{Type: "exit", ExitCode: 1}},
+			},
+		},
+		SignalReactions: []*SignalReaction{{Signal: "SIGHUP", Exit: true}},
+	}
+
+	var tc *TaskConfig
+	hclutils.NewConfigParser(taskConfigSpec).ParseHCL(t, cfgStr, &tc)
+	require.EqualValues(t, expected, tc)
+}
+
+func TestTimeline_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	require.NoError(t, (&Timeline{Events: []*TimelineEvent{{After: "1s", Type: "exit"}}}).validate())
+	require.Error(t, (&Timeline{Events: []*TimelineEvent{{After: "1", Type: "exit"}}}).validate())
+	require.Error(t, (&Timeline{Events: []*TimelineEvent{{Type: "crash"}}}).validate())
+	require.Error(t, (&Timeline{Events: []*TimelineEvent{{Type: "stats", MemoryRSS: -1}}}).validate())
+}
+
+func TestLoadReplay(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "events.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+  {"Type": "Received", "Time": 1000000000},
+  {"Type": "Started", "Time": 2000000000},
+  {"Type": "Driver", "Time": 3000000000, "DriverMessage": "pulling image"},
+  {"Type": "Terminated", "Time": 5000000000, "ExitCode": 137, "Signal": 9, "Details": {"oom_killed": "true"}},
+  {"Type": "Restarting", "Time": 6000000000},
+  {"Type": "Started", "Time": 7000000000},
+  {"Type": "Terminated", "Time": 7500000000, "ExitCode": 1}
+]`), 0644))
+
+	timelines, err := loadReplay(path)
+	require.NoError(t, err)
+	require.Len(t, timelines, 2)
+
+	require.Equal(t, 1, timelines[0].Attempt)
+	require.Len(t, timelines[0].Events, 2)
+	require.Equal(t, "event", timelines[0].Events[0].Type)
+	require.Equal(t, "pulling image", timelines[0].Events[0].Message)
+	require.Equal(t, time.Second, timelines[0].Events[0].after)
+	require.Equal(t, "oom", timelines[0].Events[1].Type)
+	require.Equal(t, 2*time.Second, timelines[0].Events[1].after)
+
+	require.Equal(t, 2, timelines[1].Attempt)
+	require.Len(t, timelines[1].Events, 1)
+	require.Equal(t, "exit", timelines[1].Events[0].Type)
+	require.Equal(t, 1, timelines[1].Events[0].ExitCode)
+	require.Equal(t, 500*time.Millisecond, timelines[1].Events[0].after)
+
+	require.NoError(t, os.WriteFile(path, []byte(`[{"Type": "Received", "Time": 1}]`), 0644))
+	_, err = loadReplay(path)
+	require.Error(t, err)
+}
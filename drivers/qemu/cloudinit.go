@@ -0,0 +1,267 @@
+package qemu
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	// cloudInitSeedName is the name of the NoCloud seed image written to the
+	// task directory.
+	cloudInitSeedName = "cidata.iso"
+
+	// cloudInitVolumeID is the volume label cloud-init looks for when
+	// searching for a NoCloud data source.
+	cloudInitVolumeID = "cidata"
+
+	isoSectorSize = 2048
+)
+
+// CloudInit is the cloud-init configuration of a task, written to a NoCloud
+// seed image attached to the VM.
+type CloudInit struct {
+	UserData      string `codec:"user_data"`
+	MetaData      string `codec:"meta_data"`
+	NetworkConfig string `codec:"network_config"`
+}
+
+// seedFiles returns the files of the NoCloud data source. The meta-data
+// defaults to an instance-id unique to the allocation and task, so that
+// cloud-init runs once per allocation.
+func (c *CloudInit) seedFiles(instanceID, hostname, networkConfig string) map[string][]byte {
+	metaData := c.MetaData
+	if metaData == "" {
+		metaData = fmt.Sprintf("instance-id: %s\nlocal-hostname: %s\n", instanceID, hostname)
+	}
+	if c.NetworkConfig != "" {
+		networkConfig = c.NetworkConfig
+	}
+
+	files := map[string][]byte{
+		"user-data": []byte(c.UserData),
+		"meta-data": []byte(metaData),
+	}
+	if networkConfig != "" {
+		files["network-config"] = []byte(networkConfig)
+	}
+	return files
+}
+
+// writeCloudInitSeed writes the files as a NoCloud seed image at path.
+func writeCloudInitSeed(path string, files map[string][]byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create cloud-init seed: %v", err)
+	}
+	defer f.Close()
+
+	if err := writeISO(f, cloudInitVolumeID, files, time.Now()); err != nil {
+		return fmt.Errorf("failed to write cloud-init seed: %v", err)
+	}
+	return f.Close()
+}
+
+// writeISO writes a minimal ISO 9660 image with Joliet extensions holding
+// files in its root directory. The primary directory uses upper case names
+// for strict readers while the Joliet directory preserves the names as
+// given, which is what cloud-init expects to find.
+func writeISO(w io.Writer, volumeID string, files map[string][]byte, now time.Time) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Layout: system area, primary and Joliet volume descriptors, the set
+	// terminator, the L and M path tables of both descriptors, both root
+	// directories and finally the file extents.
+	const (
+		pvdSector       = 16
+		svdSector       = 17
+		termSector      = 18
+		pathTableSector = 19
+		primaryRoot     = 23
+		jolietRoot      = 24
+		firstFileSector = 25
+	)
+
+	extents := make(map[string]uint32, len(names))
+	sector := uint32(firstFileSector)
+	for _, name := range names {
+		extents[name] = sector
+		sector += sectorsFor(len(files[name]))
+	}
+	totalSectors := sector
+
+	primaryDir := buildISODir(primaryRoot, names, files, extents, now, func(name string) []byte {
+		return []byte(strings.ToUpper(name) + ";1")
+	})
+	jolietDir := buildISODir(jolietRoot, names, files, extents, now, func(name string) []byte {
+		return ucs2(name)
+	})
+	if len(primaryDir) > isoSectorSize || len(jolietDir) > isoSectorSize {
+		return fmt.Errorf("too many files for seed image")
+	}
+
+	img := make([]byte, int(firstFileSector)*isoSectorSize)
+	writeVolumeDescriptor(img[pvdSector*isoSectorSize:], false, volumeID, totalSectors,
+		pathTableSector, primaryRoot, now)
+	writeVolumeDescriptor(img[svdSector*isoSectorSize:], true, volumeID, totalSectors,
+		pathTableSector+2, jolietRoot, now)
+
+	term := img[termSector*isoSectorSize:]
+	term[0] = 255
+	copy(term[1:6], "CD001")
+	term[6] = 1
+
+	writePathTables(img[pathTableSector*isoSectorSize:], primaryRoot)
+	writePathTables(img[(pathTableSector+2)*isoSectorSize:], jolietRoot)
+	copy(img[primaryRoot*isoSectorSize:], primaryDir)
+	copy(img[jolietRoot*isoSectorSize:], jolietDir)
+
+	if _, err := w.Write(img); err != nil {
+		return err
+	}
+	for _, name := range names {
+		data := files[name]
+		padded := make([]byte, int(sectorsFor(len(data)))*isoSectorSize)
+		copy(padded, data)
+		if _, err := w.Write(padded); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sectorsFor(size int) uint32 {
+	return uint32((size + isoSectorSize - 1) / isoSectorSize)
+}
+
+func writeVolumeDescriptor(b []byte, joliet bool, volumeID string, totalSectors, pathTable, root uint32, now time.Time) {
+	text := func(field []byte, s string) {
+		if joliet {
+			for i := 0; i+1 < len(field); i += 2 {
+				field[i], field[i+1] = 0, ' '
+			}
+			copy(field, ucs2(s))
+			return
+		}
+		for i := range field {
+			field[i] = ' '
+		}
+		copy(field, s)
+	}
+
+	b[0] = 1
+	if joliet {
+		b[0] = 2
+	}
+	copy(b[1:6], "CD001")
+	b[6] = 1
+	text(b[8:40], "")
+	text(b[40:72], volumeID)
+	putBothUint32(b[80:88], totalSectors)
+	if joliet {
+		// UCS-2 level 3
+		copy(b[88:91], "%/E")
+	}
+	putBothUint16(b[120:124], 1)
+	putBothUint16(b[124:128], 1)
+	putBothUint16(b[128:132], isoSectorSize)
+	putBothUint32(b[132:140], pathTableSize)
+	binary.LittleEndian.PutUint32(b[140:144], pathTable)
+	binary.BigEndian.PutUint32(b[148:152], pathTable+1)
+	copy(b[156:190], isoDirRecord(root, isoSectorSize, true, []byte{0}, now))
+	for _, field := range [][]byte{b[190:318], b[318:446], b[446:574], b[574:702], b[702:739], b[739:776], b[776:813]} {
+		text(field, "")
+	}
+	copy(b[813:830], isoDate(now))
+	copy(b[830:847], isoDate(now))
+	copy(b[847:864], isoDate(time.Time{}))
+	copy(b[864:881], isoDate(now))
+	b[881] = 1
+}
+
+// pathTableSize is the size of a path table holding only the root directory.
+const pathTableSize = 10
+
+// writePathTables writes the little endian path table in the first sector of
+// b and the big endian one in the second.
+func writePathTables(b []byte, root uint32) {
+	l, m := b[:pathTableSize], b[isoSectorSize:isoSectorSize+pathTableSize]
+	l[0], m[0] = 1, 1
+	binary.LittleEndian.PutUint32(l[2:6], root)
+	binary.BigEndian.PutUint32(m[2:6], root)
+	binary.LittleEndian.PutUint16(l[6:8], 1)
+	binary.BigEndian.PutUint16(m[6:8], 1)
+}
+
+func buildISODir(root uint32, names []string, files map[string][]byte, extents map[string]uint32,
+	now time.Time, identifier func(string) []byte) []byte {
+
+	var buf bytes.Buffer
+	buf.Write(isoDirRecord(root, isoSectorSize, true, []byte{0}, now))
+	buf.Write(isoDirRecord(root, isoSectorSize, true, []byte{1}, now))
+	for _, name := range names {
+		buf.Write(isoDirRecord(extents[name], uint32(len(files[name])), false, identifier(name), now))
+	}
+	return buf.Bytes()
+}
+
+func isoDirRecord(extent, size uint32, dir bool, id []byte, now time.Time) []byte {
+	length := 33 + len(id)
+	if length%2 != 0 {
+		length++
+	}
+	r := make([]byte, length)
+	r[0] = byte(length)
+	putBothUint32(r[2:10], extent)
+	putBothUint32(r[10:18], size)
+	now = now.UTC()
+	r[18] = byte(now.Year() - 1900)
+	r[19] = byte(now.Month())
+	r[20] = byte(now.Day())
+	r[21] = byte(now.Hour())
+	r[22] = byte(now.Minute())
+	r[23] = byte(now.Second())
+	if dir {
+		r[25] = 2
+	}
+	putBothUint16(r[28:32], 1)
+	r[32] = byte(len(id))
+	copy(r[33:], id)
+	return r
+}
+
+func isoDate(t time.Time) []byte {
+	if t.IsZero() {
+		return append([]byte("0000000000000000"), 0)
+	}
+	return append([]byte(t.UTC().Format("20060102150405")+"00"), 0)
+}
+
+func ucs2(s string) []byte {
+	units := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(units))
+	for i, u := range units {
+		binary.BigEndian.PutUint16(b[2*i:], u)
+	}
+	return b
+}
+
+func putBothUint16(b []byte, v uint16) {
+	binary.LittleEndian.PutUint16(b[0:2], v)
+	binary.BigEndian.PutUint16(b[2:4], v)
+}
+
+func putBothUint32(b []byte, v uint32) {
+	binary.LittleEndian.PutUint32(b[0:4], v)
+	binary.BigEndian.PutUint32(b[4:8], v)
+}
@@ -0,0 +1,97 @@
+package qemu
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+// readISORoot returns the volume label and the files in the root directory of
+// an image written by writeISO, read through the Joliet directory.
+func readISORoot(t *testing.T, img []byte) (string, map[string]string) {
+	sector := func(n uint32) []byte {
+		return img[int(n)*isoSectorSize : int(n+1)*isoSectorSize]
+	}
+	ucs2String := func(b []byte) string {
+		units := make([]uint16, len(b)/2)
+		for i := range units {
+			units[i] = binary.BigEndian.Uint16(b[2*i:])
+		}
+		return string(utf16.Decode(units))
+	}
+
+	svd := sector(17)
+	require.Equal(t, byte(2), svd[0])
+	require.Equal(t, "CD001", string(svd[1:6]))
+	require.Equal(t, "%/E", string(svd[88:91]))
+	label := string(bytes.TrimRight([]byte(ucs2String(svd[40:72])), " "))
+
+	root := svd[156:190]
+	dir := sector(binary.LittleEndian.Uint32(root[2:6]))
+
+	files := map[string]string{}
+	for off := 0; off < len(dir) && dir[off] != 0; off += int(dir[off]) {
+		r := dir[off : off+int(dir[off])]
+		id := r[33 : 33+int(r[32])]
+		if len(id) == 1 {
+			// "." and ".."
+			continue
+		}
+		extent := binary.LittleEndian.Uint32(r[2:6])
+		size := binary.LittleEndian.Uint32(r[10:14])
+		files[ucs2String(id)] = string(img[int(extent)*isoSectorSize : int(extent)*isoSectorSize+int(size)])
+	}
+	return label, files
+}
+
+func TestQemuDriver_writeISO(t *testing.T) {
+	ci.Parallel(t)
+
+	files := map[string][]byte{
+		"user-data":      []byte("#cloud-config\nhostname: web\n"),
+		"meta-data":      []byte("instance-id: web\n"),
+		"network-config": bytes.Repeat([]byte("x"), 3*isoSectorSize+1),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeISO(&buf, cloudInitVolumeID, files, time.Now()))
+	require.Zero(t, buf.Len()%isoSectorSize)
+
+	pvd := buf.Bytes()[16*isoSectorSize:]
+	require.Equal(t, byte(1), pvd[0])
+	require.Equal(t, "cidata", string(bytes.TrimRight(pvd[40:72], " ")))
+	require.Equal(t, uint32(buf.Len()/isoSectorSize), binary.LittleEndian.Uint32(pvd[80:84]))
+
+	label, got := readISORoot(t, buf.Bytes())
+	require.Equal(t, "cidata", label)
+	require.Len(t, got, 3)
+	for name, content := range files {
+		require.Equal(t, string(content), got[name], name)
+	}
+}
+
+func TestQemuDriver_CloudInit_seedFiles(t *testing.T) {
+	ci.Parallel(t)
+
+	c := &CloudInit{UserData: "#cloud-config\n"}
+	files := c.seedFiles("alloc-web", "web", "")
+	require.Equal(t, map[string][]byte{
+		"user-data": []byte("#cloud-config\n"),
+		"meta-data": []byte("instance-id: alloc-web\nlocal-hostname: web\n"),
+	}, files)
+
+	// generated network configuration is included unless overridden
+	files = c.seedFiles("alloc-web", "web", "version: 2\n")
+	require.Equal(t, "version: 2\n", string(files["network-config"]))
+
+	c.MetaData = "instance-id: custom\n"
+	c.NetworkConfig = "version: 1\n"
+	files = c.seedFiles("alloc-web", "web", "version: 2\n")
+	require.Equal(t, "instance-id: custom\n", string(files["meta-data"]))
+	require.Equal(t, "version: 1\n", string(files["network-config"]))
+}
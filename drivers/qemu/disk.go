@@ -0,0 +1,86 @@
+package qemu
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+)
+
+// diskSizeRegex matches the disk sizes accepted by qemu-img, in bytes or
+// with a K, M, G or T suffix.
+var diskSizeRegex = regexp.MustCompile(`^[1-9][0-9]*[KMGT]?$`)
+
+// Disk is an additional disk attached to the VM. Disks backed by an image
+// are copy-on-write overlays, so the image itself is never modified.
+type Disk struct {
+	ImagePath string `codec:"image_path"`
+	Size      string `codec:"size"`
+	Interface string `codec:"interface"`
+}
+
+func (d *Disk) validate() error {
+	if d.ImagePath == "" && d.Size == "" {
+		return fmt.Errorf("disk must set image_path or size")
+	}
+	if d.Size != "" && !diskSizeRegex.MatchString(d.Size) {
+		return fmt.Errorf("invalid disk size %q", d.Size)
+	}
+	if d.Interface != "" && !isAllowedDriveInterface(d.Interface) {
+		return fmt.Errorf("Unsupported disk interface %q", d.Interface)
+	}
+	return nil
+}
+
+// createOverlay creates a qcow2 image at path. If backing is set the image is
+// a copy-on-write overlay on top of it, otherwise a blank disk of the given
+// size. An existing image is reused so the disk survives task restarts.
+func createOverlay(qemuImg, path, backing, size string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	args := []string{"create", "-f", "qcow2"}
+	if backing != "" {
+		abs, err := filepath.Abs(backing)
+		if err != nil {
+			return err
+		}
+		format, err := imageFormat(qemuImg, abs)
+		if err != nil {
+			return err
+		}
+		args = append(args, "-F", format, "-b", abs)
+	}
+	args = append(args, path)
+	if size != "" {
+		args = append(args, size)
+	}
+
+	if out, err := exec.Command(qemuImg, args...).CombinedOutput(); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("failed to create disk %s: %v: %s", path, err, out)
+	}
+	return nil
+}
+
+// imageFormat returns the format of the image at path as detected by
+// qemu-img.
+func imageFormat(qemuImg, path string) (string, error) {
+	out, err := exec.Command(qemuImg, "info", "--output=json", path).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect image %s: %v", path, err)
+	}
+	var info struct {
+		Format string `json:"format"`
+	}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return "", fmt.Errorf("failed to parse image info of %s: %v", path, err)
+	}
+	if info.Format == "" {
+		return "", fmt.Errorf("unknown format of image %s", path)
+	}
+	return info.Format, nil
+}
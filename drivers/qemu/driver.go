@@ -92,6 +92,19 @@ var (
 		"guest_agent":       hclspec.NewAttr("guest_agent", "bool", false),
 		"args":              hclspec.NewAttr("args", "list(string)", false),
 		"port_map":          hclspec.NewAttr("port_map", "list(map(number))", false),
+		"image_overlay":     hclspec.NewAttr("image_overlay", "bool", false),
+		"network_mode":      hclspec.NewAttr("network_mode", "string", false),
+		"guest_stats":       hclspec.NewAttr("guest_stats", "bool", false),
+		"cloud_init": hclspec.NewBlock("cloud_init", false, hclspec.NewObject(map[string]*hclspec.Spec{
+			"user_data":      hclspec.NewAttr("user_data", "string", false),
+			"meta_data":      hclspec.NewAttr("meta_data", "string", false),
+			"network_config": hclspec.NewAttr("network_config", "string", false),
+		})),
+		"disk": hclspec.NewBlockList("disk", hclspec.NewObject(map[string]*hclspec.Spec{
+			"image_path": hclspec.NewAttr("image_path", "string", false),
+			"size":       hclspec.NewAttr("size", "string", false),
+			"interface":  hclspec.NewAttr("interface", "string", false),
+		})),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -119,6 +132,11 @@ type TaskConfig struct {
 	GracefulShutdown bool               `codec:"graceful_shutdown"`
 	DriveInterface   string             `codec:"drive_interface"` // Use interface for image
 	GuestAgent       bool               `codec:"guest_agent"`
+	ImageOverlay     bool               `codec:"image_overlay"` // Boot from a copy-on-write overlay of the image
+	NetworkMode      string             `codec:"network_mode"`  // Either user or tap
+	GuestStats       bool               `codec:"guest_stats"`   // Collect memory stats from the guest balloon driver
+	CloudInit        *CloudInit         `codec:"cloud_init"`
+	Disks            []*Disk            `codec:"disk"`
 }
 
 // TaskState is the state which is encoded in the handle returned in StartTask.
@@ -295,8 +313,21 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 		return fmt.Errorf("failed to reattach to executor: %v", err)
 	}
 
-	// Try to restore monitor socket path.
+	var driverConfig TaskConfig
+	if err := taskState.TaskConfig.DecodeDriverConfig(&driverConfig); err != nil {
+		d.logger.Error("failed to decode driver config from taskConfig state", "error", err, "task_id", handle.Config.ID)
+		return fmt.Errorf("failed to decode driver config from taskConfig state: %v", err)
+	}
+
+	// Try to restore QMP socket path.
 	taskDir := filepath.Join(handle.Config.AllocDir, handle.Config.Name)
+	qmpPath := filepath.Join(taskDir, qmpSocketName)
+	if _, err := os.Stat(qmpPath); err != nil {
+		qmpPath = ""
+	}
+
+	// Try to restore monitor socket path of tasks started before QMP was
+	// used for graceful shutdowns.
 	possiblePaths := []string{
 		filepath.Join(taskDir, qemuMonitorSocketName),
 		// Support restoring tasks that used the old socket name.
@@ -313,15 +344,18 @@ func (d *Driver) RecoverTask(handle *drivers.TaskHandle) error {
 	}
 
 	h := &taskHandle{
-		exec:         execImpl,
-		pid:          taskState.Pid,
-		monitorPath:  monitorPath,
-		pluginClient: pluginClient,
-		taskConfig:   taskState.TaskConfig,
-		procState:    drivers.TaskStateRunning,
-		startedAt:    taskState.StartedAt,
-		exitResult:   &drivers.ExitResult{},
-		logger:       d.logger,
+		exec:             execImpl,
+		pid:              taskState.Pid,
+		monitorPath:      monitorPath,
+		qmpPath:          qmpPath,
+		gracefulShutdown: driverConfig.GracefulShutdown,
+		guestStats:       driverConfig.GuestStats,
+		pluginClient:     pluginClient,
+		taskConfig:       taskState.TaskConfig,
+		procState:        drivers.TaskStateRunning,
+		startedAt:        taskState.StartedAt,
+		exitResult:       &drivers.ExitResult{},
+		logger:           d.logger,
 	}
 
 	d.tasks.Set(taskState.TaskConfig.ID, h)
@@ -446,15 +480,85 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		return nil, nil, fmt.Errorf("Unsupported drive_interface")
 	}
 
+	for _, disk := range driverConfig.Disks {
+		if err := disk.validate(); err != nil {
+			return nil, nil, err
+		}
+		if disk.ImagePath != "" && !isAllowedImagePath(d.config.ImagePaths, cfg.AllocDir, disk.ImagePath) {
+			return nil, nil, fmt.Errorf("disk image_path is not in the allowed paths")
+		}
+	}
+
+	networkMode := networkModeUser
+	if driverConfig.NetworkMode != "" {
+		networkMode = driverConfig.NetworkMode
+	}
+	switch networkMode {
+	case networkModeUser:
+	case networkModeTap:
+		if cfg.NetworkIsolation == nil || cfg.NetworkIsolation.Path == "" {
+			return nil, nil, fmt.Errorf("network_mode %q requires a bridge or cni group network", networkModeTap)
+		}
+		if len(driverConfig.PortMap) > 0 {
+			return nil, nil, fmt.Errorf("port_map is not supported with network_mode %q", networkModeTap)
+		}
+	default:
+		return nil, nil, fmt.Errorf("Unsupported network_mode %q", networkMode)
+	}
+
+	taskDir := filepath.Join(cfg.AllocDir, cfg.Name)
+
+	// Images are resolved by qemu relative to the task directory
+	imagePath := func(path string) string {
+		if filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(taskDir, path)
+	}
+
+	var qemuImg string
+	if driverConfig.ImageOverlay || len(driverConfig.Disks) > 0 {
+		if qemuImg, err = GetAbsolutePath("qemu-img"); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Boot from a copy-on-write overlay so the image itself is never written
+	bootDrive := "file=" + vmPath + ",if=" + driveInterface
+	if driverConfig.ImageOverlay {
+		overlay := filepath.Join(taskDir, "boot.qcow2")
+		if err := createOverlay(qemuImg, overlay, imagePath(vmPath), ""); err != nil {
+			return nil, nil, err
+		}
+		bootDrive = "file=" + overlay + ",if=" + driveInterface + ",format=qcow2"
+	}
+
 	args := []string{
 		absPath,
 		"-machine", "type=pc,accel=" + accelerator,
 		"-name", vmID,
 		"-m", mem,
-		"-drive", "file=" + vmPath + ",if=" + driveInterface,
+		"-drive", bootDrive,
 		"-nographic",
 	}
 
+	for i, disk := range driverConfig.Disks {
+		overlay := filepath.Join(taskDir, fmt.Sprintf("disk%d.qcow2", i))
+		var backing string
+		if disk.ImagePath != "" {
+			backing = imagePath(disk.ImagePath)
+		}
+		if err := createOverlay(qemuImg, overlay, backing, disk.Size); err != nil {
+			return nil, nil, err
+		}
+
+		diskInterface := driveInterface
+		if disk.Interface != "" {
+			diskInterface = disk.Interface
+		}
+		args = append(args, "-drive", "file="+overlay+",if="+diskInterface+",format=qcow2")
+	}
+
 	var netdevArgs []string
 	if cfg.DNS != nil {
 		if len(cfg.DNS.Servers) > 0 {
@@ -466,21 +570,27 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		}
 	}
 
-	taskDir := filepath.Join(cfg.AllocDir, cfg.Name)
+	if driverConfig.GracefulShutdown && runtime.GOOS == "windows" {
+		return nil, nil, errors.New("QEMU graceful shutdown is unsupported on the Windows platform")
+	}
+	if driverConfig.GuestStats && runtime.GOOS == "windows" {
+		return nil, nil, errors.New("QEMU guest stats are unsupported on the Windows platform")
+	}
 
-	var monitorPath string
-	if driverConfig.GracefulShutdown {
-		if runtime.GOOS == "windows" {
-			return nil, nil, errors.New("QEMU graceful shutdown is unsupported on the Windows platform")
-		}
+	var qmpPath string
+	if driverConfig.GracefulShutdown || driverConfig.GuestStats {
 		// This socket will be used to manage the virtual machine (for example,
-		// to perform graceful shutdowns)
-		monitorPath = filepath.Join(taskDir, qemuMonitorSocketName)
-		if err := validateSocketPath(monitorPath); err != nil {
+		// to perform graceful shutdowns and collect guest stats)
+		qmpPath = filepath.Join(taskDir, qmpSocketName)
+		if err := validateSocketPath(qmpPath); err != nil {
 			return nil, nil, err
 		}
-		d.logger.Debug("got monitor path", "monitorPath", monitorPath)
-		args = append(args, "-monitor", fmt.Sprintf("unix:%s,server,nowait", monitorPath))
+		d.logger.Debug("got QMP path", "qmpPath", qmpPath)
+		args = append(args, "-qmp", fmt.Sprintf("unix:%s,server,nowait", qmpPath))
+	}
+
+	if driverConfig.GuestStats {
+		args = append(args, "-device", "virtio-balloon,id="+balloonDeviceID)
 	}
 
 	if driverConfig.GuestAgent {
@@ -511,7 +621,7 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	// still reach out to the world, but without port mappings it is effectively
 	// firewalled
 	protocols := []string{"udp", "tcp"}
-	if len(cfg.Resources.NomadResources.Networks) > 0 {
+	if networkMode == networkModeUser && len(cfg.Resources.NomadResources.Networks) > 0 {
 		// Loop through the port map and construct the hostfwd string, to map
 		// reserved ports to the ports listenting in the VM
 		// Ex: hostfwd=tcp::22000-:22,hostfwd=tcp::80-:8080
@@ -536,6 +646,32 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		}
 	}
 
+	// In tap mode the VM takes over the interface of the allocation network
+	// namespace, including its hardware and IP addresses
+	var tap *tapNetwork
+	if networkMode == networkModeTap {
+		if tap, err = setupTap(cfg.NetworkIsolation.Path); err != nil {
+			return nil, nil, err
+		}
+		args = append(args,
+			"-netdev", fmt.Sprintf("tap,id=tap.0,ifname=%s,script=no,downscript=no", tap.Device),
+			"-device", "virtio-net,netdev=tap.0,mac="+tap.MAC,
+		)
+	}
+
+	if driverConfig.CloudInit != nil {
+		var networkConfig string
+		if tap != nil {
+			networkConfig = tap.cloudInitNetworkConfig(cfg.DNS)
+		}
+		files := driverConfig.CloudInit.seedFiles(cfg.AllocID+"-"+cfg.Name, cfg.Name, networkConfig)
+		seed := filepath.Join(taskDir, cloudInitSeedName)
+		if err := writeCloudInitSeed(seed, files); err != nil {
+			return nil, nil, err
+		}
+		args = append(args, "-drive", "file="+seed+",if=virtio,format=raw,readonly=on")
+	}
+
 	// If using KVM, add optimization args
 	if accelerator == "kvm" {
 		if runtime.GOOS == "windows" {
@@ -586,14 +722,16 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	d.logger.Debug("started new QemuVM", "ID", vmID)
 
 	h := &taskHandle{
-		exec:         execImpl,
-		pid:          ps.Pid,
-		monitorPath:  monitorPath,
-		pluginClient: pluginClient,
-		taskConfig:   cfg,
-		procState:    drivers.TaskStateRunning,
-		startedAt:    time.Now().Round(time.Millisecond),
-		logger:       d.logger,
+		exec:             execImpl,
+		pid:              ps.Pid,
+		qmpPath:          qmpPath,
+		gracefulShutdown: driverConfig.GracefulShutdown,
+		guestStats:       driverConfig.GuestStats,
+		pluginClient:     pluginClient,
+		taskConfig:       cfg,
+		procState:        drivers.TaskStateRunning,
+		startedAt:        time.Now().Round(time.Millisecond),
+		logger:           d.logger,
 	}
 
 	qemuDriverState := TaskState{
@@ -641,11 +779,17 @@ func (d *Driver) StopTask(taskID string, timeout time.Duration, signal string) e
 	}
 
 	// Attempt a graceful shutdown only if it was configured in the job
-	if handle.monitorPath != "" {
+	switch {
+	case handle.gracefulShutdown && handle.qmpPath != "":
+		d.logger.Debug("sending graceful shutdown command to qemu QMP socket", "qmp_path", handle.qmpPath, "pid", handle.pid)
+		if err := qmpExecute(handle.qmpPath, "system_powerdown", nil, nil); err != nil {
+			d.logger.Debug("error sending graceful shutdown ", "pid", handle.pid, "error", err)
+		}
+	case handle.monitorPath != "":
 		if err := sendQemuShutdown(d.logger, handle.monitorPath, handle.pid); err != nil {
 			d.logger.Debug("error sending graceful shutdown ", "pid", handle.pid, "error", err)
 		}
-	} else {
+	default:
 		d.logger.Debug("monitor socket is empty, forcing shutdown")
 	}
 
@@ -699,7 +843,14 @@ func (d *Driver) TaskStats(ctx context.Context, taskID string, interval time.Dur
 		return nil, drivers.ErrTaskNotFound
 	}
 
-	return handle.exec.Stats(ctx, interval)
+	ch, err := handle.exec.Stats(ctx, interval)
+	if err != nil || !handle.guestStats || handle.qmpPath == "" {
+		return ch, err
+	}
+
+	out := make(chan *drivers.TaskResourceUsage)
+	go handle.withGuestStats(ctx, ch, out)
+	return out, nil
 }
 
 func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, error) {
@@ -284,6 +284,20 @@ config {
     https = 443
   }
   graceful_shutdown = true
+  image_overlay = true
+  network_mode = "tap"
+  guest_stats = true
+  cloud_init {
+    user_data = "#cloud-config"
+    meta_data = "instance-id: web"
+  }
+  disk {
+    image_path = "/tmp/data.qcow2"
+  }
+  disk {
+    size = "10G"
+    interface = "scsi"
+  }
 }`
 
 	expected := &TaskConfig{
@@ -296,6 +310,17 @@ config {
 			"https": 443,
 		},
 		GracefulShutdown: true,
+		ImageOverlay:     true,
+		NetworkMode:      "tap",
+		GuestStats:       true,
+		CloudInit: &CloudInit{
+			UserData: "#cloud-config",
+			MetaData: "instance-id: web",
+		},
+		Disks: []*Disk{
+			{ImagePath: "/tmp/data.qcow2"},
+			{Size: "10G", Interface: "scsi"},
+		},
 	}
 
 	var tc *TaskConfig
@@ -377,3 +402,85 @@ func TestArgsAllowList(t *testing.T) {
 	}
 
 }
+
+func TestDisk_validate(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, disk := range []*Disk{
+		{ImagePath: "data.qcow2"},
+		{ImagePath: "data.qcow2", Size: "20G", Interface: "virtio"},
+		{Size: "1073741824"},
+	} {
+		require.NoError(t, disk.validate(), "%+v", disk)
+	}
+
+	for _, disk := range []*Disk{
+		{},
+		{Size: "10GB"},
+		{Size: "0"},
+		{Size: "10G", Interface: "virtio-foo"},
+	} {
+		require.Error(t, disk.validate(), "%+v", disk)
+	}
+}
+
+func TestCreateOverlay(t *testing.T) {
+	ci.Parallel(t)
+	qemuImg, err := GetAbsolutePath("qemu-img")
+	if err != nil {
+		t.Skip("Test requires qemu-img")
+	}
+
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.img")
+	require.NoError(t, os.WriteFile(base, make([]byte, 1<<20), 0644))
+
+	overlay := filepath.Join(dir, "overlay.qcow2")
+	require.NoError(t, createOverlay(qemuImg, overlay, base, "2M"))
+	format, err := imageFormat(qemuImg, overlay)
+	require.NoError(t, err)
+	require.Equal(t, "qcow2", format)
+
+	// the base image is left untouched
+	data, err := os.ReadFile(base)
+	require.NoError(t, err)
+	require.Len(t, data, 1<<20)
+
+	// an existing overlay is reused
+	require.NoError(t, createOverlay("/does/not/exist", overlay, base, ""))
+
+	blank := filepath.Join(dir, "blank.qcow2")
+	require.NoError(t, createOverlay(qemuImg, blank, "", "10M"))
+	require.FileExists(t, blank)
+}
+
+func TestTapNetwork_cloudInitNetworkConfig(t *testing.T) {
+	ci.Parallel(t)
+
+	tap := &tapNetwork{
+		Device:    tapDeviceName,
+		MAC:       "02:42:ac:1a:40:05",
+		Addresses: []string{"172.26.64.5/20"},
+		Gateway:   "172.26.64.1",
+	}
+	dns := &drivers.DNSConfig{
+		Servers:  []string{"1.1.1.1"},
+		Searches: []string{"service.consul"},
+	}
+
+	expected := `version: 2
+ethernets:
+  nomad:
+    match:
+      macaddress: "02:42:ac:1a:40:05"
+    addresses:
+      - "172.26.64.5/20"
+    gateway4: "172.26.64.1"
+    nameservers:
+      addresses:
+        - "1.1.1.1"
+      search:
+        - "service.consul"
+`
+	require.Equal(t, expected, tap.cloudInitNetworkConfig(dns))
+}
@@ -17,7 +17,16 @@ type taskHandle struct {
 	pid          int
 	pluginClient *plugin.Client
 	logger       hclog.Logger
-	monitorPath  string
+
+	// monitorPath is the human monitor socket of tasks started before QMP
+	// was used for graceful shutdowns
+	monitorPath string
+
+	// qmpPath is the QMP socket of the VM, if graceful shutdown or guest
+	// stats are enabled
+	qmpPath          string
+	gracefulShutdown bool
+	guestStats       bool
 
 	// stateLock syncs access to all fields below
 	stateLock sync.RWMutex
@@ -77,3 +86,34 @@ func (h *taskHandle) run() {
 
 	// TODO: detect if the taskConfig OOMed
 }
+
+// withGuestStats forwards the stats of the QEMU process, replacing its memory
+// usage with the memory usage reported by the balloon driver of the guest
+// once available. The memory of the QEMU process only grows as the guest
+// touches its memory, so it overstates what the guest is using.
+func (h *taskHandle) withGuestStats(ctx context.Context, in <-chan *drivers.TaskResourceUsage, out chan<- *drivers.TaskResourceUsage) {
+	defer close(out)
+	for usage := range in {
+		stats, ok, err := queryGuestMemoryStats(h.qmpPath)
+		if err != nil {
+			h.logger.Debug("failed to query guest memory stats", "error", err)
+		}
+		if ok && usage.ResourceUsage != nil {
+			ms := &drivers.MemoryStats{
+				Usage:    uint64(stats.Total - stats.Available),
+				Measured: []string{"Usage"},
+			}
+			if stats.DiskCache >= 0 {
+				ms.Cache = uint64(stats.DiskCache)
+				ms.Measured = append(ms.Measured, "Cache")
+			}
+			usage.ResourceUsage.MemoryStats = ms
+		}
+
+		select {
+		case out <- usage:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package qemu
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// qmpSocketName is the name of the QEMU Machine Protocol socket in the
+	// task directory. Use a short file name since socket paths have a
+	// maximum length.
+	qmpSocketName = "qmp.sock"
+
+	// qmpTimeout bounds each conversation with the QMP socket. QEMU serves a
+	// single QMP client at a time, so it must be short.
+	qmpTimeout = 5 * time.Second
+
+	// balloonDeviceID is the id of the virtio balloon device used to collect
+	// memory statistics from the guest.
+	balloonDeviceID = "balloon0"
+
+	// guestStatsPollingInterval is how often, in seconds, the balloon driver
+	// of the guest is asked to refresh its memory statistics.
+	guestStatsPollingInterval = 5
+)
+
+// qmpClient is a minimal client of the QEMU Machine Protocol.
+type qmpClient struct {
+	conn net.Conn
+	dec  *json.Decoder
+	enc  *json.Encoder
+}
+
+type qmpResponse struct {
+	Return json.RawMessage `json:"return"`
+	Event  string          `json:"event"`
+	Error  *struct {
+		Class string `json:"class"`
+		Desc  string `json:"desc"`
+	} `json:"error"`
+}
+
+// dialQMP connects to the QMP socket at path and negotiates capabilities,
+// after which commands can be executed.
+func dialQMP(path string) (*qmpClient, error) {
+	conn, err := net.DialTimeout("unix", path, qmpTimeout)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(qmpTimeout))
+
+	c := &qmpClient{
+		conn: conn,
+		dec:  json.NewDecoder(conn),
+		enc:  json.NewEncoder(conn),
+	}
+
+	// the server greets with its version and capabilities
+	var greeting struct {
+		QMP json.RawMessage `json:"QMP"`
+	}
+	if err := c.dec.Decode(&greeting); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read QMP greeting: %v", err)
+	}
+	if greeting.QMP == nil {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected QMP greeting")
+	}
+
+	if err := c.execute("qmp_capabilities", nil, nil); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// execute runs the command with the given arguments and decodes its return
+// value into result, if not nil.
+func (c *qmpClient) execute(command string, args, result interface{}) error {
+	req := map[string]interface{}{"execute": command}
+	if args != nil {
+		req["arguments"] = args
+	}
+	if err := c.enc.Encode(req); err != nil {
+		return fmt.Errorf("failed to send QMP command %q: %v", command, err)
+	}
+
+	for {
+		var resp qmpResponse
+		if err := c.dec.Decode(&resp); err != nil {
+			return fmt.Errorf("failed to read QMP response to %q: %v", command, err)
+		}
+		switch {
+		case resp.Event != "":
+			// asynchronous events may arrive at any time
+			continue
+		case resp.Error != nil:
+			return fmt.Errorf("QMP command %q failed: %s: %s", command, resp.Error.Class, resp.Error.Desc)
+		case result != nil:
+			return json.Unmarshal(resp.Return, result)
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *qmpClient) Close() error {
+	return c.conn.Close()
+}
+
+// qmpExecute runs a single command against the QMP socket at path.
+func qmpExecute(path, command string, args, result interface{}) error {
+	c, err := dialQMP(path)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	return c.execute(command, args, result)
+}
+
+// guestMemoryStats are the memory statistics reported by the balloon driver
+// of the guest, in bytes. Statistics the guest does not report are negative.
+type guestMemoryStats struct {
+	Total     int64 `json:"stat-total-memory"`
+	Free      int64 `json:"stat-free-memory"`
+	Available int64 `json:"stat-available-memory"`
+	DiskCache int64 `json:"stat-disk-caches"`
+	SwapIn    int64 `json:"stat-swap-in"`
+	SwapOut   int64 `json:"stat-swap-out"`
+}
+
+// queryGuestMemoryStats returns the memory statistics of the guest. Polling
+// of the statistics is enabled on the first call, so until the guest reports
+// them ok is false.
+func queryGuestMemoryStats(path string) (stats *guestMemoryStats, ok bool, err error) {
+	c, err := dialQMP(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer c.Close()
+
+	device := "/machine/peripheral/" + balloonDeviceID
+
+	var interval int
+	if err := c.execute("qom-get", map[string]interface{}{
+		"path": device, "property": "guest-stats-polling-interval",
+	}, &interval); err != nil {
+		return nil, false, err
+	}
+	if interval == 0 {
+		err := c.execute("qom-set", map[string]interface{}{
+			"path": device, "property": "guest-stats-polling-interval", "value": guestStatsPollingInterval,
+		}, nil)
+		return nil, false, err
+	}
+
+	var result struct {
+		Stats      guestMemoryStats `json:"stats"`
+		LastUpdate int64            `json:"last-update"`
+	}
+	if err := c.execute("qom-get", map[string]interface{}{
+		"path": device, "property": "guest-stats",
+	}, &result); err != nil {
+		return nil, false, err
+	}
+	if result.LastUpdate == 0 || result.Stats.Total < 0 || result.Stats.Available < 0 {
+		return nil, false, nil
+	}
+	return &result.Stats, true, nil
+}
@@ -0,0 +1,174 @@
+package qemu
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQMP serves the QMP protocol on a unix socket, answering commands with
+// the given handler.
+type fakeQMP struct {
+	path string
+
+	lock     sync.Mutex
+	commands []string
+}
+
+func newFakeQMP(t *testing.T, handler func(cmd string, args json.RawMessage) (interface{}, error)) *fakeQMP {
+	f := &fakeQMP{path: filepath.Join(t.TempDir(), qmpSocketName)}
+	l, err := net.Listen("unix", f.path)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			f.serve(conn, handler)
+		}
+	}()
+	return f
+}
+
+func (f *fakeQMP) serve(conn net.Conn, handler func(string, json.RawMessage) (interface{}, error)) {
+	defer conn.Close()
+	enc := json.NewEncoder(conn)
+	enc.Encode(map[string]interface{}{"QMP": map[string]interface{}{"capabilities": []string{}}})
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		var req struct {
+			Execute   string          `json:"execute"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			return
+		}
+		f.lock.Lock()
+		f.commands = append(f.commands, req.Execute)
+		f.lock.Unlock()
+
+		// events may be interleaved with responses
+		enc.Encode(map[string]interface{}{"event": "NIC_RX_FILTER_CHANGED"})
+
+		if req.Execute == "qmp_capabilities" {
+			enc.Encode(map[string]interface{}{"return": map[string]interface{}{}})
+			continue
+		}
+		result, err := handler(req.Execute, req.Arguments)
+		if err != nil {
+			enc.Encode(map[string]interface{}{"error": map[string]string{"class": "GenericError", "desc": err.Error()}})
+			continue
+		}
+		enc.Encode(map[string]interface{}{"return": result})
+	}
+}
+
+func (f *fakeQMP) received() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string(nil), f.commands...)
+}
+
+func TestQemuDriver_qmpExecute(t *testing.T) {
+	ci.Parallel(t)
+
+	f := newFakeQMP(t, func(cmd string, _ json.RawMessage) (interface{}, error) {
+		switch cmd {
+		case "system_powerdown":
+			return map[string]interface{}{}, nil
+		case "query-status":
+			return map[string]interface{}{"status": "running", "running": true}, nil
+		}
+		return nil, fmt.Errorf("command %s not found", cmd)
+	})
+
+	require.NoError(t, qmpExecute(f.path, "system_powerdown", nil, nil))
+
+	var status struct {
+		Status string `json:"status"`
+	}
+	require.NoError(t, qmpExecute(f.path, "query-status", nil, &status))
+	require.Equal(t, "running", status.Status)
+
+	err := qmpExecute(f.path, "bogus", nil, nil)
+	require.EqualError(t, err, `QMP command "bogus" failed: GenericError: command bogus not found`)
+
+	require.Equal(t, []string{
+		"qmp_capabilities", "system_powerdown",
+		"qmp_capabilities", "query-status",
+		"qmp_capabilities", "bogus",
+	}, f.received())
+}
+
+func TestQemuDriver_queryGuestMemoryStats(t *testing.T) {
+	ci.Parallel(t)
+
+	var (
+		lock     sync.Mutex
+		interval int
+	)
+	f := newFakeQMP(t, func(cmd string, raw json.RawMessage) (interface{}, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		var args struct {
+			Path     string `json:"path"`
+			Property string `json:"property"`
+			Value    int    `json:"value"`
+		}
+		json.Unmarshal(raw, &args)
+		if args.Path != "/machine/peripheral/"+balloonDeviceID {
+			return nil, fmt.Errorf("unexpected path %s", args.Path)
+		}
+
+		switch {
+		case cmd == "qom-set" && args.Property == "guest-stats-polling-interval":
+			interval = args.Value
+			return map[string]interface{}{}, nil
+		case cmd == "qom-get" && args.Property == "guest-stats-polling-interval":
+			return interval, nil
+		case cmd == "qom-get" && args.Property == "guest-stats":
+			return map[string]interface{}{
+				"last-update": 1666170000,
+				"stats": map[string]int64{
+					"stat-total-memory":     1 << 30,
+					"stat-available-memory": 768 << 20,
+					"stat-free-memory":      512 << 20,
+					"stat-disk-caches":      128 << 20,
+					"stat-swap-in":          -1,
+					"stat-swap-out":         -1,
+				},
+			}, nil
+		}
+		return nil, fmt.Errorf("unexpected command %s", cmd)
+	})
+
+	// the first query enables polling
+	_, ok, err := queryGuestMemoryStats(f.path)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, guestStatsPollingInterval, interval)
+
+	stats, ok, err := queryGuestMemoryStats(f.path)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, &guestMemoryStats{
+		Total:     1 << 30,
+		Free:      512 << 20,
+		Available: 768 << 20,
+		DiskCache: 128 << 20,
+		SwapIn:    -1,
+		SwapOut:   -1,
+	}, stats)
+}
@@ -0,0 +1,76 @@
+package qemu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/plugins/drivers"
+)
+
+const (
+	// networkModeUser is the default network mode, using QEMU user mode
+	// networking with port forwarding from the host.
+	networkModeUser = "user"
+
+	// networkModeTap connects the VM to the network namespace of the
+	// allocation through a tap device.
+	networkModeTap = "tap"
+
+	// tapDeviceName is the name of the tap device created in the network
+	// namespace of the allocation.
+	tapDeviceName = "tap0"
+)
+
+// tapNetwork describes the interface of the allocation network namespace
+// that has been handed over to the VM through a tap device.
+type tapNetwork struct {
+	// Device is the name of the tap device
+	Device string
+
+	// MAC is the hardware address of the allocation interface, which the VM
+	// network interface takes over
+	MAC string
+
+	// Addresses are the addresses of the allocation interface in CIDR
+	// notation
+	Addresses []string
+
+	// Gateway is the IPv4 default gateway of the allocation, if any
+	Gateway string
+}
+
+// cloudInitNetworkConfig returns a cloud-init network configuration (version
+// 2) assigning the addresses of the allocation interface to the VM.
+func (t *tapNetwork) cloudInitNetworkConfig(dns *drivers.DNSConfig) string {
+	var b strings.Builder
+	b.WriteString("version: 2\n")
+	b.WriteString("ethernets:\n")
+	b.WriteString("  nomad:\n")
+	b.WriteString("    match:\n")
+	fmt.Fprintf(&b, "      macaddress: %q\n", t.MAC)
+	if len(t.Addresses) > 0 {
+		b.WriteString("    addresses:\n")
+		for _, addr := range t.Addresses {
+			fmt.Fprintf(&b, "      - %q\n", addr)
+		}
+	}
+	if t.Gateway != "" {
+		fmt.Fprintf(&b, "    gateway4: %q\n", t.Gateway)
+	}
+	if dns != nil && (len(dns.Servers) > 0 || len(dns.Searches) > 0) {
+		b.WriteString("    nameservers:\n")
+		if len(dns.Servers) > 0 {
+			b.WriteString("      addresses:\n")
+			for _, s := range dns.Servers {
+				fmt.Fprintf(&b, "        - %q\n", s)
+			}
+		}
+		if len(dns.Searches) > 0 {
+			b.WriteString("      search:\n")
+			for _, s := range dns.Searches {
+				fmt.Fprintf(&b, "        - %q\n", s)
+			}
+		}
+	}
+	return b.String()
+}
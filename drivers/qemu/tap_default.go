@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package qemu
+
+import (
+	"errors"
+)
+
+// setupTap is only supported on Linux.
+func setupTap(string) (*tapNetwork, error) {
+	return nil, errors.New("tap networking is only supported on Linux")
+}
@@ -0,0 +1,156 @@
+//go:build linux
+// +build linux
+
+package qemu
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// setupTap creates a tap device in the network namespace at netnsPath and
+// redirects all traffic between it and the interface holding the default
+// route of the namespace, so that the VM takes over that interface. The
+// device is reused if it already exists, e.g. when the task restarts.
+func setupTap(netnsPath string) (*tapNetwork, error) {
+	netNS, err := ns.GetNS(netnsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open network namespace %s: %v", netnsPath, err)
+	}
+	defer netNS.Close()
+
+	var tap *tapNetwork
+	err = netNS.Do(func(ns.NetNS) error {
+		link, gateway, err := defaultRouteLink()
+		if err != nil {
+			return err
+		}
+
+		device, err := ensureTapDevice(link.Attrs().MTU)
+		if err != nil {
+			return err
+		}
+		if err := redirectTraffic(link, device); err != nil {
+			return err
+		}
+		if err := redirectTraffic(device, link); err != nil {
+			return err
+		}
+
+		tap = &tapNetwork{
+			Device:  tapDeviceName,
+			MAC:     link.Attrs().HardwareAddr.String(),
+			Gateway: gateway,
+		}
+		addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+		if err != nil {
+			return fmt.Errorf("failed to list addresses of %s: %v", link.Attrs().Name, err)
+		}
+		for _, addr := range addrs {
+			if addr.IP.IsGlobalUnicast() {
+				tap.Addresses = append(tap.Addresses, addr.IPNet.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up tap networking: %v", err)
+	}
+	return tap, nil
+}
+
+// defaultRouteLink returns the interface of the IPv4 default route of the
+// current network namespace, and the gateway of that route.
+func defaultRouteLink() (netlink.Link, string, error) {
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list routes: %v", err)
+	}
+	for _, route := range routes {
+		if route.Dst != nil {
+			continue
+		}
+		link, err := netlink.LinkByIndex(route.LinkIndex)
+		if err != nil {
+			return nil, "", err
+		}
+		var gateway string
+		if route.Gw != nil {
+			gateway = route.Gw.String()
+		}
+		return link, gateway, nil
+	}
+	return nil, "", errors.New("no default route in network namespace")
+}
+
+func ensureTapDevice(mtu int) (netlink.Link, error) {
+	device, err := netlink.LinkByName(tapDeviceName)
+	if err != nil {
+		if _, ok := err.(netlink.LinkNotFoundError); !ok {
+			return nil, err
+		}
+		tap := &netlink.Tuntap{
+			LinkAttrs: netlink.LinkAttrs{Name: tapDeviceName},
+			Mode:      netlink.TUNTAP_MODE_TAP,
+			Flags:     netlink.TUNTAP_NO_PI | netlink.TUNTAP_VNET_HDR,
+		}
+		if err := netlink.LinkAdd(tap); err != nil {
+			return nil, fmt.Errorf("failed to create tap device: %v", err)
+		}
+		if device, err = netlink.LinkByName(tapDeviceName); err != nil {
+			return nil, err
+		}
+	}
+	if err := netlink.LinkSetMTU(device, mtu); err != nil {
+		return nil, fmt.Errorf("failed to set tap device mtu: %v", err)
+	}
+	if err := netlink.LinkSetUp(device); err != nil {
+		return nil, fmt.Errorf("failed to set tap device up: %v", err)
+	}
+	return device, nil
+}
+
+// redirectTraffic redirects all ingress traffic of the from interface to the
+// egress of the to interface.
+func redirectTraffic(from, to netlink.Link) error {
+	qdisc := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: from.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscAdd(qdisc); err != nil {
+		if !errors.Is(err, unix.EEXIST) {
+			return fmt.Errorf("failed to add ingress qdisc to %s: %v", from.Attrs().Name, err)
+		}
+	}
+
+	filters, err := netlink.FilterList(from, netlink.MakeHandle(0xffff, 0))
+	if err != nil {
+		return fmt.Errorf("failed to list filters of %s: %v", from.Attrs().Name, err)
+	}
+	for _, filter := range filters {
+		if u32, ok := filter.(*netlink.U32); ok && u32.RedirIndex == to.Attrs().Index {
+			return nil
+		}
+	}
+
+	filter := &netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: from.Attrs().Index,
+			Parent:    netlink.MakeHandle(0xffff, 0),
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		RedirIndex: to.Attrs().Index,
+	}
+	if err := netlink.FilterAdd(filter); err != nil {
+		return fmt.Errorf("failed to redirect %s to %s: %v", from.Attrs().Name, to.Attrs().Name, err)
+	}
+	return nil
+}
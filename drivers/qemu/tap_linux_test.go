@@ -0,0 +1,93 @@
+//go:build linux
+// +build linux
+
+package qemu
+
+import (
+	"net"
+	"testing"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/testutils"
+	"github.com/hashicorp/nomad/ci"
+	ctestutil "github.com/hashicorp/nomad/client/testutil"
+	"github.com/shoenig/test/must"
+	"github.com/vishvananda/netlink"
+)
+
+func TestQemuDriver_setupTap(t *testing.T) {
+	ci.Parallel(t)
+	ctestutil.RequireRoot(t)
+
+	netNS, err := testutils.NewNS()
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		netNS.Close()
+		testutils.UnmountNS(netNS)
+	})
+
+	// emulate the interface a bridge network gives the allocation
+	mac, _ := net.ParseMAC("02:42:ac:1a:40:05")
+	must.NoError(t, netNS.Do(func(ns.NetNS) error {
+		link := &netlink.Veth{
+			LinkAttrs: netlink.LinkAttrs{Name: "eth0", HardwareAddr: mac, MTU: 1400},
+			PeerName:  "peer0",
+		}
+		if err := netlink.LinkAdd(link); err != nil {
+			return err
+		}
+		if err := netlink.LinkSetUp(link); err != nil {
+			return err
+		}
+		addr, _ := netlink.ParseAddr("172.26.64.5/20")
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			return err
+		}
+		return netlink.RouteAdd(&netlink.Route{LinkIndex: link.Attrs().Index, Gw: net.ParseIP("172.26.64.1")})
+	}))
+
+	tap, err := setupTap(netNS.Path())
+	must.NoError(t, err)
+	must.Eq(t, &tapNetwork{
+		Device:    tapDeviceName,
+		MAC:       "02:42:ac:1a:40:05",
+		Addresses: []string{"172.26.64.5/20"},
+		Gateway:   "172.26.64.1",
+	}, tap)
+
+	// setting up again, as when the task restarts, reuses the device
+	_, err = setupTap(netNS.Path())
+	must.NoError(t, err)
+
+	must.NoError(t, netNS.Do(func(ns.NetNS) error {
+		device, err := netlink.LinkByName(tapDeviceName)
+		must.NoError(t, err)
+		must.Eq(t, 1400, device.Attrs().MTU)
+
+		eth0, err := netlink.LinkByName("eth0")
+		must.NoError(t, err)
+		for _, pair := range [][2]netlink.Link{{eth0, device}, {device, eth0}} {
+			filters, err := netlink.FilterList(pair[0], netlink.MakeHandle(0xffff, 0))
+			must.NoError(t, err)
+			must.Len(t, 1, filters)
+			must.Eq(t, pair[1].Attrs().Index, filters[0].(*netlink.U32).RedirIndex)
+		}
+		return nil
+	}))
+}
+
+func TestQemuDriver_setupTap_NoDefaultRoute(t *testing.T) {
+	ci.Parallel(t)
+	ctestutil.RequireRoot(t)
+
+	netNS, err := testutils.NewNS()
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		netNS.Close()
+		testutils.UnmountNS(netNS)
+	})
+
+	_, err = setupTap(netNS.Path())
+	must.Error(t, err)
+	must.ContainsString(t, err.Error(), "no default route")
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/consul-template/signals"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/lib/cgutil"
 	"github.com/hashicorp/nomad/drivers/shared/eventer"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
 	"github.com/hashicorp/nomad/helper/pluginutils/loader"
@@ -82,13 +83,22 @@ var (
 			hclspec.NewAttr("no_cgroups", "bool", false),
 			hclspec.NewLiteral("false"),
 		),
+		"resource_isolation": hclspec.NewDefault(
+			hclspec.NewAttr("resource_isolation", "bool", false),
+			hclspec.NewLiteral("false"),
+		),
 	})
 
 	// taskConfigSpec is the hcl specification for the driver config section of
 	// a task within a job. It is returned in the TaskConfigSchema RPC
 	taskConfigSpec = hclspec.NewObject(map[string]*hclspec.Spec{
-		"command": hclspec.NewAttr("command", "string", true),
-		"args":    hclspec.NewAttr("args", "list(string)", false),
+		"command":        hclspec.NewAttr("command", "string", true),
+		"args":           hclspec.NewAttr("args", "list(string)", false),
+		"cpu_hard_limit": hclspec.NewAttr("cpu_hard_limit", "bool", false),
+		"cpu_cfs_period": hclspec.NewDefault(
+			hclspec.NewAttr("cpu_cfs_period", "number", false),
+			hclspec.NewLiteral(`100000`),
+		),
 	})
 
 	// capabilities is returned by the Capabilities RPC and indicates what
@@ -105,9 +115,9 @@ var (
 	}
 )
 
-// Driver is a privileged version of the exec driver. It provides no
-// resource isolation and just fork/execs. The Exec driver should be preferred
-// and this should only be used when explicitly needed.
+// Driver is a privileged version of the exec driver. By default it provides
+// no resource isolation and just fork/execs. The Exec driver should be
+// preferred and this should only be used when explicitly needed.
 type Driver struct {
 	// eventer is used to handle multiplexing of TaskEvents calls such that an
 	// event can be broadcast to all callers
@@ -138,12 +148,25 @@ type Config struct {
 
 	// Enabled is set to true to enable the raw_exec driver
 	Enabled bool `codec:"enabled"`
+
+	// ResourceIsolation enforces the memory and cpu resources of tasks
+	// through their cgroup. It requires cgroups v2.
+	ResourceIsolation bool `codec:"resource_isolation"`
+}
+
+func (c *Config) validate() error {
+	if c.ResourceIsolation && c.NoCgroups {
+		return fmt.Errorf("resource_isolation cannot be enabled when no_cgroups is set")
+	}
+	return nil
 }
 
 // TaskConfig is the driver configuration of a task within a job
 type TaskConfig struct {
-	Command string   `codec:"command"`
-	Args    []string `codec:"args"`
+	Command      string   `codec:"command"`
+	Args         []string `codec:"args"`
+	CPUHardLimit bool     `codec:"cpu_hard_limit"`
+	CPUCFSPeriod int64    `codec:"cpu_cfs_period"`
 }
 
 // TaskState is the state which is encoded in the handle returned in
@@ -184,6 +207,10 @@ func (d *Driver) SetConfig(cfg *base.Config) error {
 		}
 	}
 
+	if err := config.validate(); err != nil {
+		return err
+	}
+
 	d.config = &config
 	if cfg.AgentConfig != nil {
 		d.nomadConfig = cfg.AgentConfig.Driver
@@ -196,7 +223,20 @@ func (d *Driver) TaskConfigSchema() (*hclspec.Spec, error) {
 }
 
 func (d *Driver) Capabilities() (*drivers.Capabilities, error) {
-	return capabilities, nil
+	if !d.useCgroups() {
+		return capabilities, nil
+	}
+
+	// tasks can be paused with the freezer of their cgroup
+	caps := *capabilities
+	caps.Pause = true
+	return &caps, nil
+}
+
+// useCgroups returns whether tasks are run in a cgroup. Cgroups are only used
+// when running as root on linux, doing so in other cases will cause an error.
+func (d *Driver) useCgroups() bool {
+	return !d.config.NoCgroups && runtime.GOOS == "linux" && syscall.Geteuid() == 0
 }
 
 func (d *Driver) Fingerprint(ctx context.Context) (<-chan *drivers.Fingerprint, error) {
@@ -229,6 +269,9 @@ func (d *Driver) buildFingerprint() *drivers.Fingerprint {
 		health = drivers.HealthStateHealthy
 		desc = drivers.DriverHealthy
 		attrs["driver.raw_exec"] = pstructs.NewBoolAttribute(true)
+		if d.config.ResourceIsolation {
+			attrs["driver.raw_exec.resource_isolation"] = pstructs.NewBoolAttribute(true)
+		}
 	} else {
 		health = drivers.HealthStateUndetected
 		desc = "disabled"
@@ -309,6 +352,20 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	}
 
 	d.logger.Info("starting task", "driver_cfg", hclog.Fmt("%+v", driverConfig))
+
+	useCgroups := d.useCgroups()
+
+	var resources *drivers.Resources
+	if d.config.ResourceIsolation {
+		if !useCgroups || !cgutil.UseV2 {
+			return nil, nil, fmt.Errorf("resource_isolation requires running as root on a host with cgroups v2")
+		}
+		var err error
+		if resources, err = taskResources(cfg.Resources, &driverConfig); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	handle := drivers.NewTaskHandle(taskHandleVersion)
 	handle.Config = cfg
 
@@ -324,16 +381,14 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 		return nil, nil, fmt.Errorf("failed to create executor: %v", err)
 	}
 
-	// Only use cgroups when running as root on linux - Doing so in other cases
-	// will cause an error.
-	useCgroups := !d.config.NoCgroups && runtime.GOOS == "linux" && syscall.Geteuid() == 0
-
 	execCmd := &executor.ExecCommand{
 		Cmd:                driverConfig.Command,
 		Args:               driverConfig.Args,
 		Env:                cfg.EnvList(),
 		User:               cfg.User,
 		BasicProcessCgroup: useCgroups,
+		ResourceLimits:     d.config.ResourceIsolation,
+		Resources:          resources,
 		TaskDir:            cfg.TaskDir().Dir,
 		StdoutPath:         cfg.StdoutPath,
 		StderrPath:         cfg.StderrPath,
@@ -376,6 +431,31 @@ func (d *Driver) StartTask(cfg *drivers.TaskConfig) (*drivers.TaskHandle, *drive
 	return handle, nil, nil
 }
 
+// taskResources returns the resources to be enforced on the task cgroup,
+// including the cpu quota if the task asked for a hard limit on cpu.
+func taskResources(res *drivers.Resources, tc *TaskConfig) (*drivers.Resources, error) {
+	if res == nil {
+		return nil, nil
+	}
+	res = res.Copy()
+	if !tc.CPUHardLimit || res.LinuxResources == nil {
+		return res, nil
+	}
+
+	// cpu.max is the time per period across all cores, so we must
+	// multiply the time by the number of cores available
+	if tc.CPUCFSPeriod < 0 || tc.CPUCFSPeriod > 1000000 {
+		return nil, fmt.Errorf("invalid value for cpu_cfs_period")
+	}
+	period := tc.CPUCFSPeriod
+	if period == 0 {
+		period = res.LinuxResources.CPUPeriod
+	}
+	res.LinuxResources.CPUPeriod = period
+	res.LinuxResources.CPUQuota = int64(res.LinuxResources.PercentTicks*float64(period)) * int64(runtime.NumCPU())
+	return res, nil
+}
+
 func (d *Driver) WaitTask(ctx context.Context, taskID string) (<-chan *drivers.ExitResult, error) {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -398,8 +478,9 @@ func (d *Driver) handleWait(ctx context.Context, handle *taskHandle, ch chan *dr
 		}
 	} else {
 		result = &drivers.ExitResult{
-			ExitCode: ps.ExitCode,
-			Signal:   ps.Signal,
+			ExitCode:  ps.ExitCode,
+			Signal:    ps.Signal,
+			OOMKilled: ps.OOMKilled,
 		}
 	}
 
@@ -478,6 +559,26 @@ func (d *Driver) TaskEvents(ctx context.Context) (<-chan *drivers.TaskEvent, err
 	return d.eventer.TaskEvents(ctx)
 }
 
+// PauseTask freezes the processes of the task.
+func (d *Driver) PauseTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Pause()
+}
+
+// ResumeTask thaws the processes of a paused task.
+func (d *Driver) ResumeTask(taskID string) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.exec.Resume()
+}
+
 func (d *Driver) SignalTask(taskID string, signal string) error {
 	handle, ok := d.tasks.Get(taskID)
 	if !ok {
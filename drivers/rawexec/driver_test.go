@@ -98,6 +98,21 @@ func TestRawExecDriver_SetConfig(t *testing.T) {
 	bconfig.PluginConfig = data
 	require.NoError(harness.SetConfig(bconfig))
 	require.Exactly(config, d.(*Driver).config)
+
+	// Enable resource isolation.
+	config.ResourceIsolation = true
+	data = []byte{}
+	require.NoError(basePlug.MsgPackEncode(&data, config))
+	bconfig.PluginConfig = data
+	require.NoError(harness.SetConfig(bconfig))
+	require.Exactly(config, d.(*Driver).config)
+
+	// Resource isolation requires cgroups.
+	config.NoCgroups = true
+	data = []byte{}
+	require.NoError(basePlug.MsgPackEncode(&data, config))
+	bconfig.PluginConfig = data
+	require.Error(harness.SetConfig(bconfig))
 }
 
 func TestRawExecDriver_Fingerprint(t *testing.T) {
@@ -155,6 +170,21 @@ func TestRawExecDriver_Fingerprint(t *testing.T) {
 				HealthDescription: drivers.DriverHealthy,
 			},
 		},
+		{
+			Name: "ResourceIsolation",
+			Conf: Config{
+				Enabled:           true,
+				ResourceIsolation: true,
+			},
+			Expected: drivers.Fingerprint{
+				Attributes: map[string]*pstructs.Attribute{
+					"driver.raw_exec":                    pstructs.NewBoolAttribute(true),
+					"driver.raw_exec.resource_isolation": pstructs.NewBoolAttribute(true),
+				},
+				Health:            drivers.HealthStateHealthy,
+				HealthDescription: drivers.DriverHealthy,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -554,11 +584,15 @@ func TestConfig_ParseAllHCL(t *testing.T) {
 config {
   command = "/bin/bash"
   args = ["-c", "echo hello"]
+  cpu_hard_limit = true
+  cpu_cfs_period = 50000
 }`
 
 	expected := &TaskConfig{
-		Command: "/bin/bash",
-		Args:    []string{"-c", "echo hello"},
+		Command:      "/bin/bash",
+		Args:         []string{"-c", "echo hello"},
+		CPUHardLimit: true,
+		CPUCFSPeriod: 50000,
 	}
 
 	var tc *TaskConfig
@@ -567,6 +601,35 @@ config {
 	require.EqualValues(t, expected, tc)
 }
 
+func TestRawExecDriver_taskResources(t *testing.T) {
+	ci.Parallel(t)
+
+	res := &drivers.Resources{
+		LinuxResources: &drivers.LinuxResources{
+			CPUPeriod:    100000,
+			PercentTicks: 0.25,
+		},
+	}
+
+	// no hard limit leaves the quota unset
+	out, err := taskResources(res, &TaskConfig{})
+	require.NoError(t, err)
+	require.Zero(t, out.LinuxResources.CPUQuota)
+
+	// the quota is spread over all cores
+	out, err = taskResources(res, &TaskConfig{CPUHardLimit: true, CPUCFSPeriod: 50000})
+	require.NoError(t, err)
+	require.Equal(t, int64(50000), out.LinuxResources.CPUPeriod)
+	require.Equal(t, int64(12500*runtime.NumCPU()), out.LinuxResources.CPUQuota)
+
+	// the task resources are not modified
+	require.Equal(t, int64(100000), res.LinuxResources.CPUPeriod)
+	require.Zero(t, res.LinuxResources.CPUQuota)
+
+	_, err = taskResources(res, &TaskConfig{CPUHardLimit: true, CPUCFSPeriod: 2000000})
+	require.Error(t, err)
+}
+
 func TestRawExecDriver_Disabled(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
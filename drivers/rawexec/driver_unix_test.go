@@ -20,6 +20,7 @@ import (
 	clienttestutil "github.com/hashicorp/nomad/client/testutil"
 	"github.com/hashicorp/nomad/helper/testtask"
 	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
 	basePlug "github.com/hashicorp/nomad/plugins/base"
 	"github.com/hashicorp/nomad/plugins/drivers"
 	dtestutil "github.com/hashicorp/nomad/plugins/drivers/testutils"
@@ -442,3 +443,55 @@ func TestRawExecDriver_NoCgroup(t *testing.T) {
 		return true, nil
 	}, func(err error) { require.NoError(t, err) })
 }
+
+func TestRawExecDriver_ResourceIsolation_OOM(t *testing.T) {
+	ci.Parallel(t)
+	clienttestutil.ExecCompatible(t)
+	clienttestutil.CgroupsCompatibleV2(t)
+
+	d := newEnabledRawExecDriver(t)
+	d.config.ResourceIsolation = true
+	harness := dtestutil.NewDriverHarness(t, d)
+	defer harness.Kill()
+
+	task := &drivers.TaskConfig{
+		AllocID: uuid.Generate(),
+		ID:      uuid.Generate(),
+		Name:    "oom",
+		Env:     defaultEnv(),
+		Resources: &drivers.Resources{
+			NomadResources: &structs.AllocatedTaskResources{
+				Memory: structs.AllocatedMemoryResources{MemoryMB: 64},
+				Cpu:    structs.AllocatedCpuResources{CpuShares: 100},
+			},
+			LinuxResources: &drivers.LinuxResources{
+				CPUPeriod:        100000,
+				MemoryLimitBytes: 64 * 1024 * 1024,
+			},
+		},
+	}
+
+	cleanup := harness.MkAllocDir(task, false)
+	defer cleanup()
+
+	// tail buffers its input until a newline, which /dev/zero never sends
+	tc := &TaskConfig{
+		Command: "/bin/sh",
+		Args:    []string{"-c", "tail /dev/zero"},
+	}
+	require.NoError(t, task.EncodeConcreteDriverConfig(&tc))
+
+	_, _, err := harness.StartTask(task)
+	require.NoError(t, err)
+	defer harness.DestroyTask(task.ID, true)
+
+	waitCh, err := harness.WaitTask(context.Background(), task.ID)
+	require.NoError(t, err)
+	select {
+	case res := <-waitCh:
+		require.False(t, res.Successful())
+		require.True(t, res.OOMKilled)
+	case <-time.After(time.Duration(testutil.TestMultiplier()*10) * time.Second):
+		require.Fail(t, "WaitTask timeout")
+	}
+}
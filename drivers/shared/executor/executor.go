@@ -41,6 +41,12 @@ const (
 
 	// IsolationModeHost represents the host isolation mode for a namespace
 	IsolationModeHost = "host"
+
+	// SeccompProfileDefault selects the executor's built-in seccomp profile
+	SeccompProfileDefault = "default"
+
+	// SeccompProfileUnconfined disables seccomp filtering for a task
+	SeccompProfileUnconfined = "unconfined"
 )
 
 var (
@@ -88,6 +94,16 @@ type Executor interface {
 
 	ExecStreaming(ctx context.Context, cmd []string, tty bool,
 		stream drivers.ExecTaskStream) error
+
+	// Checkpoint dumps the process tree of the task into dir with CRIU and
+	// stops it. The task is restored by launching it with RestoreFrom set.
+	Checkpoint(dir string) error
+
+	// Pause freezes all processes of the task with the cgroup freezer.
+	Pause() error
+
+	// Resume thaws the processes of a task frozen by Pause.
+	Resume() error
 }
 
 // ExecCommand holds the user command, args, and other isolation related
@@ -154,6 +170,40 @@ type ExecCommand struct {
 
 	// Capabilities are the linux capabilities to be enabled by the task driver.
 	Capabilities []string
+
+	// SeccompProfile is the seccomp filter applied to the task. It is either
+	// empty or SeccompProfileUnconfined for no filter, SeccompProfileDefault
+	// for the built-in profile, or the host path of an OCI seccomp profile.
+	SeccompProfile string
+
+	// ModeUser is the user namespace isolation mode (private or host). In
+	// private mode container IDs starting at 0 are mapped to the host IDs
+	// starting at UserNSHostUID and UserNSHostGID.
+	ModeUser string
+
+	// UserNSHostUID is the first host UID of the user namespace mapping.
+	UserNSHostUID uint32
+
+	// UserNSHostGID is the first host GID of the user namespace mapping.
+	UserNSHostGID uint32
+
+	// UserNSSize is the number of IDs mapped into the user namespace.
+	UserNSSize uint32
+
+	// Rootfs is the image root filesystem used as the root of the task
+	// instead of TaskDir. Only supported by the isolated executor.
+	Rootfs string
+
+	// WorkDir is the working directory of the task within its root.
+	WorkDir string
+
+	// RestoreFrom is the directory of a CRIU checkpoint the task is restored
+	// from instead of running Cmd. Only supported by the isolated executor.
+	RestoreFrom string
+
+	// CriuPath is the path of the criu binary used to checkpoint and restore
+	// the task.
+	CriuPath string
 }
 
 // SetWriters sets the writer for the process stdout and stderr. This should
@@ -223,6 +273,10 @@ type ProcessState struct {
 	ExitCode int
 	Signal   int
 	Time     time.Time
+
+	// OOMKilled is true if the kernel OOM killer killed a process of the
+	// task. It is only detected when resource limits are enforced.
+	OOMKilled bool
 }
 
 // ExecutorVersion is the version of the executor
@@ -248,6 +302,10 @@ type UniversalExecutor struct {
 	// currently only used for killing pids via freezer cgroup on linux
 	containment resources.Containment
 
+	// cgroupPath is the v2 cgroup enforcing the resource limits of the task,
+	// if the executor was asked to enforce them
+	cgroupPath string
+
 	totalCpuStats  *stats.CpuStats
 	userCpuStats   *stats.CpuStats
 	systemCpuStats *stats.CpuStats
@@ -495,7 +553,13 @@ func (e *UniversalExecutor) wait() {
 		e.logger.Warn("unexpected Cmd.Wait() error type", "error", err)
 	}
 
-	e.exitState = &ProcessState{Pid: pid, ExitCode: exitCode, Signal: signal, Time: time.Now()}
+	e.exitState = &ProcessState{
+		Pid:       pid,
+		ExitCode:  exitCode,
+		Signal:    signal,
+		Time:      time.Now(),
+		OOMKilled: e.oomKilled(),
+	}
 }
 
 var (
@@ -532,6 +596,13 @@ func (e *UniversalExecutor) Shutdown(signal string, grace time.Duration) error {
 		return err
 	}
 
+	// A paused task cannot handle the signal, so thaw it first
+	if e.containment != nil {
+		if err := e.containment.Thaw(); err != nil {
+			e.logger.Debug("failed to thaw task before shutdown", "error", err)
+		}
+	}
+
 	// If grace is 0 then skip shutdown logic
 	if grace > 0 {
 		// Default signal to SIGINT if not set
@@ -611,6 +682,29 @@ func (e *UniversalExecutor) Signal(s os.Signal) error {
 	return nil
 }
 
+// Checkpoint is not supported by the universal executor as its tasks are not
+// isolated.
+func (e *UniversalExecutor) Checkpoint(dir string) error {
+	return fmt.Errorf("checkpoint is not supported by this executor")
+}
+
+// Pause freezes the processes of the task. It requires the task to run in a
+// cgroup, which is not the case on clients without cgroups.
+func (e *UniversalExecutor) Pause() error {
+	if e.containment == nil {
+		return fmt.Errorf("pausing tasks requires cgroups")
+	}
+	return e.containment.Freeze()
+}
+
+// Resume thaws the processes of the task.
+func (e *UniversalExecutor) Resume() error {
+	if e.containment == nil {
+		return fmt.Errorf("pausing tasks requires cgroups")
+	}
+	return e.containment.Thaw()
+}
+
 func (e *UniversalExecutor) Stats(ctx context.Context, interval time.Duration) (<-chan *cstructs.TaskResourceUsage, error) {
 	ch := make(chan *cstructs.TaskResourceUsage)
 	go e.handleStats(ch, ctx, interval)
@@ -629,16 +723,22 @@ func (e *UniversalExecutor) handleStats(ch chan *cstructs.TaskResourceUsage, ctx
 			timer.Reset(interval)
 		}
 
-		pidStats, err := e.pidCollector.pidStats()
+		pidStats, processes, err := e.pidCollector.pidStats()
 		if err != nil {
 			e.logger.Warn("error collecting stats", "error", err)
 			return
 		}
 
+		usage := aggregatedResourceUsage(e.systemCpuStats, pidStats)
+		usage.Processes = processes
+		if cgUsage := e.cgroupResourceUsage(); cgUsage != nil {
+			usage.ResourceUsage = cgUsage
+		}
+
 		select {
 		case <-ctx.Done():
 			return
-		case ch <- aggregatedResourceUsage(e.systemCpuStats, pidStats):
+		case ch <- usage:
 		}
 	}
 }
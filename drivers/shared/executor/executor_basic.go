@@ -18,6 +18,10 @@ func NewExecutorWithIsolation(logger hclog.Logger) Executor {
 
 func (e *UniversalExecutor) configureResourceContainer(_ int) error { return nil }
 
+func (e *UniversalExecutor) cgroupResourceUsage() *drivers.ResourceUsage { return nil }
+
+func (e *UniversalExecutor) oomKilled() bool { return false }
+
 func (e *UniversalExecutor) getAllPids() (resources.PIDs, error) {
 	return getAllPidsByScanning()
 }
@@ -31,3 +35,6 @@ func withNetworkIsolation(f func() error, _ *drivers.NetworkIsolationSpec) error
 }
 
 func setCmdUser(*exec.Cmd, string) error { return nil }
+
+// SeccompSupported is always false as seccomp is only available on Linux.
+const SeccompSupported = false
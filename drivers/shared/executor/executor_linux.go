@@ -95,14 +95,18 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 
 	l.command = command
 
-	// create a new factory which will store the container state in the allocDir
-	factory, err := libcontainer.New(
-		path.Join(command.TaskDir, "../alloc/container"),
+	factoryOpts := []func(*libcontainer.LinuxFactory) error{
 		// note that os.Args[0] refers to the executor shim typically
 		// and first args arguments is ignored now due
 		// until https://github.com/opencontainers/runc/pull/1888 is merged
 		libcontainer.InitArgs(os.Args[0], "libcontainer-shim"),
-	)
+	}
+	if command.CriuPath != "" {
+		factoryOpts = append(factoryOpts, libcontainer.CriuPath(command.CriuPath))
+	}
+
+	// create a new factory which will store the container state in the allocDir
+	factory, err := libcontainer.New(path.Join(command.TaskDir, "../alloc/container"), factoryOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create factory: %v", err)
 	}
@@ -113,38 +117,22 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 		return nil, fmt.Errorf("failed to configure container(%s): %v", l.id, err)
 	}
 
+	if err := shiftUserNamespaceOwnership(command); err != nil {
+		return nil, fmt.Errorf("failed to shift task directory ownership: %v", err)
+	}
+
 	container, err := factory.Create(l.id, containerCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create container(%s): %v", l.id, err)
 	}
 	l.container = container
 
-	// Look up the binary path and make it executable
-	absPath, err := lookupTaskBin(command)
-
+	// Look up the binary path relative to the container root
+	path, err := lookupContainerBin(command)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := makeExecutable(absPath); err != nil {
-		return nil, err
-	}
-
-	path := absPath
-
-	// Ensure that the path is contained in the chroot, and find it relative to the container
-	rel, err := filepath.Rel(command.TaskDir, path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to determine relative path base=%q target=%q: %v", command.TaskDir, path, err)
-	}
-
-	// Turn relative-to-chroot path into absolute path to avoid
-	// libcontainer trying to resolve the binary using $PATH.
-	// Do *not* use filepath.Join as it will translate ".."s returned by
-	// filepath.Rel. Prepending "/" will cause the path to be rooted in the
-	// chroot which is the desired behavior.
-	path = "/" + rel
-
 	combined := append([]string{path}, command.Args...)
 	stdout, err := command.Stdout()
 	if err != nil {
@@ -163,6 +151,7 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 		Env:    command.Env,
 		Stdout: stdout,
 		Stderr: stderr,
+		Cwd:    command.WorkDir,
 		Init:   true,
 	}
 
@@ -175,8 +164,14 @@ func (l *LibcontainerExecutor) Launch(command *ExecCommand) (*ProcessState, erro
 	l.userCpuStats = stats.NewCpuStats()
 	l.systemCpuStats = stats.NewCpuStats()
 
-	// Starts the task
-	if err := container.Run(process); err != nil {
+	// Starts the task, or restores it from its checkpoint
+	if command.RestoreFrom != "" {
+		l.logger.Debug("restoring from checkpoint", "dir", command.RestoreFrom)
+		if err := container.Restore(process, criuOpts(command.RestoreFrom)); err != nil {
+			container.Destroy()
+			return nil, fmt.Errorf("failed to restore from checkpoint: %v", err)
+		}
+	} else if err := container.Run(process); err != nil {
 		container.Destroy()
 		return nil, err
 	}
@@ -277,6 +272,13 @@ func (l *LibcontainerExecutor) Shutdown(signal string, grace time.Duration) erro
 		return nil
 	}
 
+	// A paused task cannot handle the signal, so resume it first
+	if status == libcontainer.Paused {
+		if err := l.container.Resume(); err != nil {
+			return fmt.Errorf("failed to resume paused task: %v", err)
+		}
+	}
+
 	if grace > 0 {
 		if signal == "" {
 			signal = "SIGINT"
@@ -319,6 +321,54 @@ func (l *LibcontainerExecutor) Shutdown(signal string, grace time.Duration) erro
 	}
 }
 
+// Checkpoint dumps the container with CRIU into dir. CRIU kills the
+// processes once dumped, so the task exits as if killed.
+func (l *LibcontainerExecutor) Checkpoint(dir string) error {
+	if l.container == nil {
+		return fmt.Errorf("task not yet run")
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create checkpoint dir: %v", err)
+	}
+
+	l.logger.Debug("checkpointing task", "dir", dir)
+	if err := l.container.Checkpoint(criuOpts(dir)); err != nil {
+		// Remove the partial images so the task isn't restored from them
+		if rerr := os.RemoveAll(dir); rerr != nil {
+			l.logger.Warn("failed to remove checkpoint dir", "dir", dir, "error", rerr)
+		}
+		return fmt.Errorf("failed to checkpoint task: %v", err)
+	}
+	return nil
+}
+
+// Pause freezes the processes of the container.
+func (l *LibcontainerExecutor) Pause() error {
+	if l.container == nil {
+		return fmt.Errorf("task not yet run")
+	}
+	return l.container.Pause()
+}
+
+// Resume thaws the processes of a paused container.
+func (l *LibcontainerExecutor) Resume() error {
+	if l.container == nil {
+		return fmt.Errorf("task not yet run")
+	}
+	return l.container.Resume()
+}
+
+// criuOpts returns the CRIU options used to checkpoint the task into dir and
+// restore it from there. The CRIU logs are written alongside the images.
+func criuOpts(dir string) *libcontainer.CriuOpts {
+	return &libcontainer.CriuOpts{
+		ImagesDirectory: dir,
+		WorkDirectory:   dir,
+		FileLocks:       true,
+	}
+}
+
 // UpdateResources updates the resource isolation with new values to be enforced
 func (l *LibcontainerExecutor) UpdateResources(resources *drivers.Resources) error {
 	return nil
@@ -361,55 +411,19 @@ func (l *LibcontainerExecutor) handleStats(ch chan *cstructs.TaskResourceUsage,
 			return
 		}
 
-		pidStats, err := l.pidCollector.pidStats()
+		pidStats, processes, err := l.pidCollector.pidStats()
 		if err != nil {
 			l.logger.Warn("error collecting stats", "error", err)
 			return
 		}
 
 		ts := time.Now()
-		stats := lstats.CgroupStats
-
-		// Memory Related Stats
-		swap := stats.MemoryStats.SwapUsage
-		maxUsage := stats.MemoryStats.Usage.MaxUsage
-		rss := stats.MemoryStats.Stats["rss"]
-		cache := stats.MemoryStats.Stats["cache"]
-		mapped_file := stats.MemoryStats.Stats["mapped_file"]
-		ms := &cstructs.MemoryStats{
-			RSS:            rss,
-			Cache:          cache,
-			Swap:           swap.Usage,
-			MappedFile:     mapped_file,
-			Usage:          stats.MemoryStats.Usage.Usage,
-			MaxUsage:       maxUsage,
-			KernelUsage:    stats.MemoryStats.KernelUsage.Usage,
-			KernelMaxUsage: stats.MemoryStats.KernelUsage.MaxUsage,
-			Measured:       measuredMemStats,
-		}
-
-		// CPU Related Stats
-		totalProcessCPUUsage := float64(stats.CpuStats.CpuUsage.TotalUsage)
-		userModeTime := float64(stats.CpuStats.CpuUsage.UsageInUsermode)
-		kernelModeTime := float64(stats.CpuStats.CpuUsage.UsageInKernelmode)
-
-		totalPercent := l.totalCpuStats.Percent(totalProcessCPUUsage)
-		cs := &cstructs.CpuStats{
-			SystemMode:       l.systemCpuStats.Percent(kernelModeTime),
-			UserMode:         l.userCpuStats.Percent(userModeTime),
-			Percent:          totalPercent,
-			ThrottledPeriods: stats.CpuStats.ThrottlingData.ThrottledPeriods,
-			ThrottledTime:    stats.CpuStats.ThrottlingData.ThrottledTime,
-			TotalTicks:       l.systemCpuStats.TicksConsumed(totalPercent),
-			Measured:         ExecutorCgroupMeasuredCpuStats,
-		}
 		taskResUsage := cstructs.TaskResourceUsage{
-			ResourceUsage: &cstructs.ResourceUsage{
-				MemoryStats: ms,
-				CpuStats:    cs,
-			},
+			ResourceUsage: cgroupResourceUsage(lstats.CgroupStats, measuredMemStats,
+				l.totalCpuStats, l.userCpuStats, l.systemCpuStats),
 			Timestamp: ts.UTC().UnixNano(),
 			Pids:      pidStats,
+			Processes: processes,
 		}
 
 		select {
@@ -421,6 +435,52 @@ func (l *LibcontainerExecutor) handleStats(ch chan *cstructs.TaskResourceUsage,
 	}
 }
 
+// cgroupResourceUsage converts the stats of a task cgroup into the resource
+// usage reported by the executor. The CPU stats trackers are used to compute
+// percentages from the cumulative usage counters of the cgroup.
+func cgroupResourceUsage(cgStats *cgroups.Stats, measuredMemStats []string,
+	totalCpuStats, userCpuStats, systemCpuStats *stats.CpuStats) *cstructs.ResourceUsage {
+
+	// Memory Related Stats
+	swap := cgStats.MemoryStats.SwapUsage
+	maxUsage := cgStats.MemoryStats.Usage.MaxUsage
+	rss := cgStats.MemoryStats.Stats["rss"]
+	cache := cgStats.MemoryStats.Stats["cache"]
+	mapped_file := cgStats.MemoryStats.Stats["mapped_file"]
+	ms := &cstructs.MemoryStats{
+		RSS:            rss,
+		Cache:          cache,
+		Swap:           swap.Usage,
+		MappedFile:     mapped_file,
+		Usage:          cgStats.MemoryStats.Usage.Usage,
+		MaxUsage:       maxUsage,
+		KernelUsage:    cgStats.MemoryStats.KernelUsage.Usage,
+		KernelMaxUsage: cgStats.MemoryStats.KernelUsage.MaxUsage,
+		Measured:       measuredMemStats,
+	}
+
+	// CPU Related Stats
+	totalProcessCPUUsage := float64(cgStats.CpuStats.CpuUsage.TotalUsage)
+	userModeTime := float64(cgStats.CpuStats.CpuUsage.UsageInUsermode)
+	kernelModeTime := float64(cgStats.CpuStats.CpuUsage.UsageInKernelmode)
+
+	totalPercent := totalCpuStats.Percent(totalProcessCPUUsage)
+	cs := &cstructs.CpuStats{
+		SystemMode:       systemCpuStats.Percent(kernelModeTime),
+		UserMode:         userCpuStats.Percent(userModeTime),
+		Percent:          totalPercent,
+		ThrottledPeriods: cgStats.CpuStats.ThrottlingData.ThrottledPeriods,
+		ThrottledTime:    cgStats.CpuStats.ThrottlingData.ThrottledTime,
+		TotalTicks:       systemCpuStats.TicksConsumed(totalPercent),
+		Measured:         ExecutorCgroupMeasuredCpuStats,
+	}
+
+	return &cstructs.ResourceUsage{
+		MemoryStats: ms,
+		CpuStats:    cs,
+	}
+}
+
 // Signal sends a signal to the process managed by the executor
 func (l *LibcontainerExecutor) Signal(s os.Signal) error {
 	return l.userProc.Signal(s)
@@ -564,7 +624,7 @@ func configureNamespaces(pidMode, ipcMode string) lconfigs.Namespaces {
 // The process runs in a container configured with the following:
 //
 // * the task directory as the chroot
-// * dedicated mount points namespace, but shares the PID, User, domain, network namespaces with host
+// * dedicated mount points namespace, the PID, IPC and User namespaces are shared with host unless configured otherwise
 // * small subset of devices (e.g. stdout/stderr/stdin, tty, shm, pts); default to using the same set of devices as Docker
 // * some special filesystems: `/proc`, `/sys`.  Some case is given to avoid exec escaping or setting malicious values through them.
 func configureIsolation(cfg *lconfigs.Config, command *ExecCommand) error {
@@ -572,6 +632,9 @@ func configureIsolation(cfg *lconfigs.Config, command *ExecCommand) error {
 
 	// set the new root directory for the container
 	cfg.Rootfs = command.TaskDir
+	if command.Rootfs != "" {
+		cfg.Rootfs = command.Rootfs
+	}
 
 	// disable pivot_root if set in the driver's configuration
 	cfg.NoPivotRoot = command.NoPivotRoot
@@ -652,6 +715,106 @@ func configureIsolation(cfg *lconfigs.Config, command *ExecCommand) error {
 		cfg.Mounts = append(cfg.Mounts, cmdMounts(command.Mounts)...)
 	}
 
+	configureUserNamespace(cfg, command)
+
+	return nil
+}
+
+// configureUserNamespace runs the task in a private user namespace when
+// requested, mapping root in the container to an unprivileged range of host
+// IDs.
+func configureUserNamespace(cfg *lconfigs.Config, command *ExecCommand) {
+	if command.ModeUser != IsolationModePrivate {
+		return
+	}
+
+	cfg.Namespaces = append(cfg.Namespaces, lconfigs.Namespace{Type: lconfigs.NEWUSER})
+	cfg.UidMappings = []lconfigs.IDMap{{
+		ContainerID: 0,
+		HostID:      int(command.UserNSHostUID),
+		Size:        int(command.UserNSSize),
+	}}
+	cfg.GidMappings = []lconfigs.IDMap{{
+		ContainerID: 0,
+		HostID:      int(command.UserNSHostGID),
+		Size:        int(command.UserNSSize),
+	}}
+
+	// sysfs cannot be mounted from a user namespace that does not own the
+	// network namespace, so bind the host's sysfs read-only instead
+	for _, m := range cfg.Mounts {
+		if m.Device == "sysfs" {
+			m.Source = "/sys"
+			m.Device = "bind"
+			m.Flags = syscall.MS_BIND | syscall.MS_REC | syscall.MS_RDONLY |
+				syscall.MS_NOEXEC | syscall.MS_NOSUID | syscall.MS_NODEV
+		}
+	}
+}
+
+// shiftUserNamespaceOwnership shifts the owners of the task's writable
+// directories into the host IDs a private user namespace maps, so that files
+// the client wrote there are owned by the same users inside the namespace as
+// on the host. IDs outside the mapped range are left alone, which makes the
+// shift idempotent as the host IDs start above the range.
+//
+// The chroot directories are never shifted, as they hard link host files.
+func shiftUserNamespaceOwnership(command *ExecCommand) error {
+	if command.ModeUser != IsolationModePrivate {
+		return nil
+	}
+
+	size := int(command.UserNSSize)
+	shift := func(path string, fi os.FileInfo) error {
+		st, ok := fi.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		uid, gid := int(st.Uid), int(st.Gid)
+		if uid >= size && gid >= size {
+			return nil
+		}
+		if uid < size {
+			uid += int(command.UserNSHostUID)
+		}
+		if gid < size {
+			gid += int(command.UserNSHostGID)
+		}
+		return os.Lchown(path, uid, gid)
+	}
+
+	// the task and shared alloc directories themselves, whose other
+	// content is not the task's
+	allocDir := filepath.Join(command.TaskDir, "..", allocdir.SharedAllocName)
+	for _, dir := range []string{command.TaskDir, allocDir} {
+		fi, err := os.Lstat(dir)
+		if err != nil {
+			return err
+		}
+		if err := shift(dir, fi); err != nil {
+			return err
+		}
+	}
+
+	dirs := []string{
+		filepath.Join(command.TaskDir, allocdir.TaskLocal),
+		filepath.Join(command.TaskDir, allocdir.TaskSecrets),
+		filepath.Join(command.TaskDir, allocdir.TmpDirName),
+	}
+	for _, dir := range allocdir.SharedAllocDirs {
+		dirs = append(dirs, filepath.Join(allocDir, dir))
+	}
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return shift(path, fi)
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -743,6 +906,10 @@ func newLibcontainerConfig(command *ExecCommand) (*lconfigs.Config, error) {
 		return nil, err
 	}
 
+	if err := configureSeccomp(cfg, command); err != nil {
+		return nil, err
+	}
+
 	if err := configureCgroups(cfg, command); err != nil {
 		return nil, err
 	}
@@ -805,6 +972,79 @@ func cmdMounts(mounts []*drivers.MountConfig) []*lconfigs.Mount {
 	return r
 }
 
+// lookupContainerBin finds the task binary and returns its path inside the
+// container.
+func lookupContainerBin(command *ExecCommand) (string, error) {
+	if command.Rootfs != "" {
+		return lookupImageBin(command)
+	}
+
+	// Look up the binary path and make it executable
+	absPath, err := lookupTaskBin(command)
+	if err != nil {
+		return "", err
+	}
+
+	if err := makeExecutable(absPath); err != nil {
+		return "", err
+	}
+
+	// Ensure that the path is contained in the chroot, and find it relative to the container
+	rel, err := filepath.Rel(command.TaskDir, absPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine relative path base=%q target=%q: %v", command.TaskDir, absPath, err)
+	}
+
+	// Turn relative-to-chroot path into absolute path to avoid
+	// libcontainer trying to resolve the binary using $PATH.
+	// Do *not* use filepath.Join as it will translate ".."s returned by
+	// filepath.Rel. Prepending "/" will cause the path to be rooted in the
+	// chroot which is the desired behavior.
+	return "/" + rel, nil
+}
+
+// lookupImageBin finds the file `bin` in the image root filesystem, either at
+// its path relative to the working directory or through the task's PATH. The
+// task's local directory is checked first as it is mounted into the image.
+// Files are never followed on the host since image symlinks are relative to
+// the container root, nor made executable since they belong to the image.
+func lookupImageBin(command *ExecCommand) (string, error) {
+	bin := command.Cmd
+	exists := func(p string) bool {
+		fi, err := os.Lstat(filepath.Join(command.Rootfs, p))
+		return err == nil && !fi.IsDir()
+	}
+
+	local := filepath.Join(command.TaskDir, allocdir.TaskLocal, bin)
+	if fi, err := os.Stat(local); err == nil && !fi.IsDir() {
+		return filepath.Join("/", allocdir.TaskLocal, bin), nil
+	}
+
+	if strings.Contains(bin, "/") {
+		p := bin
+		if !filepath.IsAbs(p) {
+			p = filepath.Join("/", command.WorkDir, p)
+		}
+		if exists(p) {
+			return p, nil
+		}
+		return "", fmt.Errorf("file %s not found in image", bin)
+	}
+
+	path := "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+	for _, env := range command.Env {
+		if strings.HasPrefix(env, "PATH=") {
+			path = strings.TrimPrefix(env, "PATH=")
+		}
+	}
+	for _, dir := range filepath.SplitList(path) {
+		if p := filepath.Join("/", dir, bin); exists(p) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("file %s not found in image PATH %s", bin, path)
+}
+
 // lookupTaskBin finds the file `bin` in taskDir/local, taskDir in that order, then performs
 // a PATH search inside taskDir. It returns an absolute path. See also executor.lookupBin
 func lookupTaskBin(command *ExecCommand) (string, error) {
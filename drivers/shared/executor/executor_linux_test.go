@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -128,6 +130,159 @@ func TestExecutor_configureNamespaces(t *testing.T) {
 	})
 }
 
+func TestExecutor_configureUserNamespace(t *testing.T) {
+	ci.Parallel(t)
+
+	cfg := &lconfigs.Config{
+		Mounts: []*lconfigs.Mount{{
+			Source:      "sysfs",
+			Destination: "/sys",
+			Device:      "sysfs",
+		}},
+	}
+
+	configureUserNamespace(cfg, &ExecCommand{ModeUser: IsolationModeHost})
+	require.Empty(t, cfg.Namespaces)
+	require.Empty(t, cfg.UidMappings)
+
+	configureUserNamespace(cfg, &ExecCommand{
+		ModeUser:      IsolationModePrivate,
+		UserNSHostUID: 100000,
+		UserNSHostGID: 200000,
+		UserNSSize:    65536,
+	})
+	require.True(t, cfg.Namespaces.Contains(lconfigs.NEWUSER))
+	require.Equal(t, []lconfigs.IDMap{{ContainerID: 0, HostID: 100000, Size: 65536}}, cfg.UidMappings)
+	require.Equal(t, []lconfigs.IDMap{{ContainerID: 0, HostID: 200000, Size: 65536}}, cfg.GidMappings)
+	require.Equal(t, "bind", cfg.Mounts[0].Device)
+	require.Equal(t, "/sys", cfg.Mounts[0].Source)
+	require.NotZero(t, cfg.Mounts[0].Flags&unix.MS_RDONLY)
+}
+
+func TestExecutor_shiftUserNamespaceOwnership(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	allocDir := allocdir.NewAllocDir(testlog.HCLogger(t), t.TempDir(), "alloc")
+	require.NoError(t, allocDir.Build())
+	defer allocDir.Destroy()
+	taskDir := allocDir.NewTaskDir("web")
+	require.NoError(t, taskDir.Build(false, nil))
+
+	secret := filepath.Join(taskDir.SecretsDir, "token")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0600))
+	foreign := filepath.Join(taskDir.LocalDir, "foreign")
+	require.NoError(t, os.WriteFile(foreign, nil, 0644))
+	require.NoError(t, os.Chown(foreign, 70000, 70000))
+
+	owner := func(path string) (uint32, uint32) {
+		fi, err := os.Lstat(path)
+		require.NoError(t, err)
+		st := fi.Sys().(*syscall.Stat_t)
+		return st.Uid, st.Gid
+	}
+	nobodyUID, nobodyGID := owner(taskDir.LocalDir)
+
+	command := &ExecCommand{
+		TaskDir:       taskDir.Dir,
+		ModeUser:      IsolationModePrivate,
+		UserNSHostUID: 100000,
+		UserNSHostGID: 200000,
+		UserNSSize:    65536,
+	}
+
+	// shifting twice is the same as shifting once
+	for i := 0; i < 2; i++ {
+		require.NoError(t, shiftUserNamespaceOwnership(command))
+
+		uid, gid := owner(secret)
+		require.Equal(t, uint32(100000), uid)
+		require.Equal(t, uint32(200000), gid)
+
+		uid, gid = owner(taskDir.LocalDir)
+		require.Equal(t, 100000+nobodyUID, uid)
+		require.Equal(t, 200000+nobodyGID, gid)
+
+		uid, gid = owner(filepath.Join(allocDir.SharedDir, allocdir.SharedDataDir))
+		require.Equal(t, 100000+nobodyUID, uid)
+		require.Equal(t, 200000+nobodyGID, gid)
+
+		// IDs outside the mapped range are not shifted
+		uid, gid = owner(foreign)
+		require.Equal(t, uint32(70000), uid)
+		require.Equal(t, uint32(70000), gid)
+	}
+}
+
+func TestExecutor_UserNamespace_TaskDirs(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	defer allocDir.Destroy()
+
+	// a file written by the client is owned by root on the host
+	secret := filepath.Join(execCmd.TaskDir, allocdir.TaskSecrets, "token")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0600))
+
+	execCmd.Env = append(execCmd.Env, "NOMAD_TASK_DIR=/local", "NOMAD_SECRETS_DIR=/secrets")
+	execCmd.Cmd = "/bin/bash"
+	execCmd.Args = []string{"-c",
+		`cat $NOMAD_SECRETS_DIR/token > $NOMAD_TASK_DIR/out && echo written >> $NOMAD_SECRETS_DIR/token`}
+	execCmd.ModePID = IsolationModePrivate
+	execCmd.ModeIPC = IsolationModePrivate
+	execCmd.ModeUser = IsolationModePrivate
+	execCmd.UserNSHostUID = 100000
+	execCmd.UserNSHostGID = 100000
+	execCmd.UserNSSize = 65536
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	ps, err := executor.Launch(execCmd)
+	require.NoError(t, err)
+	require.NotZero(t, ps.Pid)
+
+	state, err := executor.Wait(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, state.ExitCode, testExecCmd.stderr.String())
+
+	out, err := os.ReadFile(filepath.Join(execCmd.TaskDir, allocdir.TaskLocal, "out"))
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(out))
+
+	content, err := os.ReadFile(secret)
+	require.NoError(t, err)
+	require.Equal(t, "secretwritten\n", string(content))
+}
+
+func TestExecutor_configureSeccomp(t *testing.T) {
+	ci.Parallel(t)
+
+	cfg := &lconfigs.Config{}
+	require.NoError(t, configureSeccomp(cfg, &ExecCommand{SeccompProfile: SeccompProfileUnconfined}))
+	require.Nil(t, cfg.Seccomp)
+
+	path := filepath.Join(t.TempDir(), "profile.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "defaultAction": "SCMP_ACT_ALLOW",
+  "syscalls": [{"names": ["ptrace"], "action": "SCMP_ACT_ERRNO"}]
+}`), 0644))
+
+	err := configureSeccomp(cfg, &ExecCommand{SeccompProfile: path})
+	if !SeccompSupported {
+		require.EqualError(t, err, fmt.Sprintf("seccomp profile %q requested but seccomp is not supported by this build", path))
+		return
+	}
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Seccomp)
+	require.Len(t, cfg.Seccomp.Syscalls, 1)
+
+	require.NoError(t, configureSeccomp(cfg, &ExecCommand{SeccompProfile: SeccompProfileDefault}))
+	require.Len(t, cfg.Seccomp.Syscalls, len(defaultSeccompBlocked))
+}
+
 func TestExecutor_Isolation_PID_and_IPC_hostMode(t *testing.T) {
 	ci.Parallel(t)
 	r := require.New(t)
@@ -669,6 +824,145 @@ func TestExecutor_ClientCleanup(t *testing.T) {
 	require.Equal(len(output), len(output1))
 }
 
+func TestExecutor_CheckpointRestore(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+
+	criu, err := exec.LookPath("criu")
+	if err != nil {
+		t.Skip("criu not found")
+	}
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	defer allocDir.Destroy()
+
+	execCmd.Cmd = "/bin/sleep"
+	execCmd.Args = []string{"1000"}
+	execCmd.ResourceLimits = true
+	execCmd.CriuPath = criu
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	ps, err := executor.Launch(execCmd)
+	require.NoError(t, err)
+	require.NotZero(t, ps.Pid)
+
+	// checkpointing stops the task
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	require.NoError(t, executor.Checkpoint(dir))
+	_, err = executor.Wait(context.Background())
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, "inventory.img"))
+
+	// the task resumes when restored
+	restored := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer restored.Shutdown("SIGKILL", 0)
+
+	execCmd.RestoreFrom = dir
+	ps, err = restored.Launch(execCmd)
+	require.NoError(t, err)
+	require.NotZero(t, ps.Pid)
+
+	lexec := restored.(*LibcontainerExecutor)
+	state, err := lexec.container.Status()
+	require.NoError(t, err)
+	require.Equal(t, "running", state.String())
+}
+
+func TestExecutor_Checkpoint_Failed(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+
+	testExecCmd := testExecutorCommandWithChroot(t)
+	execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+	defer allocDir.Destroy()
+
+	execCmd.Cmd = "/bin/sleep"
+	execCmd.Args = []string{"1000"}
+	execCmd.ResourceLimits = true
+	execCmd.CriuPath = filepath.Join(t.TempDir(), "criu")
+
+	executor := NewExecutorWithIsolation(testlog.HCLogger(t))
+	defer executor.Shutdown("SIGKILL", 0)
+
+	ps, err := executor.Launch(execCmd)
+	require.NoError(t, err)
+	require.NotZero(t, ps.Pid)
+
+	// a failed checkpoint removes the partial images
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "inventory.img"), nil, 0600))
+
+	require.Error(t, executor.Checkpoint(dir))
+	require.NoDirExists(t, dir)
+
+	// the task keeps running
+	lexec := executor.(*LibcontainerExecutor)
+	state, err := lexec.container.Status()
+	require.NoError(t, err)
+	require.Equal(t, "running", state.String())
+}
+
+func TestExecutor_PauseResume(t *testing.T) {
+	ci.Parallel(t)
+	testutil.ExecCompatible(t)
+
+	for name, factory := range executorFactories {
+		t.Run(name, func(t *testing.T) {
+			testExecCmd := testExecutorCommand(t)
+			execCmd, allocDir := testExecCmd.command, testExecCmd.allocDir
+			execCmd.Cmd = "/bin/sh"
+			execCmd.Args = []string{"-c", "while true; do echo X; sleep 0.1; done"}
+			execCmd.BasicProcessCgroup = true
+			factory.configureExecCmd(t, execCmd)
+			defer allocDir.Destroy()
+
+			executor := factory.new(testlog.HCLogger(t))
+			defer executor.Shutdown("SIGKILL", 0)
+
+			_, err := executor.Launch(execCmd)
+			require.NoError(t, err)
+
+			// wait for output, which stops once paused
+			tu.WaitForResult(func() (bool, error) {
+				return testExecCmd.stdout.Len() > 0, fmt.Errorf("no output")
+			}, func(err error) {
+				require.NoError(t, err)
+			})
+			require.NoError(t, executor.Pause())
+			time.Sleep(200 * time.Millisecond)
+			paused := testExecCmd.stdout.Len()
+			time.Sleep(500 * time.Millisecond)
+			require.Equal(t, paused, testExecCmd.stdout.Len())
+
+			// output resumes once thawed
+			require.NoError(t, executor.Resume())
+			tu.WaitForResult(func() (bool, error) {
+				return testExecCmd.stdout.Len() > paused, fmt.Errorf("no output after resume")
+			}, func(err error) {
+				require.NoError(t, err)
+			})
+
+			// paused tasks can still be shut down
+			require.NoError(t, executor.Pause())
+			require.NoError(t, executor.Shutdown("SIGTERM", time.Second))
+			ch := make(chan struct{})
+			go func() {
+				executor.Wait(context.Background())
+				close(ch)
+			}()
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for paused task to shutdown")
+			}
+		})
+	}
+}
+
 func TestExecutor_cmdDevices(t *testing.T) {
 	ci.Parallel(t)
 	input := []*drivers.DeviceConfig{
@@ -780,3 +1074,80 @@ func TestUniversalExecutor_NoCgroup(t *testing.T) {
 	})
 
 }
+
+func TestExecutor_lookupImageBin(t *testing.T) {
+	ci.Parallel(t)
+
+	rootfs, taskDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(rootfs, "usr", "bin"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(rootfs, "srv"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(taskDir, "local"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(rootfs, "srv", "app"), nil, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(taskDir, "local", "tool"), nil, 0755))
+
+	// absolute symlinks resolve inside the image, not on the host
+	require.NoError(t, os.Symlink("/opt/missing-on-host", filepath.Join(rootfs, "usr", "bin", "sh")))
+
+	command := &ExecCommand{Rootfs: rootfs, TaskDir: taskDir, WorkDir: "/srv", Env: []string{"PATH=/usr/bin"}}
+	for bin, exp := range map[string]string{
+		"sh":       "/usr/bin/sh",
+		"./app":    "/srv/app",
+		"/srv/app": "/srv/app",
+		"tool":     "/local/tool",
+	} {
+		command.Cmd = bin
+		path, err := lookupImageBin(command)
+		require.NoError(t, err)
+		require.Equal(t, exp, path)
+	}
+
+	command.Cmd = "app"
+	_, err := lookupImageBin(command)
+	require.EqualError(t, err, "file app not found in image PATH /usr/bin")
+}
+
+func TestUniversalExecutor_configureResourceLimits(t *testing.T) {
+	ci.Parallel(t)
+
+	newConfig := func() *lconfigs.Config {
+		return &lconfigs.Config{Cgroups: &lconfigs.Cgroup{Resources: &lconfigs.Resources{}}}
+	}
+	alloc := mock.Alloc()
+	res := alloc.AllocatedResources.Tasks[alloc.Job.TaskGroups[0].Tasks[0].Name]
+	res.Memory.MemoryMB = 256
+	res.Cpu.CpuShares = 500
+
+	t.Run("memory and weight", func(t *testing.T) {
+		cfg := newConfig()
+		command := &ExecCommand{Resources: &drivers.Resources{NomadResources: res}}
+		require.NoError(t, configureResourceLimits(cfg, command))
+		require.Equal(t, int64(256*1024*1024), cfg.Cgroups.Resources.Memory)
+		require.Zero(t, cfg.Cgroups.Resources.MemoryReservation)
+		require.Equal(t, cgroups.ConvertCPUSharesToCgroupV2Value(500), cfg.Cgroups.Resources.CpuWeight)
+		require.Zero(t, cfg.Cgroups.Resources.CpuQuota)
+	})
+
+	t.Run("memory oversubscription and quota", func(t *testing.T) {
+		res := res.Copy()
+		res.Memory.MemoryMaxMB = 512
+
+		cfg := newConfig()
+		command := &ExecCommand{Resources: &drivers.Resources{
+			NomadResources: res,
+			LinuxResources: &drivers.LinuxResources{CPUPeriod: 100000, CPUQuota: 50000},
+		}}
+		require.NoError(t, configureResourceLimits(cfg, command))
+		require.Equal(t, int64(512*1024*1024), cfg.Cgroups.Resources.Memory)
+		require.Equal(t, int64(256*1024*1024), cfg.Cgroups.Resources.MemoryReservation)
+		require.Equal(t, int64(50000), cfg.Cgroups.Resources.CpuQuota)
+		require.Equal(t, uint64(100000), cfg.Cgroups.Resources.CpuPeriod)
+	})
+
+	t.Run("invalid shares", func(t *testing.T) {
+		res := res.Copy()
+		res.Cpu.CpuShares = 1
+
+		command := &ExecCommand{Resources: &drivers.Resources{NomadResources: res}}
+		require.Error(t, configureResourceLimits(newConfig(), command))
+	})
+}
@@ -288,6 +288,13 @@ func TestExecutor_WaitExitSignal(t *testing.T) {
 						return false, fmt.Errorf("stats failed to send on interval")
 					case ru := <-ch:
 						assert.NotEmpty(t, ru.Pids, "no pids recorded in stats")
+						assert.Len(t, ru.Processes, len(ru.Pids))
+
+						var cmdlines []string
+						for _, p := range ru.Processes {
+							cmdlines = append(cmdlines, strings.Join(p.Cmdline, " "))
+						}
+						assert.Contains(t, cmdlines, "/bin/sleep 10000")
 
 						// just checking we measured something; each executor type has its own abilities,
 						// and e.g. cgroup v2 provides different information than cgroup v1
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/nomad/client/lib/resources"
 	"github.com/hashicorp/nomad/client/taskenv"
 	"github.com/hashicorp/nomad/plugins/drivers"
+	"github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/opencontainers/runc/libcontainer/cgroups/fs2"
 	"github.com/opencontainers/runc/libcontainer/configs"
 	"github.com/opencontainers/runc/libcontainer/specconv"
 )
@@ -107,6 +109,16 @@ func (e *UniversalExecutor) configureResourceContainer(pid int) error {
 		scope := cgutil.CgroupScope(allocID, task)
 		path := filepath.Join("/", cgutil.GetCgroupParent(parent), scope)
 		cfg.Cgroups.Path = path
+
+		// in v2 the resource limits of the task can be enforced on the same
+		// cgroup, which also becomes the source of the task stats
+		if e.commandCfg.ResourceLimits {
+			if err := configureResourceLimits(cfg, e.commandCfg); err != nil {
+				return err
+			}
+			e.cgroupPath = filepath.Join(cgutil.CgroupRoot, path)
+		}
+
 		e.containment = resources.Contain(e.logger, cfg.Cgroups)
 		return e.containment.Apply(pid)
 
@@ -129,6 +141,78 @@ func (e *UniversalExecutor) configureResourceContainer(pid int) error {
 	}
 }
 
+// configureResourceLimits sets the memory and cpu limits of the task on the v2
+// cgroup configuration.
+func configureResourceLimits(cfg *configs.Config, command *ExecCommand) error {
+	if command.Resources == nil || command.Resources.NomadResources == nil {
+		return nil
+	}
+
+	// Total amount of memory allowed to consume
+	res := command.Resources.NomadResources
+	memHard, memSoft := res.Memory.MemoryMaxMB, res.Memory.MemoryMB
+	if memHard <= 0 {
+		memHard = res.Memory.MemoryMB
+		memSoft = 0
+	}
+
+	if memHard > 0 {
+		cfg.Cgroups.Resources.Memory = memHard * 1024 * 1024
+		cfg.Cgroups.Resources.MemoryReservation = memSoft * 1024 * 1024
+	}
+
+	cpuShares := res.Cpu.CpuShares
+	if cpuShares < 2 {
+		return fmt.Errorf("resources.Cpu.CpuShares must be equal to or greater than 2: %v", cpuShares)
+	}
+	cfg.Cgroups.Resources.CpuWeight = cgroups.ConvertCPUSharesToCgroupV2Value(uint64(cpuShares))
+
+	// Set the hard limit on cpu time, if one was requested by the driver
+	if lr := command.Resources.LinuxResources; lr != nil && lr.CPUQuota > 0 {
+		cfg.Cgroups.Resources.CpuQuota = lr.CPUQuota
+		cfg.Cgroups.Resources.CpuPeriod = uint64(lr.CPUPeriod)
+	}
+
+	return nil
+}
+
+// cgroupResourceUsage returns the resource usage of the task cgroup, or nil if
+// the executor is not enforcing resource limits on a v2 cgroup.
+func (e *UniversalExecutor) cgroupResourceUsage() *drivers.ResourceUsage {
+	if e.cgroupPath == "" {
+		return nil
+	}
+
+	mgr, err := fs2.NewManager(nil, e.cgroupPath)
+	if err != nil {
+		e.logger.Warn("failed to create cgroup manager for stats", "error", err)
+		return nil
+	}
+	cgStats, err := mgr.GetStats()
+	if err != nil {
+		e.logger.Warn("error collecting cgroup stats", "error", err)
+		return nil
+	}
+
+	return cgroupResourceUsage(cgStats, ExecutorCgroupV2MeasuredMemStats,
+		e.totalCpuStats, e.userCpuStats, e.systemCpuStats)
+}
+
+// oomKilled returns whether the kernel OOM killer has killed a process in the
+// task cgroup.
+func (e *UniversalExecutor) oomKilled() bool {
+	if e.cgroupPath == "" {
+		return false
+	}
+
+	kills, err := cgutil.OOMKills(e.cgroupPath)
+	if err != nil {
+		e.logger.Warn("failed to read oom kill events", "error", err)
+		return false
+	}
+	return kills > 0
+}
+
 func (e *UniversalExecutor) getAllPids() (resources.PIDs, error) {
 	if e.containment == nil {
 		return getAllPidsByScanning()
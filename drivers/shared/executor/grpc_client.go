@@ -50,6 +50,15 @@ func (c *grpcExecutorClient) Launch(cmd *ExecCommand) (*ProcessState, error) {
 		DefaultPidMode:     cmd.ModePID,
 		DefaultIpcMode:     cmd.ModeIPC,
 		Capabilities:       cmd.Capabilities,
+		SeccompProfile:     cmd.SeccompProfile,
+		DefaultUserMode:    cmd.ModeUser,
+		UsernsHostUid:      cmd.UserNSHostUID,
+		UsernsHostGid:      cmd.UserNSHostGID,
+		UsernsSize:         cmd.UserNSSize,
+		Rootfs:             cmd.Rootfs,
+		WorkDir:            cmd.WorkDir,
+		RestoreFrom:        cmd.RestoreFrom,
+		CriuPath:           cmd.CriuPath,
 	}
 	resp, err := c.client.Launch(ctx, req)
 	if err != nil {
@@ -265,3 +274,31 @@ func (c *grpcExecutorClient) execStreaming(ctx context.Context,
 		}
 	}
 }
+
+func (c *grpcExecutorClient) Checkpoint(dir string) error {
+	ctx := context.Background()
+	req := &proto.CheckpointRequest{Dir: dir}
+	if _, err := c.client.Checkpoint(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *grpcExecutorClient) Pause() error {
+	ctx := context.Background()
+	if _, err := c.client.Pause(ctx, &proto.PauseRequest{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *grpcExecutorClient) Resume() error {
+	ctx := context.Background()
+	if _, err := c.client.Resume(ctx, &proto.ResumeRequest{}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -39,6 +39,15 @@ func (s *grpcExecutorServer) Launch(ctx context.Context, req *proto.LaunchReques
 		ModePID:            req.DefaultPidMode,
 		ModeIPC:            req.DefaultIpcMode,
 		Capabilities:       req.Capabilities,
+		SeccompProfile:     req.SeccompProfile,
+		ModeUser:           req.DefaultUserMode,
+		UserNSHostUID:      req.UsernsHostUid,
+		UserNSHostGID:      req.UsernsHostGid,
+		UserNSSize:         req.UsernsSize,
+		Rootfs:             req.Rootfs,
+		WorkDir:            req.WorkDir,
+		RestoreFrom:        req.RestoreFrom,
+		CriuPath:           req.CriuPath,
 	})
 
 	if err != nil {
@@ -176,3 +185,24 @@ func (s *grpcExecutorServer) ExecStreaming(server proto.Executor_ExecStreamingSe
 		msg.Setup.Command, msg.Setup.Tty,
 		server)
 }
+
+func (s *grpcExecutorServer) Checkpoint(ctx context.Context, req *proto.CheckpointRequest) (*proto.CheckpointResponse, error) {
+	if err := s.impl.Checkpoint(req.Dir); err != nil {
+		return nil, err
+	}
+	return &proto.CheckpointResponse{}, nil
+}
+
+func (s *grpcExecutorServer) Pause(ctx context.Context, req *proto.PauseRequest) (*proto.PauseResponse, error) {
+	if err := s.impl.Pause(); err != nil {
+		return nil, err
+	}
+	return &proto.PauseResponse{}, nil
+}
+
+func (s *grpcExecutorServer) Resume(ctx context.Context, req *proto.ResumeRequest) (*proto.ResumeResponse, error) {
+	if err := s.impl.Resume(); err != nil {
+		return nil, err
+	}
+	return &proto.ResumeResponse{}, nil
+}
@@ -122,9 +122,10 @@ func scanPids(parentPid int, allPids []ps.Process) (map[int]*resources.PID, erro
 	return res, nil
 }
 
-// pidStats returns the resource usage stats per pid
-func (c *pidCollector) pidStats() (map[string]*drivers.ResourceUsage, error) {
+// pidStats returns the resource usage stats and the description of each pid
+func (c *pidCollector) pidStats() (map[string]*drivers.ResourceUsage, map[string]*drivers.ProcessInfo, error) {
 	stats := make(map[string]*drivers.ResourceUsage)
+	processes := make(map[string]*drivers.ProcessInfo)
 	c.pidLock.RLock()
 	pids := make(map[int]*resources.PID, len(c.pids))
 	for k, v := range c.pids {
@@ -154,9 +155,18 @@ func (c *pidCollector) pidStats() (map[string]*drivers.ResourceUsage, error) {
 			cs.Percent = np.StatsTotalCPU.Percent(cpuStats.Total() * float64(time.Second))
 		}
 		stats[strconv.Itoa(pid)] = &drivers.ResourceUsage{MemoryStats: ms, CpuStats: cs}
+
+		info := &drivers.ProcessInfo{}
+		if ppid, err := p.Ppid(); err == nil {
+			info.PPID = int(ppid)
+		}
+		if cmdline, err := p.CmdlineSlice(); err == nil {
+			info.Cmdline = cmdline
+		}
+		processes[strconv.Itoa(pid)] = info
 	}
 
-	return stats, nil
+	return stats, processes, nil
 }
 
 // aggregatedResourceUsage aggregates the resource usage of all the pids and
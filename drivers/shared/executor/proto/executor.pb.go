@@ -46,6 +46,15 @@ type LaunchRequest struct {
 	CpusetCgroup         string                       `protobuf:"bytes,17,opt,name=cpuset_cgroup,json=cpusetCgroup,proto3" json:"cpuset_cgroup,omitempty"`
 	AllowCaps            []string                     `protobuf:"bytes,18,rep,name=allow_caps,json=allowCaps,proto3" json:"allow_caps,omitempty"`
 	Capabilities         []string                     `protobuf:"bytes,19,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	SeccompProfile       string                       `protobuf:"bytes,20,opt,name=seccomp_profile,json=seccompProfile,proto3" json:"seccomp_profile,omitempty"`
+	DefaultUserMode      string                       `protobuf:"bytes,21,opt,name=default_user_mode,json=defaultUserMode,proto3" json:"default_user_mode,omitempty"`
+	UsernsHostUid        uint32                       `protobuf:"varint,22,opt,name=userns_host_uid,json=usernsHostUid,proto3" json:"userns_host_uid,omitempty"`
+	UsernsHostGid        uint32                       `protobuf:"varint,23,opt,name=userns_host_gid,json=usernsHostGid,proto3" json:"userns_host_gid,omitempty"`
+	UsernsSize           uint32                       `protobuf:"varint,24,opt,name=userns_size,json=usernsSize,proto3" json:"userns_size,omitempty"`
+	Rootfs               string                       `protobuf:"bytes,25,opt,name=rootfs,proto3" json:"rootfs,omitempty"`
+	WorkDir              string                       `protobuf:"bytes,26,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	RestoreFrom          string                       `protobuf:"bytes,27,opt,name=restore_from,json=restoreFrom,proto3" json:"restore_from,omitempty"`
+	CriuPath             string                       `protobuf:"bytes,28,opt,name=criu_path,json=criuPath,proto3" json:"criu_path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -209,6 +218,69 @@ func (m *LaunchRequest) GetCapabilities() []string {
 	return nil
 }
 
+func (m *LaunchRequest) GetSeccompProfile() string {
+	if m != nil {
+		return m.SeccompProfile
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetDefaultUserMode() string {
+	if m != nil {
+		return m.DefaultUserMode
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetUsernsHostUid() uint32 {
+	if m != nil {
+		return m.UsernsHostUid
+	}
+	return 0
+}
+
+func (m *LaunchRequest) GetUsernsHostGid() uint32 {
+	if m != nil {
+		return m.UsernsHostGid
+	}
+	return 0
+}
+
+func (m *LaunchRequest) GetUsernsSize() uint32 {
+	if m != nil {
+		return m.UsernsSize
+	}
+	return 0
+}
+
+func (m *LaunchRequest) GetRootfs() string {
+	if m != nil {
+		return m.Rootfs
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetWorkDir() string {
+	if m != nil {
+		return m.WorkDir
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetRestoreFrom() string {
+	if m != nil {
+		return m.RestoreFrom
+	}
+	return ""
+}
+
+func (m *LaunchRequest) GetCriuPath() string {
+	if m != nil {
+		return m.CriuPath
+	}
+	return ""
+}
+
 type LaunchResponse struct {
 	Process              *ProcessState `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -786,11 +858,206 @@ func (m *ExecResponse) GetExitCode() int32 {
 	return 0
 }
 
+type CheckpointRequest struct {
+	Dir                  string   `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointRequest) Reset()         { *m = CheckpointRequest{} }
+func (m *CheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointRequest) ProtoMessage()    {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{16}
+}
+
+func (m *CheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointRequest.Unmarshal(m, b)
+}
+func (m *CheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointRequest.Merge(m, src)
+}
+func (m *CheckpointRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointRequest.Size(m)
+}
+func (m *CheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointRequest proto.InternalMessageInfo
+
+func (m *CheckpointRequest) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+type CheckpointResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointResponse) Reset()         { *m = CheckpointResponse{} }
+func (m *CheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointResponse) ProtoMessage()    {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{17}
+}
+
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointResponse.Unmarshal(m, b)
+}
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
+}
+func (m *CheckpointResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointResponse.Size(m)
+}
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
+
+type PauseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseRequest) Reset()         { *m = PauseRequest{} }
+func (m *PauseRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseRequest) ProtoMessage()    {}
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{18}
+}
+
+func (m *PauseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseRequest.Unmarshal(m, b)
+}
+func (m *PauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseRequest.Merge(m, src)
+}
+func (m *PauseRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseRequest.Size(m)
+}
+func (m *PauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseRequest proto.InternalMessageInfo
+
+type PauseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseResponse) Reset()         { *m = PauseResponse{} }
+func (m *PauseResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseResponse) ProtoMessage()    {}
+func (*PauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{19}
+}
+
+func (m *PauseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseResponse.Unmarshal(m, b)
+}
+func (m *PauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseResponse.Merge(m, src)
+}
+func (m *PauseResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseResponse.Size(m)
+}
+func (m *PauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseResponse proto.InternalMessageInfo
+
+type ResumeRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeRequest) Reset()         { *m = ResumeRequest{} }
+func (m *ResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeRequest) ProtoMessage()    {}
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{20}
+}
+
+func (m *ResumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeRequest.Unmarshal(m, b)
+}
+func (m *ResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeRequest.Merge(m, src)
+}
+func (m *ResumeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeRequest.Size(m)
+}
+func (m *ResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeRequest proto.InternalMessageInfo
+
+type ResumeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeResponse) Reset()         { *m = ResumeResponse{} }
+func (m *ResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeResponse) ProtoMessage()    {}
+func (*ResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66b85426380683f3, []int{21}
+}
+
+func (m *ResumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeResponse.Unmarshal(m, b)
+}
+func (m *ResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeResponse.Merge(m, src)
+}
+func (m *ResumeResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeResponse.Size(m)
+}
+func (m *ResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeResponse proto.InternalMessageInfo
+
 type ProcessState struct {
 	Pid                  int32                `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
 	ExitCode             int32                `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
 	Signal               int32                `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
 	Time                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	OomKilled            bool                 `protobuf:"varint,5,opt,name=oom_killed,json=oomKilled,proto3" json:"oom_killed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -800,7 +1067,7 @@ func (m *ProcessState) Reset()         { *m = ProcessState{} }
 func (m *ProcessState) String() string { return proto.CompactTextString(m) }
 func (*ProcessState) ProtoMessage()    {}
 func (*ProcessState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_66b85426380683f3, []int{16}
+	return fileDescriptor_66b85426380683f3, []int{22}
 }
 
 func (m *ProcessState) XXX_Unmarshal(b []byte) error {
@@ -849,6 +1116,13 @@ func (m *ProcessState) GetTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *ProcessState) GetOomKilled() bool {
+	if m != nil {
+		return m.OomKilled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LaunchRequest)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchRequest")
 	proto.RegisterType((*LaunchResponse)(nil), "hashicorp.nomad.plugins.executor.proto.LaunchResponse")
@@ -866,6 +1140,12 @@ func init() {
 	proto.RegisterType((*SignalResponse)(nil), "hashicorp.nomad.plugins.executor.proto.SignalResponse")
 	proto.RegisterType((*ExecRequest)(nil), "hashicorp.nomad.plugins.executor.proto.ExecRequest")
 	proto.RegisterType((*ExecResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ExecResponse")
+	proto.RegisterType((*CheckpointRequest)(nil), "hashicorp.nomad.plugins.executor.proto.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "hashicorp.nomad.plugins.executor.proto.CheckpointResponse")
+	proto.RegisterType((*PauseRequest)(nil), "hashicorp.nomad.plugins.executor.proto.PauseRequest")
+	proto.RegisterType((*PauseResponse)(nil), "hashicorp.nomad.plugins.executor.proto.PauseResponse")
+	proto.RegisterType((*ResumeRequest)(nil), "hashicorp.nomad.plugins.executor.proto.ResumeRequest")
+	proto.RegisterType((*ResumeResponse)(nil), "hashicorp.nomad.plugins.executor.proto.ResumeResponse")
 	proto.RegisterType((*ProcessState)(nil), "hashicorp.nomad.plugins.executor.proto.ProcessState")
 }
 
@@ -874,73 +1154,90 @@ func init() {
 }
 
 var fileDescriptor_66b85426380683f3 = []byte{
-	// 1058 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xee, 0xc5, 0x89, 0x5f, 0xc6, 0x76, 0xe2, 0x2e, 0xa8, 0x5c, 0x8d, 0x50, 0xcd, 0x21, 0x51,
-	0x0b, 0xca, 0x25, 0x4a, 0xdf, 0x90, 0x90, 0x28, 0x22, 0x2d, 0xa8, 0x52, 0x1a, 0x45, 0x97, 0x42,
-	0x25, 0x3e, 0x70, 0x6c, 0xee, 0xb6, 0xf6, 0x2a, 0xf6, 0xed, 0xb2, 0xbb, 0xe7, 0x04, 0x09, 0x89,
-	0x4f, 0xfc, 0x03, 0x90, 0xf8, 0x31, 0xfc, 0x38, 0xb4, 0x6f, 0x17, 0x3b, 0x2d, 0xd5, 0xb9, 0x88,
-	0x4f, 0xbe, 0x1d, 0x3f, 0xcf, 0xcc, 0xec, 0xce, 0xcc, 0x33, 0x70, 0x27, 0x17, 0x74, 0x41, 0x84,
-	0xdc, 0x95, 0x53, 0x2c, 0x48, 0xbe, 0x4b, 0x2e, 0x48, 0x56, 0x2a, 0x26, 0x76, 0xb9, 0x60, 0x8a,
-	0x55, 0xc7, 0xd8, 0x1c, 0xd1, 0xc7, 0x53, 0x2c, 0xa7, 0x34, 0x63, 0x82, 0xc7, 0x05, 0x9b, 0xe3,
-	0x3c, 0xe6, 0xb3, 0x72, 0x42, 0x0b, 0x19, 0xaf, 0xe2, 0x86, 0xb7, 0x26, 0x8c, 0x4d, 0x66, 0xc4,
-	0x3a, 0x39, 0x2d, 0x5f, 0xee, 0x2a, 0x3a, 0x27, 0x52, 0xe1, 0x39, 0x77, 0x80, 0xc8, 0x11, 0x77,
-	0x7d, 0x78, 0x1b, 0xce, 0x9e, 0x2c, 0x26, 0xfa, 0xbb, 0x09, 0xfd, 0x43, 0x5c, 0x16, 0xd9, 0x34,
-	0x21, 0x3f, 0x97, 0x44, 0x2a, 0x34, 0x80, 0x46, 0x36, 0xcf, 0xc3, 0x60, 0x14, 0x8c, 0x3b, 0x89,
-	0xfe, 0x44, 0x08, 0x36, 0xb1, 0x98, 0xc8, 0x70, 0x63, 0xd4, 0x18, 0x77, 0x12, 0xf3, 0x8d, 0x8e,
-	0xa0, 0x23, 0x88, 0x64, 0xa5, 0xc8, 0x88, 0x0c, 0x1b, 0xa3, 0x60, 0xdc, 0xdd, 0xdf, 0x8b, 0xff,
-	0x2d, 0x71, 0x17, 0xdf, 0x86, 0x8c, 0x13, 0xcf, 0x4b, 0x2e, 0x5d, 0xa0, 0x5b, 0xd0, 0x95, 0x2a,
-	0x67, 0xa5, 0x4a, 0x39, 0x56, 0xd3, 0x70, 0xd3, 0x44, 0x07, 0x6b, 0x3a, 0xc6, 0x6a, 0xea, 0x00,
-	0x44, 0x08, 0x0b, 0xd8, 0xaa, 0x00, 0x44, 0x08, 0x03, 0x18, 0x40, 0x83, 0x14, 0x8b, 0xb0, 0x69,
-	0x92, 0xd4, 0x9f, 0x3a, 0xef, 0x52, 0x12, 0x11, 0xb6, 0x0c, 0xd6, 0x7c, 0xa3, 0x9b, 0xd0, 0x56,
-	0x58, 0x9e, 0xa5, 0x39, 0x15, 0x61, 0xdb, 0xd8, 0x5b, 0xfa, 0xfc, 0x98, 0x0a, 0x74, 0x1b, 0x76,
-	0x7c, 0x3e, 0xe9, 0x8c, 0xce, 0xa9, 0x92, 0x61, 0x67, 0x14, 0x8c, 0xdb, 0xc9, 0xb6, 0x37, 0x1f,
-	0x1a, 0x2b, 0xda, 0x83, 0x77, 0x4f, 0xb1, 0xa4, 0x59, 0xca, 0x05, 0xcb, 0x88, 0x94, 0x69, 0x36,
-	0x11, 0xac, 0xe4, 0x21, 0x18, 0x34, 0x32, 0xff, 0x1d, 0xdb, 0xbf, 0x0e, 0xcc, 0x3f, 0xe8, 0x31,
-	0x34, 0xe7, 0xac, 0x2c, 0x94, 0x0c, 0xbb, 0xa3, 0xc6, 0xb8, 0xbb, 0x7f, 0xa7, 0xe6, 0x53, 0x3d,
-	0xd3, 0xa4, 0xc4, 0x71, 0xd1, 0xb7, 0xd0, 0xca, 0xc9, 0x82, 0xea, 0x17, 0xef, 0x19, 0x37, 0x9f,
-	0xd5, 0x74, 0xf3, 0xd8, 0xb0, 0x12, 0xcf, 0x46, 0x53, 0xb8, 0x5e, 0x10, 0x75, 0xce, 0xc4, 0x59,
-	0x4a, 0x25, 0x9b, 0x61, 0x45, 0x59, 0x11, 0xf6, 0x4d, 0x11, 0xbf, 0xa8, 0xe9, 0xf2, 0xc8, 0xf2,
-	0x9f, 0x7a, 0xfa, 0x09, 0x27, 0x59, 0x32, 0x28, 0xae, 0x58, 0x51, 0x04, 0xfd, 0x82, 0xa5, 0x9c,
-	0x2e, 0x98, 0x4a, 0x05, 0x63, 0x2a, 0xdc, 0x36, 0x6f, 0xd4, 0x2d, 0xd8, 0xb1, 0xb6, 0x25, 0x8c,
-	0x29, 0x34, 0x86, 0x41, 0x4e, 0x5e, 0xe2, 0x72, 0xa6, 0x52, 0x4e, 0xf3, 0x74, 0xce, 0x72, 0x12,
-	0xee, 0x98, 0xd2, 0x6c, 0x3b, 0xfb, 0x31, 0xcd, 0x9f, 0xb1, 0x9c, 0x2c, 0x23, 0x29, 0xcf, 0x2c,
-	0x72, 0xb0, 0x82, 0x7c, 0xca, 0x33, 0x83, 0xfc, 0x08, 0xfa, 0x19, 0x2f, 0x25, 0x51, 0xbe, 0x36,
-	0xd7, 0x0d, 0xac, 0x67, 0x8d, 0xae, 0x2a, 0x1f, 0x00, 0xe0, 0xd9, 0x8c, 0x9d, 0xa7, 0x19, 0xe6,
-	0x32, 0x44, 0xa6, 0x71, 0x3a, 0xc6, 0x72, 0x80, 0xb9, 0x44, 0x11, 0xf4, 0x32, 0xcc, 0xf1, 0x29,
-	0x9d, 0x51, 0x45, 0x89, 0x0c, 0xdf, 0x31, 0x80, 0x15, 0x5b, 0xf4, 0x13, 0x6c, 0xfb, 0xe9, 0x91,
-	0x9c, 0x15, 0x92, 0xa0, 0x23, 0x68, 0xb9, 0xb6, 0x30, 0x23, 0xd4, 0xdd, 0xbf, 0x17, 0xd7, 0x9b,
-	0xe7, 0xd8, 0xb5, 0xcc, 0x89, 0xc2, 0x8a, 0x24, 0xde, 0x49, 0xd4, 0x87, 0xee, 0x0b, 0x4c, 0x95,
-	0x9b, 0xce, 0xe8, 0x47, 0xe8, 0xd9, 0xe3, 0xff, 0x14, 0xee, 0x10, 0x76, 0x4e, 0xa6, 0xa5, 0xca,
-	0xd9, 0x79, 0xe1, 0x05, 0xe1, 0x06, 0x34, 0x25, 0x9d, 0x14, 0x78, 0xe6, 0x34, 0xc1, 0x9d, 0xd0,
-	0x87, 0xd0, 0x9b, 0x08, 0x9c, 0x91, 0x94, 0x13, 0x41, 0x59, 0x1e, 0x6e, 0x8c, 0x82, 0x71, 0x23,
-	0xe9, 0x1a, 0xdb, 0xb1, 0x31, 0x45, 0x08, 0x06, 0x97, 0xde, 0x6c, 0xc6, 0xd1, 0x14, 0x6e, 0x7c,
-	0xc7, 0x73, 0x1d, 0xb4, 0xd2, 0x01, 0x17, 0x68, 0x45, 0x53, 0x82, 0xff, 0xac, 0x29, 0xd1, 0x4d,
-	0x78, 0xef, 0x95, 0x48, 0x2e, 0x89, 0x01, 0x6c, 0x7f, 0x4f, 0x84, 0xa4, 0xcc, 0xdf, 0x32, 0xfa,
-	0x14, 0x76, 0x2a, 0x8b, 0x7b, 0xdb, 0x10, 0x5a, 0x0b, 0x6b, 0x72, 0x37, 0xf7, 0xc7, 0xe8, 0x13,
-	0xe8, 0xe9, 0x77, 0xab, 0x32, 0x1f, 0x42, 0x9b, 0x16, 0x8a, 0x88, 0x85, 0x7b, 0xa4, 0x46, 0x52,
-	0x9d, 0xa3, 0x17, 0xd0, 0x77, 0x58, 0xe7, 0xf6, 0x1b, 0xd8, 0x92, 0xda, 0xb0, 0xe6, 0x15, 0x9f,
-	0x63, 0x79, 0x66, 0x1d, 0x59, 0x7a, 0x74, 0x1b, 0xfa, 0x27, 0xa6, 0x12, 0xaf, 0x2f, 0xd4, 0x96,
-	0x2f, 0x94, 0xbe, 0xac, 0x07, 0xba, 0xeb, 0x9f, 0x41, 0xf7, 0xc9, 0x05, 0xc9, 0x3c, 0xf1, 0x01,
-	0xb4, 0x73, 0x82, 0xf3, 0x19, 0x2d, 0x88, 0x4b, 0x6a, 0x18, 0xdb, 0xe5, 0x12, 0xfb, 0xe5, 0x12,
-	0x3f, 0xf7, 0xcb, 0x25, 0xa9, 0xb0, 0x7e, 0x55, 0x6c, 0xbc, 0xba, 0x2a, 0x1a, 0x97, 0xab, 0x22,
-	0x3a, 0x80, 0x9e, 0x0d, 0xe6, 0xee, 0x7f, 0x03, 0x9a, 0xac, 0x54, 0xbc, 0x54, 0x26, 0x56, 0x2f,
-	0x71, 0x27, 0xf4, 0x3e, 0x74, 0xc8, 0x05, 0x55, 0x69, 0xa6, 0xc7, 0x7a, 0xc3, 0xdc, 0xa0, 0xad,
-	0x0d, 0x07, 0x2c, 0x27, 0xd1, 0xef, 0x01, 0xf4, 0x96, 0x3b, 0x56, 0xc7, 0xe6, 0x34, 0x77, 0x37,
-	0xd5, 0x9f, 0x6f, 0xe4, 0x2f, 0xbd, 0x4d, 0x63, 0xf9, 0x6d, 0x50, 0x0c, 0x9b, 0x7a, 0x6d, 0x9a,
-	0x85, 0xf3, 0xe6, 0x6b, 0x1b, 0xdc, 0xfe, 0x9f, 0x1d, 0x68, 0x3f, 0x71, 0x83, 0x84, 0x7e, 0x81,
-	0xa6, 0x9d, 0x7e, 0x74, 0xbf, 0xee, 0xd4, 0xad, 0xec, 0xda, 0xe1, 0x83, 0x75, 0x69, 0xae, 0x7e,
-	0xd7, 0x90, 0x84, 0x4d, 0xad, 0x03, 0xe8, 0x6e, 0x5d, 0x0f, 0x4b, 0x22, 0x32, 0xbc, 0xb7, 0x1e,
-	0xa9, 0x0a, 0xfa, 0x1b, 0xb4, 0xfd, 0x38, 0xa3, 0x87, 0x75, 0x7d, 0x5c, 0x91, 0x93, 0xe1, 0xe7,
-	0xeb, 0x13, 0xab, 0x04, 0xfe, 0x08, 0x60, 0xe7, 0xca, 0x48, 0xa3, 0x2f, 0xeb, 0xfa, 0x7b, 0xbd,
-	0xea, 0x0c, 0x1f, 0xbd, 0x35, 0xbf, 0x4a, 0xeb, 0x57, 0x68, 0x39, 0xed, 0x40, 0xb5, 0x2b, 0xba,
-	0x2a, 0x3f, 0xc3, 0x87, 0x6b, 0xf3, 0xaa, 0xe8, 0x17, 0xb0, 0x65, 0x74, 0x01, 0xd5, 0x2e, 0xeb,
-	0xb2, 0x76, 0x0d, 0xef, 0xaf, 0xc9, 0xf2, 0x71, 0xf7, 0x02, 0xdd, 0xff, 0x56, 0x58, 0xea, 0xf7,
-	0xff, 0x8a, 0x62, 0xd5, 0xef, 0xff, 0x2b, 0xfa, 0x65, 0xfa, 0x5f, 0x8f, 0x61, 0xfd, 0xfe, 0x5f,
-	0xd2, 0xbb, 0xfa, 0xfd, 0xbf, 0xac, 0x5b, 0xd1, 0x35, 0xf4, 0x57, 0x00, 0x7d, 0x6d, 0x3a, 0x51,
-	0x82, 0xe0, 0x39, 0x2d, 0x26, 0xe8, 0x51, 0x4d, 0xf1, 0xd6, 0x2c, 0x2b, 0xe0, 0x8e, 0xe9, 0x53,
-	0xf9, 0xea, 0xed, 0x1d, 0xf8, 0xb4, 0xc6, 0xc1, 0x5e, 0xf0, 0x75, 0xeb, 0x87, 0x2d, 0xab, 0x59,
-	0x4d, 0xf3, 0x73, 0xf7, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x63, 0xc4, 0xd3, 0x74, 0x0c,
+	// 1330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5b, 0x6f, 0xdc, 0x44,
+	0x14, 0xc6, 0xd9, 0x64, 0x2f, 0x67, 0xaf, 0x19, 0x4a, 0xea, 0xba, 0xa0, 0x2e, 0x46, 0xb4, 0xab,
+	0x52, 0x36, 0x51, 0xda, 0xa6, 0x5c, 0x24, 0x8a, 0x48, 0x2f, 0x54, 0xb4, 0xd5, 0xca, 0x69, 0xa9,
+	0xc4, 0x03, 0xc6, 0xb5, 0x27, 0xbb, 0xa3, 0xd8, 0x1e, 0x33, 0x33, 0xde, 0xa6, 0x15, 0x12, 0x4f,
+	0xbc, 0xf3, 0xc0, 0x03, 0x3f, 0x80, 0xbf, 0xc8, 0x3b, 0x9a, 0x8b, 0x9d, 0xdd, 0xb4, 0x80, 0x37,
+	0x88, 0xa7, 0xf5, 0x7c, 0xf3, 0x9d, 0xcb, 0xcc, 0x99, 0xf3, 0x9d, 0x85, 0x6b, 0x11, 0x23, 0x73,
+	0xcc, 0xf8, 0x36, 0x9f, 0x05, 0x0c, 0x47, 0xdb, 0xf8, 0x18, 0x87, 0xb9, 0xa0, 0x6c, 0x3b, 0x63,
+	0x54, 0xd0, 0x72, 0x39, 0x56, 0x4b, 0x74, 0x79, 0x16, 0xf0, 0x19, 0x09, 0x29, 0xcb, 0xc6, 0x29,
+	0x4d, 0x82, 0x68, 0x9c, 0xc5, 0xf9, 0x94, 0xa4, 0x7c, 0xbc, 0xcc, 0x73, 0x2e, 0x4d, 0x29, 0x9d,
+	0xc6, 0x58, 0x3b, 0x79, 0x9e, 0x1f, 0x6e, 0x0b, 0x92, 0x60, 0x2e, 0x82, 0x24, 0x33, 0x04, 0xd7,
+	0x18, 0x6e, 0x17, 0xe1, 0x75, 0x38, 0xbd, 0xd2, 0x1c, 0xf7, 0xcf, 0x26, 0x74, 0x1f, 0x06, 0x79,
+	0x1a, 0xce, 0x3c, 0xfc, 0x63, 0x8e, 0xb9, 0x40, 0x03, 0xa8, 0x85, 0x49, 0x64, 0x5b, 0x43, 0x6b,
+	0xd4, 0xf2, 0xe4, 0x27, 0x42, 0xb0, 0x1e, 0xb0, 0x29, 0xb7, 0xd7, 0x86, 0xb5, 0x51, 0xcb, 0x53,
+	0xdf, 0xe8, 0x31, 0xb4, 0x18, 0xe6, 0x34, 0x67, 0x21, 0xe6, 0x76, 0x6d, 0x68, 0x8d, 0xda, 0xbb,
+	0x3b, 0xe3, 0xbf, 0x4b, 0xdc, 0xc4, 0xd7, 0x21, 0xc7, 0x5e, 0x61, 0xe7, 0x9d, 0xb8, 0x40, 0x97,
+	0xa0, 0xcd, 0x45, 0x44, 0x73, 0xe1, 0x67, 0x81, 0x98, 0xd9, 0xeb, 0x2a, 0x3a, 0x68, 0x68, 0x12,
+	0x88, 0x99, 0x21, 0x60, 0xc6, 0x34, 0x61, 0xa3, 0x24, 0x60, 0xc6, 0x14, 0x61, 0x00, 0x35, 0x9c,
+	0xce, 0xed, 0xba, 0x4a, 0x52, 0x7e, 0xca, 0xbc, 0x73, 0x8e, 0x99, 0xdd, 0x50, 0x5c, 0xf5, 0x8d,
+	0x2e, 0x40, 0x53, 0x04, 0xfc, 0xc8, 0x8f, 0x08, 0xb3, 0x9b, 0x0a, 0x6f, 0xc8, 0xf5, 0x1d, 0xc2,
+	0xd0, 0x15, 0xe8, 0x17, 0xf9, 0xf8, 0x31, 0x49, 0x88, 0xe0, 0x76, 0x6b, 0x68, 0x8d, 0x9a, 0x5e,
+	0xaf, 0x80, 0x1f, 0x2a, 0x14, 0xed, 0xc0, 0xb9, 0xe7, 0x01, 0x27, 0xa1, 0x9f, 0x31, 0x1a, 0x62,
+	0xce, 0xfd, 0x70, 0xca, 0x68, 0x9e, 0xd9, 0xa0, 0xd8, 0x48, 0xed, 0x4d, 0xf4, 0xd6, 0xbe, 0xda,
+	0x41, 0x77, 0xa0, 0x9e, 0xd0, 0x3c, 0x15, 0xdc, 0x6e, 0x0f, 0x6b, 0xa3, 0xf6, 0xee, 0xb5, 0x8a,
+	0x57, 0xf5, 0x48, 0x1a, 0x79, 0xc6, 0x16, 0xdd, 0x87, 0x46, 0x84, 0xe7, 0x44, 0xde, 0x78, 0x47,
+	0xb9, 0xf9, 0xb8, 0xa2, 0x9b, 0x3b, 0xca, 0xca, 0x2b, 0xac, 0xd1, 0x0c, 0x36, 0x53, 0x2c, 0x5e,
+	0x50, 0x76, 0xe4, 0x13, 0x4e, 0xe3, 0x40, 0x10, 0x9a, 0xda, 0x5d, 0x55, 0xc4, 0xcf, 0x2b, 0xba,
+	0x7c, 0xac, 0xed, 0x1f, 0x14, 0xe6, 0x07, 0x19, 0x0e, 0xbd, 0x41, 0x7a, 0x0a, 0x45, 0x2e, 0x74,
+	0x53, 0xea, 0x67, 0x64, 0x4e, 0x85, 0xcf, 0x28, 0x15, 0x76, 0x4f, 0xdd, 0x51, 0x3b, 0xa5, 0x13,
+	0x89, 0x79, 0x94, 0x0a, 0x34, 0x82, 0x41, 0x84, 0x0f, 0x83, 0x3c, 0x16, 0x7e, 0x46, 0x22, 0x3f,
+	0xa1, 0x11, 0xb6, 0xfb, 0xaa, 0x34, 0x3d, 0x83, 0x4f, 0x48, 0xf4, 0x88, 0x46, 0x78, 0x91, 0x49,
+	0xb2, 0x50, 0x33, 0x07, 0x4b, 0xcc, 0x07, 0x59, 0xa8, 0x98, 0x1f, 0x40, 0x37, 0xcc, 0x72, 0x8e,
+	0x45, 0x51, 0x9b, 0x4d, 0x45, 0xeb, 0x68, 0xd0, 0x54, 0xe5, 0x3d, 0x80, 0x20, 0x8e, 0xe9, 0x0b,
+	0x3f, 0x0c, 0x32, 0x6e, 0x23, 0xf5, 0x70, 0x5a, 0x0a, 0xd9, 0x0f, 0x32, 0x8e, 0x5c, 0xe8, 0x84,
+	0x41, 0x16, 0x3c, 0x27, 0x31, 0x11, 0x04, 0x73, 0xfb, 0x6d, 0x45, 0x58, 0xc2, 0xe4, 0x9b, 0xe1,
+	0x38, 0x0c, 0x69, 0x92, 0xc9, 0xc7, 0x70, 0x48, 0x62, 0x6c, 0x9f, 0xd3, 0x09, 0x19, 0x78, 0xa2,
+	0x51, 0x74, 0x15, 0x36, 0x8b, 0xd4, 0xe5, 0x3b, 0xd4, 0xb9, 0xbf, 0xa3, 0xa8, 0x7d, 0xb3, 0xf1,
+	0x94, 0x63, 0xa6, 0x92, 0xbf, 0x0c, 0x7d, 0xc9, 0x49, 0xb9, 0x3f, 0xa3, 0x5c, 0xf8, 0x39, 0x89,
+	0xec, 0xad, 0xa1, 0x35, 0xea, 0x7a, 0x5d, 0x0d, 0x7f, 0x4d, 0xb9, 0x78, 0x4a, 0xa2, 0xd3, 0xbc,
+	0x29, 0x89, 0xec, 0xf3, 0xa7, 0x79, 0xf7, 0x49, 0x24, 0x5b, 0xc7, 0xf0, 0x38, 0x79, 0x85, 0x6d,
+	0x5b, 0x71, 0x40, 0x43, 0x07, 0xe4, 0x15, 0x46, 0x5b, 0x50, 0x97, 0xc5, 0x39, 0xe4, 0xf6, 0x05,
+	0x95, 0x91, 0x59, 0xc9, 0x66, 0x51, 0x8f, 0x44, 0x36, 0x8b, 0xa3, 0x9b, 0x45, 0xae, 0x65, 0xb3,
+	0xbc, 0x0f, 0x1d, 0x86, 0xb9, 0xa0, 0x0c, 0xfb, 0x87, 0x8c, 0x26, 0xf6, 0x45, 0xb5, 0xdd, 0x36,
+	0xd8, 0x3d, 0x46, 0x13, 0x74, 0x11, 0x5a, 0x21, 0x23, 0xb9, 0xee, 0xd7, 0x77, 0xd5, 0x7e, 0x53,
+	0x02, 0xb2, 0x5b, 0xdd, 0x1f, 0xa0, 0x57, 0xc8, 0x0e, 0xcf, 0x68, 0xca, 0x31, 0x7a, 0x0c, 0x0d,
+	0xd3, 0x4f, 0x4a, 0x7b, 0xda, 0xbb, 0x37, 0xc6, 0xd5, 0x84, 0x70, 0x6c, 0x7a, 0xed, 0x40, 0x04,
+	0x02, 0x7b, 0x85, 0x13, 0xb7, 0x0b, 0xed, 0x67, 0x01, 0x11, 0x46, 0xd6, 0xdc, 0xef, 0xa1, 0xa3,
+	0x97, 0xff, 0x53, 0xb8, 0x87, 0xd0, 0x3f, 0x98, 0xe5, 0x22, 0xa2, 0x2f, 0xd2, 0x42, 0x49, 0xb7,
+	0xa0, 0xce, 0xc9, 0x34, 0x0d, 0x62, 0x23, 0xa6, 0x66, 0x25, 0xef, 0x6e, 0xca, 0x82, 0x10, 0xfb,
+	0x19, 0x66, 0x84, 0x46, 0xf6, 0xda, 0xd0, 0x1a, 0xd5, 0xbc, 0xb6, 0xc2, 0x26, 0x0a, 0x72, 0x11,
+	0x0c, 0x4e, 0xbc, 0xe9, 0x8c, 0xdd, 0x19, 0x6c, 0x3d, 0xcd, 0x22, 0x19, 0xb4, 0x14, 0x50, 0x13,
+	0x68, 0x49, 0x8c, 0xad, 0xff, 0x2c, 0xc6, 0xee, 0x05, 0x38, 0xff, 0x5a, 0x24, 0x93, 0xc4, 0x00,
+	0x7a, 0xdf, 0x62, 0xc6, 0x09, 0x2d, 0x4e, 0xe9, 0x7e, 0x04, 0xfd, 0x12, 0x31, 0x77, 0x6b, 0x43,
+	0x63, 0xae, 0x21, 0x73, 0xf2, 0x62, 0xe9, 0x5e, 0x85, 0x8e, 0xbc, 0xb7, 0x32, 0x73, 0x07, 0x9a,
+	0x24, 0x15, 0x98, 0xcd, 0xcd, 0x25, 0xd5, 0xbc, 0x72, 0xed, 0x3e, 0x83, 0xae, 0xe1, 0x1a, 0xb7,
+	0xf7, 0x60, 0x83, 0x4b, 0x60, 0xc5, 0x23, 0x3e, 0x09, 0xf8, 0x91, 0x76, 0xa4, 0xcd, 0xdd, 0x2b,
+	0xd0, 0x3d, 0x50, 0x95, 0x78, 0x73, 0xa1, 0x36, 0x8a, 0x42, 0xc9, 0xc3, 0x16, 0x44, 0x73, 0xfc,
+	0x23, 0x68, 0xdf, 0x3d, 0xc6, 0x61, 0x61, 0xb8, 0x07, 0xcd, 0x08, 0x07, 0x51, 0x4c, 0x52, 0x6c,
+	0x92, 0x72, 0xc6, 0x7a, 0x2a, 0x8f, 0x8b, 0xa9, 0x3c, 0x7e, 0x52, 0x4c, 0x65, 0xaf, 0xe4, 0x16,
+	0x33, 0x76, 0xed, 0xf5, 0x19, 0x5b, 0x3b, 0x99, 0xb1, 0xee, 0x3e, 0x74, 0x74, 0x30, 0x73, 0xfe,
+	0x2d, 0xa8, 0xd3, 0x5c, 0x64, 0xb9, 0x50, 0xb1, 0x3a, 0x9e, 0x59, 0xc9, 0x46, 0xc3, 0xc7, 0x44,
+	0xf8, 0xa1, 0xd4, 0x94, 0x35, 0x75, 0x82, 0xa6, 0x04, 0xf6, 0x69, 0x84, 0xdd, 0x0f, 0x61, 0x73,
+	0x7f, 0x86, 0xc3, 0xa3, 0x8c, 0x92, 0x54, 0x2c, 0xcc, 0x78, 0xd9, 0xd3, 0x66, 0xc6, 0x47, 0x84,
+	0xb9, 0xe7, 0x00, 0x2d, 0xd2, 0xcc, 0x71, 0x7b, 0xd0, 0x99, 0x04, 0x39, 0xc7, 0x45, 0xad, 0xfb,
+	0xd0, 0x35, 0x6b, 0x43, 0xe8, 0x43, 0xd7, 0xc3, 0x3c, 0x4f, 0x4a, 0xc6, 0x00, 0x7a, 0x05, 0x60,
+	0x28, 0x7f, 0x58, 0xd0, 0x59, 0x6c, 0x19, 0x19, 0x3c, 0x23, 0x91, 0xb9, 0x6a, 0xf9, 0xf9, 0x8f,
+	0x07, 0x58, 0x28, 0x4e, 0x6d, 0xb1, 0x38, 0x68, 0x0c, 0xeb, 0xf2, 0x0f, 0x8f, 0xbd, 0xfe, 0xaf,
+	0xf7, 0xae, 0x78, 0x52, 0xed, 0x29, 0x4d, 0xfc, 0x23, 0x12, 0xc7, 0x38, 0x52, 0xff, 0x1f, 0x9a,
+	0x5e, 0x8b, 0xd2, 0xe4, 0x1b, 0x05, 0xec, 0xfe, 0xda, 0x81, 0xe6, 0x5d, 0xd3, 0xe8, 0xe8, 0x25,
+	0xd4, 0xb5, 0x3a, 0xa1, 0x9b, 0x55, 0x55, 0x61, 0xe9, 0x4f, 0x94, 0xb3, 0xb7, 0xaa, 0x99, 0xb9,
+	0xac, 0xb7, 0x10, 0x87, 0x75, 0xa9, 0x53, 0xe8, 0x7a, 0x55, 0x0f, 0x0b, 0x22, 0xe7, 0xdc, 0x58,
+	0xcd, 0xa8, 0x0c, 0xfa, 0x33, 0x34, 0x0b, 0xb9, 0x41, 0xb7, 0xaa, 0xfa, 0x38, 0x25, 0x77, 0xce,
+	0x27, 0xab, 0x1b, 0x96, 0x09, 0xfc, 0x66, 0x41, 0xff, 0x94, 0xe4, 0xa0, 0x2f, 0xaa, 0xfa, 0x7b,
+	0xb3, 0x2a, 0x3a, 0xb7, 0xcf, 0x6c, 0x5f, 0xa6, 0xf5, 0x13, 0x34, 0x8c, 0xb6, 0xa1, 0xca, 0x15,
+	0x5d, 0x96, 0x47, 0xe7, 0xd6, 0xca, 0x76, 0x65, 0xf4, 0x63, 0xd8, 0x50, 0xba, 0x85, 0x2a, 0x97,
+	0x75, 0x51, 0x5b, 0x9d, 0x9b, 0x2b, 0x5a, 0x15, 0x71, 0x77, 0x2c, 0xf9, 0xfe, 0xb5, 0xf0, 0x55,
+	0x7f, 0xff, 0x4b, 0x8a, 0xea, 0xec, 0xad, 0x6a, 0xb6, 0xf8, 0xfe, 0x65, 0x1b, 0x56, 0x7f, 0xff,
+	0x0b, 0x7a, 0xec, 0xdc, 0x58, 0xcd, 0xa8, 0x0c, 0xfa, 0xbb, 0x05, 0x5d, 0x09, 0x1d, 0x08, 0x86,
+	0x83, 0x84, 0xa4, 0x53, 0x74, 0xbb, 0xe2, 0x70, 0x91, 0x56, 0x7a, 0xc0, 0x18, 0xcb, 0x22, 0x95,
+	0x2f, 0xcf, 0xee, 0xa0, 0x48, 0x6b, 0x64, 0xed, 0x58, 0xe8, 0x17, 0x0b, 0xe0, 0x44, 0x99, 0xd1,
+	0xa7, 0x55, 0x4f, 0xf8, 0x9a, 0xe8, 0x3b, 0x9f, 0x9d, 0xc5, 0xb4, 0xbc, 0xa2, 0x39, 0x6c, 0x28,
+	0xe9, 0xaf, 0xfe, 0x18, 0x17, 0x27, 0x87, 0x73, 0x73, 0x45, 0xab, 0x32, 0xee, 0x4b, 0xa8, 0xeb,
+	0x81, 0x52, 0xfd, 0x29, 0x2e, 0x4d, 0x24, 0x67, 0x6f, 0x55, 0xb3, 0x22, 0xf4, 0x57, 0x8d, 0xef,
+	0x36, 0xf4, 0x34, 0xa9, 0xab, 0x9f, 0xeb, 0x7f, 0x0d, 0x00, 0xc3, 0x23, 0x08, 0xcc, 0xc8, 0x0f,
 	0x00, 0x00,
 }
 
@@ -966,6 +1263,9 @@ type ExecutorClient interface {
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExecStreaming(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecStreamingClient, error)
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error)
 }
 
 type executorClient struct {
@@ -1102,6 +1402,33 @@ func (x *executorExecStreamingClient) Recv() (*proto1.ExecTaskStreamingResponse,
 	return m, nil
 }
 
+func (c *executorClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error) {
+	out := new(PauseResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
+	out := new(ResumeResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.executor.proto.Executor/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecutorServer is the server API for Executor service.
 type ExecutorServer interface {
 	Launch(context.Context, *LaunchRequest) (*LaunchResponse, error)
@@ -1114,6 +1441,9 @@ type ExecutorServer interface {
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExecStreaming(Executor_ExecStreamingServer) error
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	Pause(context.Context, *PauseRequest) (*PauseResponse, error)
+	Resume(context.Context, *ResumeRequest) (*ResumeResponse, error)
 }
 
 // UnimplementedExecutorServer can be embedded to have forward compatible implementations.
@@ -1147,6 +1477,15 @@ func (*UnimplementedExecutorServer) Exec(ctx context.Context, req *ExecRequest)
 func (*UnimplementedExecutorServer) ExecStreaming(srv Executor_ExecStreamingServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExecStreaming not implemented")
 }
+func (*UnimplementedExecutorServer) Checkpoint(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedExecutorServer) Pause(ctx context.Context, req *PauseRequest) (*PauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedExecutorServer) Resume(ctx context.Context, req *ResumeRequest) (*ResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 
 func RegisterExecutorServer(s *grpc.Server, srv ExecutorServer) {
 	s.RegisterService(&_Executor_serviceDesc, srv)
@@ -1325,6 +1664,60 @@ func (x *executorExecStreamingServer) Recv() (*proto1.ExecTaskStreamingRequest,
 	return m, nil
 }
 
+func _Executor_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.executor.proto.Executor/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Executor_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.executor.proto.Executor",
 	HandlerType: (*ExecutorServer)(nil),
@@ -1357,6 +1750,18 @@ var _Executor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Executor_Exec_Handler,
 		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Executor_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Executor_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Executor_Resume_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,132 @@
+//go:build linux
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	lconfigs "github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/seccomp"
+	"github.com/opencontainers/runc/libcontainer/specconv"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+)
+
+// SeccompSupported is true when the executor was built with seccomp support.
+// Without it libcontainer refuses to start containers with a seccomp filter.
+const SeccompSupported = seccomp.Enabled
+
+// defaultSeccompBlocked are the syscalls denied by the default seccomp
+// profile. These are the syscalls Docker's default profile denies to
+// containers without extra capabilities; everything else is allowed.
+var defaultSeccompBlocked = []string{
+	"_sysctl",
+	"acct",
+	"add_key",
+	"bpf",
+	"clock_adjtime",
+	"clock_settime",
+	"create_module",
+	"delete_module",
+	"finit_module",
+	"get_kernel_syms",
+	"get_mempolicy",
+	"init_module",
+	"ioperm",
+	"iopl",
+	"kcmp",
+	"kexec_file_load",
+	"kexec_load",
+	"keyctl",
+	"lookup_dcookie",
+	"mbind",
+	"mount",
+	"move_pages",
+	"name_to_handle_at",
+	"nfsservctl",
+	"open_by_handle_at",
+	"perf_event_open",
+	"pivot_root",
+	"process_vm_readv",
+	"process_vm_writev",
+	"ptrace",
+	"query_module",
+	"quotactl",
+	"reboot",
+	"request_key",
+	"set_mempolicy",
+	"setns",
+	"settimeofday",
+	"stime",
+	"swapoff",
+	"swapon",
+	"sysfs",
+	"umount",
+	"umount2",
+	"unshare",
+	"uselib",
+	"userfaultfd",
+	"ustat",
+	"vm86",
+	"vm86old",
+}
+
+// defaultSeccompProfile returns the built-in seccomp profile. Only the native
+// architecture is allowed, so denied syscalls cannot be reached through a
+// compat ABI.
+func defaultSeccompProfile() *specs.LinuxSeccomp {
+	errno := uint(unix.EPERM)
+	return &specs.LinuxSeccomp{
+		DefaultAction: specs.ActAllow,
+		Syscalls: []specs.LinuxSyscall{
+			{
+				Names:    defaultSeccompBlocked,
+				Action:   specs.ActErrno,
+				ErrnoRet: &errno,
+			},
+		},
+	}
+}
+
+// loadSeccompProfile reads an OCI runtime-spec seccomp profile from path.
+func loadSeccompProfile(path string) (*specs.LinuxSeccomp, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read seccomp profile: %v", err)
+	}
+
+	var profile specs.LinuxSeccomp
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse seccomp profile %q: %v", path, err)
+	}
+	return &profile, nil
+}
+
+// configureSeccomp sets the seccomp filter of the container.
+func configureSeccomp(cfg *lconfigs.Config, command *ExecCommand) error {
+	var profile *specs.LinuxSeccomp
+	switch command.SeccompProfile {
+	case "", SeccompProfileUnconfined:
+		return nil
+	case SeccompProfileDefault:
+		profile = defaultSeccompProfile()
+	default:
+		var err error
+		if profile, err = loadSeccompProfile(command.SeccompProfile); err != nil {
+			return err
+		}
+	}
+
+	if !SeccompSupported {
+		return fmt.Errorf("seccomp profile %q requested but seccomp is not supported by this build", command.SeccompProfile)
+	}
+
+	sc, err := specconv.SetupSeccomp(profile)
+	if err != nil {
+		return fmt.Errorf("invalid seccomp profile: %v", err)
+	}
+	cfg.Seccomp = sc
+	return nil
+}
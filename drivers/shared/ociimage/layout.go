@@ -0,0 +1,67 @@
+package ociimage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// layoutSource reads images from an OCI image layout directory.
+type layoutSource struct {
+	ref *Reference
+}
+
+func newLayoutSource(ref *Reference) *layoutSource {
+	return &layoutSource{ref: ref}
+}
+
+// resolve returns the manifest of index.json whose ref name annotation
+// matches the reference tag. Without a tag, the index must hold exactly one
+// manifest.
+func (l *layoutSource) resolve(_ context.Context) (ocispec.Descriptor, error) {
+	data, err := os.ReadFile(filepath.Join(l.ref.LayoutDir, ocispec.ImageLayoutFile))
+	if err != nil {
+		return ocispec.Descriptor{}, fmt.Errorf("not an OCI image layout: %v", err)
+	}
+	var layout ocispec.ImageLayout
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return ocispec.Descriptor{}, fmt.Errorf("invalid %s: %v", ocispec.ImageLayoutFile, err)
+	}
+	if layout.Version != ocispec.ImageLayoutVersion {
+		return ocispec.Descriptor{}, fmt.Errorf("unsupported image layout version %q", layout.Version)
+	}
+
+	data, err = os.ReadFile(filepath.Join(l.ref.LayoutDir, "index.json"))
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	var index ocispec.Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return ocispec.Descriptor{}, fmt.Errorf("invalid index.json: %v", err)
+	}
+
+	if l.ref.Tag == "" {
+		if len(index.Manifests) != 1 {
+			return ocispec.Descriptor{}, fmt.Errorf("image layout holds %d images, a tag is required", len(index.Manifests))
+		}
+		return index.Manifests[0], nil
+	}
+	for _, m := range index.Manifests {
+		if m.Annotations[ocispec.AnnotationRefName] == l.ref.Tag {
+			return m, nil
+		}
+	}
+	return ocispec.Descriptor{}, fmt.Errorf("tag %q not found in image layout", l.ref.Tag)
+}
+
+func (l *layoutSource) fetch(_ context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, err
+	}
+	return os.Open(filepath.Join(l.ref.LayoutDir, "blobs", desc.Digest.Algorithm().String(), desc.Digest.Encoded()))
+}
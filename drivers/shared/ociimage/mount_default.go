@@ -0,0 +1,18 @@
+//go:build !linux
+
+package ociimage
+
+import (
+	"errors"
+	"io"
+)
+
+var errUnsupported = errors.New("images are only supported on Linux")
+
+func unpackLayer(string, string, io.Reader) error { return errUnsupported }
+
+// Mount is not supported on this platform.
+func (i *Image) Mount(string) (string, error) { return "", errUnsupported }
+
+// Unmount is a no-op on this platform.
+func Unmount(string) error { return nil }
@@ -0,0 +1,57 @@
+//go:build linux
+
+package ociimage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// Mount mounts the image layers as an overlay filesystem under dir and
+// returns the path of the root filesystem. Changes made by the task are
+// written to dir, leaving the cached layers untouched.
+func (i *Image) Mount(dir string) (string, error) {
+	if len(i.Layers) == 0 {
+		return "", fmt.Errorf("image %q has no layers", i.Reference)
+	}
+
+	upper := filepath.Join(dir, "upper")
+	work := filepath.Join(dir, "work")
+	rootfs := filepath.Join(dir, "rootfs")
+	for _, d := range []string{upper, work, rootfs} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			return "", err
+		}
+	}
+
+	// overlayfs lists lower directories from the top layer down
+	lower := make([]string, len(i.Layers))
+	for n, layer := range i.Layers {
+		lower[len(i.Layers)-1-n] = layer
+	}
+
+	opts := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", strings.Join(lower, ":"), upper, work)
+	if len(opts) >= unix.Getpagesize() {
+		return "", fmt.Errorf("image %q has too many layers to mount", i.Reference)
+	}
+	if err := unix.Mount("overlay", rootfs, "overlay", 0, opts); err != nil {
+		return "", fmt.Errorf("failed to mount image %q: %v", i.Reference, err)
+	}
+	return rootfs, nil
+}
+
+// Unmount unmounts the root filesystem mounted under dir by Image.Mount. It
+// is safe to call if nothing is mounted.
+func Unmount(dir string) error {
+	rootfs := filepath.Join(dir, "rootfs")
+	err := unix.Unmount(rootfs, unix.MNT_DETACH)
+	if err != nil && !errors.Is(err, unix.EINVAL) && !errors.Is(err, unix.ENOENT) {
+		return fmt.Errorf("failed to unmount image at %q: %v", rootfs, err)
+	}
+	return nil
+}
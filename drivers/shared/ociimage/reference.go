@@ -0,0 +1,104 @@
+package ociimage
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+)
+
+const (
+	// LayoutPrefix prefixes image references pointing to an OCI image layout
+	// directory on the client, e.g. "oci:/srv/images/redis:7".
+	LayoutPrefix = "oci:"
+
+	// defaultTag is the tag used when an image reference does not have one.
+	defaultTag = "latest"
+
+	// dockerHubHost is the registry serving images hosted on the Docker Hub.
+	dockerHubHost = "registry-1.docker.io"
+)
+
+// Reference is a parsed image reference.
+type Reference struct {
+	// LayoutDir is the OCI image layout directory of the image. It is empty
+	// for images pulled from a registry.
+	LayoutDir string
+
+	// Registry is the host (and port) of the registry serving the image.
+	Registry string
+
+	// Repository is the path of the image in the registry.
+	Repository string
+
+	// Tag is the tag of the image. For images in a layout directory it
+	// matches the image's "org.opencontainers.image.ref.name" annotation.
+	Tag string
+
+	// Digest pins the image manifest, if set.
+	Digest string
+}
+
+// ParseReference parses an image reference. References with the "oci:"
+// prefix point to an OCI image layout directory, optionally followed by a
+// tag. Others are registry references such as "redis:7" or
+// "registry.example.com/team/app@sha256:...".
+func ParseReference(s string) (*Reference, error) {
+	if strings.HasPrefix(s, LayoutPrefix) {
+		return parseLayoutReference(strings.TrimPrefix(s, LayoutPrefix))
+	}
+
+	named, err := reference.ParseNormalizedNamed(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference %q: %v", s, err)
+	}
+
+	ref := &Reference{
+		Registry:   reference.Domain(named),
+		Repository: reference.Path(named),
+	}
+	if ref.Registry == "docker.io" {
+		ref.Registry = dockerHubHost
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		ref.Tag = tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		ref.Digest = digested.Digest().String()
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+	return ref, nil
+}
+
+func parseLayoutReference(s string) (*Reference, error) {
+	dir, tag := s, ""
+	if i := strings.LastIndex(s, ":"); i > strings.LastIndex(s, "/") {
+		dir, tag = s[:i], s[i+1:]
+	}
+	if !filepath.IsAbs(dir) {
+		return nil, fmt.Errorf("image layout path must be absolute, got %q", dir)
+	}
+	return &Reference{LayoutDir: filepath.Clean(dir), Tag: tag}, nil
+}
+
+// String returns the reference in its canonical form.
+func (r *Reference) String() string {
+	if r.LayoutDir != "" {
+		if r.Tag != "" {
+			return LayoutPrefix + r.LayoutDir + ":" + r.Tag
+		}
+		return LayoutPrefix + r.LayoutDir
+	}
+
+	s := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
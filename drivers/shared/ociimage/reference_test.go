@@ -0,0 +1,64 @@
+package ociimage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		ref string
+		exp *Reference
+		err string
+	}{
+		{
+			ref: "redis",
+			exp: &Reference{Registry: "registry-1.docker.io", Repository: "library/redis", Tag: "latest"},
+		},
+		{
+			ref: "registry.example.com:5000/team/app:1.2",
+			exp: &Reference{Registry: "registry.example.com:5000", Repository: "team/app", Tag: "1.2"},
+		},
+		{
+			ref: "ghcr.io/org/app@sha256:8d8f8b2c4a4a1c1f1a3b4b9c6f0f7f1e8f2e4c6a8b0d2f4e6a8c0e2f4a6c8e0a",
+			exp: &Reference{Registry: "ghcr.io", Repository: "org/app", Digest: "sha256:8d8f8b2c4a4a1c1f1a3b4b9c6f0f7f1e8f2e4c6a8b0d2f4e6a8c0e2f4a6c8e0a"},
+		},
+		{
+			ref: "oci:/srv/images/app:v1",
+			exp: &Reference{LayoutDir: "/srv/images/app", Tag: "v1"},
+		},
+		{
+			ref: "oci:/srv/images/app.v1",
+			exp: &Reference{LayoutDir: "/srv/images/app.v1"},
+		},
+		{
+			ref: "oci:images/app",
+			err: `image layout path must be absolute, got "images/app"`,
+		},
+		{
+			ref: "Invalid:Ref",
+			err: `invalid image reference "Invalid:Ref": invalid reference format: repository name must be lowercase`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ref, func(t *testing.T) {
+			ref, err := ParseReference(tc.ref)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, ref)
+
+			// the canonical form parses to the same reference
+			again, err := ParseReference(ref.String())
+			require.NoError(t, err)
+			require.Equal(t, ref, again)
+		})
+	}
+}
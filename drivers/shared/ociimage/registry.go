@@ -0,0 +1,245 @@
+package ociimage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// manifestMediaTypes are the manifest media types accepted from registries.
+var manifestMediaTypes = []string{
+	ocispec.MediaTypeImageIndex,
+	ocispec.MediaTypeImageManifest,
+	mediaTypeDockerManifestList,
+	mediaTypeDockerManifest,
+}
+
+// registrySource pulls images using the OCI distribution API. Only bearer
+// token and basic authentication are supported.
+type registrySource struct {
+	ref    *Reference
+	auth   *Auth
+	client *http.Client
+
+	// token is the bearer token of the last successful authentication
+	token     string
+	tokenLock sync.Mutex
+}
+
+func newRegistrySource(ref *Reference, auth *Auth) *registrySource {
+	return &registrySource{
+		ref:    ref,
+		auth:   auth,
+		client: http.DefaultClient,
+	}
+}
+
+// baseURL returns the URL of the registry API. Like Docker, registries on the
+// loopback interface are reached over plain HTTP.
+func (r *registrySource) baseURL() string {
+	scheme := "https"
+	host := r.ref.Registry
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+		scheme = "http"
+	}
+	return scheme + "://" + r.ref.Registry + "/v2/" + r.ref.Repository
+}
+
+func (r *registrySource) resolve(ctx context.Context) (ocispec.Descriptor, error) {
+	tag := r.ref.Digest
+	if tag == "" {
+		tag = r.ref.Tag
+	}
+
+	resp, err := r.do(ctx, http.MethodHead, "/manifests/"+tag, manifestMediaTypes)
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	resp.Body.Close()
+
+	desc := ocispec.Descriptor{
+		MediaType: strings.TrimSpace(strings.Split(resp.Header.Get("Content-Type"), ";")[0]),
+		Digest:    digest.Digest(resp.Header.Get("Docker-Content-Digest")),
+		Size:      resp.ContentLength,
+	}
+	if r.ref.Digest != "" {
+		desc.Digest = digest.Digest(r.ref.Digest)
+	}
+	if desc.Digest == "" {
+		// the registry did not return the digest, so compute it
+		resp, err := r.do(ctx, http.MethodGet, "/manifests/"+tag, manifestMediaTypes)
+		if err != nil {
+			return ocispec.Descriptor{}, err
+		}
+		defer resp.Body.Close()
+		if desc.Digest, err = digest.FromReader(io.LimitReader(resp.Body, maxManifestSize)); err != nil {
+			return ocispec.Descriptor{}, err
+		}
+	}
+	return desc, nil
+}
+
+func (r *registrySource) fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	for _, mt := range manifestMediaTypes {
+		if desc.MediaType == mt {
+			resp, err := r.do(ctx, http.MethodGet, "/manifests/"+desc.Digest.String(), manifestMediaTypes)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Body, nil
+		}
+	}
+
+	resp, err := r.do(ctx, http.MethodGet, "/blobs/"+desc.Digest.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// do performs a request against the repository, authenticating once if the
+// registry requires it.
+func (r *registrySource) do(ctx context.Context, method, path string, accept []string) (*http.Response, error) {
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, r.baseURL()+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+		r.tokenLock.Lock()
+		token := r.token
+		r.tokenLock.Unlock()
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else if r.auth != nil && r.auth.Username != "" {
+			req.SetBasicAuth(r.auth.Username, r.auth.Password)
+		}
+		return req, nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := r.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if req, err = newRequest(); err != nil {
+			return nil, err
+		}
+		if resp, err = r.client.Do(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected response from registry for %s: %s", path, resp.Status)
+	}
+	return resp, nil
+}
+
+// authenticate requests a bearer token as described by a WWW-Authenticate
+// challenge.
+func (r *registrySource) authenticate(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") {
+		return fmt.Errorf("registry requires unsupported authentication %q", scheme)
+	}
+	if params["realm"] == "" {
+		return fmt.Errorf("registry authentication challenge is missing the realm")
+	}
+
+	u, err := url.Parse(params["realm"])
+	if err != nil {
+		return fmt.Errorf("invalid authentication realm: %v", err)
+	}
+	q := u.Query()
+	if params["service"] != "" {
+		q.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + r.ref.Repository + ":pull"
+	}
+	q.Set("scope", scope)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	if r.auth != nil && r.auth.Username != "" {
+		req.SetBasicAuth(r.auth.Username, r.auth.Password)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to authenticate with registry: %s", resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxManifestSize)).Decode(&body); err != nil {
+		return fmt.Errorf("invalid authentication response: %v", err)
+	}
+
+	r.tokenLock.Lock()
+	defer r.tokenLock.Unlock()
+	r.token = body.Token
+	if r.token == "" {
+		r.token = body.AccessToken
+	}
+	if r.token == "" {
+		return fmt.Errorf("registry did not return a token")
+	}
+	return nil
+}
+
+// parseChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"`.
+func parseChallenge(header string) (string, map[string]string) {
+	params := make(map[string]string)
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			params[key] = value
+		}
+	}
+	return scheme, params
+}
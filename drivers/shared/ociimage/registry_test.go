@@ -0,0 +1,106 @@
+package ociimage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	ocitest "github.com/hashicorp/nomad/drivers/shared/ociimage/testutil"
+	"github.com/hashicorp/nomad/helper/testlog"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// testRegistry serves the images of an OCI layout directory through the
+// distribution API, requiring a bearer token obtained with basic auth.
+func testRegistry(t *testing.T, layoutDir string, manifest ocispec.Descriptor) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			user, pass, _ := r.BasicAuth()
+			if user != "user" || pass != "secret" || r.URL.Query().Get("scope") != "repository:team/app:pull" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`{"token":"t0ken"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer t0ken" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		prefix := "/v2/team/app/"
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		kind, ref, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, prefix), "/")
+		if kind == "manifests" && ref == "v1" {
+			ref = manifest.Digest.String()
+		}
+		algo, encoded, _ := strings.Cut(ref, ":")
+		data, err := os.ReadFile(filepath.Join(layoutDir, "blobs", algo, encoded))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if kind == "manifests" {
+			w.Header().Set("Content-Type", manifest.MediaType)
+			w.Header().Set("Docker-Content-Digest", manifest.Digest.String())
+		}
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestStore_Pull_Registry(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("images are only supported on Linux")
+	}
+
+	layoutDir := t.TempDir()
+	config := ocispec.ImageConfig{Cmd: []string{"/app"}}
+	manifest := ocitest.WriteLayout(t, layoutDir, "v1", config,
+		ocitest.Layer(t, ocitest.File{Name: "app", Content: "app"}))
+
+	srv := testRegistry(t, layoutDir, manifest)
+	ref := strings.TrimPrefix(srv.URL, "http://") + "/team/app:v1"
+
+	store := NewStore(t.TempDir(), testlog.HCLogger(t))
+	_, err := store.Pull(context.Background(), ref, nil)
+	require.ErrorContains(t, err, "failed to authenticate with registry: 403 Forbidden")
+
+	image, err := store.Pull(context.Background(), ref, &Auth{Username: "user", Password: "secret"})
+	require.NoError(t, err)
+	require.Equal(t, manifest.Digest.String(), image.Digest)
+	require.Equal(t, config, image.Config)
+	require.Len(t, image.Layers, 1)
+	require.FileExists(t, filepath.Join(image.Layers[0], "app"))
+}
+
+func TestParseChallenge(t *testing.T) {
+	ci.Parallel(t)
+
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/redis:pull"`)
+	require.Equal(t, "Bearer", scheme)
+	require.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/redis:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=registry`)
+	require.Equal(t, "Basic", scheme)
+	require.Equal(t, map[string]string{"realm": "registry"}, params)
+}
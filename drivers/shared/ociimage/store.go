@@ -0,0 +1,336 @@
+// Package ociimage pulls OCI and Docker images from registries or OCI image
+// layout directories, caches their unpacked layers and mounts them as a root
+// filesystem using overlayfs.
+package ociimage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Media types of Docker images, which are laid out like OCI images.
+const (
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerLayer        = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	mediaTypeDockerForeignLayer = "application/vnd.docker.image.rootfs.foreign.diff.tar.gzip"
+)
+
+// maxManifestSize bounds the size of manifests and configs read into memory.
+const maxManifestSize = 4 << 20
+
+// Auth holds the credentials used to pull from a registry.
+type Auth struct {
+	Username string
+	Password string
+}
+
+// Image is a pulled image whose layers are unpacked in the store.
+type Image struct {
+	// Reference is the reference the image was pulled with.
+	Reference string
+
+	// Digest is the digest of the image manifest.
+	Digest string
+
+	// Config is the runtime configuration of the image.
+	Config ocispec.ImageConfig
+
+	// Layers are the unpacked layer directories, from the bottom layer up.
+	Layers []string
+}
+
+// source fetches the content of an image.
+type source interface {
+	// resolve returns the descriptor of the manifest or index referenced.
+	resolve(ctx context.Context) (ocispec.Descriptor, error)
+
+	// fetch returns the content of desc, which the caller verifies.
+	fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error)
+}
+
+// Store pulls images and caches their unpacked layers on disk. Layers are
+// shared between images and kept until evicted by Evict.
+type Store struct {
+	dir    string
+	logger hclog.Logger
+
+	// locks serializes unpacking of each layer
+	locks     map[digest.Digest]*sync.Mutex
+	locksLock sync.Mutex
+}
+
+// NewStore returns a store caching layers in dir.
+func NewStore(dir string, logger hclog.Logger) *Store {
+	return &Store{
+		dir:    dir,
+		logger: logger.Named("ociimage"),
+		locks:  make(map[digest.Digest]*sync.Mutex),
+	}
+}
+
+// Pull fetches the image referenced by ref, unpacking layers missing from the
+// store. Only the image for the client's platform is pulled from indexes.
+func (s *Store) Pull(ctx context.Context, ref string, auth *Auth) (*Image, error) {
+	r, err := ParseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var src source
+	if r.LayoutDir != "" {
+		src = newLayoutSource(r)
+	} else {
+		src = newRegistrySource(r, auth)
+	}
+
+	desc, err := src.resolve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve image %q: %v", ref, err)
+	}
+
+	manifest, desc, err := s.manifest(ctx, src, desc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest of image %q: %v", ref, err)
+	}
+
+	var config ocispec.Image
+	if err := fetchJSON(ctx, src, manifest.Config, &config); err != nil {
+		return nil, fmt.Errorf("failed to fetch config of image %q: %v", ref, err)
+	}
+
+	image := &Image{
+		Reference: r.String(),
+		Digest:    desc.Digest.String(),
+		Config:    config.Config,
+	}
+	for _, layer := range manifest.Layers {
+		dir, err := s.layer(ctx, src, layer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch layer %s of image %q: %v", layer.Digest, ref, err)
+		}
+		image.Layers = append(image.Layers, dir)
+	}
+	return image, nil
+}
+
+// manifest returns the image manifest described by desc, selecting the
+// manifest for the client's platform if desc is an index.
+func (s *Store) manifest(ctx context.Context, src source, desc ocispec.Descriptor) (*ocispec.Manifest, ocispec.Descriptor, error) {
+	for {
+		switch desc.MediaType {
+		case ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList:
+			var index ocispec.Index
+			if err := fetchJSON(ctx, src, desc, &index); err != nil {
+				return nil, desc, err
+			}
+			platform, err := selectPlatform(index.Manifests)
+			if err != nil {
+				return nil, desc, err
+			}
+			desc = platform
+
+		case ocispec.MediaTypeImageManifest, mediaTypeDockerManifest:
+			var manifest ocispec.Manifest
+			if err := fetchJSON(ctx, src, desc, &manifest); err != nil {
+				return nil, desc, err
+			}
+			return &manifest, desc, nil
+
+		default:
+			return nil, desc, fmt.Errorf("unsupported manifest media type %q", desc.MediaType)
+		}
+	}
+}
+
+// selectPlatform returns the manifest matching the client's platform.
+func selectPlatform(manifests []ocispec.Descriptor) (ocispec.Descriptor, error) {
+	for _, m := range manifests {
+		if m.Platform == nil {
+			continue
+		}
+		if m.Platform.OS == "linux" && m.Platform.Architecture == runtime.GOARCH {
+			return m, nil
+		}
+	}
+	return ocispec.Descriptor{}, fmt.Errorf("no image found for platform linux/%s", runtime.GOARCH)
+}
+
+// layer returns the directory of the unpacked layer, unpacking it if it is
+// not in the store yet.
+func (s *Store) layer(ctx context.Context, src source, desc ocispec.Descriptor) (string, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(s.dir, "layers", desc.Digest.Algorithm().String(), desc.Digest.Encoded())
+
+	lock := s.layerLock(desc.Digest)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, err := os.Stat(dir); err == nil {
+		return dir, touch(dir)
+	}
+
+	s.logger.Debug("fetching layer", "digest", desc.Digest, "size", desc.Size)
+
+	rc, err := src.fetch(ctx, desc)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	// unpack next to the final directory and rename it once complete so
+	// partially unpacked layers are never used
+	if err := os.MkdirAll(filepath.Dir(dir), 0700); err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), ".tmp-"+desc.Digest.Encoded())
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return "", err
+	}
+
+	verifier := desc.Digest.Verifier()
+	if err := unpackLayer(tmp, desc.MediaType, io.TeeReader(rc, verifier)); err != nil {
+		return "", err
+	}
+
+	// drain any trailing data so the whole blob is verified
+	if _, err := io.Copy(verifier, rc); err != nil {
+		return "", err
+	}
+	if !verifier.Verified() {
+		return "", fmt.Errorf("layer content does not match digest %s", desc.Digest)
+	}
+
+	if err := os.Rename(tmp, dir); err != nil {
+		return "", err
+	}
+	return dir, touch(dir)
+}
+
+// touch records that the layer unpacked in dir was used, through the
+// modification time of dir which its content never changes.
+func touch(dir string) error {
+	now := time.Now()
+	return os.Chtimes(dir, now, now)
+}
+
+// Evict removes the layers that are not in inUse and were last pulled
+// longer than maxAge ago, and returns the number of layers removed. inUse
+// holds the layer directories of images that are mounted.
+func (s *Store) Evict(maxAge time.Duration, inUse map[string]struct{}) (int, error) {
+	algs, err := os.ReadDir(filepath.Join(s.dir, "layers"))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-maxAge)
+	removed := 0
+	var mErr error
+	for _, alg := range algs {
+		entries, err := os.ReadDir(filepath.Join(s.dir, "layers", alg.Name()))
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+			continue
+		}
+		for _, entry := range entries {
+			d := digest.NewDigestFromEncoded(digest.Algorithm(alg.Name()), entry.Name())
+			if d.Validate() != nil {
+				// layers being unpacked
+				continue
+			}
+
+			dir := filepath.Join(s.dir, "layers", alg.Name(), entry.Name())
+			if _, ok := inUse[dir]; ok {
+				continue
+			}
+
+			ok, err := s.evictLayer(d, dir, cutoff)
+			if err != nil {
+				mErr = multierror.Append(mErr, err)
+			} else if ok {
+				removed++
+			}
+		}
+	}
+	return removed, mErr
+}
+
+// evictLayer removes the layer unpacked in dir if it was last used before
+// cutoff, holding the layer lock so it is not pulled concurrently.
+func (s *Store) evictLayer(d digest.Digest, dir string, cutoff time.Time) (bool, error) {
+	lock := s.layerLock(d)
+	lock.Lock()
+	defer lock.Unlock()
+
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return false, err
+	}
+	if !fi.ModTime().Before(cutoff) {
+		return false, nil
+	}
+
+	s.logger.Debug("evicting layer", "digest", d, "last_used", fi.ModTime())
+	if err := os.RemoveAll(dir); err != nil {
+		return false, fmt.Errorf("failed to remove layer %s: %v", d, err)
+	}
+	return true, nil
+}
+
+func (s *Store) layerLock(d digest.Digest) *sync.Mutex {
+	s.locksLock.Lock()
+	defer s.locksLock.Unlock()
+	lock, ok := s.locks[d]
+	if !ok {
+		lock = new(sync.Mutex)
+		s.locks[d] = lock
+	}
+	return lock
+}
+
+// fetchJSON fetches desc from src, verifies its digest and decodes it into v.
+func fetchJSON(ctx context.Context, src source, desc ocispec.Descriptor, v interface{}) error {
+	if err := desc.Digest.Validate(); err != nil {
+		return err
+	}
+
+	rc, err := src.fetch(ctx, desc)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxManifestSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxManifestSize {
+		return fmt.Errorf("content of %s exceeds %d bytes", desc.Digest, maxManifestSize)
+	}
+	if desc.Digest.Algorithm().FromBytes(data) != desc.Digest {
+		return fmt.Errorf("content does not match digest %s", desc.Digest)
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,139 @@
+package ociimage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	ocitest "github.com/hashicorp/nomad/drivers/shared/ociimage/testutil"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_Pull_Layout(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("images are only supported on Linux")
+	}
+
+	layoutDir := t.TempDir()
+	config := ocispec.ImageConfig{
+		Entrypoint: []string{"/bin/app"},
+		Env:        []string{"PATH=/bin"},
+		WorkingDir: "/srv",
+	}
+	desc := ocitest.WriteLayout(t, layoutDir, "v1", config,
+		ocitest.Layer(t, ocitest.File{Name: "bin/"}, ocitest.File{Name: "bin/app", Content: "app"}),
+		ocitest.Layer(t, ocitest.File{Name: "srv/"}, ocitest.File{Name: "srv/data", Content: "data"}),
+	)
+
+	store := NewStore(t.TempDir(), testlog.HCLogger(t))
+	image, err := store.Pull(context.Background(), "oci:"+layoutDir+":v1", nil)
+	require.NoError(t, err)
+	require.Equal(t, desc.Digest.String(), image.Digest)
+	require.Equal(t, config, image.Config)
+	require.Len(t, image.Layers, 2)
+
+	content, err := os.ReadFile(filepath.Join(image.Layers[0], "bin", "app"))
+	require.NoError(t, err)
+	require.Equal(t, "app", string(content))
+
+	// layers are served from the cache once unpacked
+	require.NoError(t, os.RemoveAll(filepath.Join(layoutDir, "blobs", "sha256", filepath.Base(image.Layers[1]))))
+	cached, err := store.Pull(context.Background(), "oci:"+layoutDir+":v1", nil)
+	require.NoError(t, err)
+	require.Equal(t, image.Layers, cached.Layers)
+
+	_, err = store.Pull(context.Background(), "oci:"+layoutDir+":v2", nil)
+	require.EqualError(t, err, `failed to resolve image "oci:`+layoutDir+`:v2": tag "v2" not found in image layout`)
+}
+
+func TestStore_Pull_CorruptLayer(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("images are only supported on Linux")
+	}
+
+	layoutDir := t.TempDir()
+	layer := ocitest.Layer(t, ocitest.File{Name: "app", Content: "app"})
+	ocitest.WriteLayout(t, layoutDir, "latest", ocispec.ImageConfig{}, layer)
+
+	// replace the layer with a valid tarball of different content
+	blob := filepath.Join(layoutDir, "blobs", "sha256", digest.FromBytes(layer).Encoded())
+	require.NoError(t, os.WriteFile(blob, ocitest.Layer(t, ocitest.File{Name: "app", Content: "evil"}), 0644))
+
+	storeDir := t.TempDir()
+	store := NewStore(storeDir, testlog.HCLogger(t))
+	_, err := store.Pull(context.Background(), "oci:"+layoutDir, nil)
+	require.ErrorContains(t, err, "layer content does not match digest")
+
+	entries, err := os.ReadDir(filepath.Join(storeDir, "layers", "sha256"))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestStore_Evict(t *testing.T) {
+	ci.Parallel(t)
+	if runtime.GOOS != "linux" {
+		t.Skip("images are only supported on Linux")
+	}
+
+	layoutDir := t.TempDir()
+	ocitest.WriteLayout(t, layoutDir, "v1", ocispec.ImageConfig{},
+		ocitest.Layer(t, ocitest.File{Name: "a", Content: "a"}),
+		ocitest.Layer(t, ocitest.File{Name: "b", Content: "b"}),
+		ocitest.Layer(t, ocitest.File{Name: "c", Content: "c"}),
+	)
+
+	store := NewStore(t.TempDir(), testlog.HCLogger(t))
+	image, err := store.Pull(context.Background(), "oci:"+layoutDir+":v1", nil)
+	require.NoError(t, err)
+	require.Len(t, image.Layers, 3)
+
+	// recently pulled layers are kept
+	removed, err := store.Evict(time.Hour, nil)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	// layers last used before the max age are removed unless in use
+	old := time.Now().Add(-2 * time.Hour)
+	for _, dir := range image.Layers[:2] {
+		require.NoError(t, os.Chtimes(dir, old, old))
+	}
+	inUse := map[string]struct{}{image.Layers[1]: {}}
+
+	removed, err = store.Evict(time.Hour, inUse)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	require.NoDirExists(t, image.Layers[0])
+	require.DirExists(t, image.Layers[1])
+	require.DirExists(t, image.Layers[2])
+
+	// evicted layers are unpacked again when pulled
+	image, err = store.Pull(context.Background(), "oci:"+layoutDir+":v1", nil)
+	require.NoError(t, err)
+	require.DirExists(t, image.Layers[0])
+}
+
+func TestSelectPlatform(t *testing.T) {
+	ci.Parallel(t)
+
+	other := ocispec.Descriptor{Digest: "sha256:1", Platform: &ocispec.Platform{OS: "linux", Architecture: "s390x"}}
+	native := ocispec.Descriptor{Digest: "sha256:2", Platform: &ocispec.Platform{OS: "linux", Architecture: runtime.GOARCH}}
+	if runtime.GOARCH == "s390x" {
+		other.Platform.Architecture = "arm64"
+	}
+
+	desc, err := selectPlatform([]ocispec.Descriptor{other, {Digest: "sha256:3"}, native})
+	require.NoError(t, err)
+	require.Equal(t, native, desc)
+
+	_, err = selectPlatform([]ocispec.Descriptor{other})
+	require.EqualError(t, err, "no image found for platform linux/"+runtime.GOARCH)
+}
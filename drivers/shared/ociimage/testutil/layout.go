@@ -0,0 +1,106 @@
+// Package testutil builds OCI images for tests.
+package testutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// File is a file of a test layer.
+type File struct {
+	// Name is the path of the file. Directories end with a slash.
+	Name string
+
+	// Content is the content of regular files.
+	Content string
+
+	// Linkname makes the file a symlink to Linkname.
+	Linkname string
+
+	// Mode is the permissions of the file, defaulting to 0644.
+	Mode int64
+}
+
+// Layer returns a gzipped layer tarball holding files.
+func Layer(t *testing.T, files ...File) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		mode := f.Mode
+		if mode == 0 {
+			mode = 0644
+		}
+		hdr := &tar.Header{Name: f.Name, Mode: mode, Size: int64(len(f.Content)), Typeflag: tar.TypeReg}
+		switch {
+		case f.Linkname != "":
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, f.Linkname, 0
+		case f.Name[len(f.Name)-1] == '/':
+			hdr.Typeflag, hdr.Mode = tar.TypeDir, 0755
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err := tw.Write([]byte(f.Content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// WriteLayout writes an OCI image layout holding one image tagged tag, built
+// from layers, to dir and returns the manifest descriptor.
+func WriteLayout(t *testing.T, dir, tag string, config ocispec.ImageConfig, layers ...[]byte) ocispec.Descriptor {
+	blobs := filepath.Join(dir, "blobs", "sha256")
+	require.NoError(t, os.MkdirAll(blobs, 0755))
+
+	writeBlob := func(mediaType string, data []byte) ocispec.Descriptor {
+		d := digest.FromBytes(data)
+		require.NoError(t, os.WriteFile(filepath.Join(blobs, d.Encoded()), data, 0644))
+		return ocispec.Descriptor{MediaType: mediaType, Digest: d, Size: int64(len(data))}
+	}
+	writeJSON := func(mediaType string, v interface{}) ocispec.Descriptor {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return writeBlob(mediaType, data)
+	}
+
+	manifest := ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config: writeJSON(ocispec.MediaTypeImageConfig, ocispec.Image{
+			OS:           "linux",
+			Architecture: runtime.GOARCH,
+			Config:       config,
+		}),
+	}
+	for _, layer := range layers {
+		manifest.Layers = append(manifest.Layers, writeBlob(ocispec.MediaTypeImageLayerGzip, layer))
+	}
+
+	desc := writeJSON(ocispec.MediaTypeImageManifest, manifest)
+	desc.Annotations = map[string]string{ocispec.AnnotationRefName: tag}
+
+	index := ocispec.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Manifests: []ocispec.Descriptor{desc},
+	}
+	data, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.json"), data, 0644))
+
+	data, err = json.Marshal(ocispec.ImageLayout{Version: ocispec.ImageLayoutVersion})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ocispec.ImageLayoutFile), data, 0644))
+	return desc
+}
@@ -0,0 +1,229 @@
+//go:build linux
+
+package ociimage
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// whiteoutPrefix marks a file deleted from the layers below.
+	whiteoutPrefix = ".wh."
+
+	// whiteoutOpaque marks a directory whose content in the layers below
+	// is hidden.
+	whiteoutOpaque = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// unpackLayer extracts a layer tarball into dir, converting OCI whiteouts
+// into their overlayfs representation.
+func unpackLayer(dir, mediaType string, r io.Reader) error {
+	switch mediaType {
+	case ocispec.MediaTypeImageLayerGzip, ocispec.MediaTypeImageLayerNonDistributableGzip,
+		mediaTypeDockerLayer, mediaTypeDockerForeignLayer:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	case ocispec.MediaTypeImageLayer, ocispec.MediaTypeImageLayerNonDistributable:
+	default:
+		return fmt.Errorf("unsupported layer media type %q", mediaType)
+	}
+
+	// directory times are restored once their content is written
+	type dirTimes struct {
+		path  string
+		mtime time.Time
+	}
+	var dirs []dirTimes
+
+	tr := tar.NewReader(bufio.NewReader(r))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		path, err := layerPath(dir, hdr.Name)
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			continue
+		}
+
+		base := filepath.Base(path)
+		parent := filepath.Dir(path)
+		if err := os.MkdirAll(parent, 0755); err != nil {
+			return err
+		}
+
+		switch {
+		case base == whiteoutOpaque:
+			if err := unix.Setxattr(parent, "trusted.overlay.opaque", []byte("y"), 0); err != nil {
+				return fmt.Errorf("failed to mark %q opaque: %v", parent, err)
+			}
+			continue
+		case strings.HasPrefix(base, whiteoutPrefix):
+			target, err := whiteoutPath(dir, hdr.Name, strings.TrimPrefix(base, whiteoutPrefix))
+			if err != nil {
+				return err
+			}
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			if err := unix.Mknod(target, unix.S_IFCHR, 0); err != nil {
+				return fmt.Errorf("failed to create whiteout %q: %v", target, err)
+			}
+			continue
+		}
+
+		// entries replace what an earlier entry of the layer created, except
+		// directories which are merged
+		if fi, err := os.Lstat(path); err == nil && !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+		}
+
+		mode := uint32(hdr.Mode & 07777)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.Mkdir(path, 0755); err != nil && !errors.Is(err, os.ErrExist) {
+				return err
+			}
+			dirs = append(dirs, dirTimes{path: path, mtime: hdr.ModTime})
+		case tar.TypeReg, tar.TypeRegA:
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, path); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			target, err := layerPath(dir, hdr.Linkname)
+			if err != nil {
+				return err
+			}
+			if err := os.Link(target, path); err != nil {
+				return err
+			}
+			// a hard link shares the metadata of its target
+			continue
+		case tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+			devMode := map[byte]uint32{
+				tar.TypeChar:  unix.S_IFCHR,
+				tar.TypeBlock: unix.S_IFBLK,
+				tar.TypeFifo:  unix.S_IFIFO,
+			}[hdr.Typeflag]
+			dev := int(unix.Mkdev(uint32(hdr.Devmajor), uint32(hdr.Devminor)))
+			if err := unix.Mknod(path, devMode|mode, dev); err != nil {
+				return err
+			}
+		default:
+			// other entries such as pax headers carry no files
+			continue
+		}
+
+		if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeSymlink {
+			if err := unix.Chmod(path, mode); err != nil {
+				return err
+			}
+		}
+		for key, value := range hdr.PAXRecords {
+			if name := strings.TrimPrefix(key, "SCHILY.xattr."); name != key {
+				if err := unix.Lsetxattr(path, name, []byte(value), 0); err != nil && !errors.Is(err, unix.ENOTSUP) {
+					return err
+				}
+			}
+		}
+		if hdr.Typeflag != tar.TypeDir {
+			if err := lutimes(path, hdr.ModTime); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := lutimes(dirs[i].path, dirs[i].mtime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// layerPath returns the path of a tar entry within dir. Entries may not
+// escape dir, either through ".." elements or through symlinks created by
+// earlier entries.
+func layerPath(dir, name string) (string, error) {
+	// rooting the name first drops any leading ".." elements
+	path := filepath.Join(dir, filepath.Clean("/"+name))
+
+	// every parent of the entry must be a real directory within dir
+	for p := filepath.Dir(path); p != dir && strings.HasPrefix(p, dir); p = filepath.Dir(p) {
+		fi, err := os.Lstat(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("layer entry %q is below a symlink", name)
+		}
+	}
+	return path, nil
+}
+
+// whiteoutPath returns the path of the file deleted by the whiteout entry
+// name, where deleted is the name of the whiteout without its prefix. The
+// deleted file must be a sibling of the whiteout within dir.
+func whiteoutPath(dir, name, deleted string) (string, error) {
+	switch {
+	case deleted == "", deleted == ".", deleted == "..", strings.ContainsRune(deleted, filepath.Separator):
+		return "", fmt.Errorf("layer entry %q is an invalid whiteout", name)
+	}
+
+	path, err := layerPath(dir, filepath.Join(filepath.Dir(name), deleted))
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("layer entry %q is an invalid whiteout", name)
+	}
+	return path, nil
+}
+
+func lutimes(path string, mtime time.Time) error {
+	ts := []unix.Timespec{unix.NsecToTimespec(mtime.UnixNano()), unix.NsecToTimespec(mtime.UnixNano())}
+	return unix.UtimesNanoAt(unix.AT_FDCWD, path, ts, unix.AT_SYMLINK_NOFOLLOW)
+}
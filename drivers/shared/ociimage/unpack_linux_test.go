@@ -0,0 +1,150 @@
+//go:build linux
+
+package ociimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/testutil"
+	ocitest "github.com/hashicorp/nomad/drivers/shared/ociimage/testutil"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestUnpackLayer_Whiteouts(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	dir := t.TempDir()
+	layer := ocitest.Layer(t,
+		ocitest.File{Name: "etc/"},
+		ocitest.File{Name: "etc/.wh.passwd"},
+		ocitest.File{Name: "var/"},
+		ocitest.File{Name: "var/.wh..wh..opq"},
+		ocitest.File{Name: "var/new", Content: "new"},
+		ocitest.File{Name: "bin/"},
+		ocitest.File{Name: "bin/sh", Linkname: "/bin/busybox"},
+	)
+	require.NoError(t, unpackLayer(dir, ocispec.MediaTypeImageLayerGzip, bytes.NewReader(layer)))
+
+	var st unix.Stat_t
+	require.NoError(t, unix.Lstat(filepath.Join(dir, "etc", "passwd"), &st))
+	require.Equal(t, uint32(unix.S_IFCHR), st.Mode&unix.S_IFMT)
+	require.Zero(t, st.Rdev)
+
+	opaque := make([]byte, 1)
+	_, err := unix.Getxattr(filepath.Join(dir, "var"), "trusted.overlay.opaque", opaque)
+	require.NoError(t, err)
+	require.Equal(t, "y", string(opaque))
+
+	content, err := os.ReadFile(filepath.Join(dir, "var", "new"))
+	require.NoError(t, err)
+	require.Equal(t, "new", string(content))
+
+	link, err := os.Readlink(filepath.Join(dir, "bin", "sh"))
+	require.NoError(t, err)
+	require.Equal(t, "/bin/busybox", link)
+}
+
+func TestUnpackLayer_Escape(t *testing.T) {
+	ci.Parallel(t)
+
+	outside := t.TempDir()
+	dir := t.TempDir()
+
+	// entries may not be written through symlinks
+	layer := ocitest.Layer(t,
+		ocitest.File{Name: "escape", Linkname: outside},
+		ocitest.File{Name: "escape/file", Content: "evil"},
+	)
+	err := unpackLayer(dir, ocispec.MediaTypeImageLayerGzip, bytes.NewReader(layer))
+	require.EqualError(t, err, `layer entry "escape/file" is below a symlink`)
+	require.NoFileExists(t, filepath.Join(outside, "file"))
+
+	// ".." elements are rooted in the layer
+	layer = ocitest.Layer(t, ocitest.File{Name: "../../file", Content: "evil"})
+	require.NoError(t, unpackLayer(dir, ocispec.MediaTypeImageLayerGzip, bytes.NewReader(layer)))
+	require.FileExists(t, filepath.Join(dir, "file"))
+}
+
+func TestUnpackLayer_WhiteoutEscape(t *testing.T) {
+	ci.Parallel(t)
+
+	for _, name := range []string{".wh...", ".wh..", "usr/.wh...", "usr/.wh.."} {
+		t.Run(name, func(t *testing.T) {
+			// layers are unpacked next to the other cached layers
+			parent := t.TempDir()
+			sibling := filepath.Join(parent, "sibling")
+			require.NoError(t, os.Mkdir(sibling, 0755))
+			dir := filepath.Join(parent, "layer")
+			require.NoError(t, os.Mkdir(dir, 0755))
+
+			layer := ocitest.Layer(t, ocitest.File{Name: name})
+			err := unpackLayer(dir, ocispec.MediaTypeImageLayerGzip, bytes.NewReader(layer))
+			require.EqualError(t, err, fmt.Sprintf("layer entry %q is an invalid whiteout", name))
+			require.DirExists(t, sibling)
+			require.DirExists(t, dir)
+		})
+	}
+}
+
+func TestUnpackLayer_Uncompressed(t *testing.T) {
+	ci.Parallel(t)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "file", Mode: 0755, Size: 4, Typeflag: tar.TypeReg, Uid: os.Getuid(), Gid: os.Getgid()}))
+	_, err := tw.Write([]byte("data"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	dir := t.TempDir()
+	require.NoError(t, unpackLayer(dir, ocispec.MediaTypeImageLayer, &buf))
+
+	fi, err := os.Stat(filepath.Join(dir, "file"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), fi.Mode().Perm())
+
+	err = unpackLayer(dir, "application/vnd.oci.image.layer.v1.tar+zstd", &buf)
+	require.EqualError(t, err, `unsupported layer media type "application/vnd.oci.image.layer.v1.tar+zstd"`)
+}
+
+func TestImage_Mount(t *testing.T) {
+	ci.Parallel(t)
+	testutil.RequireRoot(t)
+
+	lower, upper := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(lower, "a"), []byte("lower"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(lower, "b"), []byte("lower"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(upper, "b"), []byte("upper"), 0644))
+
+	image := &Image{Reference: "test", Layers: []string{lower, upper}}
+	dir := t.TempDir()
+	rootfs, err := image.Mount(dir)
+	require.NoError(t, err)
+	defer Unmount(dir)
+
+	for name, exp := range map[string]string{"a": "lower", "b": "upper"} {
+		content, err := os.ReadFile(filepath.Join(rootfs, name))
+		require.NoError(t, err)
+		require.Equal(t, exp, string(content))
+	}
+
+	// writes go to the task's upper directory, not the layers
+	require.NoError(t, os.WriteFile(filepath.Join(rootfs, "a"), []byte("task"), 0644))
+	content, err := os.ReadFile(filepath.Join(lower, "a"))
+	require.NoError(t, err)
+	require.Equal(t, "lower", string(content))
+	require.FileExists(t, filepath.Join(dir, "upper", "a"))
+
+	require.NoError(t, Unmount(dir))
+	require.NoFileExists(t, filepath.Join(rootfs, "b"))
+	require.NoError(t, Unmount(dir))
+}
@@ -0,0 +1,9 @@
+package catalog
+
+import "github.com/hashicorp/nomad/devices/sysfs"
+
+// Register the sysfs device plugin, which relies on the linux sysfs and
+// devtmpfs layout, with the builtin plugin catalog.
+func init() {
+	Register(sysfs.PluginID, sysfs.PluginConfig)
+}
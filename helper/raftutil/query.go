@@ -0,0 +1,594 @@
+package raftutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/go-msgpack/codec"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// maxQueryWait is the longest a blocking query waits, which is also the
+	// default wait of the HTTP API.
+	maxQueryWait = 5 * time.Minute
+)
+
+// queryError is an error returned by a QueryServer handler with the HTTP
+// status code it is returned with.
+type queryError struct {
+	code int
+	msg  string
+}
+
+func (e *queryError) Error() string { return e.msg }
+
+func notFound(msg string) error { return &queryError{code: http.StatusNotFound, msg: msg} }
+
+func badRequest(msg string) error { return &queryError{code: http.StatusBadRequest, msg: msg} }
+
+// QueryServer serves a read-only subset of the HTTP API from a state store,
+// such as one restored from a snapshot, so that the CLI can query it as it
+// would query an agent. It serves the jobs, allocations, nodes, evaluations,
+// deployments and namespaces endpoints. As the state store never changes,
+// blocking queries wait until their wait time elapses and return the same
+// results.
+//
+// The QueryServer does not enforce ACLs, so it must only be exposed to the
+// operators allowed to read the snapshot.
+type QueryServer struct {
+	state *state.StateStore
+	index uint64
+	mux   *http.ServeMux
+}
+
+// NewQueryServer returns a QueryServer serving the state store.
+func NewQueryServer(store *state.StateStore) (*QueryServer, error) {
+	index, err := store.LatestIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state index: %v", err)
+	}
+
+	s := &QueryServer{
+		state: store,
+		index: index,
+		mux:   http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("/v1/jobs", s.wrap(s.jobs))
+	s.mux.HandleFunc("/v1/job/", s.wrap(s.jobSpecific))
+	s.mux.HandleFunc("/v1/allocations", s.wrap(s.allocs))
+	s.mux.HandleFunc("/v1/allocation/", s.wrap(s.alloc))
+	s.mux.HandleFunc("/v1/nodes", s.wrap(s.nodes))
+	s.mux.HandleFunc("/v1/node/", s.wrap(s.nodeSpecific))
+	s.mux.HandleFunc("/v1/evaluations", s.wrap(s.evals))
+	s.mux.HandleFunc("/v1/evaluation/", s.wrap(s.evalSpecific))
+	s.mux.HandleFunc("/v1/deployments", s.wrap(s.deployments))
+	s.mux.HandleFunc("/v1/deployment/", s.wrap(s.deploymentSpecific))
+	s.mux.HandleFunc("/v1/namespaces", s.wrap(s.namespaces))
+	s.mux.HandleFunc("/v1/namespace/", s.wrap(s.namespace))
+	return s, nil
+}
+
+// Index returns the index of the state served.
+func (s *QueryServer) Index() uint64 {
+	return s.index
+}
+
+func (s *QueryServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	s.mux.ServeHTTP(resp, req)
+}
+
+// wrap adapts a handler to an http.HandlerFunc which only serves GET
+// requests, waits for blocking queries and encodes the handler results the
+// same way as the agent.
+func (s *QueryServer) wrap(handler func(req *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		obj, err := s.handle(handler, req)
+		var buf bytes.Buffer
+		if err == nil {
+			err = encode(&buf, obj, req)
+		}
+		if err != nil {
+			code := http.StatusInternalServerError
+			var qerr *queryError
+			if errors.As(err, &qerr) {
+				code = qerr.code
+			}
+			resp.WriteHeader(code)
+			resp.Write([]byte(err.Error()))
+			return
+		}
+
+		resp.Header().Set("X-Nomad-Index", strconv.FormatUint(s.index, 10))
+		resp.Header().Set("X-Nomad-LastContact", "0")
+		resp.Header().Set("X-Nomad-KnownLeader", "true")
+		resp.Header().Set("Content-Type", "application/json")
+		resp.Write(buf.Bytes())
+	}
+}
+
+// encode writes the JSON of the object to the buffer, pretty printed if
+// requested.
+func encode(buf *bytes.Buffer, obj interface{}, req *http.Request) error {
+	if v, ok := req.URL.Query()["pretty"]; ok && (len(v[0]) == 0 || v[0] != "0") {
+		if err := codec.NewEncoder(buf, structs.JsonHandlePretty).Encode(obj); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		return nil
+	}
+	return codec.NewEncoder(buf, structs.JsonHandleWithExtensions).Encode(obj)
+}
+
+func (s *QueryServer) handle(handler func(req *http.Request) (interface{}, error), req *http.Request) (interface{}, error) {
+	if req.Method != http.MethodGet {
+		return nil, &queryError{code: http.StatusMethodNotAllowed, msg: "Invalid method"}
+	}
+	if err := s.block(req); err != nil {
+		return nil, err
+	}
+	return handler(req)
+}
+
+// block waits for the wait time of a blocking query whose index is at or
+// past the index of the state, since the state never changes.
+func (s *QueryServer) block(req *http.Request) error {
+	query := req.URL.Query()
+
+	raw := query.Get("index")
+	if raw == "" {
+		return nil
+	}
+	index, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return badRequest("Invalid index")
+	}
+	if index < s.index {
+		return nil
+	}
+
+	wait := maxQueryWait
+	if raw := query.Get("wait"); raw != "" {
+		wait, err = time.ParseDuration(raw)
+		if err != nil {
+			return badRequest("Invalid wait time")
+		}
+		if wait > maxQueryWait {
+			wait = maxQueryWait
+		}
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-req.Context().Done():
+	}
+	return nil
+}
+
+// queryArgs are the arguments of list requests.
+type queryArgs struct {
+	namespace string
+	prefix    string
+	filter    *bexpr.Evaluator
+}
+
+func parseQueryArgs(req *http.Request) (*queryArgs, error) {
+	query := req.URL.Query()
+	args := &queryArgs{
+		namespace: query.Get("namespace"),
+		prefix:    query.Get("prefix"),
+	}
+	if args.namespace == "" {
+		args.namespace = structs.DefaultNamespace
+	}
+
+	if filter := query.Get("filter"); filter != "" {
+		eval, err := bexpr.CreateEvaluator(filter)
+		if err != nil {
+			return nil, badRequest(fmt.Sprintf("failed to read filter expression: %v", err))
+		}
+		args.filter = eval
+	}
+	return args, nil
+}
+
+// matches returns whether an object with the given namespace and ID is
+// selected by the namespace and prefix of the request.
+func (a *queryArgs) matches(namespace, id string) bool {
+	if a.namespace != structs.AllNamespacesSentinel && namespace != a.namespace {
+		return false
+	}
+	return strings.HasPrefix(id, a.prefix)
+}
+
+// include returns whether the output object is selected by the filter of the
+// request.
+func (a *queryArgs) include(obj interface{}) (bool, error) {
+	if a.filter == nil {
+		return true, nil
+	}
+	ok, err := a.filter.Evaluate(obj)
+	if err != nil {
+		return false, badRequest(fmt.Sprintf("failed to evaluate filter expression: %v", err))
+	}
+	return ok, nil
+}
+
+// requestNamespace returns the namespace of a request for a single object.
+func requestNamespace(req *http.Request) string {
+	if ns := req.URL.Query().Get("namespace"); ns != "" {
+		return ns
+	}
+	return structs.DefaultNamespace
+}
+
+// all returns whether the "all" query parameter is set to true.
+func all(req *http.Request) bool {
+	return req.URL.Query().Get("all") == "true"
+}
+
+// list collects the objects of an iterator which match the request, converted
+// by the stub function.
+func list(iter memdb.ResultIterator, err error, args *queryArgs,
+	match func(raw interface{}) bool, stub func(raw interface{}) (interface{}, error)) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]interface{}, 0)
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		if !match(raw) {
+			continue
+		}
+		obj, err := stub(raw)
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := args.include(obj); err != nil {
+			return nil, err
+		} else if ok {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func (s *QueryServer) jobs(req *http.Request) (interface{}, error) {
+	args, err := parseQueryArgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := s.state.Jobs(nil)
+	return list(iter, err, args,
+		func(raw interface{}) bool {
+			job := raw.(*structs.Job)
+			return args.matches(job.Namespace, job.ID)
+		},
+		func(raw interface{}) (interface{}, error) {
+			job := raw.(*structs.Job)
+			summary, err := s.state.JobSummaryByID(nil, job.Namespace, job.ID)
+			if err != nil || summary == nil {
+				return nil, fmt.Errorf("unable to look up summary for job: %v", job.ID)
+			}
+			return job.Stub(summary), nil
+		})
+}
+
+func (s *QueryServer) jobSpecific(req *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/job/")
+	switch {
+	case strings.HasSuffix(path, "/allocations"):
+		return s.jobAllocs(req, strings.TrimSuffix(path, "/allocations"))
+	case strings.HasSuffix(path, "/evaluations"):
+		return s.jobEvals(req, strings.TrimSuffix(path, "/evaluations"))
+	case strings.HasSuffix(path, "/summary"):
+		return s.jobSummary(req, strings.TrimSuffix(path, "/summary"))
+	case strings.HasSuffix(path, "/versions"):
+		return s.jobVersions(req, strings.TrimSuffix(path, "/versions"))
+	case strings.HasSuffix(path, "/deployments"):
+		return s.jobDeployments(req, strings.TrimSuffix(path, "/deployments"))
+	case strings.HasSuffix(path, "/deployment"):
+		return s.jobLatestDeployment(req, strings.TrimSuffix(path, "/deployment"))
+	default:
+		return s.job(req, path)
+	}
+}
+
+func (s *QueryServer) job(req *http.Request, jobID string) (interface{}, error) {
+	job, err := s.state.JobByID(nil, requestNamespace(req), jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, notFound("job not found")
+	}
+	return job, nil
+}
+
+func (s *QueryServer) jobAllocs(req *http.Request, jobID string) (interface{}, error) {
+	allocs, err := s.state.AllocsByJob(nil, requestNamespace(req), jobID, all(req))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*structs.AllocListStub, 0, len(allocs))
+	for _, alloc := range allocs {
+		out = append(out, alloc.Stub(nil))
+	}
+	return out, nil
+}
+
+func (s *QueryServer) jobEvals(req *http.Request, jobID string) (interface{}, error) {
+	evals, err := s.state.EvalsByJob(nil, requestNamespace(req), jobID)
+	if err != nil {
+		return nil, err
+	}
+	if evals == nil {
+		evals = make([]*structs.Evaluation, 0)
+	}
+	return evals, nil
+}
+
+func (s *QueryServer) jobSummary(req *http.Request, jobID string) (interface{}, error) {
+	summary, err := s.state.JobSummaryByID(nil, requestNamespace(req), jobID)
+	if err != nil {
+		return nil, err
+	}
+	if summary == nil {
+		return nil, notFound("job not found")
+	}
+	return summary, nil
+}
+
+func (s *QueryServer) jobVersions(req *http.Request, jobID string) (interface{}, error) {
+	versions, err := s.state.JobVersionsByID(nil, requestNamespace(req), jobID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, notFound("job versions not found")
+	}
+
+	out := &structs.JobVersionsResponse{Versions: versions}
+	if req.URL.Query().Get("diffs") == "true" {
+		for i := 0; i < len(versions)-1; i++ {
+			old, new := versions[i+1], versions[i]
+			d, err := old.Diff(new, true)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create job diff: %v", err)
+			}
+			out.Diffs = append(out.Diffs, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *QueryServer) jobDeployments(req *http.Request, jobID string) (interface{}, error) {
+	deployments, err := s.state.DeploymentsByJobID(nil, requestNamespace(req), jobID, all(req))
+	if err != nil {
+		return nil, err
+	}
+	if deployments == nil {
+		deployments = make([]*structs.Deployment, 0)
+	}
+	return deployments, nil
+}
+
+func (s *QueryServer) jobLatestDeployment(req *http.Request, jobID string) (interface{}, error) {
+	return s.state.LatestDeploymentByJobID(nil, requestNamespace(req), jobID)
+}
+
+func (s *QueryServer) allocs(req *http.Request) (interface{}, error) {
+	args, err := parseQueryArgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	fields := structs.NewAllocStubFields()
+	fields.Resources = query.Get("resources") == "true"
+	if raw := query.Get("task_states"); raw != "" {
+		fields.TaskStates = raw == "true"
+	}
+
+	iter, err := s.state.Allocs(nil, state.SortDefault)
+	return list(iter, err, args,
+		func(raw interface{}) bool {
+			alloc := raw.(*structs.Allocation)
+			return args.matches(alloc.Namespace, alloc.ID)
+		},
+		func(raw interface{}) (interface{}, error) {
+			return raw.(*structs.Allocation).Stub(fields), nil
+		})
+}
+
+func (s *QueryServer) alloc(req *http.Request) (interface{}, error) {
+	allocID := strings.TrimPrefix(req.URL.Path, "/v1/allocation/")
+	alloc, err := s.state.AllocByID(nil, allocID)
+	if err != nil {
+		return nil, err
+	}
+	if alloc == nil {
+		return nil, notFound("allocation not found")
+	}
+	return alloc, nil
+}
+
+func (s *QueryServer) nodes(req *http.Request) (interface{}, error) {
+	args, err := parseQueryArgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	fields := &structs.NodeStubFields{
+		Resources: query.Get("resources") == "true",
+		OS:        query.Get("os") == "true",
+	}
+
+	// Nodes aren't namespaced
+	iter, err := s.state.Nodes(nil)
+	return list(iter, err, args,
+		func(raw interface{}) bool {
+			return strings.HasPrefix(raw.(*structs.Node).ID, args.prefix)
+		},
+		func(raw interface{}) (interface{}, error) {
+			return raw.(*structs.Node).Stub(fields), nil
+		})
+}
+
+func (s *QueryServer) nodeSpecific(req *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/node/")
+	if strings.HasSuffix(path, "/allocations") {
+		allocs, err := s.state.AllocsByNode(nil, strings.TrimSuffix(path, "/allocations"))
+		if err != nil {
+			return nil, err
+		}
+		if allocs == nil {
+			allocs = make([]*structs.Allocation, 0)
+		}
+		return allocs, nil
+	}
+
+	node, err := s.state.NodeByID(nil, path)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, notFound("node not found")
+	}
+	return node.Sanitize(), nil
+}
+
+func (s *QueryServer) evals(req *http.Request) (interface{}, error) {
+	args, err := parseQueryArgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.URL.Query()
+	jobID, status := query.Get("job"), query.Get("status")
+
+	iter, err := s.state.Evals(nil, state.SortDefault)
+	return list(iter, err, args,
+		func(raw interface{}) bool {
+			eval := raw.(*structs.Evaluation)
+			if jobID != "" && eval.JobID != jobID {
+				return false
+			}
+			if status != "" && eval.Status != status {
+				return false
+			}
+			return args.matches(eval.Namespace, eval.ID)
+		},
+		func(raw interface{}) (interface{}, error) {
+			return raw, nil
+		})
+}
+
+func (s *QueryServer) evalSpecific(req *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/evaluation/")
+	if strings.HasSuffix(path, "/allocations") {
+		allocs, err := s.state.AllocsByEval(nil, strings.TrimSuffix(path, "/allocations"))
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]*structs.AllocListStub, 0, len(allocs))
+		for _, alloc := range allocs {
+			out = append(out, alloc.Stub(nil))
+		}
+		return out, nil
+	}
+
+	eval, err := s.state.EvalByID(nil, path)
+	if err != nil {
+		return nil, err
+	}
+	if eval == nil {
+		return nil, notFound("eval not found")
+	}
+	return eval, nil
+}
+
+func (s *QueryServer) deployments(req *http.Request) (interface{}, error) {
+	args, err := parseQueryArgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := s.state.Deployments(nil, state.SortDefault)
+	return list(iter, err, args,
+		func(raw interface{}) bool {
+			d := raw.(*structs.Deployment)
+			return args.matches(d.Namespace, d.ID)
+		},
+		func(raw interface{}) (interface{}, error) {
+			return raw, nil
+		})
+}
+
+func (s *QueryServer) deploymentSpecific(req *http.Request) (interface{}, error) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/deployment/")
+	if strings.HasPrefix(path, "allocations/") {
+		allocs, err := s.state.AllocsByDeployment(nil, strings.TrimPrefix(path, "allocations/"))
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]*structs.AllocListStub, 0, len(allocs))
+		for _, alloc := range allocs {
+			out = append(out, alloc.Stub(nil))
+		}
+		return out, nil
+	}
+
+	d, err := s.state.DeploymentByID(nil, path)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, notFound("deployment not found")
+	}
+	return d, nil
+}
+
+func (s *QueryServer) namespaces(req *http.Request) (interface{}, error) {
+	args, err := parseQueryArgs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := s.state.Namespaces(nil)
+	return list(iter, err, args,
+		func(raw interface{}) bool {
+			return strings.HasPrefix(raw.(*structs.Namespace).Name, args.prefix)
+		},
+		func(raw interface{}) (interface{}, error) {
+			return raw, nil
+		})
+}
+
+func (s *QueryServer) namespace(req *http.Request) (interface{}, error) {
+	name := strings.TrimPrefix(req.URL.Path, "/v1/namespace/")
+	ns, err := s.state.NamespaceByName(nil, name)
+	if err != nil {
+		return nil, err
+	}
+	if ns == nil {
+		return nil, notFound("namespace not found")
+	}
+	return ns, nil
+}
@@ -0,0 +1,231 @@
+package raftutil
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryServer(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+
+	node := mock.Node()
+	require.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	job := mock.Job()
+	require.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1001, job))
+
+	eval := mock.Eval()
+	eval.JobID = job.ID
+	require.NoError(t, store.UpsertEvals(structs.MsgTypeTestSetup, 1002, []*structs.Evaluation{eval}))
+
+	deployment := mock.Deployment()
+	deployment.JobID = job.ID
+	require.NoError(t, store.UpsertDeployment(1003, deployment))
+
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.NodeID = node.ID
+	alloc.EvalID = eval.ID
+	alloc.DeploymentID = deployment.ID
+	require.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1004, []*structs.Allocation{alloc}))
+
+	srv, err := NewQueryServer(store)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1004), srv.Index())
+
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	client, err := api.NewClient(&api.Config{Address: httpServer.URL})
+	require.NoError(t, err)
+
+	// Jobs
+	jobs, meta, err := client.Jobs().PrefixList(job.ID[:4])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1004), meta.LastIndex)
+	require.Len(t, jobs, 1)
+	require.Equal(t, job.ID, jobs[0].ID)
+	require.NotNil(t, jobs[0].JobSummary)
+
+	jobs, _, err = client.Jobs().List(&api.QueryOptions{Namespace: "other"})
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+
+	jobs, _, err = client.Jobs().List(&api.QueryOptions{Namespace: "*"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	outJob, _, err := client.Jobs().Info(job.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, *outJob.ID)
+
+	_, _, err = client.Jobs().Info("unknown", nil)
+	require.ErrorContains(t, err, "job not found")
+
+	summary, _, err := client.Jobs().Summary(job.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, summary.JobID)
+
+	versions, _, _, err := client.Jobs().Versions(job.ID, true, nil)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+
+	jobAllocs, _, err := client.Jobs().Allocations(job.ID, true, nil)
+	require.NoError(t, err)
+	require.Len(t, jobAllocs, 1)
+	require.Equal(t, alloc.ID, jobAllocs[0].ID)
+
+	jobEvals, _, err := client.Jobs().Evaluations(job.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, jobEvals, 1)
+
+	latest, _, err := client.Jobs().LatestDeployment(job.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, deployment.ID, latest.ID)
+
+	// Allocations
+	allocs, _, err := client.Allocations().List(&api.QueryOptions{
+		Filter: `NodeID == "` + node.ID + `"`,
+	})
+	require.NoError(t, err)
+	require.Len(t, allocs, 1)
+	require.Equal(t, alloc.ID, allocs[0].ID)
+
+	allocs, _, err = client.Allocations().List(&api.QueryOptions{Filter: `NodeID == "unknown"`})
+	require.NoError(t, err)
+	require.Empty(t, allocs)
+
+	_, _, err = client.Allocations().List(&api.QueryOptions{Filter: `NodeID ==`})
+	require.ErrorContains(t, err, "400")
+
+	outAlloc, _, err := client.Allocations().Info(alloc.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, node.ID, outAlloc.NodeID)
+
+	// Nodes
+	nodes, _, err := client.Nodes().PrefixList(node.ID[:4])
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+
+	outNode, _, err := client.Nodes().Info(node.ID, nil)
+	require.NoError(t, err)
+	require.Equal(t, node.Name, outNode.Name)
+
+	nodeAllocs, _, err := client.Nodes().Allocations(node.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, nodeAllocs, 1)
+
+	// Evaluations
+	evals, _, err := client.Evaluations().List(&api.QueryOptions{
+		Params: map[string]string{"job": job.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, evals, 1)
+
+	evalAllocs, _, err := client.Evaluations().Allocations(eval.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, evalAllocs, 1)
+
+	// Deployments
+	deployments, _, err := client.Deployments().PrefixList(deployment.ID[:4])
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+
+	deploymentAllocs, _, err := client.Deployments().Allocations(deployment.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, deploymentAllocs, 1)
+
+	// Namespaces
+	namespaces, _, err := client.Namespaces().List(nil)
+	require.NoError(t, err)
+	require.Len(t, namespaces, 1)
+
+	// Writes are rejected
+	resp, err := http.Post(httpServer.URL+"/v1/jobs", "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestQueryServer_Blocking(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+	require.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1000, mock.Job()))
+
+	srv, err := NewQueryServer(store)
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	client, err := api.NewClient(&api.Config{Address: httpServer.URL})
+	require.NoError(t, err)
+
+	// Queries for an older index return immediately
+	start := time.Now()
+	_, _, err = client.Jobs().List(&api.QueryOptions{WaitIndex: 999, WaitTime: 10 * time.Second})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// Queries for the index of the state wait for their wait time
+	start = time.Now()
+	_, meta, err := client.Jobs().List(&api.QueryOptions{WaitIndex: 1000, WaitTime: 100 * time.Millisecond})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	require.Equal(t, uint64(1000), meta.LastIndex)
+}
+
+func TestQueryServer_Encoding(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+
+	node := mock.Node()
+	node.DrainStrategy = &structs.DrainStrategy{}
+	require.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	srv, err := NewQueryServer(store)
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	get := func(query string) string {
+		resp, err := http.Get(httpServer.URL + "/v1/node/" + node.ID + query)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	// Nodes are encoded like the agent does, with the legacy Drain field
+	// and without their secret ID
+	body := get("")
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &out))
+	require.Equal(t, true, out["Drain"])
+	require.Equal(t, "", out["SecretID"])
+	require.NotContains(t, body, "\n")
+
+	require.Contains(t, get("?pretty"), "\n")
+	require.Contains(t, get("?pretty=1"), "\n")
+	require.NotContains(t, get("?pretty=0"), "\n")
+}
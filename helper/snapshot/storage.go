@@ -0,0 +1,209 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// storedSnapshotPrefix and storedSnapshotSuffix surround the name of the
+	// snapshots written to a Storage.
+	storedSnapshotPrefix = "nomad-snapshot-"
+	storedSnapshotSuffix = ".snap"
+)
+
+// StoredSnapshot describes a snapshot written to a Storage.
+type StoredSnapshot struct {
+	// Name is the name of the snapshot within the storage.
+	Name string
+
+	// Index is the raft index of the snapshot.
+	Index uint64
+
+	// Time is when the snapshot was taken.
+	Time time.Time
+
+	// Size is the size of the snapshot in bytes.
+	Size int64
+}
+
+// StoredSnapshotName returns the name a snapshot of the given index taken at
+// the given time is stored under. Names sort in the order the snapshots were
+// taken in.
+func StoredSnapshotName(index uint64, t time.Time) string {
+	return fmt.Sprintf("%s%019d-%d%s", storedSnapshotPrefix, t.UnixNano(), index, storedSnapshotSuffix)
+}
+
+// ParseStoredSnapshotName returns the index and time of a snapshot from the
+// name it is stored under. It returns false if the name is not the name of a
+// stored snapshot.
+func ParseStoredSnapshotName(name string) (uint64, time.Time, bool) {
+	if !strings.HasPrefix(name, storedSnapshotPrefix) || !strings.HasSuffix(name, storedSnapshotSuffix) {
+		return 0, time.Time{}, false
+	}
+
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(name, storedSnapshotPrefix), storedSnapshotSuffix), "-")
+	if len(parts) != 2 {
+		return 0, time.Time{}, false
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	return index, time.Unix(0, nanos).UTC(), true
+}
+
+// Storage is a destination snapshots are written to and retained in.
+type Storage interface {
+	// Name returns a human readable description of the storage, used in logs
+	// and status output.
+	Name() string
+
+	// Put writes the snapshot read from r under the given name.
+	Put(name string, r io.Reader) error
+
+	// Open returns a reader of the snapshot stored under the given name. The
+	// caller must close it.
+	Open(name string) (io.ReadCloser, error)
+
+	// List returns the stored snapshots, oldest first.
+	List() ([]*StoredSnapshot, error)
+
+	// Delete removes the snapshot stored under the given name.
+	Delete(name string) error
+}
+
+// LocalStorage is a Storage which writes snapshots to a directory of the local
+// filesystem.
+type LocalStorage struct {
+	path string
+}
+
+// NewLocalStorage returns a Storage writing snapshots to the directory at
+// path, which is created if it does not exist.
+func NewLocalStorage(path string) (*LocalStorage, error) {
+	if path == "" {
+		return nil, fmt.Errorf("missing snapshot directory")
+	}
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %v", err)
+	}
+	return &LocalStorage{path: path}, nil
+}
+
+func (l *LocalStorage) Name() string {
+	return "local:" + l.path
+}
+
+// Put writes the snapshot to a temporary file which is renamed once complete,
+// so that partially written snapshots are never listed.
+func (l *LocalStorage) Put(name string, r io.Reader) error {
+	if err := validStorageName(name); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(l.path, "."+name+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %v", err)
+	}
+
+	// Clean up the temporary file unless it was renamed
+	var keep bool
+	defer func() {
+		if !keep {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		return fmt.Errorf("failed to write snapshot file: %v", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync snapshot file: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot file: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(l.path, name)); err != nil {
+		return fmt.Errorf("failed to rename snapshot file: %v", err)
+	}
+
+	keep = true
+	return nil
+}
+
+func (l *LocalStorage) Open(name string) (io.ReadCloser, error) {
+	if err := validStorageName(name); err != nil {
+		return nil, err
+	}
+	return os.Open(filepath.Join(l.path, name))
+}
+
+func (l *LocalStorage) List() ([]*StoredSnapshot, error) {
+	entries, err := os.ReadDir(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot directory: %v", err)
+	}
+
+	var snapshots []*StoredSnapshot
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		index, t, ok := ParseStoredSnapshotName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// The snapshot was deleted while listing
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		snapshots = append(snapshots, &StoredSnapshot{
+			Name:  entry.Name(),
+			Index: index,
+			Time:  t,
+			Size:  info.Size(),
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	return snapshots, nil
+}
+
+func (l *LocalStorage) Delete(name string) error {
+	if err := validStorageName(name); err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(l.path, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// validStorageName ensures a name can't escape the directory of the storage.
+func validStorageName(name string) error {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid snapshot name %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package snapshot
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoredSnapshotName(t *testing.T) {
+	now := time.Date(2022, 10, 19, 11, 47, 0, 123, time.UTC)
+	name := StoredSnapshotName(1234, now)
+
+	index, ts, ok := ParseStoredSnapshotName(name)
+	require.True(t, ok)
+	require.Equal(t, uint64(1234), index)
+	require.True(t, now.Equal(ts))
+
+	// Names sort in the order the snapshots were taken in
+	require.Less(t, name, StoredSnapshotName(1, now.Add(time.Nanosecond)))
+
+	for _, invalid := range []string{
+		"",
+		"backup.snap",
+		"nomad-snapshot-1234.snap",
+		"nomad-snapshot-abc-1234.snap",
+		"nomad-snapshot-1666180020000000123-1234.tmp",
+	} {
+		_, _, ok := ParseStoredSnapshotName(invalid)
+		require.False(t, ok, invalid)
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	storage, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	require.Equal(t, "local:"+dir, storage.Name())
+
+	now := time.Now()
+	first := StoredSnapshotName(10, now)
+	second := StoredSnapshotName(20, now.Add(time.Second))
+
+	require.NoError(t, storage.Put(second, strings.NewReader("second")))
+	require.NoError(t, storage.Put(first, strings.NewReader("first")))
+
+	// Files which aren't snapshots are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "backup.snap"), []byte("other"), 0600))
+
+	snapshots, err := storage.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, first, snapshots[0].Name)
+	require.Equal(t, uint64(10), snapshots[0].Index)
+	require.Equal(t, int64(len("first")), snapshots[0].Size)
+	require.Equal(t, second, snapshots[1].Name)
+	require.Equal(t, uint64(20), snapshots[1].Index)
+
+	r, err := storage.Open(second)
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "second", string(content))
+
+	require.NoError(t, storage.Delete(first))
+	snapshots, err = storage.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, second, snapshots[0].Name)
+
+	// Names can't escape the directory of the storage
+	require.Error(t, storage.Put("../escape.snap", strings.NewReader("")))
+	_, err = storage.Open("../backup.snap")
+	require.Error(t, err)
+	require.Error(t, storage.Delete("../backup.snap"))
+}
@@ -317,6 +317,9 @@ func parseRestartPolicy(final **api.RestartPolicy, list *ast.ObjectList) error {
 		"interval",
 		"delay",
 		"mode",
+		"backoff",
+		"max_delay",
+		"reset_after",
 	}
 	if err := checkHCLKeys(obj.Val, valid); err != nil {
 		return err
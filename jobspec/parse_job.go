@@ -38,6 +38,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 	delete(m, "vault")
 	delete(m, "spread")
 	delete(m, "multiregion")
+	delete(m, "gc")
 
 	// Set the ID and name to the object key
 	result.ID = stringToPtr(obj.Keys[0].Token.Value().(string))
@@ -81,6 +82,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		"vault_token",
 		"consul_token",
 		"multiregion",
+		"gc",
 	}
 	if err := checkHCLKeys(listVal, valid); err != nil {
 		return multierror.Prefix(err, "job:")
@@ -142,6 +144,13 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		}
 	}
 
+	// If we have a gc block, then parse that
+	if o := listVal.Filter("gc"); len(o.Items) > 0 {
+		if err := parseGCPolicy(&result.GC, o); err != nil {
+			return multierror.Prefix(err, "gc ->")
+		}
+	}
+
 	// If we have a multiregion block, then parse that
 	if o := listVal.Filter("multiregion"); len(o.Items) > 0 {
 		var mr api.Multiregion
@@ -212,6 +221,45 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 	return nil
 }
 
+func parseGCPolicy(result **api.GCPolicy, list *ast.ObjectList) error {
+	list = list.Elem()
+	if len(list.Items) > 1 {
+		return fmt.Errorf("only one 'gc' block allowed per job")
+	}
+
+	// Get our resource object
+	o := list.Items[0]
+
+	// Check for invalid keys
+	valid := []string{
+		"retain_failed",
+		"retain_successful",
+	}
+	if err := checkHCLKeys(o.Val, valid); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, o.Val); err != nil {
+		return err
+	}
+
+	var g api.GCPolicy
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           &g,
+	})
+	if err != nil {
+		return err
+	}
+	if err := dec.Decode(m); err != nil {
+		return err
+	}
+	*result = &g
+	return nil
+}
+
 func parsePeriodic(result **api.PeriodicConfig, list *ast.ObjectList) error {
 	list = list.Elem()
 	if len(list.Items) > 1 {
@@ -347,6 +347,8 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 		valid := []string{
 			"hook",
 			"sidecar",
+			"timeout",
+			"prestop",
 		}
 		if err := checkHCLKeys(lifecycleBlock.Val, valid); err != nil {
 			return nil, multierror.Prefix(err, "lifecycle ->")
@@ -355,15 +357,68 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 		if err := hcl.DecodeObject(&m, lifecycleBlock.Val); err != nil {
 			return nil, err
 		}
+		delete(m, "prestop")
 
 		t.Lifecycle = &api.TaskLifecycle{}
-		if err := mapstructure.WeakDecode(m, t.Lifecycle); err != nil {
+		dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+			WeaklyTypedInput: true,
+			Result:           t.Lifecycle,
+		})
+		if err != nil {
 			return nil, err
 		}
+		if err := dec.Decode(m); err != nil {
+			return nil, err
+		}
+
+		// Parse the prestop command
+		if lo, ok := lifecycleBlock.Val.(*ast.ObjectType); ok {
+			if po := lo.List.Filter("prestop"); len(po.Items) > 0 {
+				if len(po.Items) > 1 {
+					return nil, fmt.Errorf("lifecycle -> only one prestop block is allowed")
+				}
+				preStop, err := parsePreStop(po.Items[0])
+				if err != nil {
+					return nil, multierror.Prefix(err, "lifecycle, prestop ->")
+				}
+				t.Lifecycle.PreStop = preStop
+			}
+		}
 	}
 	return &t, nil
 }
 
+func parsePreStop(o *ast.ObjectItem) (*api.TaskPreStopConfig, error) {
+	valid := []string{
+		"command",
+		"args",
+		"timeout",
+	}
+	if err := checkHCLKeys(o.Val, valid); err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, o.Val); err != nil {
+		return nil, err
+	}
+
+	var preStop api.TaskPreStopConfig
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           &preStop,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := dec.Decode(m); err != nil {
+		return nil, err
+	}
+	return &preStop, nil
+}
+
 func parseArtifacts(result *[]*api.TaskArtifact, list *ast.ObjectList) error {
 	for _, o := range list.Elem().Items {
 		// Check for invalid keys
@@ -456,6 +511,7 @@ func parseTemplates(result *[]*api.Template, list *ast.ObjectList) error {
 			"source",
 			"splay",
 			"env",
+			"error_on_missing_key",
 			"vault_grace", //COMPAT(0.12) not used; emits warning in 0.11.
 		}
 		if err := checkHCLKeys(o.Val, valid); err != nil {
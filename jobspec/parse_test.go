@@ -190,10 +190,13 @@ func TestParse(t *testing.T) {
 							"elb_checks":   "3",
 						},
 						RestartPolicy: &api.RestartPolicy{
-							Interval: timeToPtr(10 * time.Minute),
-							Attempts: intToPtr(5),
-							Delay:    timeToPtr(15 * time.Second),
-							Mode:     stringToPtr("delay"),
+							Interval:   timeToPtr(10 * time.Minute),
+							Attempts:   intToPtr(5),
+							Delay:      timeToPtr(15 * time.Second),
+							Mode:       stringToPtr("delay"),
+							Backoff:    stringToPtr("exponential"),
+							MaxDelay:   timeToPtr(5 * time.Minute),
+							ResetAfter: timeToPtr(time.Hour),
 						},
 						Spreads: []*api.Spread{
 							{
@@ -372,14 +375,15 @@ func TestParse(t *testing.T) {
 								},
 								Templates: []*api.Template{
 									{
-										SourcePath:   stringToPtr("foo"),
-										DestPath:     stringToPtr("foo"),
-										ChangeMode:   stringToPtr("foo"),
-										ChangeSignal: stringToPtr("foo"),
-										Splay:        timeToPtr(10 * time.Second),
-										Perms:        stringToPtr("0644"),
-										Envvars:      boolToPtr(true),
-										VaultGrace:   timeToPtr(33 * time.Second),
+										SourcePath:    stringToPtr("foo"),
+										DestPath:      stringToPtr("foo"),
+										ChangeMode:    stringToPtr("foo"),
+										ChangeSignal:  stringToPtr("foo"),
+										Splay:         timeToPtr(10 * time.Second),
+										Perms:         stringToPtr("0644"),
+										Envvars:       boolToPtr(true),
+										VaultGrace:    timeToPtr(33 * time.Second),
+										ErrMissingKey: boolToPtr(true),
 									},
 									{
 										SourcePath: stringToPtr("bar"),
@@ -409,6 +413,11 @@ func TestParse(t *testing.T) {
 								Lifecycle: &api.TaskLifecycle{
 									Hook:    "prestart",
 									Sidecar: true,
+									PreStop: &api.TaskPreStopConfig{
+										Command: "/bin/drain",
+										Args:    []string{"-wait", "5s"},
+										Timeout: timeToPtr(10 * time.Second),
+									},
 								},
 								Config: map[string]interface{}{
 									"image": "hashicorp/storagelocker",
@@ -557,6 +566,20 @@ func TestParse(t *testing.T) {
 			false,
 		},
 
+		{
+			"gc-policy.hcl",
+			&api.Job{
+				ID:   stringToPtr("foo"),
+				Name: stringToPtr("foo"),
+				Type: stringToPtr("batch"),
+				GC: &api.GCPolicy{
+					RetainFailed:     timeToPtr(24 * time.Hour),
+					RetainSuccessful: timeToPtr(1 * time.Hour),
+				},
+			},
+			false,
+		},
+
 		{
 			"specify-job.hcl",
 			&api.Job{
@@ -1841,6 +1864,33 @@ func TestParse(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"lifecycle-poststop.hcl",
+			&api.Job{
+				ID:   stringToPtr("lifecycle-poststop"),
+				Name: stringToPtr("lifecycle-poststop"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("group"),
+						Tasks: []*api.Task{
+							{
+								Name:   "main",
+								Driver: "docker",
+							},
+							{
+								Name:   "cleanup",
+								Driver: "docker",
+								Lifecycle: &api.TaskLifecycle{
+									Hook:    "poststop",
+									Timeout: timeToPtr(5 * time.Minute),
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -99,10 +99,13 @@ job "binstore-storagelocker" {
     }
 
     restart {
-      attempts = 5
-      interval = "10m"
-      delay    = "15s"
-      mode     = "delay"
+      attempts    = 5
+      interval    = "10m"
+      delay       = "15s"
+      mode        = "delay"
+      backoff     = "exponential"
+      max_delay   = "5m"
+      reset_after = "1h"
     }
 
     reschedule {
@@ -305,13 +308,14 @@ job "binstore-storagelocker" {
       }
 
       template {
-        source        = "foo"
-        destination   = "foo"
-        change_mode   = "foo"
-        change_signal = "foo"
-        splay         = "10s"
-        env           = true
-        vault_grace   = "33s"
+        source               = "foo"
+        destination          = "foo"
+        change_mode          = "foo"
+        change_signal        = "foo"
+        splay                = "10s"
+        env                  = true
+        vault_grace          = "33s"
+        error_on_missing_key = true
       }
 
       template {
@@ -338,6 +342,12 @@ job "binstore-storagelocker" {
       lifecycle {
         hook    = "prestart"
         sidecar = true
+
+        prestop {
+          command = "/bin/drain"
+          args    = ["-wait", "5s"]
+          timeout = "10s"
+        }
       }
 
       config {
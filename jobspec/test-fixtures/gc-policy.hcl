@@ -0,0 +1,8 @@
+job "foo" {
+  type = "batch"
+
+  gc {
+    retain_failed     = "24h"
+    retain_successful = "1h"
+  }
+}
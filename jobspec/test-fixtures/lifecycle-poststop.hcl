@@ -0,0 +1,16 @@
+job "lifecycle-poststop" {
+  group "group" {
+    task "main" {
+      driver = "docker"
+    }
+
+    task "cleanup" {
+      driver = "docker"
+
+      lifecycle {
+        hook    = "poststop"
+        timeout = "5m"
+      }
+    }
+  }
+}
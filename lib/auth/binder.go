@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// BinderStateStore is the subset of state store methods used by the binder.
+type BinderStateStore interface {
+	GetACLBindingRulesByAuthMethod(ws memdb.WatchSet, authMethod string) (memdb.ResultIterator, error)
+	GetACLRoleByName(ws memdb.WatchSet, roleName string) (*structs.ACLRole, error)
+	ACLPolicyByName(ws memdb.WatchSet, name string) (*structs.ACLPolicy, error)
+}
+
+// Binder is responsible for collecting the ACL roles and policies to be
+// assigned to a token generated as a result of "logging in" via an auth
+// method.
+//
+// It does so by applying the auth method's configured binding rules.
+type Binder struct {
+	store BinderStateStore
+}
+
+// NewBinder creates a Binder with the given state store.
+func NewBinder(store BinderStateStore) *Binder {
+	return &Binder{store}
+}
+
+// Bindings contains the ACL roles and policies to be assigned to the created
+// token.
+type Bindings struct {
+	Management bool
+	Roles      []*structs.ACLTokenRoleLink
+	Policies   []string
+}
+
+// None indicates that the resulting bindings would not give the created token
+// access to any resources.
+func (b *Bindings) None() bool {
+	if b == nil {
+		return true
+	}
+	return !b.Management && len(b.Policies) == 0 && len(b.Roles) == 0
+}
+
+// Bind collects the ACL roles and policies to be assigned to the created
+// token. Roles and policies named by matching binding rules which do not
+// exist are skipped, so that rules can be written ahead of the objects they
+// bind to.
+func (b *Binder) Bind(authMethod *structs.ACLAuthMethod, identity *Identity) (*Bindings, error) {
+	var bindings Bindings
+
+	// Load the auth method's binding rules.
+	rulesIterator, err := b.store.GetACLBindingRulesByAuthMethod(nil, authMethod.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the rules with selectors that match the identity's fields.
+	var matchingRules []*structs.ACLBindingRule
+	for raw := rulesIterator.Next(); raw != nil; raw = rulesIterator.Next() {
+		rule := raw.(*structs.ACLBindingRule)
+		match, err := doesSelectorMatch(rule.Selector, identity.Claims)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate selector of binding rule %q: %v", rule.ID, err)
+		}
+		if match {
+			matchingRules = append(matchingRules, rule)
+		}
+	}
+	if len(matchingRules) == 0 {
+		return &bindings, nil
+	}
+
+	// Compute role or policy names by interpolating the identity's claim
+	// mappings into the rule BindName templates.
+	for _, rule := range matchingRules {
+		switch rule.BindType {
+		case structs.ACLBindingRuleBindTypeManagement:
+			bindings.Management = true
+
+		case structs.ACLBindingRuleBindTypeRole:
+			bindName, err := interpolateBindName(rule.BindName, identity.ClaimMappings)
+			if err != nil {
+				return nil, fmt.Errorf("failed to interpolate bind name of binding rule %q: %v", rule.ID, err)
+			}
+
+			role, err := b.store.GetACLRoleByName(nil, bindName)
+			if err != nil {
+				return nil, err
+			}
+			if role != nil {
+				bindings.Roles = append(bindings.Roles, &structs.ACLTokenRoleLink{ID: role.ID})
+			}
+
+		case structs.ACLBindingRuleBindTypePolicy:
+			bindName, err := interpolateBindName(rule.BindName, identity.ClaimMappings)
+			if err != nil {
+				return nil, fmt.Errorf("failed to interpolate bind name of binding rule %q: %v", rule.ID, err)
+			}
+
+			policy, err := b.store.ACLPolicyByName(nil, bindName)
+			if err != nil {
+				return nil, err
+			}
+			if policy != nil {
+				bindings.Policies = append(bindings.Policies, policy.Name)
+			}
+		}
+	}
+
+	// A management token can't be linked to roles or policies.
+	if bindings.Management {
+		bindings.Roles = nil
+		bindings.Policies = nil
+	}
+
+	return &bindings, nil
+}
+
+// doesSelectorMatch checks that a single selector matches the provided
+// claims. An empty selector matches everything.
+func doesSelectorMatch(selector string, selectableVars interface{}) (bool, error) {
+	if selector == "" {
+		return true, nil
+	}
+
+	eval, err := bexpr.CreateEvaluator(selector)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := eval.Evaluate(selectableVars)
+	if err != nil {
+		// Selectors referring to claims missing from the identity don't
+		// match rather than fail the login.
+		return false, nil
+	}
+	return result, nil
+}
+
+// bindNameVarRe matches the ${name} variables of bind names.
+var bindNameVarRe = regexp.MustCompile(`\$\{\s*([^}\s]+)\s*\}`)
+
+// interpolateBindName replaces the ${value.name} variables of the bind name
+// with the mapped claims of the identity. Referring to a variable that is
+// not set is an error.
+func interpolateBindName(bindName string, vars map[string]string) (string, error) {
+	var missing []string
+	out := bindNameVarRe.ReplaceAllStringFunc(bindName, func(m string) string {
+		name := bindNameVarRe.FindStringSubmatch(m)[1]
+		v, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) != 0 {
+		return "", fmt.Errorf("unknown variables %v", missing)
+	}
+	return out, nil
+}
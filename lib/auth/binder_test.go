@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestBinder_Bind(t *testing.T) {
+	ci.Parallel(t)
+
+	testStore := state.TestStateStore(t)
+	testBind := NewBinder(testStore)
+
+	// Create an auth method which maps the editor and language claims to
+	// values and the groups claim to a list.
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Config.ClaimMappings = map[string]string{"editor": "editor", "/profile/language": "language"}
+	authMethod.Config.ListClaimMappings = map[string]string{"groups": "groups"}
+	must.NoError(t, testStore.UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	// Create the roles and policies the binding rules bind to.
+	vimRole := &structs.ACLRole{ID: "a2f2bb2a-ba9e-6ec8-ea9b-6b6cb4fbba58", Name: "vim-role"}
+	jsRole := &structs.ACLRole{ID: "b5a9bb0c-7fbf-4e20-0f18-5a0a7ae21efe", Name: "frontend-engineers"}
+	must.NoError(t, testStore.UpsertACLRoles(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLRole{vimRole, jsRole}, true))
+
+	policy := mock.ACLPolicy()
+	policy.Name = "prod-read"
+	must.NoError(t, testStore.UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 30, []*structs.ACLPolicy{policy}))
+
+	bindingRules := []*structs.ACLBindingRule{
+		{
+			ID:         "7f4e1b96-d3a4-5cce-2cd4-c1a5b0c8f3f0",
+			Selector:   `value.editor != ""`,
+			BindType:   structs.ACLBindingRuleBindTypeRole,
+			BindName:   "${value.editor}-role",
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         "2fa6edbf-b0e8-2f8c-9d9b-b5c2a4b0d1e2",
+			Selector:   `value.editor != ""`,
+			BindType:   structs.ACLBindingRuleBindTypeRole,
+			BindName:   "this-role-does-not-exist",
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         "4d9b3e0c-6c39-2bb0-8b8c-5c6d6a9e8f1a",
+			Selector:   "value.language == js",
+			BindType:   structs.ACLBindingRuleBindTypeRole,
+			BindName:   jsRole.Name,
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         "9a1e3c1f-0a6f-a6a4-3f32-7e2b9f7c5b3d",
+			Selector:   "prod in list.groups",
+			BindType:   structs.ACLBindingRuleBindTypePolicy,
+			BindName:   policy.Name,
+			AuthMethod: authMethod.Name,
+		},
+		{
+			ID:         "c0c6a0e7-22a2-2f2b-0b0e-3c3f7b0b5a4e",
+			Selector:   "admins in list.groups",
+			BindType:   structs.ACLBindingRuleBindTypeManagement,
+			AuthMethod: authMethod.Name,
+		},
+	}
+	must.NoError(t, testStore.UpsertACLBindingRules(structs.MsgTypeTestSetup, 40, bindingRules, false))
+
+	testCases := []struct {
+		name   string
+		claims map[string]interface{}
+		want   *Bindings
+	}{
+		{
+			name:   "no claims",
+			claims: map[string]interface{}{},
+			want:   &Bindings{},
+		},
+		{
+			name:   "interpolated role",
+			claims: map[string]interface{}{"editor": "vim"},
+			want:   &Bindings{Roles: []*structs.ACLTokenRoleLink{{ID: vimRole.ID}}},
+		},
+		{
+			name: "roles and policies",
+			claims: map[string]interface{}{
+				"editor":  "vim",
+				"profile": map[string]interface{}{"language": "js"},
+				"groups":  []interface{}{"dev", "prod"},
+			},
+			want: &Bindings{
+				Roles:    []*structs.ACLTokenRoleLink{{ID: jsRole.ID}, {ID: vimRole.ID}},
+				Policies: []string{policy.Name},
+			},
+		},
+		{
+			name: "management",
+			claims: map[string]interface{}{
+				"editor": "vim",
+				"groups": []interface{}{"admins"},
+			},
+			want: &Bindings{Management: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			identity, err := NewIdentity(authMethod.Config, tc.claims)
+			must.NoError(t, err)
+
+			got, err := testBind.Bind(authMethod, identity)
+			must.NoError(t, err)
+			must.Eq(t, tc.want.Management, got.Management)
+			must.Eq(t, tc.want.Policies, got.Policies)
+			must.Eq(t, tc.want.Roles, got.Roles)
+		})
+	}
+}
+
+func TestBinder_interpolateBindName(t *testing.T) {
+	ci.Parallel(t)
+
+	vars := map[string]string{"value.editor": "vim", "value.team": "web"}
+
+	out, err := interpolateBindName("${value.team}-${ value.editor }", vars)
+	must.NoError(t, err)
+	must.Eq(t, "web-vim", out)
+
+	out, err = interpolateBindName("static", vars)
+	must.NoError(t, err)
+	must.Eq(t, "static", out)
+
+	_, err = interpolateBindName("${value.missing}", vars)
+	must.EqError(t, err, "unknown variables [value.missing]")
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// Identity is the identity asserted by an auth method, with the claims made
+// about it mapped as configured by the method.
+type Identity struct {
+	// Claims is the format of this identity suitable for selection with a
+	// binding rule.
+	Claims *SelectableClaims
+
+	// ClaimMappings is the format of this identity suitable for
+	// interpolation in the bind name of a binding rule, such as value.name.
+	ClaimMappings map[string]string
+}
+
+// SelectableClaims are the mapped claims binding rule selectors are
+// evaluated against, such as `engineering in list.groups`.
+type SelectableClaims struct {
+	Value map[string]string   `bexpr:"value"`
+	List  map[string][]string `bexpr:"list"`
+}
+
+// NewIdentity maps the claims of an identity according to the claim mappings
+// of the auth method config. Claims missing from the identity map to empty
+// values.
+func NewIdentity(config *structs.ACLAuthMethodConfig, claims map[string]interface{}) (*Identity, error) {
+	selectable := &SelectableClaims{
+		Value: make(map[string]string, len(config.ClaimMappings)),
+		List:  make(map[string][]string, len(config.ListClaimMappings)),
+	}
+	vars := make(map[string]string, len(config.ClaimMappings))
+
+	for claimName, name := range config.ClaimMappings {
+		raw, ok := getClaim(claims, claimName)
+		if !ok {
+			selectable.Value[name] = ""
+			vars["value."+name] = ""
+			continue
+		}
+		v, ok := stringifyClaim(raw)
+		if !ok {
+			return nil, fmt.Errorf("error converting claim %q to string from unknown type %T", claimName, raw)
+		}
+		selectable.Value[name] = v
+		vars["value."+name] = v
+	}
+
+	for claimName, name := range config.ListClaimMappings {
+		selectable.List[name] = []string{}
+
+		raw, ok := getClaim(claims, claimName)
+		if !ok {
+			continue
+		}
+		list, ok := raw.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%q list claim could not be converted to a list", claimName)
+		}
+		for _, item := range list {
+			v, ok := stringifyClaim(item)
+			if !ok {
+				return nil, fmt.Errorf("value %v in %q list claim could not be parsed as string", item, claimName)
+			}
+			selectable.List[name] = append(selectable.List[name], v)
+		}
+	}
+
+	return &Identity{Claims: selectable, ClaimMappings: vars}, nil
+}
+
+// getClaim returns the claim named by its key or by a JSON pointer, such as
+// /groups/0.
+func getClaim(claims map[string]interface{}, name string) (interface{}, bool) {
+	if !strings.HasPrefix(name, "/") {
+		v, ok := claims[name]
+		return v, ok
+	}
+
+	var cur interface{} = claims
+	for _, token := range strings.Split(name[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch c := cur.(type) {
+		case map[string]interface{}:
+			v, ok := c[token]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(c) {
+				return nil, false
+			}
+			cur = c[i]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// stringifyClaim returns the string form of the scalar claim value.
+func stringifyClaim(raw interface{}) (string, bool) {
+	switch v := raw.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	}
+	return "", false
+}
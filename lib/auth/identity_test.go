@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewIdentity(t *testing.T) {
+	ci.Parallel(t)
+
+	config := &structs.ACLAuthMethodConfig{
+		ClaimMappings: map[string]string{
+			"email":               "email",
+			"/nested/team":        "team",
+			"admin":               "admin",
+			"/nested/missing/key": "missing",
+		},
+		ListClaimMappings: map[string]string{
+			"groups":   "groups",
+			"/orgs/~1": "orgs",
+		},
+	}
+
+	identity, err := NewIdentity(config, map[string]interface{}{
+		"email":  "alice@example.com",
+		"admin":  true,
+		"nested": map[string]interface{}{"team": "web"},
+		"groups": []interface{}{"dev", 42.0},
+		"orgs":   map[string]interface{}{"/": []interface{}{"acme"}},
+	})
+	must.NoError(t, err)
+
+	must.Eq(t, &SelectableClaims{
+		Value: map[string]string{
+			"email":   "alice@example.com",
+			"team":    "web",
+			"admin":   "true",
+			"missing": "",
+		},
+		List: map[string][]string{
+			"groups": {"dev", "42"},
+			"orgs":   {"acme"},
+		},
+	}, identity.Claims)
+
+	must.Eq(t, map[string]string{
+		"value.email":   "alice@example.com",
+		"value.team":    "web",
+		"value.admin":   "true",
+		"value.missing": "",
+	}, identity.ClaimMappings)
+
+	// Values and lists of the wrong type are rejected.
+	_, err = NewIdentity(config, map[string]interface{}{"email": []interface{}{"a"}})
+	must.Error(t, err)
+
+	_, err = NewIdentity(config, map[string]interface{}{"groups": "dev"})
+	must.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package jwt
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// ValidatorCache caches the validators of auth methods, so the public keys
+// are not parsed and the key sets are not read for every login. Validators
+// are replaced when their auth method changes.
+type ValidatorCache struct {
+	validators map[string]*cachedValidator
+	lock       sync.Mutex
+}
+
+// cachedValidator is a validator and the hash of the auth method it was
+// created for
+type cachedValidator struct {
+	validator *Validator
+	hash      []byte
+}
+
+// NewValidatorCache returns an empty validator cache.
+func NewValidatorCache() *ValidatorCache {
+	return &ValidatorCache{
+		validators: make(map[string]*cachedValidator),
+	}
+}
+
+// Get returns the validator of the auth method, creating it if the auth
+// method is not cached or has changed since.
+func (c *ValidatorCache) Get(authMethod *structs.ACLAuthMethod) (*Validator, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if cached, ok := c.validators[authMethod.Name]; ok && bytes.Equal(cached.hash, authMethod.Hash) {
+		return cached.validator, nil
+	}
+
+	validator, err := NewValidator(authMethod.Config)
+	if err != nil {
+		return nil, err
+	}
+	c.validators[authMethod.Name] = &cachedValidator{
+		validator: validator,
+		hash:      authMethod.Hash,
+	}
+	return validator, nil
+}
+
+// Delete removes the validator of the auth method from the cache.
+func (c *ValidatorCache) Delete(authMethodName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.validators, authMethodName)
+}
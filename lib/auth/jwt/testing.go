@@ -0,0 +1,123 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// TestIssuer is a fake JWT issuer for tests, such as a CI system. It signs
+// JWTs with an RSA key which is served as a JWKS.
+type TestIssuer struct {
+	t      testing.TB
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	keyID  string
+
+	// keySetReads counts the requests for the key set of the issuer
+	keySetReads int64
+
+	// Issuer and Audience are the issuer and audience of the signed JWTs.
+	Issuer   string
+	Audience string
+}
+
+// NewTestIssuer starts a fake JWT issuer which is stopped when the test
+// ends.
+func NewTestIssuer(t testing.TB) *TestIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	must.NoError(t, err)
+
+	i := &TestIssuer{
+		t:        t,
+		key:      key,
+		keyID:    uuid.Short(),
+		Issuer:   "https://ci.example.com",
+		Audience: "nomad",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/keys", i.handleKeys)
+	i.server = httptest.NewServer(mux)
+	t.Cleanup(i.server.Close)
+	return i
+}
+
+// JWKSURL returns the URL the key set of the issuer is served at.
+func (i *TestIssuer) JWKSURL() string {
+	return i.server.URL + "/keys"
+}
+
+// KeySetReads returns the number of times the key set of the issuer was read.
+func (i *TestIssuer) KeySetReads() int {
+	return int(atomic.LoadInt64(&i.keySetReads))
+}
+
+// PublicKeyPEM returns the PEM encoded public key of the issuer.
+func (i *TestIssuer) PublicKeyPEM() string {
+	der, err := x509.MarshalPKIXPublicKey(&i.key.PublicKey)
+	must.NoError(i.t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// AuthMethodConfig returns a JWT auth method config validating the JWTs of
+// the issuer against its JWKS URL.
+func (i *TestIssuer) AuthMethodConfig() *structs.ACLAuthMethodConfig {
+	return &structs.ACLAuthMethodConfig{
+		JWKSURL:        i.JWKSURL(),
+		BoundIssuer:    i.Issuer,
+		BoundAudiences: []string{i.Audience},
+	}
+}
+
+// Sign returns a JWT with the custom claims, which expires in five minutes.
+func (i *TestIssuer) Sign(claims map[string]interface{}) string {
+	now := time.Now()
+	return i.SignWith(jwt.Claims{
+		Issuer:   i.Issuer,
+		Subject:  "test-subject",
+		Audience: jwt.Audience{i.Audience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}, claims)
+}
+
+// SignWith returns a JWT with the standard and custom claims.
+func (i *TestIssuer) SignWith(std jwt.Claims, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: i.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", i.keyID),
+	)
+	must.NoError(i.t, err)
+
+	token, err := jwt.Signed(signer).Claims(std).Claims(claims).CompactSerialize()
+	must.NoError(i.t, err)
+	return token
+}
+
+func (i *TestIssuer) handleKeys(w http.ResponseWriter, _ *http.Request) {
+	atomic.AddInt64(&i.keySetReads, 1)
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key:       &i.key.PublicKey,
+		KeyID:     i.keyID,
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}}})
+	if err != nil {
+		i.t.Errorf("failed to write response: %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+package jwt
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/exp/slices"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// defaultSigningAlg is the JWT signing algorithm used when the auth
+	// method does not configure any
+	defaultSigningAlg = string(jose.RS256)
+
+	// defaultClockSkewLeeway is the leeway given when validating the times
+	// of JWTs when the auth method does not configure one
+	defaultClockSkewLeeway = time.Minute
+
+	// maxKeySetSize limits the size of the key sets read from JWKS URLs
+	maxKeySetSize = 1 << 20
+
+	// keySetReadInterval is the minimum time between two reads of the key
+	// set, so that JWTs signed by unknown keys can't make every login read
+	// the JWKS URL
+	keySetReadInterval = 30 * time.Second
+)
+
+// Validator validates JWTs issued by a third party, such as a CI system,
+// against the static public keys or the JWKS of a JWT auth method.
+type Validator struct {
+	config *structs.ACLAuthMethodConfig
+	client *http.Client
+
+	// staticKeys are the parsed JWT validation public keys of the auth
+	// method, which are used instead of a key set if set
+	staticKeys []jose.JSONWebKey
+
+	// keySet is the last read key set of the JWKS URL, which is read again
+	// when a JWT is signed by an unknown key. keySetRead is when it was last
+	// read, and keySetErr the error of that read, if it failed.
+	keySet     *jose.JSONWebKeySet
+	keySetRead time.Time
+	keySetErr  error
+	keySetLock sync.Mutex
+
+	// readCh is held by the request reading the key set, so that concurrent
+	// requests wait for its result instead of reading the key set as well
+	readCh chan struct{}
+
+	// readInterval is the minimum time between two reads of the key set
+	readInterval time.Duration
+}
+
+// NewValidator returns a validator for the JWT auth method config.
+func NewValidator(config *structs.ACLAuthMethodConfig) (*Validator, error) {
+	v := &Validator{
+		config:       config,
+		readCh:       make(chan struct{}, 1),
+		readInterval: keySetReadInterval,
+	}
+
+	if len(config.JWTValidationPubKeys) != 0 {
+		for _, raw := range config.JWTValidationPubKeys {
+			key, err := parsePublicKeyPEM([]byte(raw))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse JWT validation public key: %v", err)
+			}
+			v.staticKeys = append(v.staticKeys, jose.JSONWebKey{Key: key})
+		}
+		return v, nil
+	}
+
+	if _, err := url.Parse(config.JWKSURL); err != nil {
+		return nil, fmt.Errorf("invalid JWKS URL: %v", err)
+	}
+	client, err := httpClient(config.JWKSCACert)
+	if err != nil {
+		return nil, err
+	}
+	v.client = client
+	return v, nil
+}
+
+// parsePublicKeyPEM parses a PEM encoded public key or certificate.
+func parsePublicKeyPEM(data []byte) (interface{}, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+}
+
+// httpClient returns the client used to read the JWKS URL, trusting the
+// given CA certificate only if one is given.
+func httpClient(caCert string) (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	if caCert == "" {
+		return client, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(caCert)) {
+		return nil, errors.New("could not parse JWKS CA certificate")
+	}
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	client.Transport = transport
+	return client, nil
+}
+
+// Validate verifies the signature, issuer, audience and times of the JWT and
+// returns its claims.
+func (v *Validator) Validate(ctx context.Context, raw string) (map[string]interface{}, error) {
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(token.Headers) != 1 {
+		return nil, errors.New("JWT must have a single signature")
+	}
+	header := token.Headers[0]
+
+	algs := v.config.SigningAlgs
+	if len(algs) == 0 {
+		algs = []string{defaultSigningAlg}
+	}
+	if !slices.Contains(algs, header.Algorithm) {
+		return nil, fmt.Errorf("JWT signed with unsupported algorithm %q", header.Algorithm)
+	}
+
+	keys, err := v.keys(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		std    jwt.Claims
+		claims map[string]interface{}
+	)
+	verified := false
+	for _, key := range keys {
+		if err := token.Claims(key.Key, &std, &claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("JWT signature is invalid")
+	}
+
+	leeway := v.config.ClockSkewLeeway
+	if leeway == 0 {
+		leeway = defaultClockSkewLeeway
+	}
+	if err := std.ValidateWithLeeway(jwt.Expected{
+		Issuer: v.config.BoundIssuer,
+		Time:   time.Now(),
+	}, leeway); err != nil {
+		return nil, err
+	}
+	if std.Expiry == nil {
+		return nil, errors.New("JWT does not expire")
+	}
+
+	// A JWT issued for an audience may only be used by methods bound to it,
+	// so that tokens issued for other services can't log in.
+	if len(v.config.BoundAudiences) == 0 {
+		if len(std.Audience) != 0 {
+			return nil, errors.New("JWT has an audience but the auth method has no bound audiences")
+		}
+		return claims, nil
+	}
+	for _, aud := range v.config.BoundAudiences {
+		if std.Audience.Contains(aud) {
+			return claims, nil
+		}
+	}
+	return nil, errors.New("JWT audience does not match any bound audience")
+}
+
+// keys returns the keys that may have signed a JWT with the key ID. The key
+// set is read again if no key matches, at most once per read interval.
+func (v *Validator) keys(ctx context.Context, keyID string) ([]jose.JSONWebKey, error) {
+	if len(v.staticKeys) != 0 {
+		return v.staticKeys, nil
+	}
+
+	if keys, _, _ := v.matchKeys(keyID); len(keys) != 0 {
+		return keys, nil
+	}
+
+	// Only one request reads the key set at a time
+	select {
+	case v.readCh <- struct{}{}:
+		defer func() { <-v.readCh }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	// The key set may have been read while waiting
+	keys, read, err := v.matchKeys(keyID)
+	if len(keys) != 0 {
+		return keys, nil
+	}
+	if !read.IsZero() && time.Since(read) < v.readInterval {
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWKS: %v", err)
+		}
+		return nil, fmt.Errorf("no JWKS key found for key ID %q", keyID)
+	}
+
+	keySet, err := v.readKeySet(ctx)
+
+	v.keySetLock.Lock()
+	v.keySetRead = time.Now()
+	v.keySetErr = err
+	if err == nil {
+		v.keySet = keySet
+	}
+	v.keySetLock.Unlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %v", err)
+	}
+	if keys, _, _ := v.matchKeys(keyID); len(keys) != 0 {
+		return keys, nil
+	}
+	return nil, fmt.Errorf("no JWKS key found for key ID %q", keyID)
+}
+
+// matchKeys returns the keys of the last read key set matching the key ID,
+// along with when the key set was last read and the error of that read.
+func (v *Validator) matchKeys(keyID string) ([]jose.JSONWebKey, time.Time, error) {
+	v.keySetLock.Lock()
+	defer v.keySetLock.Unlock()
+
+	if v.keySet == nil {
+		return nil, v.keySetRead, v.keySetErr
+	}
+	if keyID == "" {
+		return v.keySet.Keys, v.keySetRead, v.keySetErr
+	}
+	return v.keySet.Key(keyID), v.keySetRead, v.keySetErr
+}
+
+// readKeySet reads the key set of the JWKS URL, from the disk for file://
+// URLs.
+func (v *Validator) readKeySet(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	u, err := url.Parse(v.config.JWKSURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if u.Scheme == "file" {
+		body, err = os.ReadFile(u.Path)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.config.JWKSURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := v.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		body, err = io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize))
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected response code %d: %s", resp.StatusCode, body)
+		}
+	}
+
+	var keySet jose.JSONWebKeySet
+	if err := json.Unmarshal(body, &keySet); err != nil {
+		return nil, err
+	}
+	return &keySet, nil
+}
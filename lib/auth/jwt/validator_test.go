@@ -0,0 +1,183 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	issuer := NewTestIssuer(t)
+	config := issuer.AuthMethodConfig()
+
+	validator, err := NewValidator(config)
+	must.NoError(t, err)
+
+	ctx := context.Background()
+	claims, err := validator.Validate(ctx, issuer.Sign(map[string]interface{}{
+		"project_path": "infra/deploy",
+	}))
+	must.NoError(t, err)
+	must.Eq(t, "test-subject", claims["sub"].(string))
+	must.Eq(t, "infra/deploy", claims["project_path"].(string))
+
+	// JWTs must be issued by the bound issuer.
+	config.BoundIssuer = "https://other.example.com"
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.StrContains(t, err.Error(), "issuer")
+	config.BoundIssuer = issuer.Issuer
+
+	// JWTs must be issued for one of the bound audiences.
+	config.BoundAudiences = []string{"not-nomad"}
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.EqError(t, err, "JWT audience does not match any bound audience")
+
+	// JWTs with an audience can't be used with methods without one.
+	config.BoundAudiences = nil
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.EqError(t, err, "JWT has an audience but the auth method has no bound audiences")
+	config.BoundAudiences = []string{issuer.Audience}
+
+	// JWTs must be signed with one of the allowed algorithms.
+	config.SigningAlgs = []string{"ES256"}
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.EqError(t, err, `JWT signed with unsupported algorithm "RS256"`)
+	config.SigningAlgs = nil
+
+	// Expired JWTs are rejected, within the clock skew leeway.
+	now := time.Now()
+	expired := issuer.SignWith(jwt.Claims{
+		Issuer:   issuer.Issuer,
+		Audience: jwt.Audience{issuer.Audience},
+		Expiry:   jwt.NewNumericDate(now.Add(-30 * time.Second)),
+	}, nil)
+	_, err = validator.Validate(ctx, expired)
+	must.NoError(t, err)
+
+	config.ClockSkewLeeway = time.Second
+	_, err = validator.Validate(ctx, expired)
+	must.StrContains(t, err.Error(), "expired")
+
+	// JWTs which don't expire are rejected.
+	_, err = validator.Validate(ctx, issuer.SignWith(jwt.Claims{
+		Issuer:   issuer.Issuer,
+		Audience: jwt.Audience{issuer.Audience},
+	}, nil))
+	must.EqError(t, err, "JWT does not expire")
+
+	// JWTs signed by another issuer are rejected.
+	other := NewTestIssuer(t)
+	_, err = validator.Validate(ctx, other.Sign(nil))
+	must.StrContains(t, err.Error(), "no JWKS key found")
+}
+
+func TestValidator_PublicKeys(t *testing.T) {
+	ci.Parallel(t)
+
+	issuer := NewTestIssuer(t)
+	other := NewTestIssuer(t)
+
+	config := issuer.AuthMethodConfig()
+	config.JWKSURL = ""
+	config.JWTValidationPubKeys = []string{other.PublicKeyPEM(), issuer.PublicKeyPEM()}
+
+	validator, err := NewValidator(config)
+	must.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.NoError(t, err)
+
+	config.JWTValidationPubKeys = []string{other.PublicKeyPEM()}
+	validator, err = NewValidator(config)
+	must.NoError(t, err)
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.EqError(t, err, "JWT signature is invalid")
+
+	config.JWTValidationPubKeys = []string{"not a key"}
+	_, err = NewValidator(config)
+	must.EqError(t, err, "failed to parse JWT validation public key: no PEM data found")
+}
+
+func TestValidator_JWKSFile(t *testing.T) {
+	ci.Parallel(t)
+
+	issuer := NewTestIssuer(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+
+	config := issuer.AuthMethodConfig()
+	config.JWKSURL = "file://" + path
+
+	validator, err := NewValidator(config)
+	must.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.StrContains(t, err.Error(), "failed to read JWKS")
+
+	// The key set is read again once it is written and the read interval
+	// has passed.
+	validator.readInterval = 0
+	resp, err := issuer.server.Client().Get(issuer.JWKSURL())
+	must.NoError(t, err)
+	defer resp.Body.Close()
+	f, err := os.Create(path)
+	must.NoError(t, err)
+	_, err = f.ReadFrom(resp.Body)
+	must.NoError(t, err)
+	must.NoError(t, f.Close())
+
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.NoError(t, err)
+}
+
+func TestValidator_JWKSReadInterval(t *testing.T) {
+	ci.Parallel(t)
+
+	issuer := NewTestIssuer(t)
+	validator, err := NewValidator(issuer.AuthMethodConfig())
+	must.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.NoError(t, err)
+	must.Eq(t, 1, issuer.KeySetReads())
+
+	// JWTs signed by unknown keys fail without reading the key set again
+	// within the read interval.
+	other := NewTestIssuer(t)
+	for i := 0; i < 3; i++ {
+		_, err = validator.Validate(ctx, other.Sign(nil))
+		must.EqError(t, err, fmt.Sprintf("no JWKS key found for key ID %q", other.keyID))
+	}
+	must.Eq(t, 1, issuer.KeySetReads())
+
+	// Concurrent logins with unknown keys read the key set once.
+	validator.readInterval = time.Hour
+	validator.keySetRead = time.Time{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = validator.Validate(ctx, other.Sign(nil))
+		}()
+	}
+	wg.Wait()
+	must.Eq(t, 2, issuer.KeySetReads())
+
+	// Known keys are still accepted.
+	_, err = validator.Validate(ctx, issuer.Sign(nil))
+	must.NoError(t, err)
+	must.Eq(t, 2, issuer.KeySetReads())
+}
@@ -0,0 +1,59 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// ProviderCache caches the providers of auth methods, so the provider
+// metadata and keys are not fetched for every login. Providers are replaced
+// when their auth method changes.
+type ProviderCache struct {
+	providers map[string]*cachedProvider
+	lock      sync.Mutex
+}
+
+// cachedProvider is a provider and the hash of the auth method it was
+// created for
+type cachedProvider struct {
+	provider *Provider
+	hash     []byte
+}
+
+// NewProviderCache returns an empty provider cache.
+func NewProviderCache() *ProviderCache {
+	return &ProviderCache{
+		providers: make(map[string]*cachedProvider),
+	}
+}
+
+// Get returns the provider of the auth method, creating it if the auth method
+// is not cached or has changed since.
+func (c *ProviderCache) Get(ctx context.Context, authMethod *structs.ACLAuthMethod) (*Provider, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if cached, ok := c.providers[authMethod.Name]; ok && bytes.Equal(cached.hash, authMethod.Hash) {
+		return cached.provider, nil
+	}
+
+	provider, err := NewProvider(ctx, authMethod.Config)
+	if err != nil {
+		return nil, err
+	}
+	c.providers[authMethod.Name] = &cachedProvider{
+		provider: provider,
+		hash:     authMethod.Hash,
+	}
+	return provider, nil
+}
+
+// Delete removes the provider of the auth method from the cache.
+func (c *ProviderCache) Delete(authMethodName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.providers, authMethodName)
+}
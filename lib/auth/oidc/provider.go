@@ -0,0 +1,333 @@
+package oidc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/exp/slices"
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// scopeOpenID is the scope all OIDC authentication requests must include
+	scopeOpenID = "openid"
+
+	// defaultSigningAlg is the ID token signing algorithm used when the auth
+	// method does not configure any, as required by the OIDC specification
+	defaultSigningAlg = string(jose.RS256)
+
+	// clockSkewLeeway is the leeway given when validating the times of ID
+	// tokens
+	clockSkewLeeway = time.Minute
+
+	// keySetFetchInterval is the minimum time between two fetches of the key
+	// set of the provider, so that ID tokens signed by unknown keys can't make
+	// every login fetch it
+	keySetFetchInterval = 30 * time.Second
+)
+
+// discoveryDocument is the subset of the OIDC provider metadata used to
+// authenticate users
+type discoveryDocument struct {
+	Issuer   string `json:"issuer"`
+	AuthURL  string `json:"authorization_endpoint"`
+	TokenURL string `json:"token_endpoint"`
+	JWKSURL  string `json:"jwks_uri"`
+}
+
+// Provider authenticates users with an OIDC provider using the authorization
+// code flow. It is configured by an OIDC auth method.
+type Provider struct {
+	config    *structs.ACLAuthMethodConfig
+	client    *http.Client
+	discovery *discoveryDocument
+
+	// keySet is the last fetched key set of the provider, which is refreshed
+	// when an ID token is signed by an unknown key. keySetFetched is when it
+	// was last fetched, and keySetErr the error of that fetch, if it failed.
+	keySet        *jose.JSONWebKeySet
+	keySetFetched time.Time
+	keySetErr     error
+	keySetLock    sync.Mutex
+
+	// fetchCh is held by the request fetching the key set, so that
+	// concurrent requests wait for its result instead of fetching it as well
+	fetchCh chan struct{}
+
+	// fetchInterval is the minimum time between two fetches of the key set
+	fetchInterval time.Duration
+}
+
+// NewProvider returns a provider for the auth method config, reading the
+// provider metadata from its discovery URL.
+func NewProvider(ctx context.Context, config *structs.ACLAuthMethodConfig) (*Provider, error) {
+	client, err := httpClient(config.DiscoveryCaPem)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:        config,
+		client:        client,
+		fetchCh:       make(chan struct{}, 1),
+		fetchInterval: keySetFetchInterval,
+	}
+
+	wellKnown := strings.TrimSuffix(config.OIDCDiscoveryURL, "/") + "/.well-known/openid-configuration"
+	var doc discoveryDocument
+	if err := p.getJSON(ctx, wellKnown, &doc); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %v", err)
+	}
+
+	// The issuer must be the discovery URL the metadata was read from, so
+	// the provider can't impersonate another one.
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(config.OIDCDiscoveryURL, "/") {
+		return nil, fmt.Errorf("OIDC provider issuer %q does not match discovery URL %q",
+			doc.Issuer, config.OIDCDiscoveryURL)
+	}
+	if doc.AuthURL == "" || doc.TokenURL == "" || doc.JWKSURL == "" {
+		return nil, errors.New("OIDC provider metadata is missing endpoints")
+	}
+	p.discovery = &doc
+
+	return p, nil
+}
+
+// httpClient returns the client used to talk to the provider, trusting the
+// given CA certificates only if any are given.
+func httpClient(caPem []string) (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	if len(caPem) == 0 {
+		return client, nil
+	}
+
+	pool := x509.NewCertPool()
+	for _, pem := range caPem {
+		if !pool.AppendCertsFromPEM([]byte(pem)) {
+			return nil, errors.New("could not parse discovery CA certificates")
+		}
+	}
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	client.Transport = transport
+	return client, nil
+}
+
+// oauth2Config returns the OAuth2 config of the provider for the redirect URI
+func (p *Provider) oauth2Config(redirectURI string) *oauth2.Config {
+	scopes := []string{scopeOpenID}
+	for _, scope := range p.config.OIDCScopes {
+		if scope != scopeOpenID {
+			scopes = append(scopes, scope)
+		}
+	}
+	return &oauth2.Config{
+		ClientID:     p.config.OIDCClientID,
+		ClientSecret: p.config.OIDCClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  p.discovery.AuthURL,
+			TokenURL: p.discovery.TokenURL,
+		},
+		RedirectURL: redirectURI,
+		Scopes:      scopes,
+	}
+}
+
+// AuthURL returns the URL the user authenticates at. The provider redirects
+// to the redirect URI with the state and a code once authenticated, and the
+// nonce is included in the ID token the code is exchanged for.
+func (p *Provider) AuthURL(redirectURI, state, nonce string) string {
+	return p.oauth2Config(redirectURI).AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+}
+
+// Exchange exchanges the code returned to the redirect URI for an ID token,
+// and returns its claims once it is verified to be issued by the provider
+// for the nonce.
+func (p *Provider) Exchange(ctx context.Context, redirectURI, code, nonce string) (map[string]interface{}, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	token, err := p.oauth2Config(redirectURI).Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %v", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response is missing an ID token")
+	}
+
+	claims, err := p.verifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify ID token: %v", err)
+	}
+
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, errors.New("ID token nonce does not match")
+	}
+	return claims, nil
+}
+
+// verifyIDToken verifies the signature, issuer, audience and times of the ID
+// token and returns its claims.
+func (p *Provider) verifyIDToken(ctx context.Context, raw string) (map[string]interface{}, error) {
+	token, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(token.Headers) != 1 {
+		return nil, errors.New("ID token must have a single signature")
+	}
+	header := token.Headers[0]
+
+	algs := p.config.SigningAlgs
+	if len(algs) == 0 {
+		algs = []string{defaultSigningAlg}
+	}
+	if !slices.Contains(algs, header.Algorithm) {
+		return nil, fmt.Errorf("ID token signed with unsupported algorithm %q", header.Algorithm)
+	}
+
+	keys, err := p.keys(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		std    jwt.Claims
+		claims map[string]interface{}
+	)
+	verified := false
+	for _, key := range keys {
+		if err := token.Claims(key.Key, &std, &claims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("ID token signature is invalid")
+	}
+
+	if err := std.ValidateWithLeeway(jwt.Expected{
+		Issuer: p.discovery.Issuer,
+		Time:   time.Now(),
+	}, clockSkewLeeway); err != nil {
+		return nil, err
+	}
+	if std.Expiry == nil {
+		return nil, errors.New("ID token does not expire")
+	}
+
+	audiences := p.config.BoundAudiences
+	if len(audiences) == 0 {
+		audiences = []string{p.config.OIDCClientID}
+	}
+	bound := false
+	for _, aud := range audiences {
+		if std.Audience.Contains(aud) {
+			bound = true
+			break
+		}
+	}
+	if !bound {
+		return nil, errors.New("ID token audience does not match any bound audience")
+	}
+
+	return claims, nil
+}
+
+// keys returns the keys of the provider that may have signed a token with
+// the key ID. The key set is fetched again if no key matches, at most once per
+// fetch interval.
+func (p *Provider) keys(ctx context.Context, keyID string) ([]jose.JSONWebKey, error) {
+	if keys, _, _ := p.matchKeys(keyID); len(keys) != 0 {
+		return keys, nil
+	}
+
+	// Only one request fetches the key set at a time
+	select {
+	case p.fetchCh <- struct{}{}:
+		defer func() { <-p.fetchCh }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	// The key set may have been fetched while waiting
+	keys, fetched, err := p.matchKeys(keyID)
+	if len(keys) != 0 {
+		return keys, nil
+	}
+	if !fetched.IsZero() && time.Since(fetched) < p.fetchInterval {
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch OIDC provider keys: %v", err)
+		}
+		return nil, fmt.Errorf("no OIDC provider key found for key ID %q", keyID)
+	}
+
+	var keySet jose.JSONWebKeySet
+	err = p.getJSON(ctx, p.discovery.JWKSURL, &keySet)
+
+	p.keySetLock.Lock()
+	p.keySetFetched = time.Now()
+	p.keySetErr = err
+	if err == nil {
+		p.keySet = &keySet
+	}
+	p.keySetLock.Unlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC provider keys: %v", err)
+	}
+	if keys, _, _ := p.matchKeys(keyID); len(keys) != 0 {
+		return keys, nil
+	}
+	return nil, fmt.Errorf("no OIDC provider key found for key ID %q", keyID)
+}
+
+// matchKeys returns the keys of the last fetched key set matching the key ID,
+// along with when the key set was last fetched and the error of that fetch.
+func (p *Provider) matchKeys(keyID string) ([]jose.JSONWebKey, time.Time, error) {
+	p.keySetLock.Lock()
+	defer p.keySetLock.Unlock()
+
+	if p.keySet == nil {
+		return nil, p.keySetFetched, p.keySetErr
+	}
+	if keyID == "" {
+		return p.keySet.Keys, p.keySetFetched, p.keySetErr
+	}
+	return p.keySet.Key(keyID), p.keySetFetched, p.keySetErr
+}
+
+// getJSON decodes the JSON document at the URL into out
+func (p *Provider) getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response code %d: %s", resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,142 @@
+package oidc
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/shoenig/test/must"
+)
+
+func TestProvider_Exchange(t *testing.T) {
+	ci.Parallel(t)
+
+	testProvider := NewTestProvider(t)
+	testProvider.SetClaims(map[string]interface{}{
+		"groups": []interface{}{"engineering"},
+	})
+
+	config := testProvider.AuthMethodConfig("http://localhost:4649/oidc/callback")
+	config.OIDCScopes = []string{"groups"}
+
+	ctx := context.Background()
+	provider, err := NewProvider(ctx, config)
+	must.NoError(t, err)
+
+	authURL := provider.AuthURL("http://localhost:4649/oidc/callback", "state-1", "nonce-1")
+	parsed, err := url.Parse(authURL)
+	must.NoError(t, err)
+	must.Eq(t, "openid groups", parsed.Query().Get("scope"))
+	must.Eq(t, "nonce-1", parsed.Query().Get("nonce"))
+
+	code, state := testProvider.Authorize(authURL)
+	must.Eq(t, "state-1", state)
+
+	// The nonce of the ID token must match the one of the login.
+	_, err = provider.Exchange(ctx, "http://localhost:4649/oidc/callback", code, "nonce-2")
+	must.EqError(t, err, "ID token nonce does not match")
+
+	code, _ = testProvider.Authorize(authURL)
+	claims, err := provider.Exchange(ctx, "http://localhost:4649/oidc/callback", code, "nonce-1")
+	must.NoError(t, err)
+	must.Eq(t, "test-subject", claims["sub"].(string))
+	must.Eq(t, []interface{}{"engineering"}, claims["groups"].([]interface{}))
+
+	// Codes can only be exchanged once.
+	_, err = provider.Exchange(ctx, "http://localhost:4649/oidc/callback", code, "nonce-1")
+	must.Error(t, err)
+
+	// ID tokens must be issued for one of the bound audiences.
+	config.BoundAudiences = []string{"not-nomad"}
+	code, _ = testProvider.Authorize(authURL)
+	_, err = provider.Exchange(ctx, "http://localhost:4649/oidc/callback", code, "nonce-1")
+	must.StrContains(t, err.Error(), "audience")
+
+	// ID tokens must be signed with one of the allowed algorithms.
+	config.BoundAudiences = nil
+	config.SigningAlgs = []string{"ES256"}
+	code, _ = testProvider.Authorize(authURL)
+	_, err = provider.Exchange(ctx, "http://localhost:4649/oidc/callback", code, "nonce-1")
+	must.StrContains(t, err.Error(), "unsupported algorithm")
+}
+
+func TestProvider_KeySetFetchInterval(t *testing.T) {
+	ci.Parallel(t)
+
+	testProvider := NewTestProvider(t)
+	config := testProvider.AuthMethodConfig("http://localhost:4649/oidc/callback")
+
+	ctx := context.Background()
+	provider, err := NewProvider(ctx, config)
+	must.NoError(t, err)
+
+	authURL := provider.AuthURL("http://localhost:4649/oidc/callback", "state-1", "nonce-1")
+	exchange := func() error {
+		code, _ := testProvider.Authorize(authURL)
+		_, err := provider.Exchange(ctx, "http://localhost:4649/oidc/callback", code, "nonce-1")
+		return err
+	}
+
+	must.NoError(t, exchange())
+	must.Eq(t, 1, testProvider.KeySetReads())
+
+	// ID tokens signed by unknown keys fail without fetching the key set
+	// again within the fetch interval.
+	testProvider.RotateKeyID()
+	for i := 0; i < 3; i++ {
+		must.StrContains(t, exchange().Error(), "no OIDC provider key found")
+	}
+	must.Eq(t, 1, testProvider.KeySetReads())
+
+	// The rotated key is found once the fetch interval has passed.
+	provider.fetchInterval = 0
+	must.NoError(t, exchange())
+	must.Eq(t, 2, testProvider.KeySetReads())
+}
+
+func TestNewProvider_Discovery(t *testing.T) {
+	ci.Parallel(t)
+
+	testProvider := NewTestProvider(t)
+	config := testProvider.AuthMethodConfig("http://localhost:4649/oidc/callback")
+	config.OIDCDiscoveryURL = testProvider.Addr() + "/"
+
+	_, err := NewProvider(context.Background(), config)
+	must.NoError(t, err)
+
+	config.OIDCDiscoveryURL = "http://127.0.0.1:1"
+	_, err = NewProvider(context.Background(), config)
+	must.Error(t, err)
+}
+
+func TestProviderCache(t *testing.T) {
+	ci.Parallel(t)
+
+	testProvider := NewTestProvider(t)
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Config = testProvider.AuthMethodConfig("http://localhost:4649/oidc/callback")
+	authMethod.SetHash()
+
+	cache := NewProviderCache()
+	p1, err := cache.Get(context.Background(), authMethod)
+	must.NoError(t, err)
+
+	p2, err := cache.Get(context.Background(), authMethod)
+	must.NoError(t, err)
+	must.True(t, p1 == p2)
+
+	// Changing the auth method replaces its provider.
+	updated := authMethod.Copy()
+	updated.Config.OIDCScopes = []string{"groups"}
+	updated.SetHash()
+	p3, err := cache.Get(context.Background(), updated)
+	must.NoError(t, err)
+	must.True(t, p1 != p3)
+
+	cache.Delete(authMethod.Name)
+	p4, err := cache.Get(context.Background(), updated)
+	must.NoError(t, err)
+	must.True(t, p3 != p4)
+}
@@ -0,0 +1,239 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// TestProvider is a fake OIDC provider for tests. Every authentication
+// request is approved immediately for a subject with the configured claims.
+type TestProvider struct {
+	t      testing.TB
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	keyID  string
+
+	ClientID     string
+	ClientSecret string
+
+	// codes are the issued codes and the nonce of their authentication
+	// request
+	codes map[string]string
+
+	claims map[string]interface{}
+	lock   sync.Mutex
+
+	// keySetReads counts the requests for the key set of the provider
+	keySetReads int
+}
+
+// NewTestProvider starts a fake OIDC provider which is stopped when the test
+// ends.
+func NewTestProvider(t testing.TB) *TestProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	must.NoError(t, err)
+
+	p := &TestProvider{
+		t:            t,
+		key:          key,
+		keyID:        uuid.Short(),
+		ClientID:     "nomad",
+		ClientSecret: uuid.Generate(),
+		codes:        make(map[string]string),
+		claims:       make(map[string]interface{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/keys", p.handleKeys)
+	mux.HandleFunc("/authorize", p.handleAuthorize)
+	mux.HandleFunc("/token", p.handleToken)
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// Addr returns the discovery URL of the provider.
+func (p *TestProvider) Addr() string {
+	return p.server.URL
+}
+
+// SetClaims sets the custom claims of the ID tokens issued by the provider.
+func (p *TestProvider) SetClaims(claims map[string]interface{}) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.claims = claims
+}
+
+// AuthMethodConfig returns an OIDC auth method config for the provider,
+// allowing the given redirect URIs.
+func (p *TestProvider) AuthMethodConfig(redirectURIs ...string) *structs.ACLAuthMethodConfig {
+	return &structs.ACLAuthMethodConfig{
+		OIDCDiscoveryURL:    p.Addr(),
+		OIDCClientID:        p.ClientID,
+		OIDCClientSecret:    p.ClientSecret,
+		AllowedRedirectURIs: redirectURIs,
+	}
+}
+
+// Authorize approves the authentication request of the auth URL as a
+// browser would, and returns the code and state passed to the redirect URI.
+func (p *TestProvider) Authorize(authURL string) (code, state string) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authURL)
+	must.NoError(p.t, err)
+	defer resp.Body.Close()
+	must.Eq(p.t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	must.NoError(p.t, err)
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+// RotateKeyID changes the key ID the provider signs ID tokens with.
+func (p *TestProvider) RotateKeyID() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.keyID = uuid.Short()
+}
+
+// KeySetReads returns the number of times the key set of the provider was
+// read.
+func (p *TestProvider) KeySetReads() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.keySetReads
+}
+
+func (p *TestProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, map[string]string{
+		"issuer":                 p.Addr(),
+		"authorization_endpoint": p.Addr() + "/authorize",
+		"token_endpoint":         p.Addr() + "/token",
+		"jwks_uri":               p.Addr() + "/keys",
+	})
+}
+
+func (p *TestProvider) handleKeys(w http.ResponseWriter, _ *http.Request) {
+	p.lock.Lock()
+	p.keySetReads++
+	keyID := p.keyID
+	p.lock.Unlock()
+
+	p.writeJSON(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key:       &p.key.PublicKey,
+		KeyID:     keyID,
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}}})
+}
+
+func (p *TestProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != p.ClientID || q.Get("response_type") != "code" {
+		http.Error(w, "invalid authentication request", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	code := uuid.Generate()
+	p.lock.Lock()
+	p.codes[code] = q.Get("nonce")
+	p.lock.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *TestProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != p.ClientID || clientSecret != p.ClientSecret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	p.lock.Lock()
+	nonce, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	claims := make(map[string]interface{}, len(p.claims))
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+	keyID := p.keyID
+	p.lock.Unlock()
+
+	if !ok {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: p.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyID),
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	claims["nonce"] = nonce
+	idToken, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer:   p.Addr(),
+		Subject:  "test-subject",
+		Audience: jwt.Audience{p.ClientID},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}).Claims(claims).CompactSerialize()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.writeJSON(w, map[string]interface{}{
+		"access_token": uuid.Generate(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (p *TestProvider) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		p.t.Errorf("failed to write response: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package nomad
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -16,9 +17,12 @@ import (
 	policy "github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/lib/auth"
+	"github.com/hashicorp/nomad/lib/auth/jwt"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/state/paginator"
 	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -30,6 +34,10 @@ const (
 	// aclBootstrapReset is the file name to create in the data dir. It's only contents
 	// should be the reset index
 	aclBootstrapReset = "acl-bootstrap-reset"
+
+	// oidcRequestTimeout is the timeout of the requests made to OIDC
+	// providers and JWKS URLs while logging in
+	oidcRequestTimeout = 30 * time.Second
 )
 
 // ACL endpoint is used for manipulating ACL tokens and policies
@@ -1573,3 +1581,867 @@ func (a *ACL) GetRoleByName(
 		},
 	})
 }
+
+// UpsertAuthMethods is used to create or update a set of auth methods.
+func (a *ACL) UpsertAuthMethods(
+	args *structs.ACLAuthMethodsUpsertRequest,
+	reply *structs.ACLAuthMethodsUpsertResponse) error {
+
+	// Only allow operators to upsert auth methods when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// This endpoint always forwards to the authoritative region as auth
+	// methods are global.
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward(structs.ACLUpsertAuthMethodsRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "upsert_auth_methods"}, time.Now())
+
+	// Only tokens with management level permissions can create auth methods.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.AuthMethods) == 0 {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, "must specify as least one auth method")
+	}
+
+	stateSnapshot, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	// defaults tracks the default auth method of each type, including the
+	// ones of this request, as there can only be one.
+	defaults := make(map[string]string)
+
+	for idx, authMethod := range args.AuthMethods {
+
+		// Updates only need to include the fields they change.
+		existing, err := stateSnapshot.GetACLAuthMethodByName(nil, authMethod.Name)
+		if err != nil {
+			return structs.NewErrRPCCodedf(http.StatusInternalServerError, "auth method lookup failed: %v", err)
+		}
+		authMethod.Merge(existing)
+		authMethod.Canonicalize()
+
+		if err := authMethod.Validate(
+			a.srv.config.ACLTokenMinExpirationTTL,
+			a.srv.config.ACLTokenMaxExpirationTTL); err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "auth method %d invalid: %v", idx, err)
+		}
+
+		// The public keys of JWT methods are parsed now rather than failing
+		// every login.
+		if authMethod.Type == structs.ACLAuthMethodTypeJWT {
+			if _, err := jwt.NewValidator(authMethod.Config); err != nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "auth method %d invalid: %v", idx, err)
+			}
+		}
+
+		if authMethod.Default {
+			if name, ok := defaults[authMethod.Type]; ok {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest,
+					"default %s auth method already set to %s", authMethod.Type, name)
+			}
+			defaults[authMethod.Type] = authMethod.Name
+
+			existingDefault, err := stateSnapshot.GetDefaultACLAuthMethodByType(nil, authMethod.Type)
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusInternalServerError, "auth method lookup failed: %v", err)
+			}
+			if existingDefault != nil && existingDefault.Name != authMethod.Name {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest,
+					"default %s auth method already exists: %s", authMethod.Type, existingDefault.Name)
+			}
+		}
+
+		authMethod.SetHash()
+	}
+
+	// Update via Raft.
+	out, index, err := a.srv.raftApply(structs.ACLAuthMethodsUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if the FSM response, which is an interface, contains an error.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	// Populate the response. We do a lookup against the state to pick up the
+	// proper create / modify times.
+	stateSnapshot, err = a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	for _, authMethod := range args.AuthMethods {
+		lookupAuthMethod, err := stateSnapshot.GetACLAuthMethodByName(nil, authMethod.Name)
+		if err != nil {
+			return structs.NewErrRPCCodedf(http.StatusInternalServerError, "auth method lookup failed: %v", err)
+		}
+		reply.AuthMethods = append(reply.AuthMethods, lookupAuthMethod)
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// DeleteAuthMethods is used to delete auth methods along with their binding
+// rules.
+func (a *ACL) DeleteAuthMethods(
+	args *structs.ACLAuthMethodsDeleteRequest,
+	reply *structs.ACLAuthMethodsDeleteResponse) error {
+
+	// Only allow operators to delete auth methods when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// This endpoint always forwards to the authoritative region as auth
+	// methods are global.
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward(structs.ACLDeleteAuthMethodsRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "delete_auth_methods"}, time.Now())
+
+	// Only tokens with management level permissions can delete auth methods.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.Names) == 0 {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, "must specify as least one auth method")
+	}
+
+	// Update via Raft.
+	out, index, err := a.srv.raftApply(structs.ACLAuthMethodsDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if the FSM response, which is an interface, contains an error.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	for _, name := range args.Names {
+		a.srv.oidcProviderCache.Delete(name)
+		a.srv.jwtValidatorCache.Delete(name)
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// ListAuthMethods is used to list the auth methods. Listing does not require
+// a token, as the methods are needed in order to log in.
+func (a *ACL) ListAuthMethods(
+	args *structs.ACLAuthMethodListRequest,
+	reply *structs.ACLAuthMethodListResponse) error {
+
+	// Only allow operators to list auth methods when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	if done, err := a.srv.forward(structs.ACLListAuthMethodsRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "list_auth_methods"}, time.Now())
+
+	// Set up and return the blocking query.
+	return a.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
+
+			iter, err := stateStore.GetACLAuthMethods(ws)
+			if err != nil {
+				return err
+			}
+
+			// Only the stubs are returned, which do not include the config
+			// of the methods.
+			var stubs []*structs.ACLAuthMethodStub
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				stubs = append(stubs, raw.(*structs.ACLAuthMethod).Stub())
+			}
+			reply.AuthMethods = stubs
+
+			// Use the index table to populate the query meta as we have no way
+			// of tracking the max index on deletes.
+			return a.srv.setReplyQueryMeta(stateStore, state.TableACLAuthMethods, &reply.QueryMeta)
+		},
+	})
+}
+
+// GetAuthMethod is used to get a single auth method, which includes the
+// secrets of its config.
+func (a *ACL) GetAuthMethod(
+	args *structs.ACLAuthMethodGetRequest,
+	reply *structs.ACLAuthMethodGetResponse) error {
+
+	// Only allow operators to read an auth method when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	if done, err := a.srv.forward(structs.ACLGetAuthMethodRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_auth_method_name"}, time.Now())
+
+	// Only tokens with management level permissions can read auth methods.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Set up and return the blocking query.
+	return a.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
+
+			out, err := stateStore.GetACLAuthMethodByName(ws, args.MethodName)
+			if err != nil {
+				return err
+			}
+
+			// Set the index correctly depending on whether the auth method
+			// was found.
+			switch out {
+			case nil:
+				index, err := stateStore.Index(state.TableACLAuthMethods)
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			default:
+				reply.Index = out.ModifyIndex
+			}
+
+			reply.AuthMethod = out
+			return nil
+		},
+	})
+}
+
+// GetAuthMethods is used to get a set of auth methods by their names. This
+// endpoint is used by the replication process and uses a specific response
+// in order to make that process easier.
+func (a *ACL) GetAuthMethods(
+	args *structs.ACLAuthMethodsGetRequest,
+	reply *structs.ACLAuthMethodsGetResponse) error {
+
+	// This endpoint is only used by the replication process which is only
+	// running on ACL enabled clusters, so this check should never be
+	// triggered.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	if done, err := a.srv.forward(structs.ACLGetAuthMethodsRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_auth_methods"}, time.Now())
+
+	// Check that the caller has a management token and that ACLs are enabled
+	// properly.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Set up and return the blocking query.
+	return a.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
+
+			// Instantiate the output map to the correct maximum length.
+			reply.AuthMethods = make(map[string]*structs.ACLAuthMethod, len(args.Names))
+
+			// Look for the auth method and add this to our mapping if we have
+			// found it.
+			for _, name := range args.Names {
+				out, err := stateStore.GetACLAuthMethodByName(ws, name)
+				if err != nil {
+					return err
+				}
+				if out != nil {
+					reply.AuthMethods[out.Name] = out
+				}
+			}
+
+			// Use the index table to populate the query meta as we have no way
+			// of tracking the max index on deletes.
+			return a.srv.setReplyQueryMeta(stateStore, state.TableACLAuthMethods, &reply.QueryMeta)
+		},
+	})
+}
+
+// UpsertBindingRules is used to create or update a set of binding rules.
+func (a *ACL) UpsertBindingRules(
+	args *structs.ACLBindingRulesUpsertRequest,
+	reply *structs.ACLBindingRulesUpsertResponse) error {
+
+	// Only allow operators to upsert binding rules when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// This endpoint always forwards to the authoritative region as binding
+	// rules are global.
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward(structs.ACLUpsertBindingRulesRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "upsert_binding_rules"}, time.Now())
+
+	// Only tokens with management level permissions can create binding
+	// rules.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.ACLBindingRules) == 0 {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, "must specify as least one binding rule")
+	}
+
+	stateSnapshot, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	for idx, bindingRule := range args.ACLBindingRules {
+
+		// If the caller has passed a rule ID, this call is considered an
+		// update to an existing rule, which only needs to include the fields
+		// it changes.
+		if bindingRule.ID != "" {
+			existing, err := stateSnapshot.GetACLBindingRule(nil, bindingRule.ID)
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusInternalServerError, "binding rule lookup failed: %v", err)
+			}
+			if existing == nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "cannot find binding rule %s", bindingRule.ID)
+			}
+			bindingRule.Merge(existing)
+		}
+
+		bindingRule.Canonicalize()
+
+		if err := bindingRule.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "binding rule %d invalid: %v", idx, err)
+		}
+
+		if !args.AllowMissingAuthMethods {
+			authMethod, err := stateSnapshot.GetACLAuthMethodByName(nil, bindingRule.AuthMethod)
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusInternalServerError, "auth method lookup failed: %v", err)
+			}
+			if authMethod == nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "cannot find auth method %s", bindingRule.AuthMethod)
+			}
+		}
+
+		bindingRule.SetHash()
+	}
+
+	// Update via Raft.
+	out, index, err := a.srv.raftApply(structs.ACLBindingRulesUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if the FSM response, which is an interface, contains an error.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	// Populate the response. We do a lookup against the state to pick up the
+	// proper create / modify times.
+	stateSnapshot, err = a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	for _, bindingRule := range args.ACLBindingRules {
+		lookupBindingRule, err := stateSnapshot.GetACLBindingRule(nil, bindingRule.ID)
+		if err != nil {
+			return structs.NewErrRPCCodedf(http.StatusInternalServerError, "binding rule lookup failed: %v", err)
+		}
+		reply.ACLBindingRules = append(reply.ACLBindingRules, lookupBindingRule)
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// DeleteBindingRules is used to batch delete binding rules using their IDs.
+func (a *ACL) DeleteBindingRules(
+	args *structs.ACLBindingRulesDeleteRequest,
+	reply *structs.ACLBindingRulesDeleteResponse) error {
+
+	// Only allow operators to delete binding rules when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// This endpoint always forwards to the authoritative region as binding
+	// rules are global.
+	args.Region = a.srv.config.AuthoritativeRegion
+
+	if done, err := a.srv.forward(structs.ACLDeleteBindingRulesRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "delete_binding_rules"}, time.Now())
+
+	// Only tokens with management level permissions can delete binding
+	// rules.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.ACLBindingRuleIDs) == 0 {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, "must specify as least one binding rule")
+	}
+
+	// Update via Raft.
+	out, index, err := a.srv.raftApply(structs.ACLBindingRulesDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if the FSM response, which is an interface, contains an error.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// ListBindingRules is used to list the binding rules. If a prefix is
+// supplied, a prefix search is performed on the binding rule ID.
+func (a *ACL) ListBindingRules(
+	args *structs.ACLBindingRulesListRequest,
+	reply *structs.ACLBindingRulesListResponse) error {
+
+	// Only allow operators to list binding rules when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	if done, err := a.srv.forward(structs.ACLListBindingRulesRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "list_binding_rules"}, time.Now())
+
+	// Only tokens with management level permissions can list binding rules.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Set up and return the blocking query.
+	return a.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
+
+			var (
+				err  error
+				iter memdb.ResultIterator
+			)
+
+			switch args.QueryOptions.Prefix {
+			case "":
+				iter, err = stateStore.GetACLBindingRules(ws)
+			default:
+				iter, err = stateStore.GetACLBindingRuleByIDPrefix(ws, args.QueryOptions.Prefix)
+			}
+			if err != nil {
+				return err
+			}
+
+			var stubs []*structs.ACLBindingRuleListStub
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				stubs = append(stubs, raw.(*structs.ACLBindingRule).Stub())
+			}
+			reply.ACLBindingRules = stubs
+
+			// Use the index table to populate the query meta as we have no way
+			// of tracking the max index on deletes.
+			return a.srv.setReplyQueryMeta(stateStore, state.TableACLBindingRules, &reply.QueryMeta)
+		},
+	})
+}
+
+// GetBindingRules is used to get a set of binding rules by their IDs. This
+// endpoint is used by the replication process and uses a specific response
+// in order to make that process easier.
+func (a *ACL) GetBindingRules(
+	args *structs.ACLBindingRulesRequest,
+	reply *structs.ACLBindingRulesResponse) error {
+
+	// This endpoint is only used by the replication process which is only
+	// running on ACL enabled clusters, so this check should never be
+	// triggered.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	if done, err := a.srv.forward(structs.ACLGetBindingRulesRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_binding_rules"}, time.Now())
+
+	// Check that the caller has a management token and that ACLs are enabled
+	// properly.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Set up and return the blocking query.
+	return a.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
+
+			// Instantiate the output map to the correct maximum length.
+			reply.ACLBindingRules = make(map[string]*structs.ACLBindingRule, len(args.ACLBindingRuleIDs))
+
+			// Look for the binding rule and add this to our mapping if we
+			// have found it.
+			for _, ruleID := range args.ACLBindingRuleIDs {
+				out, err := stateStore.GetACLBindingRule(ws, ruleID)
+				if err != nil {
+					return err
+				}
+				if out != nil {
+					reply.ACLBindingRules[out.ID] = out
+				}
+			}
+
+			// Use the index table to populate the query meta as we have no way
+			// of tracking the max index on deletes.
+			return a.srv.setReplyQueryMeta(stateStore, state.TableACLBindingRules, &reply.QueryMeta)
+		},
+	})
+}
+
+// GetBindingRule is used to look up an individual binding rule using its
+// ID.
+func (a *ACL) GetBindingRule(
+	args *structs.ACLBindingRuleRequest,
+	reply *structs.ACLBindingRuleResponse) error {
+
+	// Only allow operators to read a binding rule when ACLs are enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	if done, err := a.srv.forward(structs.ACLGetBindingRuleRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "get_binding_rule"}, time.Now())
+
+	// Only tokens with management level permissions can read binding rules.
+	if acl, err := a.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if acl == nil || !acl.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Set up and return the blocking query.
+	return a.srv.blockingRPC(&blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, stateStore *state.StateStore) error {
+
+			out, err := stateStore.GetACLBindingRule(ws, args.ACLBindingRuleID)
+			if err != nil {
+				return err
+			}
+
+			// Set the index correctly depending on whether the binding rule
+			// was found.
+			switch out {
+			case nil:
+				index, err := stateStore.Index(state.TableACLBindingRules)
+				if err != nil {
+					return err
+				}
+				reply.Index = index
+			default:
+				reply.Index = out.ModifyIndex
+			}
+
+			reply.ACLBindingRule = out
+			return nil
+		},
+	})
+}
+
+// OIDCAuthURL starts the OIDC login flow by returning the URL of the OIDC
+// provider the user authenticates at.
+func (a *ACL) OIDCAuthURL(args *structs.ACLOIDCAuthURLRequest, reply *structs.ACLOIDCAuthURLResponse) error {
+
+	// The OIDC flow can only be used when the Nomad cluster has ACL enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// Perform the initial forwarding within the region. This ensures we
+	// respect stale queries.
+	if done, err := a.srv.forward(structs.ACLOIDCAuthURLRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "oidc_auth_url"}, time.Now())
+
+	// Validate the request arguments to ensure it contains all the data it
+	// needs.
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid OIDC auth-url request: %v", err)
+	}
+
+	authMethod, err := a.oidcAuthMethod(args.AuthMethodName, args.RedirectURI)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(a.srv.shutdownCtx, oidcRequestTimeout)
+	defer cancel()
+
+	provider, err := a.srv.oidcProviderCache.Get(ctx, authMethod)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "failed to create OIDC provider: %v", err)
+	}
+
+	// The state is checked by the client when the provider redirects to it,
+	// while the client nonce is checked against the ID token when the login
+	// is completed.
+	reply.AuthURL = provider.AuthURL(args.RedirectURI, uuid.Generate(), args.ClientNonce)
+	return nil
+}
+
+// OIDCCompleteAuth completes the OIDC login flow by exchanging the code the
+// OIDC provider redirected to the client with for an ID token. The ID token
+// claims are matched against the binding rules of the auth method, and a
+// token with the bound roles and policies is created.
+func (a *ACL) OIDCCompleteAuth(
+	args *structs.ACLOIDCCompleteAuthRequest, reply *structs.ACLLoginResponse) error {
+
+	// The OIDC flow can only be used when the Nomad cluster has ACL enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// Global tokens must be created in the authoritative region, so they can
+	// be replicated.
+	if authMethod, err := a.srv.State().GetACLAuthMethodByName(nil, args.AuthMethodName); err == nil &&
+		authMethod != nil && authMethod.TokenLocalityIsGlobal() {
+		args.Region = a.srv.config.AuthoritativeRegion
+	}
+
+	if done, err := a.srv.forward(structs.ACLOIDCCompleteAuthRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "oidc_complete_auth"}, time.Now())
+
+	// Validate the request arguments to ensure it contains all the data it
+	// needs.
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid OIDC complete-auth request: %v", err)
+	}
+
+	authMethod, err := a.oidcAuthMethod(args.AuthMethodName, args.RedirectURI)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(a.srv.shutdownCtx, oidcRequestTimeout)
+	defer cancel()
+
+	provider, err := a.srv.oidcProviderCache.Get(ctx, authMethod)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "failed to create OIDC provider: %v", err)
+	}
+
+	claims, err := provider.Exchange(ctx, args.RedirectURI, args.Code, args.ClientNonce)
+	if err != nil {
+		a.logger.Debug("failed to complete OIDC login", "auth_method", authMethod.Name, "error", err)
+		return structs.NewErrRPCCodedf(http.StatusForbidden, "failed to complete OIDC login: %v", err)
+	}
+
+	return a.createLoginToken(authMethod, claims, reply)
+}
+
+// Login logs in with a JWT auth method by exchanging a JWT issued by a third
+// party, such as a CI system, for a token. The JWT is validated against the
+// keys of the auth method, and its claims are matched against the binding
+// rules of the auth method.
+func (a *ACL) Login(args *structs.ACLLoginRequest, reply *structs.ACLLoginResponse) error {
+
+	// Logging in can only be used when the Nomad cluster has ACL enabled.
+	if !a.srv.config.ACLEnabled {
+		return aclDisabled
+	}
+
+	// Global tokens must be created in the authoritative region, so they can
+	// be replicated.
+	if authMethod, err := a.srv.State().GetACLAuthMethodByName(nil, args.AuthMethodName); err == nil &&
+		authMethod != nil && authMethod.TokenLocalityIsGlobal() {
+		args.Region = a.srv.config.AuthoritativeRegion
+	}
+
+	if done, err := a.srv.forward(structs.ACLLoginRPCMethod, args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "acl", "login"}, time.Now())
+
+	// Validate the request arguments to ensure it contains all the data it
+	// needs.
+	if err := args.Validate(); err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid login request: %v", err)
+	}
+
+	authMethod, err := a.srv.State().GetACLAuthMethodByName(nil, args.AuthMethodName)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "auth method lookup failed: %v", err)
+	}
+	if authMethod == nil {
+		return structs.NewErrRPCCodedf(http.StatusNotFound, "auth-method %q not found", args.AuthMethodName)
+	}
+	if authMethod.Type != structs.ACLAuthMethodTypeJWT {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "auth-method %q is not a JWT method", args.AuthMethodName)
+	}
+
+	validator, err := a.srv.jwtValidatorCache.Get(authMethod)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "failed to create JWT validator: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(a.srv.shutdownCtx, oidcRequestTimeout)
+	defer cancel()
+
+	claims, err := validator.Validate(ctx, args.LoginToken)
+	if err != nil {
+		a.logger.Debug("failed to validate login token", "auth_method", authMethod.Name, "error", err)
+		return structs.NewErrRPCCodedf(http.StatusForbidden, "failed to validate login token: %v", err)
+	}
+
+	return a.createLoginToken(authMethod, claims, reply)
+}
+
+// createLoginToken creates the token of a login with the auth method. The
+// claims made about the identity logging in are matched against the binding
+// rules of the auth method, and the token is created with the bound roles and
+// policies.
+func (a *ACL) createLoginToken(
+	authMethod *structs.ACLAuthMethod, claims map[string]interface{}, reply *structs.ACLLoginResponse) error {
+
+	identity, err := auth.NewIdentity(authMethod.Config, claims)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "failed to map %s claims: %v", authMethod.Type, err)
+	}
+
+	stateSnapshot, err := a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	bindings, err := auth.NewBinder(stateSnapshot).Bind(authMethod, identity)
+	if err != nil {
+		return err
+	}
+	if bindings.None() {
+		return structs.NewErrRPCCoded(http.StatusForbidden, "no role or policy bindings matched")
+	}
+
+	token := &structs.ACLToken{
+		Name:          authMethod.Type + "-" + authMethod.Name,
+		Global:        authMethod.TokenLocalityIsGlobal(),
+		ExpirationTTL: authMethod.MaxTokenTTL,
+	}
+	if bindings.Management {
+		token.Type = structs.ACLManagementToken
+	} else {
+		token.Type = structs.ACLClientToken
+		token.Policies = bindings.Policies
+		token.Roles = bindings.Roles
+	}
+	token.Canonicalize()
+	token.SetHash()
+
+	upsertReq := structs.ACLTokenUpsertRequest{
+		Tokens:       []*structs.ACLToken{token},
+		WriteRequest: structs.WriteRequest{Region: a.srv.config.Region},
+	}
+
+	// Update via Raft.
+	out, index, err := a.srv.raftApply(structs.ACLTokenUpsertRequestType, &upsertReq)
+	if err != nil {
+		return err
+	}
+
+	// Check if the FSM response, which is an interface, contains an error.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	// Populate the response. We do a lookup against the state to pick up the
+	// proper create / modify times.
+	stateSnapshot, err = a.srv.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	reply.ACLToken, err = stateSnapshot.ACLTokenByAccessorID(nil, token.AccessorID)
+	if err != nil {
+		return structs.NewErrRPCCodedf(http.StatusInternalServerError, "token lookup failed: %v", err)
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// oidcAuthMethod returns the named OIDC auth method, checking the redirect
+// URI is allowed by it.
+func (a *ACL) oidcAuthMethod(name, redirectURI string) (*structs.ACLAuthMethod, error) {
+	authMethod, err := a.srv.State().GetACLAuthMethodByName(nil, name)
+	if err != nil {
+		return nil, structs.NewErrRPCCodedf(http.StatusInternalServerError, "auth method lookup failed: %v", err)
+	}
+	if authMethod == nil {
+		return nil, structs.NewErrRPCCodedf(http.StatusNotFound, "auth-method %q not found", name)
+	}
+	if authMethod.Type != structs.ACLAuthMethodTypeOIDC {
+		return nil, structs.NewErrRPCCodedf(http.StatusBadRequest, "auth-method %q is not an OIDC method", name)
+	}
+	if !slices.Contains(authMethod.Config.AllowedRedirectURIs, redirectURI) {
+		return nil, structs.NewErrRPCCodedf(http.StatusBadRequest, "redirect URI %q is not allowed", redirectURI)
+	}
+	return authMethod, nil
+}
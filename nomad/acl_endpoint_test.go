@@ -12,6 +12,8 @@ import (
 	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/lib/auth/jwt"
+	"github.com/hashicorp/nomad/lib/auth/oidc"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
@@ -2523,3 +2525,476 @@ func TestACL_GetRoleByName(t *testing.T) {
 	err = msgpackrpc.CallWithCodec(codec, structs.ACLGetRoleByNameRPCMethod, aclRoleReq6, &aclRoleResp6)
 	require.ErrorContains(t, err, "Permission denied")
 }
+
+func TestACL_UpsertAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, aclRootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	// Attempt to upsert an auth method without setting an ACL token. This
+	// should fail.
+	authMethod1 := mock.ACLAuthMethod()
+	authMethod1.Default = true
+	authMethod1.SetHash()
+	req := &structs.ACLAuthMethodsUpsertRequest{
+		AuthMethods:  []*structs.ACLAuthMethod{authMethod1},
+		WriteRequest: structs.WriteRequest{Region: DefaultRegion},
+	}
+	var resp structs.ACLAuthMethodsUpsertResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	// Upsert the auth method with the root token.
+	req.AuthToken = aclRootToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, req, &resp))
+	must.Len(t, 1, resp.AuthMethods)
+	must.True(t, authMethod1.Equal(resp.AuthMethods[0]))
+	must.NonZero(t, resp.Index)
+
+	// An update only needs to include the fields it changes.
+	update := &structs.ACLAuthMethod{Name: authMethod1.Name, MaxTokenTTL: 2 * time.Hour, Default: true}
+	req.AuthMethods = []*structs.ACLAuthMethod{update}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, req, &resp))
+	must.Eq(t, 2*time.Hour, resp.AuthMethods[0].MaxTokenTTL)
+	must.Eq(t, authMethod1.Config, resp.AuthMethods[0].Config)
+	must.Eq(t, authMethod1.TokenLocality, resp.AuthMethods[0].TokenLocality)
+
+	// Only one auth method of a type can be the default.
+	authMethod2 := mock.ACLAuthMethod()
+	authMethod2.Default = true
+	req.AuthMethods = []*structs.ACLAuthMethod{authMethod2}
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, req, &resp)
+	require.ErrorContains(t, err, "default OIDC auth method already exists")
+
+	// The token TTL must be within the bounds of the server.
+	authMethod2.Default = false
+	authMethod2.MaxTokenTTL = 48 * time.Hour
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, req, &resp)
+	require.ErrorContains(t, err, "invalid max token TTL")
+}
+
+func TestACL_DeleteAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, aclRootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	// Create the auth methods and a binding rule of the first one.
+	authMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()}
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(structs.MsgTypeTestSetup, 10, authMethods))
+
+	bindingRule := mock.ACLBindingRule()
+	bindingRule.AuthMethod = authMethods[0].Name
+	must.NoError(t, testServer.fsm.State().UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLBindingRule{bindingRule}, false))
+
+	// Attempt to delete the auth method without setting an ACL token. This
+	// should fail.
+	req := &structs.ACLAuthMethodsDeleteRequest{
+		Names:        []string{authMethods[0].Name},
+		WriteRequest: structs.WriteRequest{Region: DefaultRegion},
+	}
+	var resp structs.ACLAuthMethodsDeleteResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLDeleteAuthMethodsRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	req.AuthToken = aclRootToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLDeleteAuthMethodsRPCMethod, req, &resp))
+	must.NonZero(t, resp.Index)
+
+	out, err := testServer.fsm.State().GetACLAuthMethodByName(nil, authMethods[0].Name)
+	must.NoError(t, err)
+	must.Nil(t, out)
+
+	outRule, err := testServer.fsm.State().GetACLBindingRule(nil, bindingRule.ID)
+	must.NoError(t, err)
+	must.Nil(t, outRule)
+
+	// Deleting an auth method which does not exist fails.
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLDeleteAuthMethodsRPCMethod, req, &resp)
+	require.ErrorContains(t, err, "ACL auth method not found")
+}
+
+func TestACL_ListAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, _, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	authMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()}
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(structs.MsgTypeTestSetup, 10, authMethods))
+
+	// Listing auth methods does not require a token, as they are needed to
+	// log in.
+	req := &structs.ACLAuthMethodListRequest{
+		QueryOptions: structs.QueryOptions{Region: DefaultRegion},
+	}
+	var resp structs.ACLAuthMethodListResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListAuthMethodsRPCMethod, req, &resp))
+	must.Len(t, 2, resp.AuthMethods)
+	must.Eq(t, 10, resp.Index)
+
+	// Perform a blocking query which is unblocked by an auth method upsert.
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(
+			structs.MsgTypeTestSetup, 20, []*structs.ACLAuthMethod{mock.ACLAuthMethod()}))
+	}()
+
+	req.MinQueryIndex = 10
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListAuthMethodsRPCMethod, req, &resp))
+	must.Len(t, 3, resp.AuthMethods)
+	must.Eq(t, 20, resp.Index)
+}
+
+func TestACL_GetAuthMethod(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, aclRootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	authMethod := mock.ACLAuthMethod()
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	// Reading an auth method requires a management token, as the config
+	// contains secrets.
+	req := &structs.ACLAuthMethodGetRequest{
+		MethodName:   authMethod.Name,
+		QueryOptions: structs.QueryOptions{Region: DefaultRegion},
+	}
+	var resp structs.ACLAuthMethodGetResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLGetAuthMethodRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	req.AuthToken = aclRootToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLGetAuthMethodRPCMethod, req, &resp))
+	must.Eq(t, authMethod.Hash, resp.AuthMethod.Hash)
+	must.Eq(t, 10, resp.Index)
+
+	req.MethodName = "not-a-method"
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLGetAuthMethodRPCMethod, req, &resp))
+	must.Nil(t, resp.AuthMethod)
+}
+
+func TestACL_UpsertBindingRules(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, aclRootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	bindingRule := mock.ACLBindingRule()
+	bindingRule.ID = ""
+
+	// Attempt to upsert the binding rule without setting an ACL token. This
+	// should fail.
+	req := &structs.ACLBindingRulesUpsertRequest{
+		ACLBindingRules: []*structs.ACLBindingRule{bindingRule},
+		WriteRequest:    structs.WriteRequest{Region: DefaultRegion},
+	}
+	var resp structs.ACLBindingRulesUpsertResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLUpsertBindingRulesRPCMethod, req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	// The auth method of the binding rule must exist.
+	req.AuthToken = aclRootToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLUpsertBindingRulesRPCMethod, req, &resp)
+	require.ErrorContains(t, err, "cannot find auth method")
+
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Name = bindingRule.AuthMethod
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLUpsertBindingRulesRPCMethod, req, &resp))
+	must.Len(t, 1, resp.ACLBindingRules)
+	must.NotEq(t, "", resp.ACLBindingRules[0].ID)
+	must.Eq(t, bindingRule.Selector, resp.ACLBindingRules[0].Selector)
+
+	// Update the binding rule; its auth method and create time are kept.
+	update := resp.ACLBindingRules[0].Copy()
+	update.BindName = "eng-rw"
+	update.AuthMethod = ""
+	req.ACLBindingRules = []*structs.ACLBindingRule{update}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLUpsertBindingRulesRPCMethod, req, &resp))
+	must.Eq(t, "eng-rw", resp.ACLBindingRules[0].BindName)
+	must.Eq(t, bindingRule.AuthMethod, resp.ACLBindingRules[0].AuthMethod)
+	must.Eq(t, update.CreateTime, resp.ACLBindingRules[0].CreateTime)
+
+	// Invalid selectors are rejected.
+	invalid := mock.ACLBindingRule()
+	invalid.ID = ""
+	invalid.Selector = "engineering in"
+	req.ACLBindingRules = []*structs.ACLBindingRule{invalid}
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLUpsertBindingRulesRPCMethod, req, &resp)
+	require.ErrorContains(t, err, "selector is invalid")
+
+	// Updating a binding rule which does not exist fails.
+	req.ACLBindingRules = []*structs.ACLBindingRule{mock.ACLBindingRule()}
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLUpsertBindingRulesRPCMethod, req, &resp)
+	require.ErrorContains(t, err, "cannot find binding rule")
+}
+
+func TestACL_BindingRules(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, aclRootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	bindingRules := []*structs.ACLBindingRule{mock.ACLBindingRule(), mock.ACLBindingRule()}
+	must.NoError(t, testServer.fsm.State().UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 10, bindingRules, true))
+
+	// Listing binding rules requires a management token.
+	listReq := &structs.ACLBindingRulesListRequest{
+		QueryOptions: structs.QueryOptions{Region: DefaultRegion},
+	}
+	var listResp structs.ACLBindingRulesListResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLListBindingRulesRPCMethod, listReq, &listResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	listReq.AuthToken = aclRootToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListBindingRulesRPCMethod, listReq, &listResp))
+	must.Len(t, 2, listResp.ACLBindingRules)
+
+	listReq.Prefix = bindingRules[0].ID[:8]
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListBindingRulesRPCMethod, listReq, &listResp))
+	must.Len(t, 1, listResp.ACLBindingRules)
+	must.Eq(t, bindingRules[0].ID, listResp.ACLBindingRules[0].ID)
+
+	// Read a single binding rule.
+	getReq := &structs.ACLBindingRuleRequest{
+		ACLBindingRuleID: bindingRules[1].ID,
+		QueryOptions: structs.QueryOptions{
+			Region:    DefaultRegion,
+			AuthToken: aclRootToken.SecretID,
+		},
+	}
+	var getResp structs.ACLBindingRuleResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLGetBindingRuleRPCMethod, getReq, &getResp))
+	must.Eq(t, bindingRules[1].Hash, getResp.ACLBindingRule.Hash)
+
+	// Read the binding rules as replication does.
+	setReq := &structs.ACLBindingRulesRequest{
+		ACLBindingRuleIDs: []string{bindingRules[0].ID, bindingRules[1].ID, uuid.Generate()},
+		QueryOptions: structs.QueryOptions{
+			Region:    DefaultRegion,
+			AuthToken: aclRootToken.SecretID,
+		},
+	}
+	var setResp structs.ACLBindingRulesResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLGetBindingRulesRPCMethod, setReq, &setResp))
+	must.MapLen(t, 2, setResp.ACLBindingRules)
+
+	// Delete the first binding rule.
+	deleteReq := &structs.ACLBindingRulesDeleteRequest{
+		ACLBindingRuleIDs: []string{bindingRules[0].ID},
+		WriteRequest: structs.WriteRequest{
+			Region:    DefaultRegion,
+			AuthToken: aclRootToken.SecretID,
+		},
+	}
+	var deleteResp structs.ACLBindingRulesDeleteResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLDeleteBindingRulesRPCMethod, deleteReq, &deleteResp))
+
+	listReq.Prefix = ""
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLListBindingRulesRPCMethod, listReq, &listResp))
+	must.Len(t, 1, listResp.ACLBindingRules)
+	must.Eq(t, bindingRules[1].ID, listResp.ACLBindingRules[0].ID)
+}
+
+func TestACL_OIDCLogin(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, _, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	// Start the fake OIDC provider and create an auth method using it.
+	const redirectURI = "http://localhost:4649/oidc/callback"
+	oidcProvider := oidc.NewTestProvider(t)
+	oidcProvider.SetClaims(map[string]interface{}{
+		"groups": []interface{}{"engineering"},
+	})
+
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Config = oidcProvider.AuthMethodConfig(redirectURI)
+	authMethod.Config.ListClaimMappings = map[string]string{"groups": "roles"}
+	authMethod.SetHash()
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	// Create the role engineers are bound to.
+	policy := mock.ACLPolicy()
+	must.NoError(t, testServer.fsm.State().UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLPolicy{policy}))
+	role := &structs.ACLRole{
+		ID:       uuid.Generate(),
+		Name:     "eng-ro",
+		Policies: []*structs.ACLRolePolicyLink{{Name: policy.Name}},
+	}
+	role.SetHash()
+	must.NoError(t, testServer.fsm.State().UpsertACLRoles(
+		structs.MsgTypeTestSetup, 30, []*structs.ACLRole{role}, false))
+
+	// Redirect URIs must be allowed by the auth method.
+	authURLReq := &structs.ACLOIDCAuthURLRequest{
+		AuthMethodName: authMethod.Name,
+		RedirectURI:    "http://localhost:1234/oidc/callback",
+		ClientNonce:    "nonce",
+		WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+	}
+	var authURLResp structs.ACLOIDCAuthURLResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLOIDCAuthURLRPCMethod, authURLReq, &authURLResp)
+	require.ErrorContains(t, err, "is not allowed")
+
+	authURLReq.RedirectURI = redirectURI
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLOIDCAuthURLRPCMethod, authURLReq, &authURLResp))
+	must.StrHasPrefix(t, authURLResp.AuthURL, oidcProvider.Addr())
+
+	completeReq := &structs.ACLOIDCCompleteAuthRequest{
+		AuthMethodName: authMethod.Name,
+		ClientNonce:    "nonce",
+		RedirectURI:    redirectURI,
+		WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+	}
+
+	// No binding rule matches yet, so no token can be created.
+	completeReq.Code, completeReq.State = oidcProvider.Authorize(authURLResp.AuthURL)
+	var completeResp structs.ACLLoginResponse
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLOIDCCompleteAuthRPCMethod, completeReq, &completeResp)
+	require.ErrorContains(t, err, "no role or policy bindings matched")
+
+	bindingRule := mock.ACLBindingRule()
+	bindingRule.AuthMethod = authMethod.Name
+	must.NoError(t, testServer.fsm.State().UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 40, []*structs.ACLBindingRule{bindingRule}, false))
+
+	completeReq.Code, completeReq.State = oidcProvider.Authorize(authURLResp.AuthURL)
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLOIDCCompleteAuthRPCMethod, completeReq, &completeResp))
+
+	token := completeResp.ACLToken
+	must.NotNil(t, token)
+	must.Eq(t, "OIDC-"+authMethod.Name, token.Name)
+	must.Eq(t, structs.ACLClientToken, token.Type)
+	must.False(t, token.Global)
+	must.Len(t, 1, token.Roles)
+	must.Eq(t, role.ID, token.Roles[0].ID)
+	must.NotNil(t, token.ExpirationTime)
+	must.Eq(t, token.CreateTime.Add(authMethod.MaxTokenTTL), *token.ExpirationTime)
+
+	// The created token resolves to the policies of the role.
+	aclObj, err := testServer.ResolveToken(token.SecretID)
+	must.NoError(t, err)
+	must.NotNil(t, aclObj)
+
+	// The nonce of the login must match the one of the auth URL.
+	completeReq.Code, completeReq.State = oidcProvider.Authorize(authURLResp.AuthURL)
+	completeReq.ClientNonce = "other-nonce"
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLOIDCCompleteAuthRPCMethod, completeReq, &completeResp)
+	require.ErrorContains(t, err, "nonce does not match")
+}
+
+func TestACL_Login(t *testing.T) {
+	ci.Parallel(t)
+
+	testServer, rootToken, testServerCleanupFn := TestACLServer(t, nil)
+	defer testServerCleanupFn()
+	codec := rpcClient(t, testServer)
+	testutil.WaitForLeader(t, testServer.RPC)
+
+	// Start the fake JWT issuer and create an auth method using it.
+	issuer := jwt.NewTestIssuer(t)
+
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Type = structs.ACLAuthMethodTypeJWT
+	authMethod.Config = issuer.AuthMethodConfig()
+	authMethod.Config.JWKSURL = ""
+	authMethod.Config.JWTValidationPubKeys = []string{"not a key"}
+	authMethod.Config.ClaimMappings = map[string]string{"project_path": "project"}
+
+	// The public keys of JWT methods must be valid.
+	upsertReq := &structs.ACLAuthMethodsUpsertRequest{
+		AuthMethods: []*structs.ACLAuthMethod{authMethod},
+		WriteRequest: structs.WriteRequest{
+			Region:    DefaultRegion,
+			AuthToken: rootToken.SecretID,
+		},
+	}
+	var upsertResp structs.ACLAuthMethodsUpsertResponse
+	err := msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, upsertReq, &upsertResp)
+	require.ErrorContains(t, err, "failed to parse JWT validation public key")
+
+	authMethod.Config.JWTValidationPubKeys = []string{issuer.PublicKeyPEM()}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLUpsertAuthMethodsRPCMethod, upsertReq, &upsertResp))
+
+	// Create the role the deploy project is bound to.
+	policy := mock.ACLPolicy()
+	must.NoError(t, testServer.fsm.State().UpsertACLPolicies(
+		structs.MsgTypeTestSetup, 100, []*structs.ACLPolicy{policy}))
+	role := &structs.ACLRole{
+		ID:       uuid.Generate(),
+		Name:     "deploy",
+		Policies: []*structs.ACLRolePolicyLink{{Name: policy.Name}},
+	}
+	role.SetHash()
+	must.NoError(t, testServer.fsm.State().UpsertACLRoles(
+		structs.MsgTypeTestSetup, 110, []*structs.ACLRole{role}, false))
+
+	bindingRule := mock.ACLBindingRule()
+	bindingRule.AuthMethod = authMethod.Name
+	bindingRule.Selector = `value.project == "infra/deploy"`
+	bindingRule.BindName = role.Name
+	must.NoError(t, testServer.fsm.State().UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 120, []*structs.ACLBindingRule{bindingRule}, false))
+
+	loginReq := &structs.ACLLoginRequest{
+		AuthMethodName: authMethod.Name,
+		LoginToken:     issuer.Sign(map[string]interface{}{"project_path": "infra/deploy"}),
+		WriteRequest:   structs.WriteRequest{Region: DefaultRegion},
+	}
+	var loginResp structs.ACLLoginResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, loginReq, &loginResp))
+
+	token := loginResp.ACLToken
+	must.NotNil(t, token)
+	must.Eq(t, "JWT-"+authMethod.Name, token.Name)
+	must.Eq(t, structs.ACLClientToken, token.Type)
+	must.Len(t, 1, token.Roles)
+	must.Eq(t, role.ID, token.Roles[0].ID)
+	must.NotNil(t, token.ExpirationTime)
+	must.Eq(t, token.CreateTime.Add(authMethod.MaxTokenTTL), *token.ExpirationTime)
+
+	// JWTs of other projects are not bound to any role.
+	loginReq.LoginToken = issuer.Sign(map[string]interface{}{"project_path": "infra/other"})
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, loginReq, &loginResp)
+	require.ErrorContains(t, err, "no role or policy bindings matched")
+
+	// JWTs signed by another issuer are rejected.
+	other := jwt.NewTestIssuer(t)
+	loginReq.LoginToken = other.Sign(map[string]interface{}{"project_path": "infra/deploy"})
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, loginReq, &loginResp)
+	require.ErrorContains(t, err, "JWT signature is invalid")
+
+	// OIDC methods can't be used to log in with a JWT.
+	oidcMethod := mock.ACLAuthMethod()
+	must.NoError(t, testServer.fsm.State().UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 130, []*structs.ACLAuthMethod{oidcMethod}))
+	loginReq.AuthMethodName = oidcMethod.Name
+	err = msgpackrpc.CallWithCodec(codec, structs.ACLLoginRPCMethod, loginReq, &loginResp)
+	require.ErrorContains(t, err, "is not a JWT method")
+}
@@ -1075,8 +1075,394 @@ func TestClientAllocations_Restart_ACL(t *testing.T) {
 	}
 }
 
+func TestClientAllocations_Pause_Local(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	// Start a server and client
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	c, cleanupC := client.TestClient(t, func(c *config.Config) {
+		c.Servers = []string{s.config.RPCAddr.String()}
+	})
+	defer cleanupC()
+
+	// Force an allocation onto the node
+	a := mock.Alloc()
+	a.Job.Type = nstructs.JobTypeService
+	a.NodeID = c.NodeID()
+	a.Job.TaskGroups[0].Count = 1
+	a.Job.TaskGroups[0].Tasks[0] = &nstructs.Task{
+		Name:   "web",
+		Driver: "mock_driver",
+		Config: map[string]interface{}{
+			"run_for": "20s",
+		},
+		LogConfig: nstructs.DefaultLogConfig(),
+		Resources: &nstructs.Resources{
+			CPU:      500,
+			MemoryMB: 256,
+		},
+	}
+
+	testutil.WaitForResult(func() (bool, error) {
+		nodes := s.connectedNodes()
+		return len(nodes) == 1, nil
+	}, func(err error) {
+		t.Fatalf("should have a client")
+	})
+
+	// Upsert the allocation
+	state := s.State()
+	require.Nil(state.UpsertJob(nstructs.MsgTypeTestSetup, 999, a.Job))
+	require.Nil(state.UpsertAllocs(nstructs.MsgTypeTestSetup, 1003, []*nstructs.Allocation{a}))
+
+	waitForTaskState := func(expected string) {
+		testutil.WaitForResult(func() (bool, error) {
+			alloc, err := state.AllocByID(nil, a.ID)
+			if err != nil {
+				return false, err
+			}
+			if alloc == nil {
+				return false, fmt.Errorf("unknown alloc")
+			}
+			if alloc.ClientStatus != nstructs.AllocClientStatusRunning {
+				return false, fmt.Errorf("alloc client status: %v", alloc.ClientStatus)
+			}
+
+			taskState := alloc.TaskStates["web"]
+			if taskState == nil {
+				return false, fmt.Errorf("could not find task state")
+			}
+			if taskState.State != expected {
+				return false, fmt.Errorf("task state: %v", taskState.State)
+			}
+
+			return true, nil
+		}, func(err error) {
+			t.Fatalf("Alloc on node %q not %s: %v", c.NodeID(), expected, err)
+		})
+	}
+
+	// Wait for the client to run the allocation
+	waitForTaskState(nstructs.TaskStateRunning)
+
+	// Make the request without having an alloc id
+	req := &nstructs.AllocPauseRequest{
+		QueryOptions: nstructs.QueryOptions{Region: "global"},
+	}
+
+	// Fetch the response
+	var resp nstructs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp)
+	require.NotNil(err)
+	require.EqualError(err, "missing AllocID")
+
+	// Pause the running alloc
+	req.AllocID = a.ID
+	var resp2 nstructs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp2)
+	require.NoError(err)
+	waitForTaskState(nstructs.TaskStatePaused)
+
+	// Resume it
+	req.Resume = true
+	var resp3 nstructs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp3)
+	require.NoError(err)
+	waitForTaskState(nstructs.TaskStateRunning)
+}
+
+func TestClientAllocations_Pause_Remote(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	// Start two servers, with the client only connected to the second
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.BootstrapExpect = 2
+	})
+	defer cleanupS1()
+	s2, cleanupS2 := TestServer(t, func(c *Config) {
+		c.BootstrapExpect = 2
+	})
+	defer cleanupS2()
+	TestJoin(t, s1, s2)
+	testutil.WaitForLeader(t, s1.RPC)
+	testutil.WaitForLeader(t, s2.RPC)
+	codec := rpcClient(t, s1)
+
+	c, cleanupC := client.TestClient(t, func(c *config.Config) {
+		c.Servers = []string{s2.config.RPCAddr.String()}
+	})
+	defer cleanupC()
+
+	// Force an allocation onto the node
+	a := mock.Alloc()
+	a.Job.Type = nstructs.JobTypeService
+	a.NodeID = c.NodeID()
+	a.Job.TaskGroups[0].Count = 1
+	a.Job.TaskGroups[0].Tasks[0] = &nstructs.Task{
+		Name:   "web",
+		Driver: "mock_driver",
+		Config: map[string]interface{}{
+			"run_for": "20s",
+		},
+		LogConfig: nstructs.DefaultLogConfig(),
+		Resources: &nstructs.Resources{
+			CPU:      500,
+			MemoryMB: 256,
+		},
+	}
+
+	testutil.WaitForResult(func() (bool, error) {
+		nodes := s2.connectedNodes()
+		return len(nodes) == 1, nil
+	}, func(err error) {
+		t.Fatalf("should have a client")
+	})
+
+	// Upsert the allocation
+	state1 := s1.State()
+	state2 := s2.State()
+	require.Nil(state1.UpsertJob(nstructs.MsgTypeTestSetup, 999, a.Job))
+	require.Nil(state1.UpsertAllocs(nstructs.MsgTypeTestSetup, 1003, []*nstructs.Allocation{a}))
+	require.Nil(state2.UpsertJob(nstructs.MsgTypeTestSetup, 999, a.Job))
+	require.Nil(state2.UpsertAllocs(nstructs.MsgTypeTestSetup, 1003, []*nstructs.Allocation{a}))
+
+	// Wait for the client to run the allocation
+	testutil.WaitForResult(func() (bool, error) {
+		alloc, err := state2.AllocByID(nil, a.ID)
+		if err != nil {
+			return false, err
+		}
+		if alloc == nil {
+			return false, fmt.Errorf("unknown alloc")
+		}
+		if alloc.ClientStatus != nstructs.AllocClientStatusRunning {
+			return false, fmt.Errorf("alloc client status: %v", alloc.ClientStatus)
+		}
+
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("Alloc on node %q not running: %v", c.NodeID(), err)
+	})
+
+	// Make the request to the server the client isn't connected to, so it
+	// has to be forwarded
+	req := &nstructs.AllocPauseRequest{
+		AllocID:      a.ID,
+		QueryOptions: nstructs.QueryOptions{Region: "global"},
+	}
+
+	var resp nstructs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp)
+	require.NoError(err)
+
+	// Pausing the whole alloc again skips the paused task, while pausing the
+	// task directly fails on the client since it is no longer running
+	var resp2 nstructs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp2)
+	require.NoError(err)
+
+	req.Task = "web"
+	var resp3 nstructs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp3)
+	require.Error(err)
+	require.Contains(err.Error(), "Task not running")
+}
+
+func TestClientAllocations_Pause_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start a server
+	s, root, cleanupS := TestACLServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	// Create a bad token
+	policyBad := mock.NamespacePolicy(nstructs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob})
+	tokenBad := mock.CreatePolicyAndToken(t, s.State(), 1005, "invalid", policyBad)
+
+	policyGood := mock.NamespacePolicy(nstructs.DefaultNamespace, "", []string{acl.NamespaceCapabilityAllocLifecycle})
+	tokenGood := mock.CreatePolicyAndToken(t, s.State(), 1009, "valid2", policyGood)
+
+	// Upsert the allocation
+	state := s.State()
+	alloc := mock.Alloc()
+	require.NoError(t, state.UpsertJob(nstructs.MsgTypeTestSetup, 1010, alloc.Job))
+	require.NoError(t, state.UpsertAllocs(nstructs.MsgTypeTestSetup, 1011, []*nstructs.Allocation{alloc}))
+
+	cases := []struct {
+		Name          string
+		Token         string
+		ExpectedError string
+	}{
+		{
+			Name:          "no token",
+			Token:         "",
+			ExpectedError: nstructs.ErrPermissionDenied.Error(),
+		},
+		{
+			Name:          "bad token",
+			Token:         tokenBad.SecretID,
+			ExpectedError: nstructs.ErrPermissionDenied.Error(),
+		},
+		{
+			Name:          "good token",
+			Token:         tokenGood.SecretID,
+			ExpectedError: "Unknown node",
+		},
+		{
+			Name:          "root token",
+			Token:         root.SecretID,
+			ExpectedError: "Unknown node",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+
+			// Make the request without having a node-id
+			req := &nstructs.AllocPauseRequest{
+				AllocID: alloc.ID,
+				QueryOptions: nstructs.QueryOptions{
+					Namespace: nstructs.DefaultNamespace,
+					AuthToken: c.Token,
+					Region:    "global",
+				},
+			}
+
+			// Fetch the response
+			var resp nstructs.GenericResponse
+			err := msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pause", req, &resp)
+			require.NotNil(t, err)
+			require.Contains(t, err.Error(), c.ExpectedError)
+		})
+	}
+}
+
 // TestAlloc_ExecStreaming asserts that exec task requests are forwarded
 // to appropriate server or remote regions
+func TestClientAllocations_Pin_ServerGC(t *testing.T) {
+	ci.Parallel(t)
+
+	// Start a server and client
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	c, cleanupC := client.TestClient(t, func(c *config.Config) {
+		c.Servers = []string{s.config.RPCAddr.String()}
+		c.GCDiskUsageThreshold = 100.0
+	})
+	defer cleanupC()
+
+	// Force an allocation onto the node
+	a := mock.Alloc()
+	a.Job.Type = nstructs.JobTypeBatch
+	a.NodeID = c.NodeID()
+	a.Job.TaskGroups[0].Count = 1
+	a.Job.TaskGroups[0].Tasks[0] = &nstructs.Task{
+		Name:   "web",
+		Driver: "mock_driver",
+		Config: map[string]interface{}{
+			"run_for": "100ms",
+		},
+		LogConfig: nstructs.DefaultLogConfig(),
+		Resources: &nstructs.Resources{
+			CPU:      500,
+			MemoryMB: 256,
+		},
+	}
+
+	testutil.WaitForResult(func() (bool, error) {
+		nodes := s.connectedNodes()
+		return len(nodes) == 1, nil
+	}, func(err error) {
+		t.Fatalf("should have a clients")
+	})
+
+	// Upsert the allocation
+	state := s.State()
+	require.NoError(t, state.UpsertJob(nstructs.MsgTypeTestSetup, 999, a.Job))
+	require.NoError(t, state.UpsertAllocs(nstructs.MsgTypeTestSetup, 1003, []*nstructs.Allocation{a}))
+
+	// Wait for the client to run the allocation
+	testutil.WaitForResult(func() (bool, error) {
+		alloc, err := state.AllocByID(nil, a.ID)
+		if err != nil {
+			return false, err
+		}
+		if alloc == nil {
+			return false, fmt.Errorf("unknown alloc")
+		}
+		if alloc.ClientStatus != nstructs.AllocClientStatusComplete {
+			return false, fmt.Errorf("alloc client status: %v", alloc.ClientStatus)
+		}
+
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("Alloc on node %q not finished: %v", c.NodeID(), err)
+	})
+
+	// The node must match the allocation
+	req := &cstructs.AllocPinRequest{
+		AllocID:      a.ID,
+		NodeID:       uuid.Generate(),
+		QueryOptions: nstructs.QueryOptions{Region: "global"},
+	}
+	var resp nstructs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pin", req, &resp)
+	require.ErrorContains(t, err, "is not placed on node")
+
+	req.NodeID = ""
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pin", req, &resp))
+
+	// The servers garbage collect the allocation, which the client retains
+	require.NoError(t, state.DeleteEval(1010, nil, []string{a.ID}, false))
+	gcStatusReq := &nstructs.NodeSpecificRequest{
+		NodeID:       c.NodeID(),
+		QueryOptions: nstructs.QueryOptions{Region: "global"},
+	}
+	testutil.WaitForResult(func() (bool, error) {
+		if _, err := c.GetAlloc(a.ID); err == nil {
+			return false, fmt.Errorf("client still runs the alloc")
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+
+	var gcStatus cstructs.ClientGCStatusResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ClientAllocations.GCStatus", gcStatusReq, &gcStatus))
+	require.Len(t, gcStatus.Allocations, 1)
+	require.True(t, gcStatus.Allocations[0].Pinned)
+
+	// Without its node the allocation can't be found anymore
+	req.Unpin = true
+	err = msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pin", req, &resp)
+	require.True(t, nstructs.IsErrUnknownAllocation(err))
+
+	req.NodeID = c.NodeID()
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ClientAllocations.Pin", req, &resp))
+
+	// Once unpinned the allocation is garbage collected
+	testutil.WaitForResult(func() (bool, error) {
+		var gcStatus cstructs.ClientGCStatusResponse
+		if err := msgpackrpc.CallWithCodec(codec, "ClientAllocations.GCStatus", gcStatusReq, &gcStatus); err != nil {
+			return false, err
+		}
+		return len(gcStatus.Allocations) == 0, fmt.Errorf("allocs still on disk: %d", len(gcStatus.Allocations))
+	}, func(err error) {
+		t.Fatalf("err: %v", err)
+	})
+}
+
 func TestAlloc_ExecStreaming(t *testing.T) {
 	ci.Parallel(t)
 
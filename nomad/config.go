@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/deploymentwatcher"
+	"github.com/hashicorp/nomad/nomad/eventsink"
+	"github.com/hashicorp/nomad/nomad/snapshotagent"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 	"github.com/hashicorp/nomad/scheduler"
@@ -65,6 +67,47 @@ type Config struct {
 	// EventBufferSize is the amount of events to hold in memory.
 	EventBufferSize int64
 
+	// EventLogEnabled is used to persist published events in the data
+	// directory, so that subscribers can resume at an index which is older
+	// than the event buffer.
+	EventLogEnabled bool
+
+	// EventLogRetentionCount is the number of raft indexes whose events are
+	// retained by the event log. Zero retains any number of indexes.
+	EventLogRetentionCount int
+
+	// EventLogRetentionAge is how long events are retained by the event log.
+	// Zero retains events regardless of their age.
+	EventLogRetentionAge time.Duration
+
+	// EventSinkProgressInterval is how often the leader records the index of
+	// the latest events acknowledged by the event sinks.
+	EventSinkProgressInterval time.Duration
+
+	// SnapshotAgentEnabled is used to take snapshots of the raft state on an
+	// interval.
+	SnapshotAgentEnabled bool
+
+	// SnapshotAgentLeaderOnly is used to only take scheduled snapshots while
+	// the server is the leader. Otherwise the server takes snapshots of its
+	// local raft state regardless of its leadership.
+	SnapshotAgentLeaderOnly bool
+
+	// SnapshotAgentPath is the directory scheduled snapshots are written to.
+	// Defaults to the snapshots directory of the data directory.
+	SnapshotAgentPath string
+
+	// SnapshotAgentInterval is the interval between scheduled snapshots.
+	SnapshotAgentInterval time.Duration
+
+	// SnapshotAgentRetainCount is the number of scheduled snapshots retained.
+	// Zero retains any number of snapshots.
+	SnapshotAgentRetainCount int
+
+	// SnapshotAgentRetainAge is how long scheduled snapshots are retained.
+	// Zero retains snapshots regardless of their age.
+	SnapshotAgentRetainAge time.Duration
+
 	// LogOutput is the location to write logs to. If this is not set,
 	// logs will go to stderr.
 	LogOutput io.Writer
@@ -484,6 +527,12 @@ func DefaultConfig() *Config {
 		LicenseConfig:                    &LicenseConfig{},
 		EnableEventBroker:                true,
 		EventBufferSize:                  100,
+		EventLogRetentionCount:           10000,
+		EventLogRetentionAge:             24 * time.Hour,
+		EventSinkProgressInterval:        eventsink.DefaultProgressInterval,
+		SnapshotAgentLeaderOnly:          true,
+		SnapshotAgentInterval:            snapshotagent.DefaultInterval,
+		SnapshotAgentRetainCount:         snapshotagent.DefaultRetainCount,
 		ACLTokenMinExpirationTTL:         1 * time.Minute,
 		ACLTokenMaxExpirationTTL:         24 * time.Hour,
 		AutopilotConfig: &structs.AutopilotConfig{
@@ -2484,7 +2484,7 @@ func TestCoreScheduler_RootKeyGC(t *testing.T) {
 	variable := mock.VariableEncrypted()
 	variable.KeyID = key2.KeyID
 
-	setResp := store.VarSet(601, &structs.VarApplyStateRequest{
+	setResp := store.VarSet(structs.MsgTypeTestSetup, 601, &structs.VarApplyStateRequest{
 		Op:  structs.VarOpSet,
 		Var: variable,
 	})
@@ -51,7 +51,7 @@ func TestCSIVolumeEndpoint_Get(t *testing.T) {
 			AttachmentMode: structs.CSIVolumeAttachmentModeFilesystem,
 		}},
 	}}
-	err := state.UpsertCSIVolume(999, vols)
+	err := state.UpsertCSIVolume(structs.MsgTypeTestSetup, 999, vols)
 	require.NoError(t, err)
 
 	// Create the register request
@@ -101,7 +101,7 @@ func TestCSIVolumeEndpoint_Get_ACL(t *testing.T) {
 			AttachmentMode: structs.CSIVolumeAttachmentModeFilesystem,
 		}},
 	}}
-	err := state.UpsertCSIVolume(999, vols)
+	err := state.UpsertCSIVolume(structs.MsgTypeTestSetup, 999, vols)
 	require.NoError(t, err)
 
 	// Create the register request
@@ -136,7 +136,7 @@ func TestCSIVolumeEndpoint_Register(t *testing.T) {
 
 	// Create the register request
 	ns := mock.Namespace()
-	store.UpsertNamespaces(900, []*structs.Namespace{ns})
+	store.UpsertNamespaces(structs.MsgTypeTestSetup, 900, []*structs.Namespace{ns})
 
 	// Create the node and plugin
 	node := mock.Node()
@@ -295,7 +295,7 @@ func TestCSIVolumeEndpoint_Claim(t *testing.T) {
 		}},
 	}}
 	index++
-	err = state.UpsertCSIVolume(index, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, vols)
 	require.NoError(t, err)
 
 	// Verify that the volume exists, and is healthy
@@ -434,7 +434,7 @@ func TestCSIVolumeEndpoint_ClaimWithController(t *testing.T) {
 			AttachmentMode: structs.CSIVolumeAttachmentModeFilesystem,
 		}},
 	}}
-	err = state.UpsertCSIVolume(1003, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, 1003, vols)
 	require.NoError(t, err)
 
 	alloc := mock.BatchAlloc()
@@ -569,7 +569,7 @@ func TestCSIVolumeEndpoint_Unpublish(t *testing.T) {
 			}
 
 			index++
-			err = state.UpsertCSIVolume(index, []*structs.CSIVolume{vol})
+			err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{vol})
 			must.NoError(t, err)
 
 			// setup: create an alloc that will claim our volume
@@ -595,7 +595,7 @@ func TestCSIVolumeEndpoint_Unpublish(t *testing.T) {
 
 			index++
 			claim.State = structs.CSIVolumeClaimStateTaken
-			err = state.CSIVolumeClaim(index, ns, volID, claim)
+			err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, index, ns, volID, claim)
 			must.NoError(t, err)
 
 			// setup: claim the volume for our other alloc
@@ -608,7 +608,7 @@ func TestCSIVolumeEndpoint_Unpublish(t *testing.T) {
 
 			index++
 			otherClaim.State = structs.CSIVolumeClaimStateTaken
-			err = state.CSIVolumeClaim(index, ns, volID, otherClaim)
+			err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, index, ns, volID, otherClaim)
 			must.NoError(t, err)
 
 			// test: unpublish and check the results
@@ -700,7 +700,7 @@ func TestCSIVolumeEndpoint_List(t *testing.T) {
 			AttachmentMode: structs.CSIVolumeAttachmentModeFilesystem,
 		}},
 	}}
-	err = state.UpsertCSIVolume(1002, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, 1002, vols)
 	require.NoError(t, err)
 
 	// Query everything in the namespace
@@ -745,7 +745,7 @@ func TestCSIVolumeEndpoint_ListAllNamespaces(t *testing.T) {
 	ns0 := structs.DefaultNamespace
 	ns1 := "namespace-1"
 	ns2 := "namespace-2"
-	err := state.UpsertNamespaces(1000, []*structs.Namespace{{Name: ns1}, {Name: ns2}})
+	err := state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{{Name: ns1}, {Name: ns2}})
 	require.NoError(t, err)
 
 	// Create volumes in multiple namespaces.
@@ -779,7 +779,7 @@ func TestCSIVolumeEndpoint_ListAllNamespaces(t *testing.T) {
 		}},
 	},
 	}
-	err = state.UpsertCSIVolume(1001, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, 1001, vols)
 	require.NoError(t, err)
 
 	// Lookup volumes in all namespaces
@@ -844,7 +844,7 @@ func TestCSIVolumeEndpoint_List_PaginationFiltering(t *testing.T) {
 	plugin := mock.CSIPlugin()
 
 	// Create namespaces.
-	err := state.UpsertNamespaces(999, []*structs.Namespace{{Name: nonDefaultNS}})
+	err := state.UpsertNamespaces(structs.MsgTypeTestSetup, 999, []*structs.Namespace{{Name: nonDefaultNS}})
 	require.NoError(t, err)
 
 	for i, m := range mocks {
@@ -858,7 +858,7 @@ func TestCSIVolumeEndpoint_List_PaginationFiltering(t *testing.T) {
 			volume.Namespace = m.namespace
 		}
 		index := 1000 + uint64(i)
-		require.NoError(t, state.UpsertCSIVolume(index, []*structs.CSIVolume{volume}))
+		require.NoError(t, state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{volume}))
 	}
 
 	cases := []struct {
@@ -1232,7 +1232,7 @@ func TestCSIVolumeEndpoint_Delete(t *testing.T) {
 		Secrets:   structs.CSISecrets{"mysecret": "secretvalue"},
 	}}
 	index++
-	err = state.UpsertCSIVolume(index, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, vols)
 	require.NoError(t, err)
 
 	// Delete volumes
@@ -1460,7 +1460,7 @@ func TestCSIVolumeEndpoint_CreateSnapshot(t *testing.T) {
 		ExternalID:     "vol-12345",
 	}}
 	index++
-	require.NoError(t, state.UpsertCSIVolume(index, vols))
+	require.NoError(t, state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, vols))
 
 	// Create the snapshot request
 	req1 := &structs.CSISnapshotCreateRequest{
@@ -1937,7 +1937,7 @@ func TestCSI_RPCVolumeAndPluginLookup(t *testing.T) {
 			ControllerRequired: false,
 		},
 	}
-	err = state.UpsertCSIVolume(1002, vols)
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, 1002, vols)
 	require.NoError(t, err)
 
 	// has controller
@@ -1017,7 +1017,7 @@ func TestDeploymentEndpoint_List(t *testing.T) {
 	j2.Namespace = "prod"
 	d2.Namespace = "prod"
 	d2.JobID = j2.ID
-	assert.Nil(state.UpsertNamespaces(1001, []*structs.Namespace{{Name: "prod"}}))
+	assert.Nil(state.UpsertNamespaces(structs.MsgTypeTestSetup, 1001, []*structs.Namespace{{Name: "prod"}}))
 	assert.Nil(state.UpsertJob(structs.MsgTypeTestSetup, 1002, j2), "UpsertJob")
 	assert.Nil(state.UpsertDeployment(1003, d2), "UpsertDeployment")
 
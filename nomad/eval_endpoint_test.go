@@ -766,7 +766,7 @@ func TestEvalEndpoint_Delete(t *testing.T) {
 				require.NotNil(t, schedulerConfig)
 
 				schedulerConfig.PauseEvalBroker = true
-				require.NoError(t, testServer.fsm.State().SchedulerSetConfig(10, schedulerConfig))
+				require.NoError(t, testServer.fsm.State().SchedulerSetConfig(structs.MsgTypeTestSetup, 10, schedulerConfig))
 
 				// Create and upsert an evaluation.
 				mockEval := mock.Eval()
@@ -809,7 +809,7 @@ func TestEvalEndpoint_Delete(t *testing.T) {
 				require.NotNil(t, schedulerConfig)
 
 				schedulerConfig.PauseEvalBroker = true
-				require.NoError(t, testServer.fsm.State().SchedulerSetConfig(10, schedulerConfig))
+				require.NoError(t, testServer.fsm.State().SchedulerSetConfig(structs.MsgTypeTestSetup, 10, schedulerConfig))
 
 				// Create and upsert an evaluation.
 				mockEval := mock.Eval()
@@ -1248,7 +1248,7 @@ func TestEvalEndpoint_List_ACL(t *testing.T) {
 	// Create dev namespace
 	devNS := mock.Namespace()
 	devNS.Name = "dev"
-	err := s1.fsm.State().UpsertNamespaces(999, []*structs.Namespace{devNS})
+	err := s1.fsm.State().UpsertNamespaces(structs.MsgTypeTestSetup, 999, []*structs.Namespace{devNS})
 	require.NoError(t, err)
 
 	// Create the register request
@@ -1422,7 +1422,7 @@ func TestEvalEndpoint_List_PaginationFiltering(t *testing.T) {
 	// Create non-default namespace
 	nondefaultNS := mock.Namespace()
 	nondefaultNS.Name = "non-default"
-	err := s1.fsm.State().UpsertNamespaces(999, []*structs.Namespace{nondefaultNS})
+	err := s1.fsm.State().UpsertNamespaces(structs.MsgTypeTestSetup, 999, []*structs.Namespace{nondefaultNS})
 	require.NoError(t, err)
 
 	// create a set of evals and field values to filter on. these are
@@ -2,12 +2,17 @@ package nomad
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"time"
 
+	metrics "github.com/armon/go-metrics"
+	memdb "github.com/hashicorp/go-memdb"
 	"github.com/hashicorp/go-msgpack/codec"
 	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -47,6 +52,7 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 		Topics:    args.Topics,
 		Index:     uint64(args.Index),
 		Namespace: args.Namespace,
+		Filter:    args.Filter,
 	}
 
 	// Get the servers broker and subscribe
@@ -66,7 +72,11 @@ func (e *Event) stream(conn io.ReadWriteCloser) {
 		subscription, subErr = publisher.Subscribe(subReq)
 	}
 	if subErr != nil {
-		handleJsonResultError(subErr, pointer.Of(int64(500)), encoder)
+		code := int64(500)
+		if errors.Is(subErr, stream.ErrInvalidFilter) {
+			code = 400
+		}
+		handleJsonResultError(subErr, pointer.Of(code), encoder)
 		return
 	}
 	defer subscription.Unsubscribe()
@@ -147,6 +157,145 @@ OUTER:
 
 }
 
+// UpsertSink is used to register or update an event sink.
+func (e *Event) UpsertSink(args *structs.EventSinkUpsertRequest, reply *structs.GenericResponse) error {
+	if done, err := e.srv.forward("Event.UpsertSink", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event", "upsert_sink"}, time.Now())
+
+	// Check management permissions
+	if aclObj, err := e.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if !e.srv.config.EnableEventBroker {
+		return fmt.Errorf("event sinks require the event broker to be enabled")
+	}
+
+	if args.Sink == nil {
+		return fmt.Errorf("missing event sink for upsert")
+	}
+
+	args.Sink.Canonicalize()
+	if err := args.Sink.Validate(); err != nil {
+		return fmt.Errorf("Invalid event sink %q: %v", args.Sink.ID, err)
+	}
+
+	// Update via Raft
+	out, index, err := e.srv.raftApply(structs.EventSinkUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if there was an error when applying.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// DeleteSink is used to deregister event sinks.
+func (e *Event) DeleteSink(args *structs.EventSinkDeleteRequest, reply *structs.GenericResponse) error {
+	if done, err := e.srv.forward("Event.DeleteSink", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event", "delete_sink"}, time.Now())
+
+	// Check management permissions
+	if aclObj, err := e.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	if len(args.IDs) == 0 {
+		return fmt.Errorf("must specify at least one event sink to delete")
+	}
+
+	// Update via Raft
+	out, index, err := e.srv.raftApply(structs.EventSinkDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if there was an error when applying.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// GetSink is used to look up an event sink.
+func (e *Event) GetSink(args *structs.EventSinkSpecificRequest, reply *structs.EventSinkResponse) error {
+	if done, err := e.srv.forward("Event.GetSink", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event", "get_sink"}, time.Now())
+
+	// Check management permissions
+	if aclObj, err := e.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, s *state.StateStore) error {
+			out, err := s.EventSinkByID(ws, args.ID)
+			if err != nil {
+				return err
+			}
+			reply.Sink = out
+
+			// Use the index table, as recording the progress of a sink
+			// does not change its modify index.
+			return e.srv.setReplyQueryMeta(s, state.TableEventSinks, &reply.QueryMeta)
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
+// ListSinks is used to list the event sinks.
+func (e *Event) ListSinks(args *structs.EventSinkListRequest, reply *structs.EventSinkListResponse) error {
+	if done, err := e.srv.forward("Event.ListSinks", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "event", "list_sinks"}, time.Now())
+
+	// Check management permissions
+	if aclObj, err := e.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, s *state.StateStore) error {
+			iter, err := s.EventSinks(ws)
+			if err != nil {
+				return err
+			}
+
+			reply.Sinks = []*structs.EventSink{}
+			for raw := iter.Next(); raw != nil; raw = iter.Next() {
+				reply.Sinks = append(reply.Sinks, raw.(*structs.EventSink))
+			}
+
+			return e.srv.setReplyQueryMeta(s, state.TableEventSinks, &reply.QueryMeta)
+		}}
+	return e.srv.blockingRPC(&opts)
+}
+
 func (e *Event) forwardStreamingRPC(region string, method string, args interface{}, in io.ReadWriteCloser) error {
 	server, err := e.srv.findRegionServer(region)
 	if err != nil {
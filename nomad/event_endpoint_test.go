@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -207,6 +209,211 @@ OUTER:
 	}
 }
 
+// TestEventStream_Filter asserts that only the events matching the filter
+// expression of the request are streamed
+func TestEventStream_Filter(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+	})
+	defer cleanupS1()
+
+	handler, err := s1.StreamingRpcHandler("Event.Stream")
+	require.Nil(t, err)
+
+	subscribe := func(filter string) (chan *structs.EventStreamWrapper, chan error) {
+		p1, p2 := net.Pipe()
+		t.Cleanup(func() { p1.Close() })
+		t.Cleanup(func() { p2.Close() })
+
+		errCh := make(chan error)
+		streamMsg := make(chan *structs.EventStreamWrapper)
+
+		go handler(p2)
+		go func() {
+			decoder := codec.NewDecoder(p1, structs.MsgpackHandle)
+			for {
+				var msg structs.EventStreamWrapper
+				if err := decoder.Decode(&msg); err != nil {
+					if err == io.EOF || strings.Contains(err.Error(), "closed") {
+						return
+					}
+					errCh <- fmt.Errorf("error decoding: %w", err)
+				}
+
+				streamMsg <- &msg
+			}
+		}()
+
+		encoder := codec.NewEncoder(p1, structs.MsgpackHandle)
+		require.Nil(t, encoder.Encode(structs.EventStreamRequest{
+			Topics: map[structs.Topic][]string{"*": {"*"}},
+			QueryOptions: structs.QueryOptions{
+				Region: s1.Region(),
+				Filter: filter,
+			},
+		}))
+		return streamMsg, errCh
+	}
+
+	// Invalid filters are rejected
+	streamMsg, errCh := subscribe(`Key ==`)
+	select {
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for event stream")
+	case err := <-errCh:
+		t.Fatal(err)
+	case msg := <-streamMsg:
+		require.NotNil(t, msg.Error)
+		require.Contains(t, msg.Error.Error(), "failed to read filter expression")
+		require.Equal(t, int64(400), *msg.Error.Code)
+	}
+
+	streamMsg, errCh = subscribe(`Key == "two"`)
+
+	publisher, err := s1.State().EventBroker()
+	require.NoError(t, err)
+
+	node := mock.Node()
+	publisher.Publish(&structs.Events{Index: uint64(1), Events: []structs.Event{{Topic: "test", Key: "one", Payload: node}}})
+	publisher.Publish(&structs.Events{Index: uint64(2), Events: []structs.Event{{Topic: "test", Key: "two", Payload: node}}})
+
+	timeout := time.After(3 * time.Second)
+	for {
+		select {
+		case <-timeout:
+			t.Fatal("timeout waiting for event stream")
+		case err := <-errCh:
+			t.Fatal(err)
+		case msg := <-streamMsg:
+			if msg.Error != nil {
+				t.Fatalf("Got error: %v", msg.Error.Error())
+			}
+
+			// ignore heartbeat
+			if bytes.Equal(msg.Event.Data, stream.JsonHeartbeat.Data) {
+				continue
+			}
+
+			var event structs.Events
+			require.NoError(t, json.Unmarshal(msg.Event.Data, &event))
+			require.Equal(t, uint64(2), event.Index)
+			require.Len(t, event.Events, 1)
+			require.Equal(t, "two", event.Events[0].Key)
+			return
+		}
+	}
+}
+
+// TestEventStream_EventLog asserts that events which are no longer in the event
+// buffer are streamed from the event log
+func TestEventStream_EventLog(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+		c.EventBufferSize = 2
+		c.EventLogEnabled = true
+	})
+	defer cleanupS1()
+
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Register nodes at indexes beyond those of the raft logs of the server
+	nodes := make([]*structs.Node, 5)
+	for i := range nodes {
+		nodes[i] = mock.Node()
+		require.NoError(t, s1.State().UpsertNode(structs.NodeRegisterRequestType, uint64(1000+i), nodes[i]))
+	}
+
+	// Events are published asynchronously, so wait for the last one to reach
+	// the buffer before subscribing to avoid racing the eviction of the others
+	publisher, err := s1.State().EventBroker()
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		sub, err := publisher.Subscribe(&stream.SubscribeRequest{
+			Topics:    map[structs.Topic][]string{structs.TopicNode: {"*"}},
+			Namespace: "*",
+			Index:     1004,
+		})
+		if err != nil {
+			return false
+		}
+		defer sub.Unsubscribe()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		events, err := sub.Next(ctx)
+		return err == nil && events.Index == 1004
+	}, 5*time.Second, 10*time.Millisecond)
+
+	req := structs.EventStreamRequest{
+		Topics: map[structs.Topic][]string{structs.TopicNode: {"*"}},
+		Index:  1001,
+		QueryOptions: structs.QueryOptions{
+			Region: s1.Region(),
+		},
+	}
+
+	handler, err := s1.StreamingRpcHandler("Event.Stream")
+	require.Nil(t, err)
+
+	p1, p2 := net.Pipe()
+	defer p1.Close()
+	defer p2.Close()
+
+	errCh := make(chan error)
+	streamMsg := make(chan *structs.EventStreamWrapper)
+
+	go handler(p2)
+
+	go func() {
+		decoder := codec.NewDecoder(p1, structs.MsgpackHandle)
+		for {
+			var msg structs.EventStreamWrapper
+			if err := decoder.Decode(&msg); err != nil {
+				if err == io.EOF || strings.Contains(err.Error(), "closed") {
+					return
+				}
+				errCh <- fmt.Errorf("error decoding: %w", err)
+			}
+
+			streamMsg <- &msg
+		}
+	}()
+
+	encoder := codec.NewEncoder(p1, structs.MsgpackHandle)
+	require.Nil(t, encoder.Encode(req))
+
+	timeout := time.After(5 * time.Second)
+	want := 1
+	for want < len(nodes) {
+		select {
+		case <-timeout:
+			t.Fatal("timeout waiting for event stream")
+		case err := <-errCh:
+			t.Fatal(err)
+		case msg := <-streamMsg:
+			if msg.Error != nil {
+				t.Fatalf("Got error: %v", msg.Error.Error())
+			}
+			if bytes.Equal(msg.Event.Data, stream.JsonHeartbeat.Data) {
+				continue
+			}
+
+			var event structs.Events
+			require.NoError(t, json.Unmarshal(msg.Event.Data, &event))
+			require.Equal(t, uint64(1000+want), event.Index)
+
+			payload := event.Events[0].Payload.(map[string]interface{})
+			node := payload["Node"].(map[string]interface{})
+			require.Equal(t, nodes[want].ID, node["ID"])
+			want++
+		}
+	}
+}
+
 // TestEventStream_RegionForward tests event streaming from one server
 // to another in a different region
 func TestEventStream_RegionForward(t *testing.T) {
@@ -625,3 +832,183 @@ OUTER:
 		}
 	}
 }
+
+func TestEvent_Sinks(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Invalid sinks are rejected
+	sink := mock.EventSink()
+	sink.Address = "ftp://127.0.0.1/events"
+	req := &structs.EventSinkUpsertRequest{
+		Sink:         sink,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "Event.UpsertSink", req, &resp)
+	require.ErrorContains(t, err, "scheme must be http or https")
+
+	// Register a sink, defaulting its topics and namespace
+	sink = &structs.EventSink{
+		ID:      "audit",
+		Type:    structs.SinkWebhook,
+		Address: "http://127.0.0.1:8080/events",
+	}
+	req.Sink = sink
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.UpsertSink", req, &resp))
+	require.NotZero(t, resp.Index)
+
+	getReq := &structs.EventSinkSpecificRequest{
+		ID:           "audit",
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var getResp structs.EventSinkResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.GetSink", getReq, &getResp))
+	require.NotNil(t, getResp.Sink)
+	require.Equal(t, structs.DefaultNamespace, getResp.Sink.Namespace)
+	require.Equal(t, map[structs.Topic][]string{structs.TopicAll: {"*"}}, getResp.Sink.Topics)
+	require.Equal(t, resp.Index, getResp.Index)
+
+	listReq := &structs.EventSinkListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var listResp structs.EventSinkListResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.ListSinks", listReq, &listResp))
+	require.Len(t, listResp.Sinks, 1)
+	require.Equal(t, "audit", listResp.Sinks[0].ID)
+
+	deleteReq := &structs.EventSinkDeleteRequest{
+		IDs:          []string{"audit"},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.DeleteSink", deleteReq, &resp))
+
+	err = msgpackrpc.CallWithCodec(codec, "Event.DeleteSink", deleteReq, &resp)
+	require.ErrorContains(t, err, "event sink not found")
+
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.ListSinks", listReq, &listResp))
+	require.Empty(t, listResp.Sinks)
+}
+
+func TestEvent_Sinks_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	token := mock.CreatePolicyAndToken(t, s1.fsm.State(), 1001, "operator",
+		mock.OperatorPolicy(acl.PolicyWrite))
+
+	sink := mock.EventSink()
+	req := &structs.EventSinkUpsertRequest{
+		Sink:         sink,
+		WriteRequest: structs.WriteRequest{Region: "global", AuthToken: token.SecretID},
+	}
+	var resp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "Event.UpsertSink", req, &resp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	req.AuthToken = root.SecretID
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.UpsertSink", req, &resp))
+
+	listReq := &structs.EventSinkListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global", AuthToken: token.SecretID},
+	}
+	var listResp structs.EventSinkListResponse
+	err = msgpackrpc.CallWithCodec(codec, "Event.ListSinks", listReq, &listResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	getReq := &structs.EventSinkSpecificRequest{
+		ID:           sink.ID,
+		QueryOptions: structs.QueryOptions{Region: "global", AuthToken: token.SecretID},
+	}
+	var getResp structs.EventSinkResponse
+	err = msgpackrpc.CallWithCodec(codec, "Event.GetSink", getReq, &getResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	deleteReq := &structs.EventSinkDeleteRequest{
+		IDs:          []string{sink.ID},
+		WriteRequest: structs.WriteRequest{Region: "global", AuthToken: token.SecretID},
+	}
+	err = msgpackrpc.CallWithCodec(codec, "Event.DeleteSink", deleteReq, &resp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	deleteReq.AuthToken = root.SecretID
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.DeleteSink", deleteReq, &resp))
+}
+
+// TestEvent_Sinks_Deliver asserts that the leader delivers events to a
+// webhook sink and records its progress.
+func TestEvent_Sinks_Deliver(t *testing.T) {
+	ci.Parallel(t)
+
+	received := make(chan structs.Events, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch structs.Events
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- batch
+	}))
+	defer webhook.Close()
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.EnableEventBroker = true
+		c.EventSinkProgressInterval = 50 * time.Millisecond
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	sink := mock.EventSink()
+	sink.Topics = map[structs.Topic][]string{structs.TopicNode: {"*"}}
+	sink.Address = webhook.URL
+	req := &structs.EventSinkUpsertRequest{
+		Sink:         sink,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.GenericResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Event.UpsertSink", req, &resp))
+
+	node := mock.Node()
+	nodeReq := &structs.NodeRegisterRequest{
+		Node:         node,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var nodeResp structs.NodeUpdateResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Node.Register", nodeReq, &nodeResp))
+
+	select {
+	case batch := <-received:
+		require.Equal(t, nodeResp.Index, batch.Index)
+		require.Len(t, batch.Events, 1)
+		require.Equal(t, structs.TopicNode, batch.Events[0].Topic)
+		require.Equal(t, node.ID, batch.Events[0].Key)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for events")
+	}
+
+	testutil.WaitForResult(func() (bool, error) {
+		out, err := s1.fsm.State().EventSinkByID(nil, sink.ID)
+		if err != nil {
+			return false, err
+		}
+		if out.LatestIndex != nodeResp.Index {
+			return false, fmt.Errorf("expected progress %d, got %d", nodeResp.Index, out.LatestIndex)
+		}
+		return true, nil
+	}, func(err error) {
+		require.NoError(t, err)
+	})
+}
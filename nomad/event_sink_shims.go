@@ -0,0 +1,27 @@
+package nomad
+
+import (
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// eventSinkShim implements the eventsink.RaftApplier interface required by
+// the event sink manager.
+type eventSinkShim struct {
+	s *Server
+}
+
+func (e eventSinkShim) UpdateSinksProgress(updates []*structs.EventSinkProgressRequest) (uint64, error) {
+	args := &structs.BatchEventSinkUpdateProgressRequest{
+		Updates:      updates,
+		WriteRequest: structs.WriteRequest{Region: e.s.config.Region},
+	}
+
+	resp, index, err := e.s.raftApply(structs.BatchEventSinkUpdateProgressType, args)
+	if err != nil {
+		return index, err
+	}
+	if err, ok := resp.(error); ok && err != nil {
+		return index, err
+	}
+	return index, nil
+}
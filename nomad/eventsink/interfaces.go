@@ -0,0 +1,13 @@
+package eventsink
+
+import (
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// RaftApplier contains methods for updating the state of event sinks via
+// raft.
+type RaftApplier interface {
+	// UpdateSinksProgress records the index of the latest events
+	// acknowledged by a set of event sinks.
+	UpdateSinksProgress(updates []*structs.EventSinkProgressRequest) (uint64, error)
+}
@@ -0,0 +1,258 @@
+package eventsink
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// DefaultProgressInterval is how often the delivery progress of the
+	// event sinks is written to raft.
+	DefaultProgressInterval = 10 * time.Second
+
+	// DefaultBatchSize is the maximum number of events delivered to a sink
+	// at once.
+	DefaultBatchSize = 100
+
+	// DefaultBatchWindow is how long events are collected into a batch
+	// after the first event of the batch is received.
+	DefaultBatchWindow = 500 * time.Millisecond
+
+	// DefaultRetryInterval is the initial interval between the delivery
+	// attempts of a batch. The interval doubles after each failed attempt,
+	// up to DefaultMaxRetryInterval.
+	DefaultRetryInterval    = time.Second
+	DefaultMaxRetryInterval = time.Minute
+)
+
+// ManagerConfig is the configuration of a Manager.
+type ManagerConfig struct {
+	Logger log.Logger
+
+	// Raft is used to record the delivery progress of the sinks.
+	Raft RaftApplier
+
+	// State returns the current state store of the server. The state store
+	// changes when a snapshot is restored, so it must not be retained.
+	State func() *state.StateStore
+
+	ProgressInterval time.Duration
+	BatchSize        int
+	BatchWindow      time.Duration
+	RetryInterval    time.Duration
+	MaxRetryInterval time.Duration
+}
+
+// Manager runs the event sinks of the cluster on the leader. Each sink
+// subscribes to the event broker starting after the latest index it
+// acknowledged, and delivers batches of events until it is deregistered or
+// leadership is lost. The acknowledged indexes are periodically written to
+// raft, so that a new leader resumes delivery where the previous one left off.
+// Events acknowledged since the last progress update are delivered again
+// after a leader election, so delivery is at least once.
+type Manager struct {
+	config *ManagerConfig
+	logger log.Logger
+
+	enabled bool
+	sinks   map[string]*managedSink
+
+	// exitFn is used to stop the manager when leadership is lost
+	exitFn context.CancelFunc
+
+	l sync.Mutex
+}
+
+// NewManager returns a new event sink manager. It is inactive until enabled.
+func NewManager(config *ManagerConfig) *Manager {
+	if config.ProgressInterval == 0 {
+		config.ProgressInterval = DefaultProgressInterval
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.BatchWindow == 0 {
+		config.BatchWindow = DefaultBatchWindow
+	}
+	if config.RetryInterval == 0 {
+		config.RetryInterval = DefaultRetryInterval
+	}
+	if config.MaxRetryInterval == 0 {
+		config.MaxRetryInterval = DefaultMaxRetryInterval
+	}
+
+	return &Manager{
+		config: config,
+		logger: config.Logger.Named("event_sinks"),
+		sinks:  make(map[string]*managedSink),
+	}
+}
+
+// SetEnabled is used to control if the manager is enabled. The manager should
+// only be enabled on the active leader.
+func (m *Manager) SetEnabled(enabled bool) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if enabled == m.enabled {
+		return
+	}
+	m.enabled = enabled
+
+	if !enabled {
+		m.exitFn()
+		for id, sink := range m.sinks {
+			sink.stop()
+			delete(m.sinks, id)
+		}
+		return
+	}
+
+	var ctx context.Context
+	ctx, m.exitFn = context.WithCancel(context.Background())
+	go m.watchSinks(ctx)
+	go m.updateProgress(ctx)
+}
+
+// watchSinks starts and stops the delivery of the event sinks as they are
+// registered, updated and deregistered.
+func (m *Manager) watchSinks(ctx context.Context) {
+	for {
+		state := m.config.State()
+
+		// The abandon channel is closed when a snapshot is restored, in which
+		// case the sinks are read again from the new state store
+		ws := memdb.NewWatchSet()
+		ws.Add(state.AbandonCh())
+
+		sinks, err := m.getSinks(ws, state)
+		if err != nil {
+			m.logger.Error("failed to retrieve event sinks", "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(m.config.RetryInterval):
+				continue
+			}
+		}
+
+		m.reconcile(ctx, sinks)
+
+		if err := ws.WatchCtx(ctx); err != nil {
+			return
+		}
+	}
+}
+
+func (m *Manager) getSinks(ws memdb.WatchSet, state *state.StateStore) (map[string]*structs.EventSink, error) {
+	iter, err := state.EventSinks(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	sinks := make(map[string]*structs.EventSink)
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		sink := raw.(*structs.EventSink)
+		sinks[sink.ID] = sink
+	}
+	return sinks, nil
+}
+
+// reconcile starts the delivery of new sinks, restarts the delivery of sinks
+// whose subscription changed and stops the delivery of deregistered sinks.
+func (m *Manager) reconcile(ctx context.Context, sinks map[string]*structs.EventSink) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if !m.enabled || ctx.Err() != nil {
+		return
+	}
+
+	for id, existing := range m.sinks {
+		sink, ok := sinks[id]
+		if ok && existing.sink.EqualSubscription(sink) {
+			continue
+		}
+
+		existing.stop()
+		delete(m.sinks, id)
+
+		// Carry the progress over to the updated sink, as it may not have
+		// been written to raft yet
+		if ok {
+			acked, committed := existing.progress()
+			m.startSink(ctx, sink, acked, committed)
+		}
+	}
+
+	for id, sink := range sinks {
+		if _, ok := m.sinks[id]; !ok {
+			m.startSink(ctx, sink, sink.LatestIndex, sink.LatestIndex)
+		}
+	}
+}
+
+func (m *Manager) startSink(ctx context.Context, sink *structs.EventSink, acked, committed uint64) {
+	if sink.LatestIndex > acked {
+		acked = sink.LatestIndex
+	}
+	if sink.LatestIndex > committed {
+		committed = sink.LatestIndex
+	}
+
+	// Sinks which have not acknowledged any events yet start with the events
+	// published after they were registered
+	if acked < sink.CreateIndex {
+		acked = sink.CreateIndex
+	}
+
+	s := newManagedSink(m.config, m.logger, sink.Copy(), acked, committed)
+	m.sinks[sink.ID] = s
+	s.start(ctx)
+}
+
+// updateProgress periodically writes the delivery progress of the sinks to
+// raft.
+func (m *Manager) updateProgress(ctx context.Context) {
+	ticker := time.NewTicker(m.config.ProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		m.l.Lock()
+		var updates []*structs.EventSinkProgressRequest
+		var sinks []*managedSink
+		for id, sink := range m.sinks {
+			acked, committed := sink.progress()
+			if acked > committed {
+				updates = append(updates, &structs.EventSinkProgressRequest{ID: id, LatestIndex: acked})
+				sinks = append(sinks, sink)
+			}
+		}
+		m.l.Unlock()
+
+		if len(updates) == 0 {
+			continue
+		}
+
+		if _, err := m.config.Raft.UpdateSinksProgress(updates); err != nil {
+			m.logger.Error("failed to update event sinks progress", "error", err)
+			continue
+		}
+
+		for i, sink := range sinks {
+			sink.setCommitted(updates[i].LatestIndex)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package eventsink
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+// testRaft applies the progress updates of the manager to the state store.
+type testRaft struct {
+	state *state.StateStore
+
+	index uint64
+	l     sync.Mutex
+}
+
+func (r *testRaft) UpdateSinksProgress(updates []*structs.EventSinkProgressRequest) (uint64, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	r.index++
+	return r.index, r.state.UpdateEventSinksProgress(structs.BatchEventSinkUpdateProgressType, r.index, updates)
+}
+
+// testWebhook records the events it receives, after failing the first
+// request.
+type testWebhook struct {
+	*httptest.Server
+
+	requests int
+	indexes  []uint64
+	l        sync.Mutex
+}
+
+func newTestWebhook(t *testing.T) *testWebhook {
+	w := &testWebhook{}
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		w.l.Lock()
+		defer w.l.Unlock()
+
+		w.requests++
+		if w.requests == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var batch struct {
+			Index  uint64
+			Events []structs.Event
+		}
+		if err := json.NewDecoder(req.Body).Decode(&batch); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, e := range batch.Events {
+			w.indexes = append(w.indexes, e.Index)
+		}
+	}))
+	t.Cleanup(w.Close)
+	return w
+}
+
+func (w *testWebhook) received() []uint64 {
+	w.l.Lock()
+	defer w.l.Unlock()
+	return append([]uint64(nil), w.indexes...)
+}
+
+func testManager(t *testing.T, store *state.StateStore, raft RaftApplier) *Manager {
+	m := NewManager(&ManagerConfig{
+		Logger:           testlog.HCLogger(t),
+		Raft:             raft,
+		State:            func() *state.StateStore { return store },
+		ProgressInterval: 50 * time.Millisecond,
+		BatchWindow:      10 * time.Millisecond,
+		RetryInterval:    10 * time.Millisecond,
+	})
+	t.Cleanup(func() { m.SetEnabled(false) })
+	return m
+}
+
+func TestManager_Deliver(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+	raft := &testRaft{state: store, index: 1000}
+	webhook := newTestWebhook(t)
+
+	sink := mock.EventSink()
+	sink.Topics = map[structs.Topic][]string{structs.TopicNode: {"*"}}
+	sink.Address = webhook.URL
+	require.NoError(t, store.UpsertEventSink(structs.EventSinkUpsertRequestType, 100, sink))
+
+	// Events published before the sink was registered are not delivered
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 99, mock.Node()))
+
+	m := testManager(t, store, raft)
+	m.SetEnabled(true)
+
+	for i := uint64(101); i <= 103; i++ {
+		require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, i, mock.Node()))
+	}
+
+	// The events are delivered despite the failed first attempt, and the
+	// progress of the sink is recorded
+	require.Eventually(t, func() bool {
+		out, err := store.EventSinkByID(nil, sink.ID)
+		return err == nil && out.LatestIndex == 103
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{101, 102, 103}, webhook.received())
+
+	// A new leader resumes delivery after the recorded progress
+	m.SetEnabled(false)
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 104, mock.Node()))
+
+	m = testManager(t, store, raft)
+	m.SetEnabled(true)
+
+	require.Eventually(t, func() bool {
+		out, err := store.EventSinkByID(nil, sink.ID)
+		return err == nil && out.LatestIndex == 104
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{101, 102, 103, 104}, webhook.received())
+
+	// Deregistered sinks are no longer delivered to
+	require.NoError(t, store.DeleteEventSinks(structs.EventSinkDeleteRequestType, 105, []string{sink.ID}))
+	require.Eventually(t, func() bool {
+		m.l.Lock()
+		defer m.l.Unlock()
+		return len(m.sinks) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 106, mock.Node()))
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, []uint64{101, 102, 103, 104}, webhook.received())
+}
+
+func TestManager_UpdateSink(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+	raft := &testRaft{state: store, index: 1000}
+	webhook1 := newTestWebhook(t)
+	webhook2 := newTestWebhook(t)
+
+	sink := mock.EventSink()
+	sink.Topics = map[structs.Topic][]string{structs.TopicNode: {"*"}}
+	sink.Address = webhook1.URL
+	require.NoError(t, store.UpsertEventSink(structs.EventSinkUpsertRequestType, 100, sink))
+
+	m := testManager(t, store, raft)
+	m.SetEnabled(true)
+
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 101, mock.Node()))
+	require.Eventually(t, func() bool {
+		return len(webhook1.received()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Events after the update are delivered to the new address only
+	update := sink.Copy()
+	update.Address = webhook2.URL
+	require.NoError(t, store.UpsertEventSink(structs.EventSinkUpsertRequestType, 102, update))
+	require.Eventually(t, func() bool {
+		m.l.Lock()
+		defer m.l.Unlock()
+		return m.sinks[sink.ID] != nil && m.sinks[sink.ID].sink.Address == webhook2.URL
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 103, mock.Node()))
+	require.Eventually(t, func() bool {
+		return len(webhook2.received()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{103}, webhook2.received())
+	require.Equal(t, []uint64{101}, webhook1.received())
+}
+
+func TestManager_Filter(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStoreCfg(t, state.TestStateStorePublisher(t))
+	raft := &testRaft{state: store, index: 1000}
+	webhook := newTestWebhook(t)
+
+	node := mock.Node()
+	sink := mock.EventSink()
+	sink.Topics = map[structs.Topic][]string{structs.TopicNode: {"*"}}
+	sink.Filter = `Payload.Node.ID != "` + node.ID + `"`
+	sink.Address = webhook.URL
+	require.NoError(t, store.UpsertEventSink(structs.EventSinkUpsertRequestType, 100, sink))
+
+	m := testManager(t, store, raft)
+	m.SetEnabled(true)
+
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 101, node))
+	require.NoError(t, store.UpsertNode(structs.NodeRegisterRequestType, 102, mock.Node()))
+
+	// Only the events matching the filter are delivered
+	require.Eventually(t, func() bool {
+		out, err := store.EventSinkByID(nil, sink.ID)
+		return err == nil && out.LatestIndex == 102
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{102}, webhook.received())
+}
@@ -0,0 +1,249 @@
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-msgpack/codec"
+	"github.com/hashicorp/nomad/nomad/stream"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// webhookTimeout is the timeout of a single webhook request.
+	webhookTimeout = 30 * time.Second
+)
+
+// managedSink delivers the events of a single event sink.
+type managedSink struct {
+	config *ManagerConfig
+	logger log.Logger
+	sink   *structs.EventSink
+	client *http.Client
+
+	// acked is the index of the latest events acknowledged by the sink, and
+	// committed the latest one written to raft
+	acked     uint64
+	committed uint64
+	l         sync.Mutex
+
+	// cancel stops the delivery, and doneCh is closed once it has stopped
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+func newManagedSink(config *ManagerConfig, logger log.Logger, sink *structs.EventSink, acked, committed uint64) *managedSink {
+	client := cleanhttp.DefaultPooledClient()
+	client.Timeout = webhookTimeout
+
+	return &managedSink{
+		config:    config,
+		logger:    logger.With("sink_id", sink.ID),
+		sink:      sink,
+		client:    client,
+		acked:     acked,
+		committed: committed,
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// progress returns the acknowledged and committed indexes of the sink.
+func (s *managedSink) progress() (uint64, uint64) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.acked, s.committed
+}
+
+func (s *managedSink) setAcked(index uint64) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.acked = index
+}
+
+func (s *managedSink) setCommitted(index uint64) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if index > s.committed {
+		s.committed = index
+	}
+}
+
+// start starts the delivery of the sink in the background.
+func (s *managedSink) start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.run(ctx)
+}
+
+// stop stops the delivery of the sink and waits for it to exit.
+func (s *managedSink) stop() {
+	s.cancel()
+	<-s.doneCh
+}
+
+// run delivers the events of the sink until the context is cancelled,
+// subscribing again whenever the subscription fails.
+func (s *managedSink) run(ctx context.Context) {
+	defer close(s.doneCh)
+
+	for {
+		err := s.deliver(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Subscriptions are closed when a snapshot is restored, and can be
+		// resumed from the new state store right away
+		if errors.Is(err, stream.ErrSubscriptionClosed) {
+			continue
+		}
+
+		s.logger.Warn("event sink subscription failed", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.RetryInterval):
+		}
+	}
+}
+
+// deliver subscribes to the events of the sink and delivers them in batches.
+func (s *managedSink) deliver(ctx context.Context) error {
+	broker, err := s.config.State().EventBroker()
+	if err != nil {
+		return err
+	}
+
+	acked, _ := s.progress()
+	req := &stream.SubscribeRequest{
+		Topics:    s.sink.Topics,
+		Namespace: s.sink.Namespace,
+		Filter:    s.sink.Filter,
+		Index:     acked + 1,
+	}
+	sub, err := broker.Subscribe(req)
+	if errors.Is(err, stream.ErrIndexTooOld) {
+		s.logger.Warn("events of the sink are no longer retained, some events were not delivered",
+			"latest_index", acked, "error", err)
+		req.Index = 0
+		sub, err = broker.Subscribe(req)
+	}
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		batch, err := s.nextBatch(ctx, sub, acked)
+		if err != nil {
+			return err
+		}
+
+		if err := s.send(ctx, batch); err != nil {
+			return err
+		}
+		s.setAcked(batch.Index)
+		acked = batch.Index
+	}
+}
+
+// nextBatch blocks until events after the acknowledged index are available,
+// and collects them into a batch for up to the batch window.
+func (s *managedSink) nextBatch(ctx context.Context, sub *stream.Subscription, acked uint64) (*structs.Events, error) {
+	batch := &structs.Events{}
+
+	for len(batch.Events) == 0 {
+		events, err := sub.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Subscriptions may start at events older than requested if the
+		// requested index is not published yet
+		if events.Index > acked {
+			batch.Index = events.Index
+			batch.Events = append(batch.Events, events.Events...)
+		}
+	}
+
+	windowCtx, cancel := context.WithTimeout(ctx, s.config.BatchWindow)
+	defer cancel()
+
+	for len(batch.Events) < s.config.BatchSize {
+		events, err := sub.Next(windowCtx)
+		if err != nil {
+			if ctx.Err() == nil && windowCtx.Err() != nil {
+				break
+			}
+			return nil, err
+		}
+		batch.Index = events.Index
+		batch.Events = append(batch.Events, events.Events...)
+	}
+
+	return batch, nil
+}
+
+// send delivers a batch of events to the sink, retrying with an exponential
+// backoff until it is acknowledged or the context is cancelled.
+func (s *managedSink) send(ctx context.Context, batch *structs.Events) error {
+	var buf bytes.Buffer
+	if err := codec.NewEncoder(&buf, structs.JsonHandleWithExtensions).Encode(batch); err != nil {
+		return fmt.Errorf("failed to encode events: %v", err)
+	}
+	body := buf.Bytes()
+
+	wait := s.config.RetryInterval
+	for {
+		err := s.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+
+		s.logger.Warn("failed to deliver events to sink, retrying",
+			"index", batch.Index, "retry_in", wait, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if wait > s.config.MaxRetryInterval {
+			wait = s.config.MaxRetryInterval
+		}
+	}
+}
+
+// post sends the encoded events to the address of the webhook. Events are
+// acknowledged by any 2xx response.
+func (s *managedSink) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.sink.Address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response code %d", resp.StatusCode)
+	}
+	return nil
+}
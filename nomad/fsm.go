@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/scheduler"
 	"github.com/hashicorp/raft"
@@ -59,6 +60,8 @@ const (
 	VariablesQuotaSnapshot               SnapshotType = 23
 	RootKeyMetaSnapshot                  SnapshotType = 24
 	ACLRoleSnapshot                      SnapshotType = 25
+	ACLAuthMethodSnapshot                SnapshotType = 26
+	ACLBindingRuleSnapshot               SnapshotType = 27
 
 	// Namespace appliers were moved from enterprise and therefore start at 64
 	NamespaceSnapshot SnapshotType = 64
@@ -142,6 +145,10 @@ type FSMConfig struct {
 
 	// EventBufferSize is the amount of messages to hold in memory
 	EventBufferSize int64
+
+	// EventLog optionally persists the events of the state store. It is
+	// shared by the state stores created when restoring snapshots.
+	EventLog *stream.EventLog
 }
 
 // NewFSM is used to construct a new FSM with a blank state.
@@ -152,6 +159,7 @@ func NewFSM(config *FSMConfig) (*nomadFSM, error) {
 		Region:          config.Region,
 		EnablePublisher: config.EnableEventBroker,
 		EventBufferSize: config.EventBufferSize,
+		EventLog:        config.EventLog,
 	}
 	state, err := state.NewStateStore(sconfig)
 	if err != nil {
@@ -275,7 +283,7 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 	case structs.BatchNodeUpdateDrainRequestType:
 		return n.applyBatchDrainUpdate(msgType, buf[1:], log.Index)
 	case structs.SchedulerConfigRequestType:
-		return n.applySchedulerConfigUpdate(buf[1:], log.Index)
+		return n.applySchedulerConfigUpdate(msgType, buf[1:], log.Index)
 	case structs.NodeBatchDeregisterRequestType:
 		return n.applyDeregisterNodeBatch(msgType, buf[1:], log.Index)
 	case structs.ClusterMetadataRequestType:
@@ -285,27 +293,27 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 	case structs.ServiceIdentityAccessorDeregisterRequestType:
 		return n.applyDeregisterSIAccessor(buf[1:], log.Index)
 	case structs.CSIVolumeRegisterRequestType:
-		return n.applyCSIVolumeRegister(buf[1:], log.Index)
+		return n.applyCSIVolumeRegister(msgType, buf[1:], log.Index)
 	case structs.CSIVolumeDeregisterRequestType:
-		return n.applyCSIVolumeDeregister(buf[1:], log.Index)
+		return n.applyCSIVolumeDeregister(msgType, buf[1:], log.Index)
 	case structs.CSIVolumeClaimRequestType:
-		return n.applyCSIVolumeClaim(buf[1:], log.Index)
+		return n.applyCSIVolumeClaim(msgType, buf[1:], log.Index)
 	case structs.ScalingEventRegisterRequestType:
 		return n.applyUpsertScalingEvent(buf[1:], log.Index)
 	case structs.CSIVolumeClaimBatchRequestType:
-		return n.applyCSIVolumeBatchClaim(buf[1:], log.Index)
+		return n.applyCSIVolumeBatchClaim(msgType, buf[1:], log.Index)
 	case structs.CSIPluginDeleteRequestType:
-		return n.applyCSIPluginDelete(buf[1:], log.Index)
+		return n.applyCSIPluginDelete(msgType, buf[1:], log.Index)
 	case structs.NamespaceUpsertRequestType:
-		return n.applyNamespaceUpsert(buf[1:], log.Index)
+		return n.applyNamespaceUpsert(msgType, buf[1:], log.Index)
 	case structs.NamespaceDeleteRequestType:
-		return n.applyNamespaceDelete(buf[1:], log.Index)
-	// COMPAT(1.0): These messages were added and removed during the 1.0-beta
-	// series and should not be immediately reused for other purposes
-	case structs.EventSinkUpsertRequestType,
-		structs.EventSinkDeleteRequestType,
-		structs.BatchEventSinkUpdateProgressType:
-		return nil
+		return n.applyNamespaceDelete(msgType, buf[1:], log.Index)
+	case structs.EventSinkUpsertRequestType:
+		return n.applyUpsertEventSink(msgType, buf[1:], log.Index)
+	case structs.EventSinkDeleteRequestType:
+		return n.applyDeleteEventSinks(msgType, buf[1:], log.Index)
+	case structs.BatchEventSinkUpdateProgressType:
+		return n.applyBatchEventSinkUpdateProgress(msgType, buf[1:], log.Index)
 	case structs.OneTimeTokenUpsertRequestType:
 		return n.applyOneTimeTokenUpsert(msgType, buf[1:], log.Index)
 	case structs.OneTimeTokenDeleteRequestType:
@@ -328,6 +336,14 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applyACLRolesUpsert(msgType, buf[1:], log.Index)
 	case structs.ACLRolesDeleteByIDRequestType:
 		return n.applyACLRolesDeleteByID(msgType, buf[1:], log.Index)
+	case structs.ACLAuthMethodsUpsertRequestType:
+		return n.applyACLAuthMethodsUpsert(msgType, buf[1:], log.Index)
+	case structs.ACLAuthMethodsDeleteRequestType:
+		return n.applyACLAuthMethodsDelete(msgType, buf[1:], log.Index)
+	case structs.ACLBindingRulesUpsertRequestType:
+		return n.applyACLBindingRulesUpsert(msgType, buf[1:], log.Index)
+	case structs.ACLBindingRulesDeleteRequestType:
+		return n.applyACLBindingRulesDelete(msgType, buf[1:], log.Index)
 	}
 
 	// Check enterprise only message types.
@@ -1256,7 +1272,7 @@ func (n *nomadFSM) applyAutopilotUpdate(buf []byte, index uint64) interface{} {
 	return n.state.AutopilotSetConfig(index, &req.Config)
 }
 
-func (n *nomadFSM) applySchedulerConfigUpdate(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applySchedulerConfigUpdate(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	var req structs.SchedulerSetConfigRequest
 	if err := structs.Decode(buf, &req); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
@@ -1266,23 +1282,23 @@ func (n *nomadFSM) applySchedulerConfigUpdate(buf []byte, index uint64) interfac
 	req.Config.Canonicalize()
 
 	if req.CAS {
-		applied, err := n.state.SchedulerCASConfig(index, req.Config.ModifyIndex, &req.Config)
+		applied, err := n.state.SchedulerCASConfig(msgType, index, req.Config.ModifyIndex, &req.Config)
 		if err != nil {
 			return err
 		}
 		return applied
 	}
-	return n.state.SchedulerSetConfig(index, &req.Config)
+	return n.state.SchedulerSetConfig(msgType, index, &req.Config)
 }
 
-func (n *nomadFSM) applyCSIVolumeRegister(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyCSIVolumeRegister(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	var req structs.CSIVolumeRegisterRequest
 	if err := structs.Decode(buf, &req); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
 	}
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_csi_volume_register"}, time.Now())
 
-	if err := n.state.UpsertCSIVolume(index, req.Volumes); err != nil {
+	if err := n.state.UpsertCSIVolume(msgType, index, req.Volumes); err != nil {
 		n.logger.Error("CSIVolumeRegister failed", "error", err)
 		return err
 	}
@@ -1290,14 +1306,14 @@ func (n *nomadFSM) applyCSIVolumeRegister(buf []byte, index uint64) interface{}
 	return nil
 }
 
-func (n *nomadFSM) applyCSIVolumeDeregister(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyCSIVolumeDeregister(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	var req structs.CSIVolumeDeregisterRequest
 	if err := structs.Decode(buf, &req); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
 	}
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_csi_volume_deregister"}, time.Now())
 
-	if err := n.state.CSIVolumeDeregister(index, req.RequestNamespace(), req.VolumeIDs, req.Force); err != nil {
+	if err := n.state.CSIVolumeDeregister(msgType, index, req.RequestNamespace(), req.VolumeIDs, req.Force); err != nil {
 		n.logger.Error("CSIVolumeDeregister failed", "error", err)
 		return err
 	}
@@ -1305,7 +1321,7 @@ func (n *nomadFSM) applyCSIVolumeDeregister(buf []byte, index uint64) interface{
 	return nil
 }
 
-func (n *nomadFSM) applyCSIVolumeBatchClaim(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyCSIVolumeBatchClaim(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	var batch *structs.CSIVolumeClaimBatchRequest
 	if err := structs.Decode(buf, &batch); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
@@ -1313,7 +1329,7 @@ func (n *nomadFSM) applyCSIVolumeBatchClaim(buf []byte, index uint64) interface{
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_csi_volume_batch_claim"}, time.Now())
 
 	for _, req := range batch.Claims {
-		err := n.state.CSIVolumeClaim(index, req.RequestNamespace(),
+		err := n.state.CSIVolumeClaim(msgType, index, req.RequestNamespace(),
 			req.VolumeID, req.ToClaim())
 		if err != nil {
 			n.logger.Error("CSIVolumeClaim for batch failed", "error", err)
@@ -1323,28 +1339,28 @@ func (n *nomadFSM) applyCSIVolumeBatchClaim(buf []byte, index uint64) interface{
 	return nil
 }
 
-func (n *nomadFSM) applyCSIVolumeClaim(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyCSIVolumeClaim(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	var req structs.CSIVolumeClaimRequest
 	if err := structs.Decode(buf, &req); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
 	}
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_csi_volume_claim"}, time.Now())
 
-	if err := n.state.CSIVolumeClaim(index, req.RequestNamespace(), req.VolumeID, req.ToClaim()); err != nil {
+	if err := n.state.CSIVolumeClaim(msgType, index, req.RequestNamespace(), req.VolumeID, req.ToClaim()); err != nil {
 		n.logger.Error("CSIVolumeClaim failed", "error", err)
 		return err
 	}
 	return nil
 }
 
-func (n *nomadFSM) applyCSIPluginDelete(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyCSIPluginDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	var req structs.CSIPluginDeleteRequest
 	if err := structs.Decode(buf, &req); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
 	}
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_csi_plugin_delete"}, time.Now())
 
-	if err := n.state.DeleteCSIPlugin(index, req.ID); err != nil {
+	if err := n.state.DeleteCSIPlugin(msgType, index, req.ID); err != nil {
 		// "plugin in use" is an error for the state store but not for typical
 		// callers, so reduce log noise by not logging that case here
 		if err.Error() != "plugin in use" {
@@ -1356,7 +1372,7 @@ func (n *nomadFSM) applyCSIPluginDelete(buf []byte, index uint64) interface{} {
 }
 
 // applyNamespaceUpsert is used to upsert a set of namespaces
-func (n *nomadFSM) applyNamespaceUpsert(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyNamespaceUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_namespace_upsert"}, time.Now())
 	var req structs.NamespaceUpsertRequest
 	if err := structs.Decode(buf, &req); err != nil {
@@ -1378,7 +1394,7 @@ func (n *nomadFSM) applyNamespaceUpsert(buf []byte, index uint64) interface{} {
 		}
 	}
 
-	if err := n.state.UpsertNamespaces(index, req.Namespaces); err != nil {
+	if err := n.state.UpsertNamespaces(msgType, index, req.Namespaces); err != nil {
 		n.logger.Error("UpsertNamespaces failed", "error", err)
 		return err
 	}
@@ -1392,14 +1408,14 @@ func (n *nomadFSM) applyNamespaceUpsert(buf []byte, index uint64) interface{} {
 }
 
 // applyNamespaceDelete is used to delete a set of namespaces
-func (n *nomadFSM) applyNamespaceDelete(buf []byte, index uint64) interface{} {
+func (n *nomadFSM) applyNamespaceDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
 	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_namespace_delete"}, time.Now())
 	var req structs.NamespaceDeleteRequest
 	if err := structs.Decode(buf, &req); err != nil {
 		panic(fmt.Errorf("failed to decode request: %v", err))
 	}
 
-	if err := n.state.DeleteNamespaces(index, req.Namespaces); err != nil {
+	if err := n.state.DeleteNamespaces(msgType, index, req.Namespaces); err != nil {
 		n.logger.Error("DeleteNamespaces failed", "error", err)
 	}
 
@@ -1442,6 +1458,7 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 		Region:          n.config.Region,
 		EnablePublisher: n.config.EnableEventBroker,
 		EventBufferSize: n.config.EventBufferSize,
+		EventLog:        n.config.EventLog,
 	}
 	newState, err := state.NewStateStore(config)
 	if err != nil {
@@ -1708,9 +1725,14 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				return err
 			}
 
-		// COMPAT(1.0): Allow 1.0-beta clusterers to gracefully handle
 		case EventSinkSnapshot:
-			return nil
+			sink := new(structs.EventSink)
+			if err := dec.Decode(sink); err != nil {
+				return err
+			}
+			if err := restore.EventSinkRestore(sink); err != nil {
+				return err
+			}
 
 		case ServiceRegistrationSnapshot:
 			serviceRegistration := new(structs.ServiceRegistration)
@@ -1768,6 +1790,28 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				return err
 			}
 
+		case ACLAuthMethodSnapshot:
+			authMethod := new(structs.ACLAuthMethod)
+
+			if err := dec.Decode(authMethod); err != nil {
+				return err
+			}
+
+			if err := restore.ACLAuthMethodRestore(authMethod); err != nil {
+				return err
+			}
+
+		case ACLBindingRuleSnapshot:
+			bindingRule := new(structs.ACLBindingRule)
+
+			if err := dec.Decode(bindingRule); err != nil {
+				return err
+			}
+
+			if err := restore.ACLBindingRuleRestore(bindingRule); err != nil {
+				return err
+			}
+
 		default:
 			// Check if this is an enterprise only object being restored
 			restorer, ok := n.enterpriseRestorers[snapType]
@@ -2057,6 +2101,111 @@ func (n *nomadFSM) applyACLRolesDeleteByID(msgType structs.MessageType, buf []by
 	return nil
 }
 
+func (n *nomadFSM) applyACLAuthMethodsUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_auth_method_upsert"}, time.Now())
+	var req structs.ACLAuthMethodsUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertACLAuthMethods(msgType, index, req.AuthMethods); err != nil {
+		n.logger.Error("UpsertACLAuthMethods failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyACLAuthMethodsDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_auth_method_delete"}, time.Now())
+	var req structs.ACLAuthMethodsDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteACLAuthMethods(msgType, index, req.Names); err != nil {
+		n.logger.Error("DeleteACLAuthMethods failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyACLBindingRulesUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_binding_rule_upsert"}, time.Now())
+	var req structs.ACLBindingRulesUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertACLBindingRules(msgType, index, req.ACLBindingRules, req.AllowMissingAuthMethods); err != nil {
+		n.logger.Error("UpsertACLBindingRules failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyACLBindingRulesDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_acl_binding_rule_delete"}, time.Now())
+	var req structs.ACLBindingRulesDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteACLBindingRules(msgType, index, req.ACLBindingRuleIDs); err != nil {
+		n.logger.Error("DeleteACLBindingRules failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyUpsertEventSink(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "upsert_event_sink"}, time.Now())
+	var req structs.EventSinkUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertEventSink(msgType, index, req.Sink); err != nil {
+		n.logger.Error("UpsertEventSink failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyDeleteEventSinks(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "delete_event_sinks"}, time.Now())
+	var req structs.EventSinkDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteEventSinks(msgType, index, req.IDs); err != nil {
+		n.logger.Error("DeleteEventSinks failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyBatchEventSinkUpdateProgress(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "batch_event_sink_update_progress"}, time.Now())
+	var req structs.BatchEventSinkUpdateProgressRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpdateEventSinksProgress(msgType, index, req.Updates); err != nil {
+		n.logger.Error("UpdateEventSinksProgress failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 type FSMFilter struct {
 	evaluator *bexpr.Evaluator
 }
@@ -2092,13 +2241,13 @@ func (n *nomadFSM) applyVariableOperation(msgType structs.MessageType, buf []byt
 		[]metrics.Label{{Name: "op", Value: string(req.Op)}})
 	switch req.Op {
 	case structs.VarOpSet:
-		return n.state.VarSet(index, &req)
+		return n.state.VarSet(msgType, index, &req)
 	case structs.VarOpDelete:
-		return n.state.VarDelete(index, &req)
+		return n.state.VarDelete(msgType, index, &req)
 	case structs.VarOpDeleteCAS:
-		return n.state.VarDeleteCAS(index, &req)
+		return n.state.VarDeleteCAS(msgType, index, &req)
 	case structs.VarOpCAS:
-		return n.state.VarSetCAS(index, &req)
+		return n.state.VarSetCAS(msgType, index, &req)
 	default:
 		err := fmt.Errorf("Invalid variable operation '%s'", req.Op)
 		n.logger.Warn("Invalid variable operation", "operation", req.Op)
@@ -2262,6 +2411,18 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistACLAuthMethods(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistACLBindingRules(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
+	if err := s.persistEventSinks(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	return nil
 }
 
@@ -2916,6 +3077,70 @@ func (s *nomadSnapshot) persistACLRoles(sink raft.SnapshotSink,
 	}
 }
 
+func (s *nomadSnapshot) persistACLAuthMethods(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+
+	// Get all the ACL auth methods.
+	ws := memdb.NewWatchSet()
+	authMethodsIter, err := s.snap.GetACLAuthMethods(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := authMethodsIter.Next(); raw != nil; raw = authMethodsIter.Next() {
+		method := raw.(*structs.ACLAuthMethod)
+
+		// Write out an ACL auth method snapshot.
+		sink.Write([]byte{byte(ACLAuthMethodSnapshot)})
+		if err := encoder.Encode(method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistACLBindingRules(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+
+	// Get all the ACL binding rules.
+	ws := memdb.NewWatchSet()
+	bindingRulesIter, err := s.snap.GetACLBindingRules(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := bindingRulesIter.Next(); raw != nil; raw = bindingRulesIter.Next() {
+		rule := raw.(*structs.ACLBindingRule)
+
+		// Write out an ACL binding rule snapshot.
+		sink.Write([]byte{byte(ACLBindingRuleSnapshot)})
+		if err := encoder.Encode(rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *nomadSnapshot) persistEventSinks(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+
+	ws := memdb.NewWatchSet()
+	iter, err := s.snap.EventSinks(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		eventSink := raw.(*structs.EventSink)
+
+		sink.Write([]byte{byte(EventSinkSnapshot)})
+		if err := encoder.Encode(eventSink); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Release is a no-op, as we just need to GC the pointer
 // to the state store snapshot. There is nothing to explicitly
 // cleanup.
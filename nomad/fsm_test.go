@@ -2834,7 +2834,7 @@ func TestFSM_SnapshotRestore_SchedulerConfiguration(t *testing.T) {
 			SystemSchedulerEnabled: true,
 		},
 	}
-	state.SchedulerSetConfig(1000, schedConfig)
+	state.SchedulerSetConfig(structs.MsgTypeTestSetup, 1000, schedConfig)
 
 	// Verify the contents
 	require := require.New(t)
@@ -2930,6 +2930,48 @@ func TestFSM_SnapshotRestore_ACLRoles(t *testing.T) {
 	require.ElementsMatch(t, restoredACLRoles, aclRoles)
 }
 
+func TestFSM_SnapshotRestore_ACLAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+
+	// Create our initial FSM which will be snapshotted.
+	fsm := testFSM(t)
+	testState := fsm.State()
+
+	// Generate and upsert some ACL auth methods and binding rules.
+	authMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()}
+	require.NoError(t, testState.UpsertACLAuthMethods(structs.MsgTypeTestSetup, 10, authMethods))
+
+	bindingRules := []*structs.ACLBindingRule{mock.ACLBindingRule(), mock.ACLBindingRule()}
+	for _, rule := range bindingRules {
+		rule.AuthMethod = authMethods[0].Name
+	}
+	require.NoError(t, testState.UpsertACLBindingRules(structs.MsgTypeTestSetup, 20, bindingRules, false))
+
+	// Perform a snapshot restore.
+	restoredFSM := testSnapshotRestore(t, fsm)
+	restoredState := restoredFSM.State()
+
+	// List the ACL auth methods and binding rules from restored state and
+	// ensure everything is as expected.
+	iter, err := restoredState.GetACLAuthMethods(memdb.NewWatchSet())
+	require.NoError(t, err)
+
+	var restoredAuthMethods []*structs.ACLAuthMethod
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		restoredAuthMethods = append(restoredAuthMethods, raw.(*structs.ACLAuthMethod))
+	}
+	require.ElementsMatch(t, restoredAuthMethods, authMethods)
+
+	iter, err = restoredState.GetACLBindingRules(memdb.NewWatchSet())
+	require.NoError(t, err)
+
+	var restoredBindingRules []*structs.ACLBindingRule
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		restoredBindingRules = append(restoredBindingRules, raw.(*structs.ACLBindingRule))
+	}
+	require.ElementsMatch(t, restoredBindingRules, bindingRules)
+}
+
 func TestFSM_ReconcileSummaries(t *testing.T) {
 	ci.Parallel(t)
 	// Add some state
@@ -3281,7 +3323,7 @@ func TestFSM_DeleteNamespaces(t *testing.T) {
 
 	ns1 := mock.Namespace()
 	ns2 := mock.Namespace()
-	assert.Nil(fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns1, ns2}))
+	assert.Nil(fsm.State().UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns1, ns2}))
 
 	req := structs.NamespaceDeleteRequest{
 		Namespaces: []string{ns1.Name, ns2.Name},
@@ -3301,6 +3343,32 @@ func TestFSM_DeleteNamespaces(t *testing.T) {
 	assert.Nil(out)
 }
 
+func TestFSM_SnapshotRestore_EventSinks(t *testing.T) {
+	ci.Parallel(t)
+
+	fsm := testFSM(t)
+	testState := fsm.State()
+
+	sink1 := mock.EventSink()
+	sink2 := mock.EventSink()
+	require.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 10, sink1))
+	require.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 11, sink2))
+	require.NoError(t, testState.UpdateEventSinksProgress(structs.MsgTypeTestSetup, 12,
+		[]*structs.EventSinkProgressRequest{{ID: sink1.ID, LatestIndex: 12}}))
+
+	restoredFSM := testSnapshotRestore(t, fsm)
+	restoredState := restoredFSM.State()
+
+	out, err := restoredState.EventSinkByID(nil, sink1.ID)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.Equal(t, uint64(12), out.LatestIndex)
+
+	out, err = restoredState.EventSinkByID(nil, sink2.ID)
+	require.NoError(t, err)
+	require.Equal(t, sink2, out)
+}
+
 func TestFSM_SnapshotRestore_Namespaces(t *testing.T) {
 	ci.Parallel(t)
 	// Add some state
@@ -3308,7 +3376,7 @@ func TestFSM_SnapshotRestore_Namespaces(t *testing.T) {
 	state := fsm.State()
 	ns1 := mock.Namespace()
 	ns2 := mock.Namespace()
-	state.UpsertNamespaces(1000, []*structs.Namespace{ns1, ns2})
+	state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns1, ns2})
 
 	// Verify the contents
 	fsm2 := testSnapshotRestore(t, fsm)
@@ -3417,7 +3485,7 @@ func TestFSM_SnapshotRestore_Variables(t *testing.T) {
 	svs := msvs.List()
 
 	for _, sv := range svs {
-		setResp := testState.VarSet(10, &structs.VarApplyStateRequest{
+		setResp := testState.VarSet(structs.MsgTypeTestSetup, 10, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: sv,
 		})
@@ -3524,6 +3592,117 @@ func TestFSM_ApplyACLRolesDeleteByID(t *testing.T) {
 	require.Equal(t, 0, count)
 }
 
+func TestFSM_ApplyACLAuthMethodsUpsertAndDelete(t *testing.T) {
+	ci.Parallel(t)
+	fsm := testFSM(t)
+
+	// Generate the upsert request and apply the change.
+	req := structs.ACLAuthMethodsUpsertRequest{
+		AuthMethods: []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()},
+	}
+	buf, err := structs.Encode(structs.ACLAuthMethodsUpsertRequestType, req)
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	// Read out both ACL auth methods and perform an equality check using the
+	// hash.
+	ws := memdb.NewWatchSet()
+	out, err := fsm.State().GetACLAuthMethodByName(ws, req.AuthMethods[0].Name)
+	require.NoError(t, err)
+	require.Equal(t, req.AuthMethods[0].Hash, out.Hash)
+
+	out, err = fsm.State().GetACLAuthMethodByName(ws, req.AuthMethods[1].Name)
+	require.NoError(t, err)
+	require.Equal(t, req.AuthMethods[1].Hash, out.Hash)
+
+	// Build and apply the delete message.
+	deleteReq := structs.ACLAuthMethodsDeleteRequest{
+		Names: []string{req.AuthMethods[0].Name, req.AuthMethods[1].Name},
+	}
+	buf, err = structs.Encode(structs.ACLAuthMethodsDeleteRequestType, deleteReq)
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	iter, err := fsm.State().GetACLAuthMethods(ws)
+	require.NoError(t, err)
+	require.Nil(t, iter.Next())
+}
+
+func TestFSM_ApplyACLBindingRulesUpsertAndDelete(t *testing.T) {
+	ci.Parallel(t)
+	fsm := testFSM(t)
+
+	// Create the auth method our ACL binding rules link to.
+	authMethod := mock.ACLAuthMethod()
+	authMethod.Name = "auth0"
+	require.NoError(t, fsm.State().UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{authMethod}))
+
+	// Generate the upsert request and apply the change.
+	req := structs.ACLBindingRulesUpsertRequest{
+		ACLBindingRules: []*structs.ACLBindingRule{mock.ACLBindingRule(), mock.ACLBindingRule()},
+	}
+	buf, err := structs.Encode(structs.ACLBindingRulesUpsertRequestType, req)
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	ws := memdb.NewWatchSet()
+	out, err := fsm.State().GetACLBindingRule(ws, req.ACLBindingRules[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, req.ACLBindingRules[0].Hash, out.Hash)
+
+	out, err = fsm.State().GetACLBindingRule(ws, req.ACLBindingRules[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, req.ACLBindingRules[1].Hash, out.Hash)
+
+	// Build and apply the delete message.
+	deleteReq := structs.ACLBindingRulesDeleteRequest{
+		ACLBindingRuleIDs: []string{req.ACLBindingRules[0].ID, req.ACLBindingRules[1].ID},
+	}
+	buf, err = structs.Encode(structs.ACLBindingRulesDeleteRequestType, deleteReq)
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	iter, err := fsm.State().GetACLBindingRules(ws)
+	require.NoError(t, err)
+	require.Nil(t, iter.Next())
+}
+
+func TestFSM_ApplyEventSinks(t *testing.T) {
+	ci.Parallel(t)
+	fsm := testFSM(t)
+
+	sink := mock.EventSink()
+	buf, err := structs.Encode(structs.EventSinkUpsertRequestType, structs.EventSinkUpsertRequest{Sink: sink})
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	out, err := fsm.State().EventSinkByID(nil, sink.ID)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.Equal(t, sink.Address, out.Address)
+
+	progressReq := structs.BatchEventSinkUpdateProgressRequest{
+		Updates: []*structs.EventSinkProgressRequest{{ID: sink.ID, LatestIndex: 5}},
+	}
+	buf, err = structs.Encode(structs.BatchEventSinkUpdateProgressType, progressReq)
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	out, err = fsm.State().EventSinkByID(nil, sink.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), out.LatestIndex)
+
+	deleteReq := structs.EventSinkDeleteRequest{IDs: []string{sink.ID}}
+	buf, err = structs.Encode(structs.EventSinkDeleteRequestType, deleteReq)
+	require.NoError(t, err)
+	require.Nil(t, fsm.Apply(makeLog(buf)))
+
+	out, err = fsm.State().EventSinkByID(nil, sink.ID)
+	require.NoError(t, err)
+	require.Nil(t, out)
+}
+
 func TestFSM_ACLEvents(t *testing.T) {
 	ci.Parallel(t)
 
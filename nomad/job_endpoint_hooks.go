@@ -2,8 +2,11 @@ package nomad
 
 import (
 	"fmt"
+	"sort"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/client/taskenv"
+	"github.com/hashicorp/nomad/drivers/java"
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -147,9 +150,13 @@ func (jobImpliedConstraints) Mutate(j *structs.Job) (*structs.Job, []error, erro
 	// Identify which task groups are utilising Consul service discovery.
 	consulServiceDisco := j.RequiredConsulServiceDiscovery()
 
+	// Identify which task groups require specific JDK versions.
+	jdkVersions := requiredJDKVersions(j)
+
 	// Hot path
 	if len(signals) == 0 && len(vaultBlocks) == 0 &&
-		len(nativeServiceDisco) == 0 && len(consulServiceDisco) == 0 {
+		len(nativeServiceDisco) == 0 && len(consulServiceDisco) == 0 &&
+		len(jdkVersions) == 0 {
 		return j, nil, nil
 	}
 
@@ -181,11 +188,72 @@ func (jobImpliedConstraints) Mutate(j *structs.Job) (*structs.Job, []error, erro
 		if ok := consulServiceDisco[tg.Name]; ok {
 			mutateConstraint(constraintMatcherLeft, tg, consulServiceDiscoveryConstraint)
 		}
+
+		// If the task group runs Java tasks on specific JDK versions, run
+		// the mutator for each version.
+		for _, version := range jdkVersions[tg.Name] {
+			mutateConstraint(constraintMatcherLeft, tg, getJDKConstraint(version))
+		}
 	}
 
 	return j, nil, nil
 }
 
+// requiredJDKVersions identifies the JDK versions, keyed by task group,
+// requested by tasks within the job using the java driver. The driver config
+// is decoded after interpolating it with what is known at submission time.
+// Versions that can't be resolved yet, such as those set from node
+// attributes, don't add a constraint and are checked when the task starts.
+func requiredJDKVersions(job *structs.Job) map[string][]string {
+	groups := make(map[string][]string)
+
+	for _, tg := range job.TaskGroups {
+		versions := make(map[string]struct{})
+		for _, task := range tg.Tasks {
+			if task.Driver != "java" {
+				continue
+			}
+
+			// The node is only known once the task is placed, so interpolate
+			// with an empty one
+			env := taskenv.NewBuilder(&structs.Node{}, &structs.Allocation{
+				Job:       job,
+				TaskGroup: tg.Name,
+			}, task, job.Region).Build()
+			vars, _, err := env.AllValues()
+			if err != nil {
+				continue
+			}
+
+			version, err := java.JDKVersion(task.Config, vars)
+			if err != nil || version == "" {
+				continue
+			}
+			versions[version] = struct{}{}
+		}
+
+		if len(versions) != 0 {
+			flat := make([]string, 0, len(versions))
+			for v := range versions {
+				flat = append(flat, v)
+			}
+			sort.Strings(flat)
+			groups[tg.Name] = flat
+		}
+	}
+
+	return groups
+}
+
+// getJDKConstraint builds the constraint which ensures a task group is
+// placed on clients with the given JDK version installed.
+func getJDKConstraint(version string) *structs.Constraint {
+	return &structs.Constraint{
+		LTarget: fmt.Sprintf("${attr.driver.java.%s.path}", version),
+		Operand: structs.ConstraintAttributeIsSet,
+	}
+}
+
 // constraintMatcher is a custom type which helps control how constraints are
 // identified as being present within a task group.
 type constraintMatcher uint
@@ -705,6 +705,47 @@ func Test_jobImpliedConstraints_Mutate(t *testing.T) {
 			expectedOutputError:    nil,
 			name:                   "task group with empty provider",
 		},
+		{
+			inputJob: &structs.Job{
+				Name: "example",
+				TaskGroups: []*structs.TaskGroup{
+					{
+						Name: "group1",
+						Tasks: []*structs.Task{
+							{
+								Name:   "group1-task1",
+								Driver: "java",
+								Config: map[string]interface{}{"jdk_version": "17"},
+							},
+						},
+					},
+				},
+			},
+			expectedOutputJob: &structs.Job{
+				Name: "example",
+				TaskGroups: []*structs.TaskGroup{
+					{
+						Name: "group1",
+						Tasks: []*structs.Task{
+							{
+								Name:   "group1-task1",
+								Driver: "java",
+								Config: map[string]interface{}{"jdk_version": "17"},
+							},
+						},
+						Constraints: []*structs.Constraint{
+							{
+								LTarget: "${attr.driver.java.17.path}",
+								Operand: structs.ConstraintAttributeIsSet,
+							},
+						},
+					},
+				},
+			},
+			expectedOutputWarnings: nil,
+			expectedOutputError:    nil,
+			name:                   "task with jdk version",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -717,3 +758,42 @@ func Test_jobImpliedConstraints_Mutate(t *testing.T) {
 		})
 	}
 }
+
+func Test_requiredJDKVersions(t *testing.T) {
+	ci.Parallel(t)
+
+	job := &structs.Job{
+		Meta: map[string]string{"jdk": "21"},
+		TaskGroups: []*structs.TaskGroup{
+			{
+				Name: "group1",
+				Tasks: []*structs.Task{
+					{Name: "a", Driver: "java", Config: map[string]interface{}{"jdk_version": "17"}},
+					{Name: "b", Driver: "java", Config: map[string]interface{}{"jdk_version": 11}},
+					{Name: "c", Driver: "java", Config: map[string]interface{}{"jdk_version": "17"}},
+				},
+			},
+			{
+				Name: "group2",
+				Tasks: []*structs.Task{
+					{Name: "a", Driver: "java", Config: map[string]interface{}{"jar_path": "local/app.jar"}},
+					{Name: "b", Driver: "exec", Config: map[string]interface{}{"jdk_version": "17"}},
+				},
+			},
+			{
+				Name: "group3",
+				Tasks: []*structs.Task{
+					// Interpolated from the job meta
+					{Name: "a", Driver: "java", Config: map[string]interface{}{"jdk_version": "${NOMAD_META_jdk}"}},
+					// Node attributes are only known on the client
+					{Name: "b", Driver: "java", Config: map[string]interface{}{"jdk_version": "${attr.jdk}"}},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, map[string][]string{
+		"group1": {"11", "17"},
+		"group3": {"21"},
+	}, requiredJDKVersions(job))
+}
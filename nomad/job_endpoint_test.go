@@ -2124,7 +2124,7 @@ func TestJobEndpoint_Register_ValidateMemoryMax(t *testing.T) {
 	require.Contains(t, resp.Warnings, "Memory oversubscription is not enabled")
 
 	// enable now and try again
-	s1.State().SchedulerSetConfig(100, &structs.SchedulerConfiguration{
+	s1.State().SchedulerSetConfig(structs.MsgTypeTestSetup, 100, &structs.SchedulerConfiguration{
 		MemoryOversubscriptionEnabled: true,
 	})
 	resp = submitNewJob()
@@ -2215,7 +2215,7 @@ func TestJobEndpoint_Register_ACL_Namespace(t *testing.T) {
 	// Upsert namespace
 	ns := mock.Namespace()
 	ns.Name = "test"
-	err = s1.fsm.State().UpsertNamespaces(1000, []*structs.Namespace{ns})
+	err = s1.fsm.State().UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns})
 	assert.Nil(err)
 
 	// Create the register request
@@ -5174,7 +5174,7 @@ func TestJobEndpoint_ListJobs_PaginationFiltering(t *testing.T) {
 	}
 
 	state := s1.fsm.State()
-	require.NoError(t, state.UpsertNamespaces(999, []*structs.Namespace{{Name: "non-default"}, {Name: "other"}}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 999, []*structs.Namespace{{Name: "non-default"}, {Name: "other"}}))
 
 	for i, m := range mocks {
 		if m.name == "" {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	memdb "github.com/hashicorp/go-memdb"
 
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -30,7 +31,7 @@ func (k *Keyring) Rotate(args *structs.KeyringRotateRootKeyRequest, reply *struc
 
 	if aclObj, err := k.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilityRootKeyring) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -96,14 +97,14 @@ func (k *Keyring) List(args *structs.KeyringListRootKeyMetaRequest, reply *struc
 
 	defer metrics.MeasureSince([]string{"nomad", "keyring", "list"}, time.Now())
 
-	// we need to allow both humans with management tokens and
+	// we need to allow both humans with the root keyring capability and
 	// non-leader servers to list keys, in order to support
 	// replication
 	err := validateTLSCertificateLevel(k.srv, k.ctx, tlsCertificateLevelServer)
 	if err != nil {
 		if aclObj, err := k.srv.ResolveToken(args.AuthToken); err != nil {
 			return err
-		} else if aclObj != nil && !aclObj.IsManagement() {
+		} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilityRootKeyring) {
 			return structs.ErrPermissionDenied
 		}
 	}
@@ -151,7 +152,7 @@ func (k *Keyring) Update(args *structs.KeyringUpdateRootKeyRequest, reply *struc
 
 	if aclObj, err := k.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilityRootKeyring) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -280,7 +281,7 @@ func (k *Keyring) Delete(args *structs.KeyringDeleteRootKeyRequest, reply *struc
 
 	if aclObj, err := k.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilityRootKeyring) {
 		return structs.ErrPermissionDenied
 	}
 
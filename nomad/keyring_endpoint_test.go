@@ -1,6 +1,8 @@
 package nomad
 
 import (
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"sync"
 	"testing"
 	"time"
@@ -8,7 +10,9 @@ import (
 	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
 )
@@ -121,6 +125,102 @@ func TestKeyringEndpoint_CRUD(t *testing.T) {
 	require.Len(t, listResp.Keys, 1) // just the bootstrap key
 }
 
+// TestKeyringEndpoint_ACL_OperatorWrite asserts that the root keyring can't
+// be managed with an operator write token, and requires the root keyring
+// capability to be granted explicitly.
+func TestKeyringEndpoint_ACL_OperatorWrite(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, rootToken, shutdown := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+
+		// Listing keys is allowed for servers regardless of ACLs, so the
+		// connection of the list requests below is verified as a client's
+		c.TLSConfig.VerifyServerHostname = true
+	})
+	defer shutdown()
+	testutil.WaitForLeader(t, srv.RPC)
+	codec := rpcClient(t, srv)
+
+	state := srv.fsm.State()
+	operatorToken := mock.CreatePolicyAndToken(t, state, 1001, "test-operator",
+		mock.OperatorPolicy(acl.PolicyWrite))
+	keyringToken := mock.CreatePolicyAndToken(t, state, 1003, "test-keyring",
+		mock.CapabilityPolicy("operator", acl.OperatorCapabilityRootKeyring))
+
+	key, err := structs.NewRootKey(structs.EncryptionAlgorithmAES256GCM)
+	require.NoError(t, err)
+
+	updateReq := &structs.KeyringUpdateRootKeyRequest{
+		RootKey: key,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var updateResp structs.KeyringUpdateRootKeyResponse
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Update", updateReq, &updateResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	updateReq.AuthToken = keyringToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Update", updateReq, &updateResp)
+	require.NoError(t, err)
+
+	rotateReq := &structs.KeyringRotateRootKeyRequest{
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var rotateResp structs.KeyringRotateRootKeyResponse
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", rotateReq, &rotateResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	delReq := &structs.KeyringDeleteRootKeyRequest{
+		KeyID: key.Meta.KeyID,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var delResp structs.KeyringDeleteRootKeyResponse
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Delete", delReq, &delResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	delReq.AuthToken = keyringToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Delete", delReq, &delResp)
+	require.NoError(t, err)
+
+	// Call List with the context of a connection from a client
+	endpoint := &Keyring{
+		srv:       srv,
+		logger:    srv.logger,
+		encrypter: srv.encrypter,
+		ctx: &RPCContext{
+			TLS: true,
+			VerifiedChains: [][]*x509.Certificate{{
+				{Subject: pkix.Name{CommonName: "client.global.nomad"}},
+			}},
+		},
+	}
+	listReq := &structs.KeyringListRootKeyMetaRequest{
+		QueryOptions: structs.QueryOptions{
+			Region:    "global",
+			AuthToken: operatorToken.SecretID,
+		},
+	}
+	var listResp structs.KeyringListRootKeyMetaResponse
+	err = endpoint.List(listReq, &listResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	listReq.AuthToken = keyringToken.SecretID
+	require.NoError(t, endpoint.List(listReq, &listResp))
+	require.NotEmpty(t, listResp.Keys)
+
+	listReq.AuthToken = rootToken.SecretID
+	require.NoError(t, endpoint.List(listReq, &listResp))
+}
+
 // TestKeyringEndpoint_validateUpdate exercises all the various
 // validations we make for the update RPC
 func TestKeyringEndpoint_InvalidUpdates(t *testing.T) {
@@ -250,7 +350,17 @@ func TestKeyringEndpoint_Rotate(t *testing.T) {
 	err = msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", rotateReq, &rotateResp)
 	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
 
-	rotateReq.AuthToken = rootToken.SecretID
+	// Tokens granting other operator capabilities can't rotate the key
+	state := srv.fsm.State()
+	snapshotToken := mock.CreatePolicyAndToken(t, state, 1001, "test-snapshot",
+		mock.CapabilityPolicy("operator", acl.OperatorCapabilitySnapshotSave))
+	rotateReq.AuthToken = snapshotToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", rotateReq, &rotateResp)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	keyringToken := mock.CreatePolicyAndToken(t, state, 1003, "test-keyring",
+		mock.CapabilityPolicy("operator", acl.OperatorCapabilityRootKeyring))
+	rotateReq.AuthToken = keyringToken.SecretID
 	err = msgpackrpc.CallWithCodec(codec, "Keyring.Rotate", rotateReq, &rotateResp)
 	require.NoError(t, err)
 	require.NotEqual(t, updateResp.Index, rotateResp.Index)
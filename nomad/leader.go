@@ -322,6 +322,16 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 	// Enable the volume watcher, since we are now the leader
 	s.volumeWatcher.SetEnabled(true, s.State(), s.getLeaderAcl())
 
+	// Enable the delivery of events to the event sinks
+	if s.config.EnableEventBroker {
+		s.eventSinkManager.SetEnabled(true)
+	}
+
+	// Enable the scheduled snapshots, if they are only taken by the leader
+	if s.snapshotAgent != nil && s.config.SnapshotAgentLeaderOnly {
+		s.snapshotAgent.SetEnabled(true)
+	}
+
 	// Restore the eval broker state and blocked eval state. If these are
 	// currently paused, we do not need to do this.
 	if restoreEvals {
@@ -396,6 +406,8 @@ func (s *Server) establishLeadership(stopCh chan struct{}) error {
 			go s.replicateACLPolicies(stopCh)
 			go s.replicateACLTokens(stopCh)
 			go s.replicateACLRoles(stopCh)
+			go s.replicateACLAuthMethods(stopCh)
+			go s.replicateACLBindingRules(stopCh)
 			go s.replicateNamespaces(stopCh)
 		}
 	}
@@ -1196,6 +1208,14 @@ func (s *Server) revokeLeadership() error {
 	// Disable the volume watcher
 	s.volumeWatcher.SetEnabled(false, nil, "")
 
+	// Disable the delivery of events to the event sinks
+	s.eventSinkManager.SetEnabled(false)
+
+	// Disable the scheduled snapshots, if they are only taken by the leader
+	if s.snapshotAgent != nil && s.config.SnapshotAgentLeaderOnly {
+		s.snapshotAgent.SetEnabled(false)
+	}
+
 	// Disable any enterprise systems required.
 	if err := s.revokeEnterpriseLeadership(); err != nil {
 		return err
@@ -1833,6 +1853,274 @@ func (s *Server) replicateACLRoles(stopCh chan struct{}) {
 	}
 }
 
+// replicateACLAuthMethods is used to replicate ACL auth methods from the
+// authoritative region to this region. The loop should only be run on the
+// leader within the federated region.
+func (s *Server) replicateACLAuthMethods(stopCh chan struct{}) {
+
+	// Generate our request object. We only need to do this once and reuse it
+	// for every RPC request. The MinQueryIndex is updated after every
+	// successful replication loop, so the next query acts as a blocking query
+	// and only returns upon a change in the authoritative region.
+	req := structs.ACLAuthMethodListRequest{
+		QueryOptions: structs.QueryOptions{
+			AllowStale: true,
+			Region:     s.config.AuthoritativeRegion,
+		},
+	}
+
+	limiter := rate.NewLimiter(replicationRateLimit, int(replicationRateLimit))
+	s.logger.Debug("starting ACL Auth-Methods replication from authoritative region",
+		"authoritative_region", req.Region)
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+
+			// Rate limit how often we attempt replication. It is OK to ignore
+			// the error as the context will never be cancelled and the limit
+			// parameters are controlled internally.
+			_ = limiter.Wait(context.Background())
+
+			// Set the replication token on each replication iteration so that
+			// it is always current and can handle agent SIGHUP reloads.
+			req.AuthToken = s.ReplicationToken()
+
+			var resp structs.ACLAuthMethodListResponse
+
+			// Make the list RPC request to the authoritative region, so we
+			// capture the latest ACL auth method listing.
+			err := s.forwardRegion(s.config.AuthoritativeRegion, structs.ACLListAuthMethodsRPCMethod, &req, &resp)
+			if err != nil {
+				s.logger.Error("failed to fetch ACL auth-methods from authoritative region", "error", err)
+				if s.replicationBackoffContinue(stopCh) {
+					continue
+				} else {
+					return
+				}
+			}
+
+			// Perform a two-way diff on the ACL auth methods.
+			toDelete, toUpdate := diffACLAuthMethods(s.State(), req.MinQueryIndex, resp.AuthMethods)
+
+			// A significant amount of time could pass between the last check
+			// on whether we should stop the replication process. Therefore, do
+			// a check here, before calling Raft.
+			select {
+			case <-stopCh:
+				return
+			default:
+			}
+
+			// If we have ACL auth methods to delete, make this call directly
+			// to Raft.
+			if len(toDelete) > 0 {
+				args := structs.ACLAuthMethodsDeleteRequest{Names: toDelete}
+				_, _, err := s.raftApply(structs.ACLAuthMethodsDeleteRequestType, &args)
+
+				// If the error was because we lost leadership while calling
+				// Raft, avoid logging as this can be confusing to operators.
+				if err != nil {
+					if err != raft.ErrLeadershipLost {
+						s.logger.Error("failed to delete ACL auth-methods", "error", err)
+					}
+					if s.replicationBackoffContinue(stopCh) {
+						continue
+					} else {
+						return
+					}
+				}
+			}
+
+			// Fetch any outdated auth methods.
+			var fetched []*structs.ACLAuthMethod
+			if len(toUpdate) > 0 {
+				req := structs.ACLAuthMethodsGetRequest{
+					Names: toUpdate,
+					QueryOptions: structs.QueryOptions{
+						Region:        s.config.AuthoritativeRegion,
+						AuthToken:     s.ReplicationToken(),
+						AllowStale:    true,
+						MinQueryIndex: resp.Index - 1,
+					},
+				}
+				var reply structs.ACLAuthMethodsGetResponse
+				if err := s.forwardRegion(s.config.AuthoritativeRegion, structs.ACLGetAuthMethodsRPCMethod, &req, &reply); err != nil {
+					s.logger.Error("failed to fetch ACL auth-methods from authoritative region", "error", err)
+					if s.replicationBackoffContinue(stopCh) {
+						continue
+					} else {
+						return
+					}
+				}
+				for _, authMethod := range reply.AuthMethods {
+					fetched = append(fetched, authMethod)
+				}
+			}
+
+			// Update local auth methods.
+			if len(fetched) > 0 {
+				args := structs.ACLAuthMethodsUpsertRequest{
+					AuthMethods: fetched,
+				}
+
+				// Perform the upsert directly via Raft.
+				_, _, err := s.raftApply(structs.ACLAuthMethodsUpsertRequestType, &args)
+				if err != nil {
+					s.logger.Error("failed to update ACL auth-methods", "error", err)
+					if s.replicationBackoffContinue(stopCh) {
+						continue
+					} else {
+						return
+					}
+				}
+			}
+
+			// Update the minimum query index, blocks until there is a change.
+			req.MinQueryIndex = resp.Index
+		}
+	}
+}
+
+// replicateACLBindingRules is used to replicate ACL binding rules from the
+// authoritative region to this region. The loop should only be run on the
+// leader within the federated region.
+func (s *Server) replicateACLBindingRules(stopCh chan struct{}) {
+
+	// Generate our request object. We only need to do this once and reuse it
+	// for every RPC request. The MinQueryIndex is updated after every
+	// successful replication loop, so the next query acts as a blocking query
+	// and only returns upon a change in the authoritative region.
+	req := structs.ACLBindingRulesListRequest{
+		QueryOptions: structs.QueryOptions{
+			AllowStale: true,
+			Region:     s.config.AuthoritativeRegion,
+		},
+	}
+
+	limiter := rate.NewLimiter(replicationRateLimit, int(replicationRateLimit))
+	s.logger.Debug("starting ACL Binding-Rules replication from authoritative region",
+		"authoritative_region", req.Region)
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+
+			// Rate limit how often we attempt replication. It is OK to ignore
+			// the error as the context will never be cancelled and the limit
+			// parameters are controlled internally.
+			_ = limiter.Wait(context.Background())
+
+			// Set the replication token on each replication iteration so that
+			// it is always current and can handle agent SIGHUP reloads.
+			req.AuthToken = s.ReplicationToken()
+
+			var resp structs.ACLBindingRulesListResponse
+
+			// Make the list RPC request to the authoritative region, so we
+			// capture the latest ACL binding rule listing.
+			err := s.forwardRegion(s.config.AuthoritativeRegion, structs.ACLListBindingRulesRPCMethod, &req, &resp)
+			if err != nil {
+				s.logger.Error("failed to fetch ACL binding-rules from authoritative region", "error", err)
+				if s.replicationBackoffContinue(stopCh) {
+					continue
+				} else {
+					return
+				}
+			}
+
+			// Perform a two-way diff on the ACL binding rules.
+			toDelete, toUpdate := diffACLBindingRules(s.State(), req.MinQueryIndex, resp.ACLBindingRules)
+
+			// A significant amount of time could pass between the last check
+			// on whether we should stop the replication process. Therefore, do
+			// a check here, before calling Raft.
+			select {
+			case <-stopCh:
+				return
+			default:
+			}
+
+			// If we have ACL binding rules to delete, make this call directly
+			// to Raft.
+			if len(toDelete) > 0 {
+				args := structs.ACLBindingRulesDeleteRequest{ACLBindingRuleIDs: toDelete}
+				_, _, err := s.raftApply(structs.ACLBindingRulesDeleteRequestType, &args)
+
+				// If the error was because we lost leadership while calling
+				// Raft, avoid logging as this can be confusing to operators.
+				if err != nil {
+					if err != raft.ErrLeadershipLost {
+						s.logger.Error("failed to delete ACL binding-rules", "error", err)
+					}
+					if s.replicationBackoffContinue(stopCh) {
+						continue
+					} else {
+						return
+					}
+				}
+			}
+
+			// Fetch any outdated binding rules.
+			var fetched []*structs.ACLBindingRule
+			if len(toUpdate) > 0 {
+				req := structs.ACLBindingRulesRequest{
+					ACLBindingRuleIDs: toUpdate,
+					QueryOptions: structs.QueryOptions{
+						Region:        s.config.AuthoritativeRegion,
+						AuthToken:     s.ReplicationToken(),
+						AllowStale:    true,
+						MinQueryIndex: resp.Index - 1,
+					},
+				}
+				var reply structs.ACLBindingRulesResponse
+				if err := s.forwardRegion(s.config.AuthoritativeRegion, structs.ACLGetBindingRulesRPCMethod, &req, &reply); err != nil {
+					s.logger.Error("failed to fetch ACL binding-rules from authoritative region", "error", err)
+					if s.replicationBackoffContinue(stopCh) {
+						continue
+					} else {
+						return
+					}
+				}
+				for _, bindingRule := range reply.ACLBindingRules {
+					fetched = append(fetched, bindingRule)
+				}
+			}
+
+			// Update local binding rules.
+			if len(fetched) > 0 {
+
+				// The replication of ACL binding rules and auth methods are
+				// independent, therefore we cannot ensure the auth method
+				// linked within the binding rule is present. We must set
+				// allow missing to true.
+				args := structs.ACLBindingRulesUpsertRequest{
+					ACLBindingRules:         fetched,
+					AllowMissingAuthMethods: true,
+				}
+
+				// Perform the upsert directly via Raft.
+				_, _, err := s.raftApply(structs.ACLBindingRulesUpsertRequestType, &args)
+				if err != nil {
+					s.logger.Error("failed to update ACL binding-rules", "error", err)
+					if s.replicationBackoffContinue(stopCh) {
+						continue
+					} else {
+						return
+					}
+				}
+			}
+
+			// Update the minimum query index, blocks until there is a change.
+			req.MinQueryIndex = resp.Index
+		}
+	}
+}
+
 // replicationBackoffContinue should be used when a replication loop encounters
 // an error and wants to wait until either the backoff time has been met, or
 // the stopCh has been closed. The boolean indicates whether the replication
@@ -1913,6 +2201,112 @@ func diffACLRoles(
 	return
 }
 
+// diffACLAuthMethods is used to perform a two-way diff between the local ACL
+// auth methods and the remote auth methods to determine which ones need to
+// be deleted or updated. The returned array's contain ACL auth method names.
+func diffACLAuthMethods(
+	store *state.StateStore, minIndex uint64, remoteList []*structs.ACLAuthMethodStub) (
+	delete []string, update []string) {
+
+	// The local ACL auth method tracking is keyed by the name and the value
+	// is the hash of the auth method.
+	local := make(map[string][]byte)
+
+	// The remote ACL auth method tracking is keyed by the name; the value is
+	// an empty struct as we already have the full object.
+	remote := make(map[string]struct{})
+
+	// Read all the ACL auth methods currently held within our local state.
+	// This panic will only happen as a developer making a mistake with naming
+	// the index to use.
+	iter, err := store.GetACLAuthMethods(nil)
+	if err != nil {
+		panic(fmt.Sprintf("failed to iterate local ACL auth methods: %v", err))
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		authMethod := raw.(*structs.ACLAuthMethod)
+		local[authMethod.Name] = authMethod.Hash
+	}
+
+	for _, remoteAuthMethod := range remoteList {
+		remote[remoteAuthMethod.Name] = struct{}{}
+
+		// Identify whether the ACL auth method is within the local state. If
+		// it is not, add this to our update list.
+		if localHash, ok := local[remoteAuthMethod.Name]; !ok {
+			update = append(update, remoteAuthMethod.Name)
+
+			// Check if the ACL auth method is newer remotely and there is a
+			// hash mismatch.
+		} else if remoteAuthMethod.ModifyIndex > minIndex && !bytes.Equal(localHash, remoteAuthMethod.Hash) {
+			update = append(update, remoteAuthMethod.Name)
+		}
+	}
+
+	// If we have ACL auth methods within state which are no longer present
+	// in the authoritative region we should delete them.
+	for localAuthMethod := range local {
+		if _, ok := remote[localAuthMethod]; !ok {
+			delete = append(delete, localAuthMethod)
+		}
+	}
+	return
+}
+
+// diffACLBindingRules is used to perform a two-way diff between the local ACL
+// binding rules and the remote binding rules to determine which ones need to
+// be deleted or updated. The returned array's contain ACL binding rule IDs.
+func diffACLBindingRules(
+	store *state.StateStore, minIndex uint64, remoteList []*structs.ACLBindingRuleListStub) (
+	delete []string, update []string) {
+
+	// The local ACL binding rule tracking is keyed by the ID and the value is
+	// the hash of the binding rule.
+	local := make(map[string][]byte)
+
+	// The remote ACL binding rule tracking is keyed by the ID; the value is
+	// an empty struct as we already have the full object.
+	remote := make(map[string]struct{})
+
+	// Read all the ACL binding rules currently held within our local state.
+	// This panic will only happen as a developer making a mistake with naming
+	// the index to use.
+	iter, err := store.GetACLBindingRules(nil)
+	if err != nil {
+		panic(fmt.Sprintf("failed to iterate local ACL binding rules: %v", err))
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		bindingRule := raw.(*structs.ACLBindingRule)
+		local[bindingRule.ID] = bindingRule.Hash
+	}
+
+	for _, remoteBindingRule := range remoteList {
+		remote[remoteBindingRule.ID] = struct{}{}
+
+		// Identify whether the ACL binding rule is within the local state. If
+		// it is not, add this to our update list.
+		if localHash, ok := local[remoteBindingRule.ID]; !ok {
+			update = append(update, remoteBindingRule.ID)
+
+			// Check if the ACL binding rule is newer remotely and there is a
+			// hash mismatch.
+		} else if remoteBindingRule.ModifyIndex > minIndex && !bytes.Equal(localHash, remoteBindingRule.Hash) {
+			update = append(update, remoteBindingRule.ID)
+		}
+	}
+
+	// If we have ACL binding rules within state which are no longer present
+	// in the authoritative region we should delete them.
+	for localBindingRule := range local {
+		if _, ok := remote[localBindingRule]; !ok {
+			delete = append(delete, localBindingRule)
+		}
+	}
+	return
+}
+
 // getOrCreateAutopilotConfig is used to get the autopilot config, initializing it if necessary
 func (s *Server) getOrCreateAutopilotConfig() *structs.AutopilotConfig {
 	state := s.fsm.State()
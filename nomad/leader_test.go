@@ -1135,6 +1135,68 @@ func Test_diffACLRoles(t *testing.T) {
 	require.ElementsMatch(t, []string{aclRole3.ID, aclRole4.ID}, toUpdate)
 }
 
+func Test_diffACLAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+
+	stateStore := state.TestStateStore(t)
+
+	// Build an initial baseline of ACL auth methods.
+	authMethod0 := mock.ACLAuthMethod()
+	authMethod1 := mock.ACLAuthMethod()
+	authMethod2 := mock.ACLAuthMethod()
+	authMethod3 := mock.ACLAuthMethod()
+
+	// Upsert these into our local state. Use copies, so we can alter the auth
+	// methods directly and use within the diff func.
+	err := stateStore.UpsertACLAuthMethods(structs.MsgTypeTestSetup, 50, []*structs.ACLAuthMethod{
+		authMethod0.Copy(), authMethod1.Copy(), authMethod2.Copy(), authMethod3.Copy()})
+	require.NoError(t, err)
+
+	// Modify the ACL auth methods to create a number of differences. These
+	// methods represent the state of the authoritative region.
+	authMethod2.ModifyIndex = 50
+	authMethod3.ModifyIndex = 200
+	authMethod3.Hash = []byte{0, 1, 2, 3}
+	authMethod4 := mock.ACLAuthMethod()
+
+	// Run the diff function and test the output.
+	toDelete, toUpdate := diffACLAuthMethods(stateStore, 50, []*structs.ACLAuthMethodStub{
+		authMethod2.Stub(), authMethod3.Stub(), authMethod4.Stub()})
+	require.ElementsMatch(t, []string{authMethod0.Name, authMethod1.Name}, toDelete)
+	require.ElementsMatch(t, []string{authMethod3.Name, authMethod4.Name}, toUpdate)
+}
+
+func Test_diffACLBindingRules(t *testing.T) {
+	ci.Parallel(t)
+
+	stateStore := state.TestStateStore(t)
+
+	// Build an initial baseline of ACL binding rules.
+	bindingRule0 := mock.ACLBindingRule()
+	bindingRule1 := mock.ACLBindingRule()
+	bindingRule2 := mock.ACLBindingRule()
+	bindingRule3 := mock.ACLBindingRule()
+
+	// Upsert these into our local state. Use copies, so we can alter the
+	// binding rules directly and use within the diff func.
+	err := stateStore.UpsertACLBindingRules(structs.MsgTypeTestSetup, 50, []*structs.ACLBindingRule{
+		bindingRule0.Copy(), bindingRule1.Copy(), bindingRule2.Copy(), bindingRule3.Copy()}, true)
+	require.NoError(t, err)
+
+	// Modify the ACL binding rules to create a number of differences. These
+	// rules represent the state of the authoritative region.
+	bindingRule2.ModifyIndex = 50
+	bindingRule3.ModifyIndex = 200
+	bindingRule3.Hash = []byte{0, 1, 2, 3}
+	bindingRule4 := mock.ACLBindingRule()
+
+	// Run the diff function and test the output.
+	toDelete, toUpdate := diffACLBindingRules(stateStore, 50, []*structs.ACLBindingRuleListStub{
+		bindingRule2.Stub(), bindingRule3.Stub(), bindingRule4.Stub()})
+	require.ElementsMatch(t, []string{bindingRule0.ID, bindingRule1.ID}, toDelete)
+	require.ElementsMatch(t, []string{bindingRule3.ID, bindingRule4.ID}, toUpdate)
+}
+
 func TestLeader_Reelection(t *testing.T) {
 	ci.Parallel(t)
 
@@ -1520,7 +1582,7 @@ func TestLeader_ReplicateNamespaces(t *testing.T) {
 
 	// Write a namespace to the authoritative region
 	ns1 := mock.Namespace()
-	assert.Nil(s1.State().UpsertNamespaces(100, []*structs.Namespace{ns1}))
+	assert.Nil(s1.State().UpsertNamespaces(structs.MsgTypeTestSetup, 100, []*structs.Namespace{ns1}))
 
 	// Wait for the namespace to replicate
 	testutil.WaitForResult(func() (bool, error) {
@@ -1532,7 +1594,7 @@ func TestLeader_ReplicateNamespaces(t *testing.T) {
 	})
 
 	// Delete the namespace at the authoritative region
-	assert.Nil(s1.State().DeleteNamespaces(200, []string{ns1.Name}))
+	assert.Nil(s1.State().DeleteNamespaces(structs.MsgTypeTestSetup, 200, []string{ns1.Name}))
 
 	// Wait for the namespace deletion to replicate
 	testutil.WaitForResult(func() (bool, error) {
@@ -1553,7 +1615,7 @@ func TestLeader_DiffNamespaces(t *testing.T) {
 	ns1 := mock.Namespace()
 	ns2 := mock.Namespace()
 	ns3 := mock.Namespace()
-	assert.Nil(t, state.UpsertNamespaces(100, []*structs.Namespace{ns1, ns2, ns3}))
+	assert.Nil(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 100, []*structs.Namespace{ns1, ns2, ns3}))
 
 	// Simulate a remote list
 	rns2 := ns2.Copy()
@@ -108,6 +108,22 @@ func NodePolicy(policy string) string {
 	return fmt.Sprintf("node {\n\tpolicy = %q\n}\n", policy)
 }
 
+// OperatorPolicy is a helper for generating the hcl for a given operator
+// policy.
+func OperatorPolicy(policy string) string {
+	return fmt.Sprintf("operator {\n\tpolicy = %q\n}\n", policy)
+}
+
+// CapabilityPolicy is a helper for generating the hcl for an agent, node or
+// operator block granting only the given capabilities.
+func CapabilityPolicy(block string, capabilities ...string) string {
+	quoted := make([]string, len(capabilities))
+	for i, c := range capabilities {
+		quoted[i] = strconv.Quote(c)
+	}
+	return fmt.Sprintf("%s {\n\tcapabilities = [%s]\n}\n", block, strings.Join(quoted, ","))
+}
+
 // QuotaPolicy is a helper for generating the hcl for a given quota policy.
 func QuotaPolicy(policy string) string {
 	return fmt.Sprintf("quota {\n\tpolicy = %q\n}\n", policy)
@@ -2341,6 +2341,18 @@ func Namespace() *structs.Namespace {
 	return ns
 }
 
+func EventSink() *structs.EventSink {
+	return &structs.EventSink{
+		ID:        fmt.Sprintf("webhook-%s", uuid.Generate()[:8]),
+		Type:      structs.SinkWebhook,
+		Namespace: "*",
+		Topics: map[structs.Topic][]string{
+			structs.TopicAll: {"*"},
+		},
+		Address: "http://127.0.0.1:8080/events",
+	}
+}
+
 // ServiceRegistrations generates an array containing two unique service
 // registrations.
 func ServiceRegistrations() []*structs.ServiceRegistration {
@@ -2526,3 +2538,48 @@ func ACLRole() *structs.ACLRole {
 	role.SetHash()
 	return &role
 }
+
+func ACLAuthMethod() *structs.ACLAuthMethod {
+	maxTokenTTL, _ := time.ParseDuration("3600s")
+	method := structs.ACLAuthMethod{
+		Name:          fmt.Sprintf("acl-auth-method-%s", uuid.Short()),
+		Type:          structs.ACLAuthMethodTypeOIDC,
+		TokenLocality: structs.ACLAuthMethodTokenLocalityLocal,
+		MaxTokenTTL:   maxTokenTTL,
+		Default:       false,
+		Config: &structs.ACLAuthMethodConfig{
+			OIDCDiscoveryURL:    "http://example.com",
+			OIDCClientID:        "mock",
+			OIDCClientSecret:    "very secret secret",
+			OIDCScopes:          []string{"groups"},
+			BoundAudiences:      []string{"sales", "engineering"},
+			AllowedRedirectURIs: []string{"foo", "bar"},
+			DiscoveryCaPem:      []string{"foo"},
+			SigningAlgs:         []string{"RS256"},
+			ClaimMappings:       map[string]string{"foo": "bar"},
+			ListClaimMappings:   map[string]string{"groups": "roles"},
+		},
+		CreateTime:  time.Now().UTC(),
+		CreateIndex: 10,
+		ModifyIndex: 10,
+	}
+	method.SetHash()
+	return &method
+}
+
+func ACLBindingRule() *structs.ACLBindingRule {
+	rule := structs.ACLBindingRule{
+		ID:          uuid.Generate(),
+		Description: "mocked-acl-binding-rule",
+		AuthMethod:  "auth0",
+		Selector:    "engineering in list.roles",
+		BindType:    structs.ACLBindingRuleBindTypeRole,
+		BindName:    "eng-ro",
+		CreateTime:  time.Now().UTC(),
+		ModifyTime:  time.Now().UTC(),
+		CreateIndex: 10,
+		ModifyIndex: 10,
+	}
+	rule.SetHash()
+	return &rule
+}
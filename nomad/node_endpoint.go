@@ -594,10 +594,10 @@ func (n *Node) UpdateDrain(args *structs.NodeUpdateDrainRequest,
 	}
 	defer metrics.MeasureSince([]string{"nomad", "client", "update_drain"}, time.Now())
 
-	// Check node write permissions
+	// Check drain node permissions
 	if aclObj, err := n.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNodeWrite() {
+	} else if aclObj != nil && !aclObj.AllowNodeOperation(acl.NodeCapabilityDrainNode) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -688,10 +688,10 @@ func (n *Node) UpdateEligibility(args *structs.NodeUpdateEligibilityRequest,
 	}
 	defer metrics.MeasureSince([]string{"nomad", "client", "update_eligibility"}, time.Now())
 
-	// Check node write permissions
+	// Check toggle eligibility permissions
 	if aclObj, err := n.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.AllowNodeWrite() {
+	} else if aclObj != nil && !aclObj.AllowNodeOperation(acl.NodeCapabilityToggleEligibility) {
 		return structs.ErrPermissionDenied
 	}
 
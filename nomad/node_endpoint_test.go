@@ -1241,6 +1241,10 @@ func TestClientEndpoint_UpdateDrain_ACL(t *testing.T) {
 	// Create the policy and tokens
 	validToken := mock.CreatePolicyAndToken(t, state, 1001, "test-valid", mock.NodePolicy(acl.PolicyWrite))
 	invalidToken := mock.CreatePolicyAndToken(t, state, 1003, "test-invalid", mock.NodePolicy(acl.PolicyRead))
+	drainToken := mock.CreatePolicyAndToken(t, state, 1005, "test-drain",
+		mock.CapabilityPolicy("node", acl.NodeCapabilityDrainNode))
+	eligibilityToken := mock.CreatePolicyAndToken(t, state, 1007, "test-eligibility",
+		mock.CapabilityPolicy("node", acl.NodeCapabilityToggleEligibility))
 
 	// Update the status without a token and expect failure
 	dereg := &structs.NodeUpdateDrainRequest{
@@ -1278,6 +1282,26 @@ func TestClientEndpoint_UpdateDrain_ACL(t *testing.T) {
 		require.Equal(err.Error(), structs.ErrPermissionDenied.Error())
 	}
 
+	// Try with a token only granting the drain node capability
+	dereg.DrainStrategy.DrainSpec.Deadline = 15 * time.Second
+	dereg.AuthToken = drainToken.SecretID
+	{
+		var resp structs.NodeDrainUpdateResponse
+		require.Nil(msgpackrpc.CallWithCodec(codec, "Node.UpdateDrain", dereg, &resp), "RPC")
+		out, err := state.NodeByID(nil, node.ID)
+		require.NoError(err)
+		require.Equal(drainToken.AccessorID, out.LastDrain.AccessorID)
+	}
+
+	// Try with a token granting another node capability
+	dereg.AuthToken = eligibilityToken.SecretID
+	{
+		var resp structs.NodeDrainUpdateResponse
+		err := msgpackrpc.CallWithCodec(codec, "Node.UpdateDrain", dereg, &resp)
+		require.NotNil(err, "RPC")
+		require.Equal(err.Error(), structs.ErrPermissionDenied.Error())
+	}
+
 	// Try with a root token
 	dereg.DrainStrategy.DrainSpec.Deadline = 20 * time.Second
 	dereg.AuthToken = root.SecretID
@@ -1499,6 +1523,10 @@ func TestClientEndpoint_UpdateEligibility_ACL(t *testing.T) {
 	// Create the policy and tokens
 	validToken := mock.CreatePolicyAndToken(t, state, 1001, "test-valid", mock.NodePolicy(acl.PolicyWrite))
 	invalidToken := mock.CreatePolicyAndToken(t, state, 1003, "test-invalid", mock.NodePolicy(acl.PolicyRead))
+	eligibilityToken := mock.CreatePolicyAndToken(t, state, 1005, "test-eligibility",
+		mock.CapabilityPolicy("node", acl.NodeCapabilityToggleEligibility))
+	drainToken := mock.CreatePolicyAndToken(t, state, 1007, "test-drain",
+		mock.CapabilityPolicy("node", acl.NodeCapabilityDrainNode))
 
 	// Update the status without a token and expect failure
 	dereg := &structs.NodeUpdateEligibilityRequest{
@@ -1529,6 +1557,23 @@ func TestClientEndpoint_UpdateEligibility_ACL(t *testing.T) {
 		require.Equal(err.Error(), structs.ErrPermissionDenied.Error())
 	}
 
+	// Try with a token only granting the toggle eligibility capability
+	dereg.Eligibility = structs.NodeSchedulingEligible
+	dereg.AuthToken = eligibilityToken.SecretID
+	{
+		var resp structs.NodeEligibilityUpdateResponse
+		require.Nil(msgpackrpc.CallWithCodec(codec, "Node.UpdateEligibility", dereg, &resp), "RPC")
+	}
+
+	// Try with a token granting another node capability
+	dereg.AuthToken = drainToken.SecretID
+	{
+		var resp structs.NodeEligibilityUpdateResponse
+		err := msgpackrpc.CallWithCodec(codec, "Node.UpdateEligibility", dereg, &resp)
+		require.NotNil(err, "RPC")
+		require.Equal(err.Error(), structs.ErrPermissionDenied.Error())
+	}
+
 	// Try with a root token
 	dereg.AuthToken = root.SecretID
 	{
@@ -1935,7 +1980,7 @@ func TestClientEndpoint_GetAllocs_ACL_Namespaces(t *testing.T) {
 	allocAltNS.NodeID = node.ID
 	allocOtherNS.NodeID = node.ID
 	state := s1.fsm.State()
-	assert.Nil(state.UpsertNamespaces(1, []*structs.Namespace{ns1, ns2}), "UpsertNamespaces")
+	assert.Nil(state.UpsertNamespaces(structs.MsgTypeTestSetup, 1, []*structs.Namespace{ns1, ns2}), "UpsertNamespaces")
 	assert.Nil(state.UpsertNode(structs.MsgTypeTestSetup, 2, node), "UpsertNode")
 	assert.Nil(state.UpsertJobSummary(3, mock.JobSummary(allocDefaultNS.JobID)), "UpsertJobSummary")
 	assert.Nil(state.UpsertJobSummary(4, mock.JobSummary(allocAltNS.JobID)), "UpsertJobSummary")
@@ -2777,7 +2822,7 @@ func TestClientEndpoint_CreateNodeEvals_MultipleNSes(t *testing.T) {
 
 	idx := uint64(3)
 	ns1 := mock.Namespace()
-	err := state.UpsertNamespaces(idx, []*structs.Namespace{ns1})
+	err := state.UpsertNamespaces(structs.MsgTypeTestSetup, idx, []*structs.Namespace{ns1})
 	require.NoError(t, err)
 	idx++
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/raft"
 	"github.com/hashicorp/serf/serf"
 
+	"github.com/hashicorp/nomad/acl"
 	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/helper/snapshot"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -98,7 +99,7 @@ func (op *Operator) RaftRemovePeerByAddress(args *structs.RaftPeerByAddressReque
 	// Check management permissions
 	if aclObj, err := op.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilityRaftPeerRemove) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -150,7 +151,7 @@ func (op *Operator) RaftRemovePeerByID(args *structs.RaftPeerByIDRequest, reply
 	// Check management permissions
 	if aclObj, err := op.srv.ResolveToken(args.AuthToken); err != nil {
 		return err
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilityRaftPeerRemove) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -306,11 +307,11 @@ func (op *Operator) SchedulerSetConfiguration(args *structs.SchedulerSetConfigRe
 		return err
 	}
 
-	// This action requires operator write access.
+	// This action requires the scheduler config capability.
 	rule, err := op.srv.ResolveToken(args.AuthToken)
 	if err != nil {
 		return err
-	} else if rule != nil && !rule.AllowOperatorWrite() {
+	} else if rule != nil && !rule.AllowOperatorOperation(acl.OperatorCapabilitySchedulerConfig) {
 		return structs.ErrPermissionDenied
 	}
 
@@ -379,6 +380,31 @@ func (op *Operator) SchedulerGetConfiguration(args *structs.GenericRequest, repl
 	return nil
 }
 
+// SnapshotStatus is used to get the status of the scheduled snapshots of the
+// leader, or of the local server for stale queries.
+func (op *Operator) SnapshotStatus(args *structs.GenericRequest, reply *structs.SnapshotStatusResponse) error {
+	if done, err := op.srv.forward("Operator.SnapshotStatus", args, args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	rule, err := op.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if rule != nil && !rule.AllowOperatorRead() {
+		return structs.ErrPermissionDenied
+	}
+
+	if op.srv.snapshotAgent != nil {
+		reply.Status = op.srv.snapshotAgent.Status()
+	} else {
+		reply.Status = &structs.SnapshotAgentStatus{Server: op.srv.config.NodeName}
+	}
+	op.srv.setQueryMeta(&reply.QueryMeta)
+
+	return nil
+}
+
 func (op *Operator) forwardStreamingRPC(region string, method string, args interface{}, in io.ReadWriteCloser) error {
 	server, err := op.srv.findRegionServer(region)
 	if err != nil {
@@ -458,7 +484,7 @@ func (op *Operator) snapshotSave(conn io.ReadWriteCloser) {
 		}
 		handleFailure(code, err)
 		return
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilitySnapshotSave) {
 		handleFailure(403, structs.ErrPermissionDenied)
 		return
 	}
@@ -538,7 +564,7 @@ func (op *Operator) snapshotRestore(conn io.ReadWriteCloser) {
 		}
 		handleFailure(code, err)
 		return
-	} else if aclObj != nil && !aclObj.IsManagement() {
+	} else if aclObj != nil && !aclObj.AllowOperatorOperation(acl.OperatorCapabilitySnapshotRestore) {
 		handleFailure(403, structs.ErrPermissionDenied)
 		return
 	}
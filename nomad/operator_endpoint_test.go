@@ -247,6 +247,32 @@ func TestOperator_RaftRemovePeerByAddress_ACL(t *testing.T) {
 		assert.Equal(err.Error(), structs.ErrPermissionDenied.Error())
 	}
 
+	// Try with an operator write token and expect permission denied, as
+	// removing peers must be granted explicitly
+	{
+		token := mock.CreatePolicyAndToken(t, state, 1005, "test-operator-write",
+			mock.OperatorPolicy(acl.PolicyWrite))
+		arg.AuthToken = token.SecretID
+		err := msgpackrpc.CallWithCodec(codec, "Operator.RaftRemovePeerByAddress", &arg, &reply)
+		assert.NotNil(err)
+		assert.Equal(err.Error(), structs.ErrPermissionDenied.Error())
+	}
+
+	// Try with a token only granting the raft peer remove capability
+	{
+		token := mock.CreatePolicyAndToken(t, state, 1003, "test-peer-remove",
+			mock.CapabilityPolicy("operator", acl.OperatorCapabilityRaftPeerRemove))
+		arg.AuthToken = token.SecretID
+		err := msgpackrpc.CallWithCodec(codec, "Operator.RaftRemovePeerByAddress", &arg, &reply)
+		assert.Nil(err)
+	}
+
+	// Add the peer back to Raft.
+	{
+		future := s1.raft.AddPeer(arg.Address)
+		assert.Nil(future.Error())
+	}
+
 	// Try with a management token
 	{
 		arg.AuthToken = root.SecretID
@@ -363,6 +389,17 @@ func TestOperator_RaftRemovePeerByID_ACL(t *testing.T) {
 		assert.Equal(err.Error(), structs.ErrPermissionDenied.Error())
 	}
 
+	// Try with an operator write token and expect permission denied, as
+	// removing peers must be granted explicitly
+	{
+		token := mock.CreatePolicyAndToken(t, state, 1005, "test-operator-write",
+			mock.OperatorPolicy(acl.PolicyWrite))
+		arg.AuthToken = token.SecretID
+		err := msgpackrpc.CallWithCodec(codec, "Operator.RaftRemovePeerByID", &arg, &reply)
+		assert.NotNil(err)
+		assert.Equal(err.Error(), structs.ErrPermissionDenied.Error())
+	}
+
 	// Try with a management token
 	{
 		arg.AuthToken = root.SecretID
@@ -529,6 +566,37 @@ func TestOperator_SchedulerSetConfiguration_ACL(t *testing.T) {
 		require.Equal(err.Error(), structs.ErrPermissionDenied.Error())
 	}
 
+	// Try with a token granting another operator capability and expect
+	// permission denied
+	{
+		token := mock.CreatePolicyAndToken(t, state, 1003, "test-snapshot",
+			mock.CapabilityPolicy("operator", acl.OperatorCapabilitySnapshotSave))
+		arg.AuthToken = token.SecretID
+		err := msgpackrpc.CallWithCodec(codec, "Operator.SchedulerSetConfiguration", &arg, &reply)
+		require.NotNil(err)
+		require.Equal(err.Error(), structs.ErrPermissionDenied.Error())
+	}
+
+	// Try with an operator write token, which grants the scheduler config
+	// capability, should succeed
+	{
+		token := mock.CreatePolicyAndToken(t, state, 1007, "test-operator-write",
+			mock.OperatorPolicy(acl.PolicyWrite))
+		arg.AuthToken = token.SecretID
+		err := msgpackrpc.CallWithCodec(codec, "Operator.SchedulerSetConfiguration", &arg, &reply)
+		require.Nil(err)
+	}
+
+	// Try with a token only granting the scheduler config capability, should
+	// succeed
+	{
+		token := mock.CreatePolicyAndToken(t, state, 1005, "test-scheduler",
+			mock.CapabilityPolicy("operator", acl.OperatorCapabilitySchedulerConfig))
+		arg.AuthToken = token.SecretID
+		err := msgpackrpc.CallWithCodec(codec, "Operator.SchedulerSetConfiguration", &arg, &reply)
+		require.Nil(err)
+	}
+
 	// Try with root token, should succeed
 	{
 		arg.AuthToken = root.SecretID
@@ -538,6 +606,104 @@ func TestOperator_SchedulerSetConfiguration_ACL(t *testing.T) {
 
 }
 
+func TestOperator_SnapshotStatus(t *testing.T) {
+	ci.Parallel(t)
+
+	dir := t.TempDir()
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.DevMode = false
+		c.DataDir = dir
+		c.SnapshotAgentEnabled = true
+		c.SnapshotAgentInterval = 50 * time.Millisecond
+		c.SnapshotAgentRetainCount = 2
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	arg := structs.GenericRequest{
+		QueryOptions: structs.QueryOptions{
+			Region: s1.config.Region,
+		},
+	}
+
+	// Snapshots are taken by the leader and pruned down to the retained count
+	var reply structs.SnapshotStatusResponse
+	testutil.WaitForResult(func() (bool, error) {
+		// Snapshots are only taken when raft has new logs to snapshot
+		req := &structs.NodeRegisterRequest{Node: mock.Node()}
+		if _, _, err := s1.raftApply(structs.NodeRegisterRequestType, req); err != nil {
+			return false, err
+		}
+
+		if err := msgpackrpc.CallWithCodec(codec, "Operator.SnapshotStatus", &arg, &reply); err != nil {
+			return false, err
+		}
+		if n := len(reply.Status.Snapshots); n != 2 {
+			return false, fmt.Errorf("expected 2 snapshots, got %d: %s", n, reply.Status.LastError)
+		}
+		return true, nil
+	}, func(err error) {
+		t.Fatal(err)
+	})
+
+	status := reply.Status
+	require.Equal(t, s1.config.NodeName, status.Server)
+	require.True(t, status.Enabled)
+	require.True(t, status.Active)
+	require.Equal(t, "local:"+path.Join(dir, "snapshots"), status.Storage)
+	require.NotEmpty(t, status.LastSnapshot)
+	require.False(t, status.LastSuccess.IsZero())
+
+	// Servers without scheduled snapshots report them as disabled
+	s2, cleanupS2 := TestServer(t, nil)
+	defer cleanupS2()
+	codec2 := rpcClient(t, s2)
+	testutil.WaitForLeader(t, s2.RPC)
+
+	arg.Region = s2.config.Region
+	reply = structs.SnapshotStatusResponse{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec2, "Operator.SnapshotStatus", &arg, &reply))
+	require.False(t, reply.Status.Enabled)
+	require.Empty(t, reply.Status.Snapshots)
+}
+
+func TestOperator_SnapshotStatus_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, root, cleanupS1 := TestACLServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	state := s1.fsm.State()
+
+	invalidToken := mock.CreatePolicyAndToken(t, state, 1001, "test-invalid", mock.NodePolicy(acl.PolicyWrite))
+	operatorToken := mock.CreatePolicyAndToken(t, state, 1002, "test-operator", `operator { policy = "read" }`)
+
+	arg := structs.GenericRequest{
+		QueryOptions: structs.QueryOptions{
+			Region: s1.config.Region,
+		},
+	}
+	var reply structs.SnapshotStatusResponse
+
+	// Try with no token and expect permission denied
+	err := msgpackrpc.CallWithCodec(codec, "Operator.SnapshotStatus", &arg, &reply)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	// Try with an invalid token and expect permission denied
+	arg.AuthToken = invalidToken.SecretID
+	err = msgpackrpc.CallWithCodec(codec, "Operator.SnapshotStatus", &arg, &reply)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	// Try with an operator read and a root token, should succeed
+	for _, token := range []string{operatorToken.SecretID, root.SecretID} {
+		arg.AuthToken = token
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.SnapshotStatus", &arg, &reply))
+		require.NotNil(t, reply.Status)
+	}
+}
+
 func TestOperator_SnapshotSave(t *testing.T) {
 	ci.Parallel(t)
 
@@ -659,6 +825,12 @@ func TestOperator_SnapshotSave_ACL(t *testing.T) {
 	testutil.WaitForLeader(t, s.RPC)
 
 	deniedToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1001, "test-invalid", mock.NodePolicy(acl.PolicyWrite))
+	restoreToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1003, "test-restore",
+		mock.CapabilityPolicy("operator", acl.OperatorCapabilitySnapshotRestore))
+	saveToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1005, "test-save",
+		mock.CapabilityPolicy("operator", acl.OperatorCapabilitySnapshotSave))
+	operatorToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1007, "test-operator",
+		mock.OperatorPolicy(acl.PolicyWrite))
 
 	/////////  Actually run query now
 	cases := []struct {
@@ -668,7 +840,10 @@ func TestOperator_SnapshotSave_ACL(t *testing.T) {
 		err     error
 	}{
 		{"root", root.SecretID, 0, nil},
+		{"snapshot_save_token", saveToken.SecretID, 0, nil},
 		{"no_permission_token", deniedToken.SecretID, 403, structs.ErrPermissionDenied},
+		{"operator_write_token", operatorToken.SecretID, 403, structs.ErrPermissionDenied},
+		{"snapshot_restore_token", restoreToken.SecretID, 403, structs.ErrPermissionDenied},
 		{"invalid token", uuid.Generate(), 400, structs.ErrTokenNotFound},
 		{"unauthenticated", "", 403, structs.ErrPermissionDenied},
 	}
@@ -890,7 +1065,10 @@ func TestOperator_SnapshotRestore_ACL(t *testing.T) {
 		err     error
 	}{
 		{"root", 0, nil},
+		{"snapshot_restore_token", 0, nil},
 		{"no_permission_token", 403, structs.ErrPermissionDenied},
+		{"snapshot_save_token", 403, structs.ErrPermissionDenied},
+		{"operator_write_token", 403, structs.ErrPermissionDenied},
 		{"invalid token", 400, structs.ErrTokenNotFound},
 		{"unauthenticated", 403, structs.ErrPermissionDenied},
 	}
@@ -909,13 +1087,25 @@ func TestOperator_SnapshotRestore_ACL(t *testing.T) {
 			testutil.WaitForLeader(t, s.RPC)
 
 			deniedToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1001, "test-invalid", mock.NodePolicy(acl.PolicyWrite))
+			restoreToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1003, "test-restore",
+				mock.CapabilityPolicy("operator", acl.OperatorCapabilitySnapshotRestore))
+			saveToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1005, "test-save",
+				mock.CapabilityPolicy("operator", acl.OperatorCapabilitySnapshotSave))
+			operatorToken := mock.CreatePolicyAndToken(t, s.fsm.State(), 1007, "test-operator",
+				mock.OperatorPolicy(acl.PolicyWrite))
 
 			token := ""
 			switch c.name {
 			case "root":
 				token = root.SecretID
+			case "snapshot_restore_token":
+				token = restoreToken.SecretID
 			case "no_permission_token":
 				token = deniedToken.SecretID
+			case "snapshot_save_token":
+				token = saveToken.SecretID
+			case "operator_write_token":
+				token = operatorToken.SecretID
 			case "invalid token":
 				token = uuid.Generate()
 			case "unauthenticated":
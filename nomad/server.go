@@ -34,11 +34,17 @@ import (
 	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/codec"
 	"github.com/hashicorp/nomad/helper/pool"
+	"github.com/hashicorp/nomad/helper/snapshot"
 	"github.com/hashicorp/nomad/helper/stats"
 	"github.com/hashicorp/nomad/helper/tlsutil"
+	"github.com/hashicorp/nomad/lib/auth/jwt"
+	"github.com/hashicorp/nomad/lib/auth/oidc"
 	"github.com/hashicorp/nomad/nomad/deploymentwatcher"
 	"github.com/hashicorp/nomad/nomad/drainer"
+	"github.com/hashicorp/nomad/nomad/eventsink"
+	"github.com/hashicorp/nomad/nomad/snapshotagent"
 	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/stream"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 	"github.com/hashicorp/nomad/nomad/volumewatcher"
@@ -63,6 +69,8 @@ const (
 	peersPollJitterFactor = 2
 
 	raftState         = "raft/"
+	eventLogPath      = "events/events.db"
+	snapshotAgentPath = "snapshots"
 	serfSnapshot      = "serf/snapshot"
 	snapshotsRetained = 2
 
@@ -125,6 +133,9 @@ type Server struct {
 	// fsm is the state machine used with Raft
 	fsm *nomadFSM
 
+	// eventLog persists the events of the FSM's state store, it may be nil
+	eventLog *stream.EventLog
+
 	// rpcListener is used to listen for incoming connections
 	rpcListener net.Listener
 	listenerCh  chan struct{}
@@ -216,6 +227,13 @@ type Server struct {
 	// volumeWatcher is used to release volume claims
 	volumeWatcher *volumewatcher.Watcher
 
+	// eventSinkManager is used to deliver events to the event sinks
+	eventSinkManager *eventsink.Manager
+
+	// snapshotAgent takes scheduled snapshots of the raft state, it is nil
+	// if scheduled snapshots are disabled
+	snapshotAgent *snapshotagent.Agent
+
 	// keyringReplicator is used to replicate root encryption keys from the
 	// leader
 	keyringReplicator *KeyringReplicator
@@ -224,6 +242,14 @@ type Server struct {
 	// workload identities
 	encrypter *Encrypter
 
+	// oidcProviderCache caches the OIDC providers of the auth methods used
+	// to log in
+	oidcProviderCache *oidc.ProviderCache
+
+	// jwtValidatorCache caches the JWT validators of the auth methods used
+	// to log in
+	jwtValidatorCache *jwt.ValidatorCache
+
 	// periodicDispatcher is used to track and create evaluations for periodic jobs.
 	periodicDispatcher *PeriodicDispatch
 
@@ -366,6 +392,8 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigEntr
 		rpcTLS:                  incomingTLS,
 		aclCache:                aclCache,
 		workersEventCh:          make(chan interface{}, 1),
+		oidcProviderCache:       oidc.NewProviderCache(),
+		jwtValidatorCache:       jwt.NewValidatorCache(),
 	}
 
 	s.shutdownCtx, s.shutdownCancel = context.WithCancel(context.Background())
@@ -461,6 +489,15 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigEntr
 		return nil, fmt.Errorf("failed to create volume watcher: %v", err)
 	}
 
+	// Setup the event sink manager
+	s.setupEventSinkManager()
+
+	// Setup the scheduled snapshots
+	if err := s.setupSnapshotAgent(); err != nil {
+		s.logger.Error("failed to create snapshot agent", "error", err)
+		return nil, fmt.Errorf("failed to create snapshot agent: %v", err)
+	}
+
 	// Start the eval broker notification system so any subscribers can get
 	// updates when the processes SetEnabled is triggered.
 	go s.evalBroker.enabledNotifier.Run(s.shutdownCh)
@@ -680,6 +717,11 @@ func (s *Server) Shutdown() error {
 	s.shutdown = true
 	s.shutdownCancel()
 
+	// Wait for any scheduled snapshot in progress before stopping raft
+	if s.snapshotAgent != nil {
+		s.snapshotAgent.SetEnabled(false)
+	}
+
 	if s.serf != nil {
 		s.serf.Shutdown()
 	}
@@ -709,6 +751,13 @@ func (s *Server) Shutdown() error {
 		s.fsm.Close()
 	}
 
+	// Close the event log once the FSM's event broker has stopped
+	if s.eventLog != nil {
+		if err := s.eventLog.Close(); err != nil {
+			s.logger.Warn("error closing event log", "error", err)
+		}
+	}
+
 	// Stop Vault token renewal and revocations
 	if s.vault != nil {
 		s.vault.Stop()
@@ -1095,6 +1144,53 @@ func (s *Server) setupVolumeWatcher() error {
 	return nil
 }
 
+// setupEventSinkManager creates the manager delivering events to the event
+// sinks, which will be enabled when a server becomes a leader.
+func (s *Server) setupEventSinkManager() {
+	s.eventSinkManager = eventsink.NewManager(&eventsink.ManagerConfig{
+		Logger:           s.logger,
+		Raft:             eventSinkShim{s},
+		State:            s.State,
+		ProgressInterval: s.config.EventSinkProgressInterval,
+	})
+}
+
+// setupSnapshotAgent creates the agent taking scheduled snapshots of the raft
+// state if enabled. Agents which don't only run on the leader start right away.
+func (s *Server) setupSnapshotAgent() error {
+	if !s.config.SnapshotAgentEnabled {
+		return nil
+	}
+
+	path := s.config.SnapshotAgentPath
+	if path == "" {
+		if s.config.DataDir == "" {
+			return fmt.Errorf("scheduled snapshots require a path or a data directory")
+		}
+		path = filepath.Join(s.config.DataDir, snapshotAgentPath)
+	}
+	storage, err := snapshot.NewLocalStorage(path)
+	if err != nil {
+		return err
+	}
+
+	s.snapshotAgent = snapshotagent.New(&snapshotagent.Config{
+		Logger:  s.logger,
+		Server:  s.config.NodeName,
+		Storage: storage,
+		Snapshot: func() (*snapshot.Snapshot, error) {
+			return snapshot.New(s.logger, s.raft)
+		},
+		Interval:    s.config.SnapshotAgentInterval,
+		RetainCount: s.config.SnapshotAgentRetainCount,
+		RetainAge:   s.config.SnapshotAgentRetainAge,
+	})
+	if !s.config.SnapshotAgentLeaderOnly {
+		s.snapshotAgent.SetEnabled(true)
+	}
+	return nil
+}
+
 // setupNodeDrainer creates a node drainer which will be enabled when a server
 // becomes a leader.
 func (s *Server) setupNodeDrainer() {
@@ -1264,6 +1360,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) error {
 	server.Register(s.staticEndpoints.Agent)
 	server.Register(s.staticEndpoints.Namespace)
 	server.Register(s.staticEndpoints.Variables)
+	server.Register(s.staticEndpoints.Event)
 
 	// Create new dynamic endpoints and add them to the RPC server.
 	alloc := &Alloc{srv: s, ctx: ctx, logger: s.logger.Named("alloc")}
@@ -1297,6 +1394,23 @@ func (s *Server) setupRaft() error {
 		}
 	}()
 
+	// Open the event log the FSM's state stores publish events to
+	if s.config.EventLogEnabled {
+		if s.config.DataDir == "" {
+			return fmt.Errorf("event log requires a data directory")
+		}
+		eventLog, err := stream.NewEventLog(stream.EventLogConfig{
+			Path:           filepath.Join(s.config.DataDir, eventLogPath),
+			RetentionCount: s.config.EventLogRetentionCount,
+			RetentionAge:   s.config.EventLogRetentionAge,
+			Logger:         s.logger,
+		})
+		if err != nil {
+			return err
+		}
+		s.eventLog = eventLog
+	}
+
 	// Create the FSM
 	fsmConfig := &FSMConfig{
 		EvalBroker:        s.evalBroker,
@@ -1306,6 +1420,7 @@ func (s *Server) setupRaft() error {
 		Region:            s.Region(),
 		EnableEventBroker: s.config.EnableEventBroker,
 		EventBufferSize:   s.config.EventBufferSize,
+		EventLog:          s.eventLog,
 	}
 	var err error
 	s.fsm, err = NewFSM(fsmConfig)
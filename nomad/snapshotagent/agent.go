@@ -0,0 +1,264 @@
+// Package snapshotagent takes scheduled snapshots of the raft state of a
+// server, writes them to a snapshot storage and prunes the old ones.
+package snapshotagent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/helper/snapshot"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// DefaultInterval is the default interval between snapshots.
+	DefaultInterval = time.Hour
+
+	// DefaultRetainCount is the default number of snapshots retained.
+	DefaultRetainCount = 24
+)
+
+// Config is the configuration of an Agent.
+type Config struct {
+	Logger log.Logger
+
+	// Server is the name of the server the agent runs on.
+	Server string
+
+	// Storage is where snapshots are written to.
+	Storage snapshot.Storage
+
+	// Snapshot takes a snapshot of the raft state of the server. The caller
+	// closes the returned snapshot.
+	Snapshot func() (*snapshot.Snapshot, error)
+
+	// Interval is the interval between snapshots.
+	Interval time.Duration
+
+	// RetainCount is the number of snapshots retained. Zero retains any
+	// number of snapshots.
+	RetainCount int
+
+	// RetainAge is how long snapshots are retained. Zero retains snapshots
+	// regardless of their age.
+	RetainAge time.Duration
+}
+
+// Agent takes snapshots on an interval while it is enabled. Each snapshot is
+// verified once written to the storage, after which the snapshots exceeding
+// the retention are pruned. The latest snapshot is always retained.
+type Agent struct {
+	config *Config
+	logger log.Logger
+
+	enabled bool
+
+	// exitFn stops the agent, and doneCh is closed once it has stopped
+	exitFn context.CancelFunc
+	doneCh chan struct{}
+
+	lastSuccess  time.Time
+	lastSnapshot string
+	lastFailure  time.Time
+	lastError    string
+
+	l sync.Mutex
+}
+
+// New returns a new snapshot agent. It is inactive until enabled.
+func New(config *Config) *Agent {
+	if config.Interval == 0 {
+		config.Interval = DefaultInterval
+	}
+
+	return &Agent{
+		config: config,
+		logger: config.Logger.Named("snapshot_agent"),
+	}
+}
+
+// SetEnabled is used to control if the agent is taking snapshots. Disabling
+// the agent waits for any snapshot in progress to complete.
+func (a *Agent) SetEnabled(enabled bool) {
+	a.l.Lock()
+	if enabled == a.enabled {
+		a.l.Unlock()
+		return
+	}
+	a.enabled = enabled
+
+	if enabled {
+		var ctx context.Context
+		ctx, a.exitFn = context.WithCancel(context.Background())
+		a.doneCh = make(chan struct{})
+		go a.run(ctx, a.doneCh)
+		a.l.Unlock()
+		return
+	}
+
+	a.exitFn()
+	doneCh := a.doneCh
+	a.l.Unlock()
+	<-doneCh
+}
+
+// Status returns the status of the agent and the snapshots it retains.
+func (a *Agent) Status() *structs.SnapshotAgentStatus {
+	a.l.Lock()
+	status := &structs.SnapshotAgentStatus{
+		Server:       a.config.Server,
+		Enabled:      true,
+		Active:       a.enabled,
+		Storage:      a.config.Storage.Name(),
+		Interval:     a.config.Interval,
+		LastSuccess:  a.lastSuccess,
+		LastSnapshot: a.lastSnapshot,
+		LastFailure:  a.lastFailure,
+		LastError:    a.lastError,
+	}
+	a.l.Unlock()
+
+	snapshots, err := a.config.Storage.List()
+	if err != nil {
+		a.logger.Warn("failed to list snapshots", "error", err)
+		return status
+	}
+	for _, snap := range snapshots {
+		status.Snapshots = append(status.Snapshots, &structs.StoredSnapshot{
+			Name:  snap.Name,
+			Index: snap.Index,
+			Time:  snap.Time,
+			Size:  snap.Size,
+		})
+	}
+	return status
+}
+
+func (a *Agent) run(ctx context.Context, doneCh chan struct{}) {
+	defer close(doneCh)
+
+	timer := time.NewTimer(a.firstWait())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if err := a.snapshot(); err != nil {
+			a.logger.Error("failed to take snapshot", "error", err)
+			metrics.IncrCounter([]string{"nomad", "snapshot_agent", "failure"}, 1)
+
+			a.l.Lock()
+			a.lastFailure = time.Now()
+			a.lastError = err.Error()
+			a.l.Unlock()
+		}
+
+		timer.Reset(a.config.Interval)
+	}
+}
+
+// firstWait returns how long to wait for the first snapshot, so that the
+// interval since the latest stored snapshot is preserved when the agent is
+// enabled again, such as after a leader election.
+func (a *Agent) firstWait() time.Duration {
+	snapshots, err := a.config.Storage.List()
+	if err != nil || len(snapshots) == 0 {
+		return 0
+	}
+
+	wait := time.Until(snapshots[len(snapshots)-1].Time.Add(a.config.Interval))
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// snapshot takes a snapshot, writes it to the storage and verifies it, then
+// prunes the snapshots exceeding the retention.
+func (a *Agent) snapshot() error {
+	start := time.Now()
+
+	snap, err := a.config.Snapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	name := snapshot.StoredSnapshotName(snap.Index(), start)
+	if err := a.config.Storage.Put(name, snap); err != nil {
+		return fmt.Errorf("failed to write snapshot: %v", err)
+	}
+
+	if err := a.verify(name); err != nil {
+		if err := a.config.Storage.Delete(name); err != nil {
+			a.logger.Warn("failed to delete invalid snapshot", "name", name, "error", err)
+		}
+		return fmt.Errorf("failed to verify snapshot: %v", err)
+	}
+
+	metrics.MeasureSince([]string{"nomad", "snapshot_agent", "save"}, start)
+	metrics.IncrCounter([]string{"nomad", "snapshot_agent", "success"}, 1)
+	a.logger.Info("took snapshot", "name", name, "index", snap.Index(), "storage", a.config.Storage.Name())
+
+	a.l.Lock()
+	a.lastSuccess = time.Now()
+	a.lastSnapshot = name
+	a.l.Unlock()
+
+	if err := a.prune(name); err != nil {
+		a.logger.Warn("failed to prune snapshots", "error", err)
+	}
+	return nil
+}
+
+// verify reads the stored snapshot back and verifies its contents.
+func (a *Agent) verify(name string) error {
+	r, err := a.config.Storage.Open(name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = snapshot.Verify(r)
+	return err
+}
+
+// prune deletes the snapshots exceeding the retention, except for the latest
+// snapshot.
+func (a *Agent) prune(latest string) error {
+	snapshots, err := a.config.Storage.List()
+	if err != nil {
+		return err
+	}
+
+	var retained int
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		snap := snapshots[i]
+		if snap.Name == latest {
+			retained++
+			continue
+		}
+
+		expired := a.config.RetainAge > 0 && time.Since(snap.Time) > a.config.RetainAge
+		if !expired && (a.config.RetainCount == 0 || retained < a.config.RetainCount) {
+			retained++
+			continue
+		}
+
+		if err := a.config.Storage.Delete(snap.Name); err != nil {
+			return fmt.Errorf("failed to delete snapshot %q: %v", snap.Name, err)
+		}
+		a.logger.Debug("pruned snapshot", "name", snap.Name)
+	}
+
+	metrics.SetGauge([]string{"nomad", "snapshot_agent", "retained"}, float32(retained))
+	return nil
+}
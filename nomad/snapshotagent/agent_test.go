@@ -0,0 +1,208 @@
+package snapshotagent
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/snapshot"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+)
+
+// testFSM is an FSM whose snapshots are empty.
+type testFSM struct{}
+
+type testFSMSnapshot struct{}
+
+func (testFSM) Apply(*raft.Log) interface{}                  { return nil }
+func (testFSM) Snapshot() (raft.FSMSnapshot, error)          { return testFSMSnapshot{}, nil }
+func (testFSM) Restore(r io.ReadCloser) error                { return r.Close() }
+func (testFSMSnapshot) Persist(sink raft.SnapshotSink) error { return sink.Close() }
+func (testFSMSnapshot) Release()                             {}
+
+// testRaft returns a single node raft cluster which is the leader.
+func testRaft(t *testing.T) *raft.Raft {
+	conf := raft.DefaultConfig()
+	conf.LocalID = "server-1"
+	conf.HeartbeatTimeout = 50 * time.Millisecond
+	conf.ElectionTimeout = 50 * time.Millisecond
+	conf.LeaderLeaseTimeout = 50 * time.Millisecond
+	conf.CommitTimeout = 5 * time.Millisecond
+	conf.Logger = testlog.HCLogger(t)
+
+	store := raft.NewInmemStore()
+	addr, transport := raft.NewInmemTransport("")
+	snaps := raft.NewInmemSnapshotStore()
+
+	r, err := raft.NewRaft(conf, testFSM{}, store, store, snaps, transport)
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Shutdown() })
+
+	require.NoError(t, r.BootstrapCluster(raft.Configuration{
+		Servers: []raft.Server{{ID: conf.LocalID, Address: addr}},
+	}).Error())
+	require.Eventually(t, func() bool {
+		return r.State() == raft.Leader
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Raft refuses to snapshot without any log applied since the last one
+	require.NoError(t, r.Apply([]byte("test"), time.Second).Error())
+	return r
+}
+
+func testAgent(t *testing.T, config *Config) (*Agent, *snapshot.LocalStorage) {
+	storage, err := snapshot.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	config.Logger = testlog.HCLogger(t)
+	config.Storage = storage
+	a := New(config)
+	t.Cleanup(func() { a.SetEnabled(false) })
+	return a, storage
+}
+
+func TestAgent_Snapshot(t *testing.T) {
+	ci.Parallel(t)
+
+	r := testRaft(t)
+	logger := testlog.HCLogger(t)
+
+	a, storage := testAgent(t, &Config{
+		Server: "server-1",
+		Snapshot: func() (*snapshot.Snapshot, error) {
+			// Apply a log so that each snapshot is a new one
+			if err := r.Apply([]byte("test"), time.Second).Error(); err != nil {
+				return nil, err
+			}
+			return snapshot.New(logger, r)
+		},
+		Interval:    20 * time.Millisecond,
+		RetainCount: 2,
+	})
+
+	status := a.Status()
+	require.True(t, status.Enabled)
+	require.False(t, status.Active)
+	require.Equal(t, storage.Name(), status.Storage)
+
+	a.SetEnabled(true)
+
+	// Snapshots are taken on the interval and pruned down to the retained
+	// count
+	var latest string
+	require.Eventually(t, func() bool {
+		status := a.Status()
+		if status.LastSnapshot == "" || status.LastSnapshot == latest {
+			return false
+		}
+		if latest == "" {
+			latest = status.LastSnapshot
+			return false
+		}
+		return len(status.Snapshots) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	a.SetEnabled(false)
+
+	status = a.Status()
+	require.False(t, status.Active)
+	require.Empty(t, status.LastError)
+	require.Len(t, status.Snapshots, 2)
+	require.Equal(t, status.LastSnapshot, status.Snapshots[1].Name)
+	require.Less(t, status.Snapshots[0].Index, status.Snapshots[1].Index)
+
+	// The retained snapshots are valid
+	for _, snap := range status.Snapshots {
+		f, err := storage.Open(snap.Name)
+		require.NoError(t, err)
+		meta, err := snapshot.Verify(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.Equal(t, snap.Index, meta.Index)
+	}
+}
+
+func TestAgent_Failure(t *testing.T) {
+	ci.Parallel(t)
+
+	a, storage := testAgent(t, &Config{
+		Snapshot: func() (*snapshot.Snapshot, error) {
+			return nil, errors.New("raft is unavailable")
+		},
+		Interval: time.Hour,
+	})
+
+	a.SetEnabled(true)
+	require.Eventually(t, func() bool {
+		return !a.Status().LastFailure.IsZero()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status := a.Status()
+	require.Equal(t, "raft is unavailable", status.LastError)
+	require.True(t, status.LastSuccess.IsZero())
+
+	snapshots, err := storage.List()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
+
+func TestAgent_Prune(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Now()
+	put := func(storage snapshot.Storage, index uint64, age time.Duration) string {
+		name := snapshot.StoredSnapshotName(index, now.Add(-age))
+		require.NoError(t, storage.Put(name, strings.NewReader("snapshot")))
+		return name
+	}
+	names := func(storage snapshot.Storage) []string {
+		snapshots, err := storage.List()
+		require.NoError(t, err)
+		var out []string
+		for _, snap := range snapshots {
+			out = append(out, snap.Name)
+		}
+		return out
+	}
+
+	// Pruning by count
+	a, storage := testAgent(t, &Config{RetainCount: 2})
+	put(storage, 1, 3*time.Hour)
+	second := put(storage, 2, 2*time.Hour)
+	third := put(storage, 3, time.Hour)
+	require.NoError(t, a.prune(third))
+	require.Equal(t, []string{second, third}, names(storage))
+
+	// Pruning by age
+	a, storage = testAgent(t, &Config{RetainAge: 90 * time.Minute})
+	put(storage, 1, 3*time.Hour)
+	put(storage, 2, 2*time.Hour)
+	third = put(storage, 3, time.Hour)
+	require.NoError(t, a.prune(third))
+	require.Equal(t, []string{third}, names(storage))
+
+	// The latest snapshot is retained regardless of its age
+	a, storage = testAgent(t, &Config{RetainAge: time.Minute})
+	put(storage, 1, 3*time.Hour)
+	second = put(storage, 2, 2*time.Hour)
+	require.NoError(t, a.prune(second))
+	require.Equal(t, []string{second}, names(storage))
+}
+
+func TestAgent_FirstWait(t *testing.T) {
+	ci.Parallel(t)
+
+	a, storage := testAgent(t, &Config{Interval: time.Hour})
+	require.Zero(t, a.firstWait())
+
+	name := snapshot.StoredSnapshotName(1, time.Now().Add(-15*time.Minute))
+	require.NoError(t, storage.Put(name, strings.NewReader("snapshot")))
+	wait := a.firstWait()
+	require.Greater(t, wait, 44*time.Minute)
+	require.LessOrEqual(t, wait, 45*time.Minute)
+}
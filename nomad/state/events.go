@@ -31,6 +31,17 @@ var MsgTypeEvents = map[structs.MessageType]string{
 	structs.ServiceRegistrationUpsertRequestType:         structs.TypeServiceRegistration,
 	structs.ServiceRegistrationDeleteByIDRequestType:     structs.TypeServiceDeregistration,
 	structs.ServiceRegistrationDeleteByNodeIDRequestType: structs.TypeServiceDeregistration,
+	structs.NamespaceUpsertRequestType:                   structs.TypeNamespaceUpserted,
+	structs.NamespaceDeleteRequestType:                   structs.TypeNamespaceDeleted,
+	structs.VarApplyStateRequestType:                     structs.TypeVariableUpserted,
+	structs.ACLRolesUpsertRequestType:                    structs.TypeACLRoleUpserted,
+	structs.ACLRolesDeleteByIDRequestType:                structs.TypeACLRoleDeleted,
+	structs.CSIVolumeRegisterRequestType:                 structs.TypeCSIVolumeRegistered,
+	structs.CSIVolumeDeregisterRequestType:               structs.TypeCSIVolumeDeregistered,
+	structs.CSIVolumeClaimRequestType:                    structs.TypeCSIVolumeClaim,
+	structs.CSIVolumeClaimBatchRequestType:               structs.TypeCSIVolumeClaim,
+	structs.CSIPluginDeleteRequestType:                   structs.TypeCSIPluginDeleted,
+	structs.SchedulerConfigRequestType:                   structs.TypeSchedulerConfigUpdated,
 }
 
 func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
@@ -42,7 +53,11 @@ func eventsFromChanges(tx ReadTxn, changes Changes) *structs.Events {
 	var events []structs.Event
 	for _, change := range changes.Changes {
 		if event, ok := eventFromChange(change); ok {
-			event.Type = eventType
+			// Events of objects which are changed by several request types
+			// set their own type
+			if event.Type == "" {
+				event.Type = eventType
+			}
 			event.Index = changes.Index
 			events = append(events, event)
 		}
@@ -108,6 +123,48 @@ func eventFromChange(change memdb.Change) (structs.Event, bool) {
 					Service: before,
 				},
 			}, true
+		case TableNamespaces:
+			before, ok := change.Before.(*structs.Namespace)
+			if !ok {
+				return structs.Event{}, false
+			}
+			return namespaceEvent(before), true
+		case TableVariables:
+			before, ok := change.Before.(*structs.VariableEncrypted)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := variableEvent(before)
+			event.Type = structs.TypeVariableDeleted
+			return event, true
+		case TableACLRoles:
+			before, ok := change.Before.(*structs.ACLRole)
+			if !ok {
+				return structs.Event{}, false
+			}
+			return aclRoleEvent(before), true
+		case "csi_volumes":
+			before, ok := change.Before.(*structs.CSIVolume)
+			if !ok {
+				return structs.Event{}, false
+			}
+			return csiVolumeEvent(before), true
+		case "csi_plugins":
+			before, ok := change.Before.(*structs.CSIPlugin)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := csiPluginEvent(before)
+			event.Type = structs.TypeCSIPluginDeleted
+			return event, true
+		case "scaling_policy":
+			before, ok := change.Before.(*structs.ScalingPolicy)
+			if !ok {
+				return structs.Event{}, false
+			}
+			event := scalingPolicyEvent(before)
+			event.Type = structs.TypeScalingPolicyDeleted
+			return event, true
 		}
 		return structs.Event{}, false
 	}
@@ -235,7 +292,133 @@ func eventFromChange(change memdb.Change) (structs.Event, bool) {
 				Service: after,
 			},
 		}, true
+	case TableNamespaces:
+		after, ok := change.After.(*structs.Namespace)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return namespaceEvent(after), true
+	case TableVariables:
+		after, ok := change.After.(*structs.VariableEncrypted)
+		if !ok {
+			return structs.Event{}, false
+		}
+		event := variableEvent(after)
+		event.Type = structs.TypeVariableUpserted
+		return event, true
+	case TableACLRoles:
+		after, ok := change.After.(*structs.ACLRole)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return aclRoleEvent(after), true
+	case "csi_volumes":
+		after, ok := change.After.(*structs.CSIVolume)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return csiVolumeEvent(after), true
+	case "csi_plugins":
+		after, ok := change.After.(*structs.CSIPlugin)
+		if !ok {
+			return structs.Event{}, false
+		}
+		event := csiPluginEvent(after)
+		event.Type = structs.TypeCSIPluginUpserted
+		return event, true
+	case "scaling_policy":
+		after, ok := change.After.(*structs.ScalingPolicy)
+		if !ok {
+			return structs.Event{}, false
+		}
+		event := scalingPolicyEvent(after)
+		event.Type = structs.TypeScalingPolicyUpserted
+		return event, true
+	case "scheduler_config":
+		after, ok := change.After.(*structs.SchedulerConfiguration)
+		if !ok {
+			return structs.Event{}, false
+		}
+		return structs.Event{
+			Topic: structs.TopicSchedulerConfig,
+			Payload: &structs.SchedulerConfigEvent{
+				SchedulerConfig: after,
+			},
+		}, true
 	}
 
 	return structs.Event{}, false
 }
+
+func namespaceEvent(ns *structs.Namespace) structs.Event {
+	return structs.Event{
+		Topic:     structs.TopicNamespace,
+		Key:       ns.Name,
+		Namespace: ns.Name,
+		Payload: &structs.NamespaceEvent{
+			Namespace: ns,
+		},
+	}
+}
+
+// variableEvent only includes the metadata of the variable, so that its
+// encrypted contents never leave the state store.
+func variableEvent(sv *structs.VariableEncrypted) structs.Event {
+	meta := sv.VariableMetadata
+	return structs.Event{
+		Topic:     structs.TopicVariables,
+		Key:       sv.Path,
+		Namespace: sv.Namespace,
+		Payload: &structs.VariableEvent{
+			Variable: &meta,
+		},
+	}
+}
+
+func aclRoleEvent(role *structs.ACLRole) structs.Event {
+	return structs.Event{
+		Topic:      structs.TopicACLRole,
+		Key:        role.ID,
+		FilterKeys: []string{role.Name},
+		Payload: &structs.ACLRoleStreamEvent{
+			ACLRole: role,
+		},
+	}
+}
+
+// csiVolumeEvent removes the secrets from a copy of the volume.
+func csiVolumeEvent(vol *structs.CSIVolume) structs.Event {
+	vol = vol.Copy()
+	vol.Secrets = nil
+	return structs.Event{
+		Topic:      structs.TopicCSIVolume,
+		Key:        vol.ID,
+		FilterKeys: []string{vol.PluginID},
+		Namespace:  vol.Namespace,
+		Payload: &structs.CSIVolumeEvent{
+			Volume: vol,
+		},
+	}
+}
+
+func csiPluginEvent(plug *structs.CSIPlugin) structs.Event {
+	return structs.Event{
+		Topic: structs.TopicCSIPlugin,
+		Key:   plug.ID,
+		Payload: &structs.CSIPluginEvent{
+			Plugin: plug,
+		},
+	}
+}
+
+func scalingPolicyEvent(policy *structs.ScalingPolicy) structs.Event {
+	return structs.Event{
+		Topic:      structs.TopicScalingPolicy,
+		Key:        policy.ID,
+		FilterKeys: []string{policy.Target[structs.ScalingTargetJob]},
+		Namespace:  policy.Target[structs.ScalingTargetNamespace],
+		Payload: &structs.ScalingPolicyEvent{
+			ScalingPolicy: policy,
+		},
+	}
+}
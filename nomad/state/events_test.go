@@ -1002,6 +1002,111 @@ func Test_eventsFromChanges_ServiceRegistration(t *testing.T) {
 	require.Equal(t, service, eventPayload.Service)
 }
 
+func TestEventsFromChanges_Namespace(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	ns := mock.Namespace()
+	ns.Name = structs.DefaultNamespace
+	require.NoError(t, s.UpsertNamespaces(structs.NamespaceUpsertRequestType, 100, []*structs.Namespace{ns}))
+
+	events := WaitForEvents(t, s, 100, 1, 1*time.Second)
+	require.Len(t, events, 1)
+	require.Equal(t, structs.TopicNamespace, events[0].Topic)
+	require.Equal(t, structs.TypeNamespaceUpserted, events[0].Type)
+	require.Equal(t, ns.Name, events[0].Key)
+
+	payload := events[0].Payload.(*structs.NamespaceEvent)
+	require.Equal(t, ns.Description, payload.Namespace.Description)
+}
+
+func TestEventsFromChanges_Variable(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	sv := mock.VariableEncrypted()
+	resp := s.VarSet(structs.VarApplyStateRequestType, 100, &structs.VarApplyStateRequest{
+		Op:  structs.VarOpSet,
+		Var: sv,
+	})
+	require.NoError(t, resp.Error)
+
+	events := WaitForEvents(t, s, 100, 1, 1*time.Second)
+	require.Len(t, events, 1)
+	require.Equal(t, structs.TopicVariables, events[0].Topic)
+	require.Equal(t, structs.TypeVariableUpserted, events[0].Type)
+	require.Equal(t, sv.Path, events[0].Key)
+
+	// Only the metadata of the variable is published
+	payload := events[0].Payload.(*structs.VariableEvent)
+	require.Equal(t, sv.Path, payload.Variable.Path)
+	require.Equal(t, uint64(100), payload.Variable.ModifyIndex)
+
+	resp = s.VarDelete(structs.VarApplyStateRequestType, 110, &structs.VarApplyStateRequest{
+		Op:  structs.VarOpDelete,
+		Var: sv,
+	})
+	require.NoError(t, resp.Error)
+
+	events = WaitForEvents(t, s, 110, 1, 1*time.Second)
+	require.Len(t, events, 1)
+	require.Equal(t, structs.TypeVariableDeleted, events[0].Type)
+	require.Equal(t, sv.Path, events[0].Key)
+}
+
+func TestEventsFromChanges_CSIVolume(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	vol := mock.CSIVolume(mock.CSIPlugin())
+	vol.Secrets = structs.CSISecrets{"password": "hunter2"}
+	require.NoError(t, s.UpsertCSIVolume(structs.CSIVolumeRegisterRequestType, 100, []*structs.CSIVolume{vol}))
+
+	events := WaitForEvents(t, s, 100, 1, 1*time.Second)
+	require.Len(t, events, 1)
+	require.Equal(t, structs.TopicCSIVolume, events[0].Topic)
+	require.Equal(t, structs.TypeCSIVolumeRegistered, events[0].Type)
+	require.Equal(t, vol.ID, events[0].Key)
+	require.Equal(t, []string{vol.PluginID}, events[0].FilterKeys)
+
+	// Secrets are removed from the event but not from the state store
+	payload := events[0].Payload.(*structs.CSIVolumeEvent)
+	require.Nil(t, payload.Volume.Secrets)
+
+	out, err := s.CSIVolumeByID(nil, vol.Namespace, vol.ID)
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", out.Secrets["password"])
+
+	require.NoError(t, s.CSIVolumeDeregister(structs.CSIVolumeDeregisterRequestType, 110, vol.Namespace, []string{vol.ID}, false))
+
+	events = WaitForEvents(t, s, 110, 1, 1*time.Second)
+	require.Len(t, events, 1)
+	require.Equal(t, structs.TypeCSIVolumeDeregistered, events[0].Type)
+	require.Equal(t, vol.ID, events[0].Key)
+}
+
+func TestEventsFromChanges_SchedulerConfig(t *testing.T) {
+	ci.Parallel(t)
+	s := TestStateStoreCfg(t, TestStateStorePublisher(t))
+	defer s.StopEventBroker()
+
+	config := &structs.SchedulerConfiguration{
+		SchedulerAlgorithm: structs.SchedulerAlgorithmSpread,
+	}
+	require.NoError(t, s.SchedulerSetConfig(structs.SchedulerConfigRequestType, 100, config))
+
+	events := WaitForEvents(t, s, 100, 1, 1*time.Second)
+	require.Len(t, events, 1)
+	require.Equal(t, structs.TopicSchedulerConfig, events[0].Topic)
+	require.Equal(t, structs.TypeSchedulerConfigUpdated, events[0].Type)
+
+	payload := events[0].Payload.(*structs.SchedulerConfigEvent)
+	require.Equal(t, structs.SchedulerAlgorithmSpread, payload.SchedulerConfig.SchedulerAlgorithm)
+}
+
 func requireNodeRegistrationEventEqual(t *testing.T, want, got structs.Event) {
 	t.Helper()
 
@@ -83,6 +83,9 @@ type StateStoreConfig struct {
 
 	// EventBufferSize configures the amount of events to hold in memory
 	EventBufferSize int64
+
+	// EventLog optionally persists the events of the event publisher
+	EventLog *stream.EventLog
 }
 
 // The StateStore is responsible for maintaining all the Nomad
@@ -138,6 +141,7 @@ func NewStateStore(config *StateStoreConfig) (*StateStore, error) {
 		// Create new event publisher using provided config
 		broker, err := stream.NewEventBroker(ctx, &streamACLDelegate{s}, stream.EventBrokerCfg{
 			EventBufferSize: config.EventBufferSize,
+			EventLog:        config.EventLog,
 			Logger:          config.Logger,
 		})
 		if err != nil {
@@ -184,7 +188,9 @@ func (s *StateStore) namespaceInit() error {
 		Description: structs.DefaultNamespaceDescription,
 	}
 
-	if err := s.UpsertNamespaces(1, []*structs.Namespace{defaultNs}); err != nil {
+	// The default namespace is not created by a Raft log, so don't publish an
+	// event for it.
+	if err := s.UpsertNamespaces(structs.IgnoreUnknownTypeFlag, 1, []*structs.Namespace{defaultNs}); err != nil {
 		return fmt.Errorf("inserting default namespace failed: %v", err)
 	}
 
@@ -2226,8 +2232,8 @@ func (s *StateStore) JobSummaryByPrefix(ws memdb.WatchSet, namespace, id string)
 }
 
 // UpsertCSIVolume inserts a volume in the state store.
-func (s *StateStore) UpsertCSIVolume(index uint64, volumes []*structs.CSIVolume) error {
-	txn := s.db.WriteTxn(index)
+func (s *StateStore) UpsertCSIVolume(msgType structs.MessageType, index uint64, volumes []*structs.CSIVolume) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
 	defer txn.Abort()
 
 	for _, v := range volumes {
@@ -2456,8 +2462,8 @@ func (s *StateStore) csiVolumesByNamespaceImpl(txn *txn, ws memdb.WatchSet, name
 }
 
 // CSIVolumeClaim updates the volume's claim count and allocation list
-func (s *StateStore) CSIVolumeClaim(index uint64, namespace, id string, claim *structs.CSIVolumeClaim) error {
-	txn := s.db.WriteTxn(index)
+func (s *StateStore) CSIVolumeClaim(msgType structs.MessageType, index uint64, namespace, id string, claim *structs.CSIVolumeClaim) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
 	defer txn.Abort()
 
 	row, err := txn.First("csi_volumes", "id", namespace, id)
@@ -2532,8 +2538,8 @@ func (s *StateStore) CSIVolumeClaim(index uint64, namespace, id string, claim *s
 }
 
 // CSIVolumeDeregister removes the volume from the server
-func (s *StateStore) CSIVolumeDeregister(index uint64, namespace string, ids []string, force bool) error {
-	txn := s.db.WriteTxn(index)
+func (s *StateStore) CSIVolumeDeregister(msgType structs.MessageType, index uint64, namespace string, ids []string, force bool) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
 	defer txn.Abort()
 
 	for _, id := range ids {
@@ -2863,8 +2869,8 @@ func (s *StateStore) UpsertCSIPlugin(index uint64, plug *structs.CSIPlugin) erro
 }
 
 // DeleteCSIPlugin deletes the plugin if it's not in use.
-func (s *StateStore) DeleteCSIPlugin(index uint64, id string) error {
-	txn := s.db.WriteTxn(index)
+func (s *StateStore) DeleteCSIPlugin(msgType structs.MessageType, index uint64, id string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
 	defer txn.Abort()
 
 	plug, err := s.CSIPluginByIDTxn(txn, nil, id)
@@ -5994,8 +6000,8 @@ func (s *StateStore) schedulerConfigTxn(txn *txn) (uint64, *structs.SchedulerCon
 }
 
 // SchedulerSetConfig is used to set the current Scheduler configuration.
-func (s *StateStore) SchedulerSetConfig(index uint64, config *structs.SchedulerConfiguration) error {
-	tx := s.db.WriteTxn(index)
+func (s *StateStore) SchedulerSetConfig(msgType structs.MessageType, index uint64, config *structs.SchedulerConfiguration) error {
+	tx := s.db.WriteTxnMsgT(msgType, index)
 	defer tx.Abort()
 
 	s.schedulerSetConfigTxn(index, tx, config)
@@ -6049,8 +6055,8 @@ func (s *StateStore) WithWriteTransaction(msgType structs.MessageType, index uin
 // SchedulerCASConfig is used to update the scheduler configuration with a
 // given Raft index. If the CAS index specified is not equal to the last observed index
 // for the config, then the call is a noop.
-func (s *StateStore) SchedulerCASConfig(index, cidx uint64, config *structs.SchedulerConfiguration) (bool, error) {
-	tx := s.db.WriteTxn(index)
+func (s *StateStore) SchedulerCASConfig(msgType structs.MessageType, index, cidx uint64, config *structs.SchedulerConfiguration) (bool, error) {
+	tx := s.db.WriteTxnMsgT(msgType, index)
 	defer tx.Abort()
 
 	// Check for an existing config
@@ -6270,8 +6276,8 @@ func (s *StateStore) NamespaceNames() ([]string, error) {
 }
 
 // UpsertNamespaces is used to register or update a set of namespaces.
-func (s *StateStore) UpsertNamespaces(index uint64, namespaces []*structs.Namespace) error {
-	txn := s.db.WriteTxn(index)
+func (s *StateStore) UpsertNamespaces(msgType structs.MessageType, index uint64, namespaces []*structs.Namespace) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
 	defer txn.Abort()
 
 	for _, ns := range namespaces {
@@ -6337,8 +6343,8 @@ func (s *StateStore) upsertNamespaceImpl(index uint64, txn *txn, namespace *stru
 }
 
 // DeleteNamespaces is used to remove a set of namespaces
-func (s *StateStore) DeleteNamespaces(index uint64, names []string) error {
-	txn := s.db.WriteTxn(index)
+func (s *StateStore) DeleteNamespaces(msgType structs.MessageType, index uint64, names []string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
 	defer txn.Abort()
 
 	for _, name := range names {
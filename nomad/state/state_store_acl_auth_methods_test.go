@@ -0,0 +1,132 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_UpsertACLAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	// Create mock auth methods and upsert them into state.
+	mockedACLAuthMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()}
+	must.NoError(t, testState.UpsertACLAuthMethods(structs.MsgTypeTestSetup, 10, mockedACLAuthMethods))
+
+	// Check that the index for the table was modified as expected.
+	initialIndex, err := testState.Index(TableACLAuthMethods)
+	must.NoError(t, err)
+	must.Eq(t, 10, initialIndex)
+
+	// List all the auth methods in the table and ensure the indexes are
+	// populated correctly.
+	ws := memdb.NewWatchSet()
+	iter, err := testState.GetACLAuthMethods(ws)
+	must.NoError(t, err)
+
+	var count int
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+		method := raw.(*structs.ACLAuthMethod)
+		must.Eq(t, 10, method.CreateIndex)
+		must.Eq(t, 10, method.ModifyIndex)
+	}
+	must.Eq(t, 2, count)
+
+	// Try writing the same auth methods to state which should not result in
+	// an update to the table index.
+	must.NoError(t, testState.UpsertACLAuthMethods(structs.MsgTypeTestSetup, 20, mockedACLAuthMethods))
+	reInsertActualIndex, err := testState.Index(TableACLAuthMethods)
+	must.NoError(t, err)
+	must.Eq(t, 10, reInsertActualIndex)
+
+	// Make a change to one of the auth methods and ensure this update is
+	// accepted and the table index is updated.
+	updatedMethod := mockedACLAuthMethods[0].Copy()
+	updatedMethod.TokenLocality = structs.ACLAuthMethodTokenLocalityGlobal
+	updatedMethod.SetHash()
+	must.NoError(t, testState.UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLAuthMethod{updatedMethod}))
+
+	updatedIndex, err := testState.Index(TableACLAuthMethods)
+	must.NoError(t, err)
+	must.Eq(t, 20, updatedIndex)
+
+	method, err := testState.GetACLAuthMethodByName(ws, updatedMethod.Name)
+	must.NoError(t, err)
+	must.Eq(t, 10, method.CreateIndex)
+	must.Eq(t, 20, method.ModifyIndex)
+	must.Eq(t, structs.ACLAuthMethodTokenLocalityGlobal, method.TokenLocality)
+}
+
+func TestStateStore_DeleteACLAuthMethods(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	// Create mock auth methods, with a binding rule for the first, and
+	// upsert them into state.
+	mockedACLAuthMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), mock.ACLAuthMethod()}
+	must.NoError(t, testState.UpsertACLAuthMethods(structs.MsgTypeTestSetup, 10, mockedACLAuthMethods))
+
+	rule := mock.ACLBindingRule()
+	rule.AuthMethod = mockedACLAuthMethods[0].Name
+	must.NoError(t, testState.UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 20, []*structs.ACLBindingRule{rule}, false))
+
+	// Trying to delete an auth method that does not exist fails and does not
+	// delete anything.
+	err := testState.DeleteACLAuthMethods(
+		structs.MsgTypeTestSetup, 30, []string{mockedACLAuthMethods[0].Name, "not-a-method"})
+	must.EqError(t, err, "ACL auth method not found")
+
+	// Delete the first auth method which should also delete its binding rule.
+	must.NoError(t, testState.DeleteACLAuthMethods(
+		structs.MsgTypeTestSetup, 30, []string{mockedACLAuthMethods[0].Name}))
+
+	ws := memdb.NewWatchSet()
+	method, err := testState.GetACLAuthMethodByName(ws, mockedACLAuthMethods[0].Name)
+	must.NoError(t, err)
+	must.Nil(t, method)
+
+	method, err = testState.GetACLAuthMethodByName(ws, mockedACLAuthMethods[1].Name)
+	must.NoError(t, err)
+	must.NotNil(t, method)
+
+	outRule, err := testState.GetACLBindingRule(ws, rule.ID)
+	must.NoError(t, err)
+	must.Nil(t, outRule)
+
+	methodsIndex, err := testState.Index(TableACLAuthMethods)
+	must.NoError(t, err)
+	must.Eq(t, 30, methodsIndex)
+
+	rulesIndex, err := testState.Index(TableACLBindingRules)
+	must.NoError(t, err)
+	must.Eq(t, 30, rulesIndex)
+}
+
+func TestStateStore_GetDefaultACLAuthMethodByType(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	// No default auth method exists yet.
+	ws := memdb.NewWatchSet()
+	method, err := testState.GetDefaultACLAuthMethodByType(ws, structs.ACLAuthMethodTypeOIDC)
+	must.NoError(t, err)
+	must.Nil(t, method)
+
+	defaultMethod := mock.ACLAuthMethod()
+	defaultMethod.Default = true
+	defaultMethod.SetHash()
+	mockedACLAuthMethods := []*structs.ACLAuthMethod{mock.ACLAuthMethod(), defaultMethod}
+	must.NoError(t, testState.UpsertACLAuthMethods(structs.MsgTypeTestSetup, 10, mockedACLAuthMethods))
+
+	method, err = testState.GetDefaultACLAuthMethodByType(ws, structs.ACLAuthMethodTypeOIDC)
+	must.NoError(t, err)
+	must.Eq(t, defaultMethod, method)
+}
@@ -0,0 +1,136 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_UpsertACLBindingRules(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	// Binding rules can't be written without their auth method, unless this
+	// is explicitly allowed.
+	mockedACLBindingRules := []*structs.ACLBindingRule{mock.ACLBindingRule(), mock.ACLBindingRule()}
+	err := testState.UpsertACLBindingRules(structs.MsgTypeTestSetup, 10, mockedACLBindingRules, false)
+	must.EqError(t, err, "ACL binding rule insert failed: ACL auth method not found")
+
+	method := mock.ACLAuthMethod()
+	method.Name = mockedACLBindingRules[0].AuthMethod
+	must.NoError(t, testState.UpsertACLAuthMethods(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLAuthMethod{method}))
+	must.NoError(t, testState.UpsertACLBindingRules(structs.MsgTypeTestSetup, 20, mockedACLBindingRules, false))
+
+	// Check that the index for the table was modified as expected.
+	initialIndex, err := testState.Index(TableACLBindingRules)
+	must.NoError(t, err)
+	must.Eq(t, 20, initialIndex)
+
+	ws := memdb.NewWatchSet()
+	iter, err := testState.GetACLBindingRules(ws)
+	must.NoError(t, err)
+
+	var count int
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+		rule := raw.(*structs.ACLBindingRule)
+		must.Eq(t, 20, rule.CreateIndex)
+		must.Eq(t, 20, rule.ModifyIndex)
+	}
+	must.Eq(t, 2, count)
+
+	// Try writing the same binding rules to state which should not result in
+	// an update to the table index.
+	must.NoError(t, testState.UpsertACLBindingRules(structs.MsgTypeTestSetup, 30, mockedACLBindingRules, false))
+	reInsertActualIndex, err := testState.Index(TableACLBindingRules)
+	must.NoError(t, err)
+	must.Eq(t, 20, reInsertActualIndex)
+
+	// Make a change to one of the binding rules and ensure this update is
+	// accepted and the table index is updated.
+	updatedRule := mockedACLBindingRules[0].Copy()
+	updatedRule.BindName = "eng-rw"
+	updatedRule.SetHash()
+	must.NoError(t, testState.UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 30, []*structs.ACLBindingRule{updatedRule}, false))
+
+	updatedIndex, err := testState.Index(TableACLBindingRules)
+	must.NoError(t, err)
+	must.Eq(t, 30, updatedIndex)
+
+	rule, err := testState.GetACLBindingRule(ws, updatedRule.ID)
+	must.NoError(t, err)
+	must.Eq(t, 20, rule.CreateIndex)
+	must.Eq(t, 30, rule.ModifyIndex)
+	must.Eq(t, "eng-rw", rule.BindName)
+
+	// Replication writes binding rules regardless of their auth method.
+	orphan := mock.ACLBindingRule()
+	orphan.AuthMethod = "not-a-method"
+	must.NoError(t, testState.UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 40, []*structs.ACLBindingRule{orphan}, true))
+}
+
+func TestStateStore_DeleteACLBindingRules(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	mockedACLBindingRules := []*structs.ACLBindingRule{mock.ACLBindingRule(), mock.ACLBindingRule()}
+	must.NoError(t, testState.UpsertACLBindingRules(structs.MsgTypeTestSetup, 10, mockedACLBindingRules, true))
+
+	// Trying to delete a binding rule that does not exist fails.
+	err := testState.DeleteACLBindingRules(structs.MsgTypeTestSetup, 20, []string{"not-a-rule"})
+	must.EqError(t, err, "ACL binding rule not found")
+
+	must.NoError(t, testState.DeleteACLBindingRules(
+		structs.MsgTypeTestSetup, 20, []string{mockedACLBindingRules[0].ID}))
+
+	ws := memdb.NewWatchSet()
+	rule, err := testState.GetACLBindingRule(ws, mockedACLBindingRules[0].ID)
+	must.NoError(t, err)
+	must.Nil(t, rule)
+
+	rule, err = testState.GetACLBindingRule(ws, mockedACLBindingRules[1].ID)
+	must.NoError(t, err)
+	must.NotNil(t, rule)
+
+	index, err := testState.Index(TableACLBindingRules)
+	must.NoError(t, err)
+	must.Eq(t, 20, index)
+}
+
+func TestStateStore_GetACLBindingRulesByAuthMethod(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	rule1 := mock.ACLBindingRule()
+	rule2 := mock.ACLBindingRule()
+	rule3 := mock.ACLBindingRule()
+	rule3.AuthMethod = "okta"
+	rule3.SetHash()
+	must.NoError(t, testState.UpsertACLBindingRules(
+		structs.MsgTypeTestSetup, 10, []*structs.ACLBindingRule{rule1, rule2, rule3}, true))
+
+	ws := memdb.NewWatchSet()
+	iter, err := testState.GetACLBindingRulesByAuthMethod(ws, "auth0")
+	must.NoError(t, err)
+
+	var ids []string
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		ids = append(ids, raw.(*structs.ACLBindingRule).ID)
+	}
+	must.Len(t, 2, ids)
+	must.Contains[string](t, ids, rule1.ID)
+	must.Contains[string](t, ids, rule2.ID)
+
+	iter, err = testState.GetACLBindingRuleByIDPrefix(ws, rule3.ID[:8])
+	must.NoError(t, err)
+	raw := iter.Next()
+	must.NotNil(t, raw)
+	must.Eq(t, rule3.ID, raw.(*structs.ACLBindingRule).ID)
+}
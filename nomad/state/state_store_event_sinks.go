@@ -0,0 +1,140 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// UpsertEventSink is used to register or update an event sink. The delivery
+// progress of an existing sink is retained.
+func (s *StateStore) UpsertEventSink(msgType structs.MessageType, index uint64, sink *structs.EventSink) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	existingRaw, err := txn.First(TableEventSinks, indexID, sink.ID)
+	if err != nil {
+		return fmt.Errorf("event sink lookup failed: %v", err)
+	}
+
+	if existingRaw != nil {
+		existing := existingRaw.(*structs.EventSink)
+		sink.CreateIndex = existing.CreateIndex
+		sink.LatestIndex = existing.LatestIndex
+	} else {
+		sink.CreateIndex = index
+		sink.LatestIndex = 0
+	}
+	sink.ModifyIndex = index
+
+	if err := txn.Insert(TableEventSinks, sink); err != nil {
+		return fmt.Errorf("event sink insert failed: %v", err)
+	}
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+// DeleteEventSinks is used to deregister a set of event sinks. An error is
+// returned if any of the sinks is not found.
+func (s *StateStore) DeleteEventSinks(msgType structs.MessageType, index uint64, sinkIDs []string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, id := range sinkIDs {
+		existing, err := txn.First(TableEventSinks, indexID, id)
+		if err != nil {
+			return fmt.Errorf("event sink lookup failed: %v", err)
+		}
+		if existing == nil {
+			return errors.New("event sink not found")
+		}
+
+		if err := txn.Delete(TableEventSinks, existing); err != nil {
+			return fmt.Errorf("event sink deletion failed: %v", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+// UpdateEventSinksProgress records the index of the latest events
+// acknowledged by a set of event sinks. The progress of a sink only moves
+// forward, and sinks which have been deregistered in the meantime are
+// skipped. The modify index of the sinks is not changed, as their
+// configuration is not.
+func (s *StateStore) UpdateEventSinksProgress(msgType structs.MessageType, index uint64, updates []*structs.EventSinkProgressRequest) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	var updated bool
+	for _, update := range updates {
+		existingRaw, err := txn.First(TableEventSinks, indexID, update.ID)
+		if err != nil {
+			return fmt.Errorf("event sink lookup failed: %v", err)
+		}
+		if existingRaw == nil {
+			continue
+		}
+
+		existing := existingRaw.(*structs.EventSink)
+		if update.LatestIndex <= existing.LatestIndex {
+			continue
+		}
+
+		sink := existing.Copy()
+		sink.LatestIndex = update.LatestIndex
+		if err := txn.Insert(TableEventSinks, sink); err != nil {
+			return fmt.Errorf("event sink insert failed: %v", err)
+		}
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableEventSinks, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+// EventSinkByID is used to look up an event sink by its ID. A nil sink is
+// returned if it is not found.
+func (s *StateStore) EventSinkByID(ws memdb.WatchSet, id string) (*structs.EventSink, error) {
+	txn := s.db.ReadTxn()
+
+	watchCh, existing, err := txn.FirstWatch(TableEventSinks, indexID, id)
+	if err != nil {
+		return nil, fmt.Errorf("event sink lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing != nil {
+		return existing.(*structs.EventSink), nil
+	}
+	return nil, nil
+}
+
+// EventSinks returns an iterator over all the event sinks.
+func (s *StateStore) EventSinks(ws memdb.WatchSet) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get(TableEventSinks, indexID)
+	if err != nil {
+		return nil, fmt.Errorf("event sinks lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
@@ -0,0 +1,136 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_UpsertEventSink(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink := mock.EventSink()
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 10, sink))
+
+	index, err := testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 10, index)
+
+	ws := memdb.NewWatchSet()
+	out, err := testState.EventSinkByID(ws, sink.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+	must.Eq(t, 10, out.CreateIndex)
+	must.Eq(t, 10, out.ModifyIndex)
+
+	// Record some progress, which fires the watch but leaves the modify
+	// index of the sink untouched
+	must.NoError(t, testState.UpdateEventSinksProgress(structs.MsgTypeTestSetup, 20,
+		[]*structs.EventSinkProgressRequest{{ID: sink.ID, LatestIndex: 15}}))
+	must.True(t, watchFired(ws))
+
+	out, err = testState.EventSinkByID(nil, sink.ID)
+	must.NoError(t, err)
+	must.Eq(t, 15, out.LatestIndex)
+	must.Eq(t, 10, out.ModifyIndex)
+
+	// Updating the sink retains its progress, even if the update was
+	// submitted with a different one
+	update := sink.Copy()
+	update.Address = "http://127.0.0.1:9090/events"
+	update.LatestIndex = 0
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 30, update))
+
+	out, err = testState.EventSinkByID(nil, sink.ID)
+	must.NoError(t, err)
+	must.Eq(t, "http://127.0.0.1:9090/events", out.Address)
+	must.Eq(t, 15, out.LatestIndex)
+	must.Eq(t, 10, out.CreateIndex)
+	must.Eq(t, 30, out.ModifyIndex)
+
+	iter, err := testState.EventSinks(nil)
+	must.NoError(t, err)
+
+	var count int
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+	must.Eq(t, 1, count)
+}
+
+func TestStateStore_UpdateEventSinksProgress(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink1 := mock.EventSink()
+	sink2 := mock.EventSink()
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 10, sink1))
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 11, sink2))
+
+	must.NoError(t, testState.UpdateEventSinksProgress(structs.MsgTypeTestSetup, 20,
+		[]*structs.EventSinkProgressRequest{
+			{ID: sink1.ID, LatestIndex: 18},
+			{ID: sink2.ID, LatestIndex: 19},
+			{ID: "deregistered", LatestIndex: 19},
+		}))
+
+	// Progress never moves backwards, for instance when a former leader
+	// submits stale progress
+	must.NoError(t, testState.UpdateEventSinksProgress(structs.MsgTypeTestSetup, 30,
+		[]*structs.EventSinkProgressRequest{
+			{ID: sink1.ID, LatestIndex: 12},
+		}))
+
+	index, err := testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 20, index)
+
+	out, err := testState.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Eq(t, 18, out.LatestIndex)
+
+	out, err = testState.EventSinkByID(nil, sink2.ID)
+	must.NoError(t, err)
+	must.Eq(t, 19, out.LatestIndex)
+
+	out, err = testState.EventSinkByID(nil, "deregistered")
+	must.NoError(t, err)
+	must.Nil(t, out)
+}
+
+func TestStateStore_DeleteEventSinks(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink1 := mock.EventSink()
+	sink2 := mock.EventSink()
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 10, sink1))
+	must.NoError(t, testState.UpsertEventSink(structs.MsgTypeTestSetup, 11, sink2))
+
+	// Deleting an unknown sink deletes none of them
+	err := testState.DeleteEventSinks(structs.MsgTypeTestSetup, 20, []string{sink1.ID, "unknown"})
+	must.EqError(t, err, "event sink not found")
+
+	out, err := testState.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+
+	must.NoError(t, testState.DeleteEventSinks(structs.MsgTypeTestSetup, 30, []string{sink1.ID}))
+
+	index, err := testState.Index(TableEventSinks)
+	must.NoError(t, err)
+	must.Eq(t, 30, index)
+
+	out, err = testState.EventSinkByID(nil, sink1.ID)
+	must.NoError(t, err)
+	must.Nil(t, out)
+
+	out, err = testState.EventSinkByID(nil, sink2.ID)
+	must.NoError(t, err)
+	must.NotNil(t, out)
+}
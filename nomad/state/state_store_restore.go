@@ -233,3 +233,30 @@ func (r *StateRestore) ACLRoleRestore(aclRole *structs.ACLRole) error {
 	}
 	return nil
 }
+
+// ACLAuthMethodRestore is used to restore a single ACL auth method into the
+// acl_auth_methods table.
+func (r *StateRestore) ACLAuthMethodRestore(aclAuthMethod *structs.ACLAuthMethod) error {
+	if err := r.txn.Insert(TableACLAuthMethods, aclAuthMethod); err != nil {
+		return fmt.Errorf("ACL auth method insert failed: %v", err)
+	}
+	return nil
+}
+
+// ACLBindingRuleRestore is used to restore a single ACL binding rule into the
+// acl_binding_rules table.
+func (r *StateRestore) ACLBindingRuleRestore(aclBindingRule *structs.ACLBindingRule) error {
+	if err := r.txn.Insert(TableACLBindingRules, aclBindingRule); err != nil {
+		return fmt.Errorf("ACL binding rule insert failed: %v", err)
+	}
+	return nil
+}
+
+// EventSinkRestore is used to restore a single event sink into the
+// event_sinks table.
+func (r *StateRestore) EventSinkRestore(sink *structs.EventSink) error {
+	if err := r.txn.Insert(TableEventSinks, sink); err != nil {
+		return fmt.Errorf("event sink insert failed: %v", err)
+	}
+	return nil
+}
@@ -627,3 +627,23 @@ func TestStateStore_ACLRoleRestore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, aclRole, out)
 }
+
+func TestStateStore_EventSinkRestore(t *testing.T) {
+	ci.Parallel(t)
+	testState := testStateStore(t)
+
+	sink := mock.EventSink()
+	sink.LatestIndex = 12
+	sink.CreateIndex = 10
+	sink.ModifyIndex = 11
+
+	restore, err := testState.Restore()
+	require.NoError(t, err)
+	require.NoError(t, restore.EventSinkRestore(sink))
+	require.NoError(t, restore.Commit())
+
+	ws := memdb.NewWatchSet()
+	out, err := testState.EventSinkByID(ws, sink.ID)
+	require.NoError(t, err)
+	require.Equal(t, sink, out)
+}
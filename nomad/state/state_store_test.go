@@ -705,7 +705,7 @@ func TestStateStore_Deployments_Namespace(t *testing.T) {
 	deploy3.Namespace = ns2.Name
 	deploy4.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 
 	// Create watchsets so we can test that update fires the watch
 	watches := []memdb.WatchSet{memdb.NewWatchSet(), memdb.NewWatchSet()}
@@ -864,7 +864,7 @@ func TestStateStore_DeploymentsByIDPrefix_Namespaces(t *testing.T) {
 	deploy1.Namespace = ns1.Name
 	deploy2.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 	require.NoError(t, state.UpsertDeployment(1000, deploy1))
 	require.NoError(t, state.UpsertDeployment(1001, deploy2))
 
@@ -912,7 +912,7 @@ func TestStateStore_UpsertNamespaces(t *testing.T) {
 	_, err := state.NamespaceByName(ws, ns1.Name)
 	require.NoError(t, err)
 
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns1, ns2}))
 	require.True(t, watchFired(ws))
 
 	ws = memdb.NewWatchSet()
@@ -937,14 +937,14 @@ func TestStateStore_DeleteNamespaces(t *testing.T) {
 	ns1 := mock.Namespace()
 	ns2 := mock.Namespace()
 
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns1, ns2}))
 
 	// Create a watchset so we can test that delete fires the watch
 	ws := memdb.NewWatchSet()
 	_, err := state.NamespaceByName(ws, ns1.Name)
 	require.NoError(t, err)
 
-	require.NoError(t, state.DeleteNamespaces(1001, []string{ns1.Name, ns2.Name}))
+	require.NoError(t, state.DeleteNamespaces(structs.MsgTypeTestSetup, 1001, []string{ns1.Name, ns2.Name}))
 	require.True(t, watchFired(ws))
 
 	ws = memdb.NewWatchSet()
@@ -969,9 +969,9 @@ func TestStateStore_DeleteNamespaces_Default(t *testing.T) {
 
 	ns := mock.Namespace()
 	ns.Name = structs.DefaultNamespace
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns}))
 
-	err := state.DeleteNamespaces(1002, []string{ns.Name})
+	err := state.DeleteNamespaces(structs.MsgTypeTestSetup, 1002, []string{ns.Name})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "can not be deleted")
 }
@@ -982,7 +982,7 @@ func TestStateStore_DeleteNamespaces_NonTerminalJobs(t *testing.T) {
 	state := testStateStore(t)
 
 	ns := mock.Namespace()
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns}))
 
 	job := mock.Job()
 	job.Namespace = ns.Name
@@ -993,7 +993,7 @@ func TestStateStore_DeleteNamespaces_NonTerminalJobs(t *testing.T) {
 	_, err := state.NamespaceByName(ws, ns.Name)
 	require.NoError(t, err)
 
-	err = state.DeleteNamespaces(1002, []string{ns.Name})
+	err = state.DeleteNamespaces(structs.MsgTypeTestSetup, 1002, []string{ns.Name})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "one non-terminal")
 	require.False(t, watchFired(ws))
@@ -1015,20 +1015,20 @@ func TestStateStore_DeleteNamespaces_CSIVolumes(t *testing.T) {
 	state := testStateStore(t)
 
 	ns := mock.Namespace()
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns}))
 
 	plugin := mock.CSIPlugin()
 	vol := mock.CSIVolume(plugin)
 	vol.Namespace = ns.Name
 
-	require.NoError(t, state.UpsertCSIVolume(1001, []*structs.CSIVolume{vol}))
+	require.NoError(t, state.UpsertCSIVolume(structs.MsgTypeTestSetup, 1001, []*structs.CSIVolume{vol}))
 
 	// Create a watchset so we can test that delete fires the watch
 	ws := memdb.NewWatchSet()
 	_, err := state.NamespaceByName(ws, ns.Name)
 	require.NoError(t, err)
 
-	err = state.DeleteNamespaces(1002, []string{ns.Name})
+	err = state.DeleteNamespaces(structs.MsgTypeTestSetup, 1002, []string{ns.Name})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "one CSI volume")
 	require.False(t, watchFired(ws))
@@ -1050,12 +1050,12 @@ func TestStateStore_DeleteNamespaces_Variables(t *testing.T) {
 	state := testStateStore(t)
 
 	ns := mock.Namespace()
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns}))
 
 	sv := mock.VariableEncrypted()
 	sv.Namespace = ns.Name
 
-	resp := state.VarSet(1001, &structs.VarApplyStateRequest{
+	resp := state.VarSet(structs.MsgTypeTestSetup, 1001, &structs.VarApplyStateRequest{
 		Op:  structs.VarOpSet,
 		Var: sv,
 	})
@@ -1066,7 +1066,7 @@ func TestStateStore_DeleteNamespaces_Variables(t *testing.T) {
 	_, err := state.NamespaceByName(ws, ns.Name)
 	require.NoError(t, err)
 
-	err = state.DeleteNamespaces(1002, []string{ns.Name})
+	err = state.DeleteNamespaces(structs.MsgTypeTestSetup, 1002, []string{ns.Name})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "one variable")
 	require.False(t, watchFired(ws))
@@ -1093,7 +1093,7 @@ func TestStateStore_Namespaces(t *testing.T) {
 		namespaces = append(namespaces, ns)
 	}
 
-	require.NoError(t, state.UpsertNamespaces(1000, namespaces))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, namespaces))
 
 	// Create a watchset so we can test that getters don't cause it to fire
 	ws := memdb.NewWatchSet()
@@ -1132,7 +1132,7 @@ func TestStateStore_NamespaceNames(t *testing.T) {
 		expectedNames = append(expectedNames, ns.Name)
 	}
 
-	err := state.UpsertNamespaces(1000, namespaces)
+	err := state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, namespaces)
 	require.NoError(t, err)
 
 	found, err := state.NamespaceNames()
@@ -1151,7 +1151,7 @@ func TestStateStore_NamespaceByNamePrefix(t *testing.T) {
 	ns := mock.Namespace()
 
 	ns.Name = "foobar"
-	require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{ns}))
 
 	// Create a watchset so we can test that getters don't cause it to fire
 	ws := memdb.NewWatchSet()
@@ -1183,7 +1183,7 @@ func TestStateStore_NamespaceByNamePrefix(t *testing.T) {
 
 	ns = mock.Namespace()
 	ns.Name = "foozip"
-	err = state.UpsertNamespaces(1001, []*structs.Namespace{ns})
+	err = state.UpsertNamespaces(structs.MsgTypeTestSetup, 1001, []*structs.Namespace{ns})
 	require.NoError(t, err)
 	require.True(t, watchFired(ws))
 
@@ -2641,7 +2641,7 @@ func TestStateStore_JobsByIDPrefix_Namespaces(t *testing.T) {
 	job1.Namespace = ns1.Name
 	job2.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1000, job1))
 	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1001, job2))
 
@@ -2724,7 +2724,7 @@ func TestStateStore_JobsByNamespace(t *testing.T) {
 	job3.Namespace = ns2.Name
 	job4.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 
 	// Create watchsets so we can test that update fires the watch
 	watches := []memdb.WatchSet{memdb.NewWatchSet(), memdb.NewWatchSet()}
@@ -3313,18 +3313,18 @@ func TestStateStore_CSIVolume(t *testing.T) {
 	}}
 
 	index++
-	err = state.UpsertCSIVolume(index, []*structs.CSIVolume{v0, v1})
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{v0, v1})
 	require.NoError(t, err)
 
 	// volume registration is idempotent, unless identies are changed
 	index++
-	err = state.UpsertCSIVolume(index, []*structs.CSIVolume{v0, v1})
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{v0, v1})
 	require.NoError(t, err)
 
 	index++
 	v2 := v0.Copy()
 	v2.PluginID = "new-id"
-	err = state.UpsertCSIVolume(index, []*structs.CSIVolume{v2})
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{v2})
 	require.Error(t, err, fmt.Sprintf("volume exists: %s", v0.ID))
 
 	ws := memdb.NewWatchSet()
@@ -3381,10 +3381,10 @@ func TestStateStore_CSIVolume(t *testing.T) {
 	}
 
 	index++
-	err = state.CSIVolumeClaim(index, ns, vol0, claim0)
+	err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, index, ns, vol0, claim0)
 	require.NoError(t, err)
 	index++
-	err = state.CSIVolumeClaim(index, ns, vol0, claim1)
+	err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, index, ns, vol0, claim1)
 	require.NoError(t, err)
 
 	ws = memdb.NewWatchSet()
@@ -3394,7 +3394,7 @@ func TestStateStore_CSIVolume(t *testing.T) {
 	require.False(t, vs[0].HasFreeWriteClaims())
 
 	claim0.Mode = u
-	err = state.CSIVolumeClaim(2, ns, vol0, claim0)
+	err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, 2, ns, vol0, claim0)
 	require.NoError(t, err)
 	ws = memdb.NewWatchSet()
 	iter, err = state.CSIVolumesByPluginID(ws, ns, "", "minnie")
@@ -3404,36 +3404,36 @@ func TestStateStore_CSIVolume(t *testing.T) {
 
 	// registration is an error when the volume is in use
 	index++
-	err = state.UpsertCSIVolume(index, []*structs.CSIVolume{v0})
+	err = state.UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{v0})
 	require.Error(t, err, "volume re-registered while in use")
 	// as is deregistration
 	index++
-	err = state.CSIVolumeDeregister(index, ns, []string{vol0}, false)
+	err = state.CSIVolumeDeregister(structs.MsgTypeTestSetup, index, ns, []string{vol0}, false)
 	require.Error(t, err, "volume deregistered while in use")
 
 	// even if forced, because we have a non-terminal claim
 	index++
-	err = state.CSIVolumeDeregister(index, ns, []string{vol0}, true)
+	err = state.CSIVolumeDeregister(structs.MsgTypeTestSetup, index, ns, []string{vol0}, true)
 	require.Error(t, err, "volume force deregistered while in use")
 
 	// we use the ID, not a prefix
 	index++
-	err = state.CSIVolumeDeregister(index, ns, []string{"fo"}, true)
+	err = state.CSIVolumeDeregister(structs.MsgTypeTestSetup, index, ns, []string{"fo"}, true)
 	require.Error(t, err, "volume deregistered by prefix")
 
 	// release claims to unblock deregister
 	index++
 	claim0.State = structs.CSIVolumeClaimStateReadyToFree
-	err = state.CSIVolumeClaim(index, ns, vol0, claim0)
+	err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, index, ns, vol0, claim0)
 	require.NoError(t, err)
 	index++
 	claim1.Mode = u
 	claim1.State = structs.CSIVolumeClaimStateReadyToFree
-	err = state.CSIVolumeClaim(index, ns, vol0, claim1)
+	err = state.CSIVolumeClaim(structs.MsgTypeTestSetup, index, ns, vol0, claim1)
 	require.NoError(t, err)
 
 	index++
-	err = state.CSIVolumeDeregister(index, ns, []string{vol0}, false)
+	err = state.CSIVolumeDeregister(structs.MsgTypeTestSetup, index, ns, []string{vol0}, false)
 	require.NoError(t, err)
 
 	// List, now omitting the deregistered volume
@@ -3744,7 +3744,7 @@ func TestStateStore_CSIPlugin_Lifecycle(t *testing.T) {
 			Namespace: structs.DefaultNamespace,
 			PluginID:  plugID,
 		}
-		err = store.UpsertCSIVolume(nextIndex(store), []*structs.CSIVolume{vol})
+		err = store.UpsertCSIVolume(structs.MsgTypeTestSetup, nextIndex(store), []*structs.CSIVolume{vol})
 		require.NoError(t, err)
 
 		err = store.DeleteJob(nextIndex(store), structs.DefaultNamespace, controllerJobID)
@@ -4030,7 +4030,7 @@ func TestStateStore_UpsertEvals_Namespace(t *testing.T) {
 	eval3.Namespace = ns2.Name
 	eval4.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 
 	// Create watchsets so we can test that update fires the watch
 	watches := []memdb.WatchSet{memdb.NewWatchSet(), memdb.NewWatchSet()}
@@ -4467,7 +4467,7 @@ func TestStateStore_DeleteEval_UserInitiated(t *testing.T) {
 	// Upsert a scheduler config object, so we have something to check and
 	// modify.
 	schedulerConfig := structs.SchedulerConfiguration{PauseEvalBroker: false}
-	require.NoError(t, testState.SchedulerSetConfig(10, &schedulerConfig))
+	require.NoError(t, testState.SchedulerSetConfig(structs.MsgTypeTestSetup, 10, &schedulerConfig))
 
 	// Generate some mock evals and upsert these into state.
 	mockEval1 := mock.Eval()
@@ -4484,7 +4484,7 @@ func TestStateStore_DeleteEval_UserInitiated(t *testing.T) {
 	// Pause the eval broker on the scheduler config, and try deleting the
 	// evals again.
 	schedulerConfig.PauseEvalBroker = true
-	require.NoError(t, testState.SchedulerSetConfig(30, &schedulerConfig))
+	require.NoError(t, testState.SchedulerSetConfig(structs.MsgTypeTestSetup, 30, &schedulerConfig))
 
 	require.NoError(t, testState.DeleteEval(40, mockEvalIDs, []string{}, true))
 
@@ -4689,7 +4689,7 @@ func TestStateStore_EvalsByIDPrefix_Namespaces(t *testing.T) {
 	eval1.Namespace = ns1.Name
 	eval2.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 	require.NoError(t, state.UpsertEvals(structs.MsgTypeTestSetup, 1000, []*structs.Evaluation{eval1, eval2}))
 
 	gatherEvals := func(iter memdb.ResultIterator) []*structs.Evaluation {
@@ -5378,7 +5378,7 @@ func TestStateStore_UpsertAlloc_AllocsByNamespace(t *testing.T) {
 	alloc4.Namespace = ns2.Name
 	alloc4.Job.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 999, alloc1.Job))
 	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1000, alloc3.Job))
 
@@ -6462,7 +6462,7 @@ func TestStateStore_AllocsByIDPrefix_Namespaces(t *testing.T) {
 	alloc1.Namespace = ns1.Name
 	alloc2.Namespace = ns2.Name
 
-	require.NoError(t, state.UpsertNamespaces(998, []*structs.Namespace{ns1, ns2}))
+	require.NoError(t, state.UpsertNamespaces(structs.MsgTypeTestSetup, 998, []*structs.Namespace{ns1, ns2}))
 	require.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, []*structs.Allocation{alloc1, alloc2}))
 
 	gatherAllocs := func(iter memdb.ResultIterator) []*structs.Allocation {
@@ -49,7 +49,7 @@ func TestStateStore_UpsertVariables(t *testing.T) {
 		// Perform the initial upsert of variables.
 		for _, sv := range svs {
 			insertIndex++
-			resp := testState.VarSet(insertIndex, &structs.VarApplyStateRequest{
+			resp := testState.VarSet(structs.MsgTypeTestSetup, insertIndex, &structs.VarApplyStateRequest{
 				Op:  structs.VarOpSet,
 				Var: sv,
 			})
@@ -107,7 +107,7 @@ func TestStateStore_UpsertVariables(t *testing.T) {
 				Var: sv,
 			}
 			reInsertIndex++
-			resp := testState.VarSet(reInsertIndex, svReq)
+			resp := testState.VarSet(structs.MsgTypeTestSetup, reInsertIndex, svReq)
 			require.NoError(t, resp.Error)
 		}
 
@@ -134,7 +134,7 @@ func TestStateStore_UpsertVariables(t *testing.T) {
 
 		update1Index := uint64(40)
 
-		resp := testState.VarSet(update1Index, &structs.VarApplyStateRequest{
+		resp := testState.VarSet(structs.MsgTypeTestSetup, update1Index, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: &sv1Update,
 		})
@@ -179,7 +179,7 @@ func TestStateStore_UpsertVariables(t *testing.T) {
 		sv2.KeyID = "sv2-update"
 		sv2.ModifyIndex = update2Index
 
-		resp := testState.VarSet(update2Index, &structs.VarApplyStateRequest{
+		resp := testState.VarSet(structs.MsgTypeTestSetup, update2Index, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: &sv2,
 		})
@@ -231,7 +231,7 @@ func TestStateStore_DeleteVariable(t *testing.T) {
 
 	t.Run("1 delete a variable that does not exist", func(t *testing.T) {
 
-		resp := testState.VarDelete(initialIndex, &structs.VarApplyStateRequest{
+		resp := testState.VarDelete(structs.MsgTypeTestSetup, initialIndex, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpDelete,
 			Var: svs[0],
 		})
@@ -252,7 +252,7 @@ func TestStateStore_DeleteVariable(t *testing.T) {
 
 		ns := mock.Namespace()
 		ns.Name = svs[0].Namespace
-		require.NoError(t, testState.UpsertNamespaces(initialIndex, []*structs.Namespace{ns}))
+		require.NoError(t, testState.UpsertNamespaces(structs.MsgTypeTestSetup, initialIndex, []*structs.Namespace{ns}))
 
 		for _, sv := range svs {
 			svReq := &structs.VarApplyStateRequest{
@@ -260,14 +260,14 @@ func TestStateStore_DeleteVariable(t *testing.T) {
 				Var: sv,
 			}
 			initialIndex++
-			resp := testState.VarSet(initialIndex, svReq)
+			resp := testState.VarSet(structs.MsgTypeTestSetup, initialIndex, svReq)
 			require.NoError(t, resp.Error)
 		}
 
 		// Perform the delete.
 		delete1Index := uint64(20)
 
-		resp := testState.VarDelete(delete1Index, &structs.VarApplyStateRequest{
+		resp := testState.VarDelete(structs.MsgTypeTestSetup, delete1Index, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpDelete,
 			Var: svs[0],
 		})
@@ -303,7 +303,7 @@ func TestStateStore_DeleteVariable(t *testing.T) {
 	t.Run("3 delete remaining variable", func(t *testing.T) {
 		delete2Index := uint64(30)
 
-		resp := testState.VarDelete(delete2Index, &structs.VarApplyStateRequest{
+		resp := testState.VarDelete(structs.MsgTypeTestSetup, delete2Index, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpDelete,
 			Var: svs[1],
 		})
@@ -340,7 +340,7 @@ func TestStateStore_GetVariables(t *testing.T) {
 	ns := mock.Namespace()
 	ns.Name = "~*magical*~"
 	initialIndex := uint64(10)
-	require.NoError(t, testState.UpsertNamespaces(initialIndex, []*structs.Namespace{ns}))
+	require.NoError(t, testState.UpsertNamespaces(structs.MsgTypeTestSetup, initialIndex, []*structs.Namespace{ns}))
 
 	// Generate some test variables in different namespaces and upsert them.
 	svs := []*structs.VariableEncrypted{
@@ -357,7 +357,7 @@ func TestStateStore_GetVariables(t *testing.T) {
 			Var: sv,
 		}
 		initialIndex++
-		resp := testState.VarSet(initialIndex, svReq)
+		resp := testState.VarSet(structs.MsgTypeTestSetup, initialIndex, svReq)
 		require.NoError(t, resp.Error)
 	}
 
@@ -430,7 +430,7 @@ func TestStateStore_ListVariablesByNamespaceAndPrefix(t *testing.T) {
 	ns := mock.Namespace()
 	ns.Name = "other"
 	initialIndex := uint64(10)
-	require.NoError(t, testState.UpsertNamespaces(initialIndex, []*structs.Namespace{ns}))
+	require.NoError(t, testState.UpsertNamespaces(structs.MsgTypeTestSetup, initialIndex, []*structs.Namespace{ns}))
 
 	for _, sv := range svs {
 		svReq := &structs.VarApplyStateRequest{
@@ -438,7 +438,7 @@ func TestStateStore_ListVariablesByNamespaceAndPrefix(t *testing.T) {
 			Var: sv,
 		}
 		initialIndex++
-		resp := testState.VarSet(initialIndex, svReq)
+		resp := testState.VarSet(structs.MsgTypeTestSetup, initialIndex, svReq)
 		require.NoError(t, resp.Error)
 	}
 
@@ -609,7 +609,7 @@ func TestStateStore_ListVariablesByKeyID(t *testing.T) {
 			Var: sv,
 		}
 		initialIndex++
-		resp := testState.VarSet(initialIndex, svReq)
+		resp := testState.VarSet(structs.MsgTypeTestSetup, initialIndex, svReq)
 		require.NoError(t, resp.Error)
 	}
 
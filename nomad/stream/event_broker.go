@@ -25,6 +25,10 @@ const (
 type EventBrokerCfg struct {
 	EventBufferSize int64
 	Logger          hclog.Logger
+
+	// EventLog optionally persists published events, to serve subscribers
+	// whose index is older than the event buffer.
+	EventLog *EventLog
 }
 
 type EventBroker struct {
@@ -35,6 +39,12 @@ type EventBroker struct {
 	// eventBuf stores a configurable amount of events in memory
 	eventBuf *eventBuffer
 
+	// eventLog stores published events on disk, it may be nil
+	eventLog *EventLog
+
+	// eventLogWriter writes published events to eventLog in the background
+	eventLogWriter *eventLogWriter
+
 	// publishCh is used to send messages from an active txn to a goroutine which
 	// publishes events, so that publishing can happen asynchronously from
 	// the Commit call in the FSM hot path.
@@ -71,6 +81,7 @@ func NewEventBroker(ctx context.Context, aclDelegate ACLDelegate, cfg EventBroke
 	e := &EventBroker{
 		logger:      cfg.Logger.Named("event_broker"),
 		eventBuf:    buffer,
+		eventLog:    cfg.EventLog,
 		publishCh:   make(chan *structs.Events, 64),
 		aclCh:       make(chan structs.Event, 10),
 		aclDelegate: aclDelegate,
@@ -80,6 +91,11 @@ func NewEventBroker(ctx context.Context, aclDelegate ACLDelegate, cfg EventBroke
 		},
 	}
 
+	if e.eventLog != nil {
+		e.eventLogWriter = newEventLogWriter(e.eventLog, e.logger)
+		go e.eventLogWriter.run(ctx)
+	}
+
 	go e.handleUpdates(ctx)
 	go e.handleACLUpdates(ctx)
 
@@ -133,9 +149,17 @@ func (e *EventBroker) SubscribeWithACLCheck(req *SubscribeRequest) (*Subscriptio
 // set and the index is no longer in the buffer or not yet in the buffer an error
 // will be returned.
 //
+// An error wrapping ErrInvalidFilter is returned if the filter expression of the
+// request can't be parsed.
+//
 // When a caller is finished with the subscription it must call Subscription.Unsubscribe
 // to free ACL tracking resources.
 func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
+	evaluator, err := newFilterEvaluator(req)
+	if err != nil {
+		return nil, err
+	}
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -146,6 +170,32 @@ func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
 	} else {
 		head = e.eventBuf.Head()
 	}
+
+	// Indexes older than the buffer are served from the event log, which
+	// holds every event that has been appended to the buffer once the queued
+	// events are written. Only the first page of events is read here, the
+	// following ones are read as the subscriber reaches them.
+	if e.eventLog != nil && req.Index != 0 && (head.Events.Index == 0 || req.Index < head.Events.Index) {
+		e.eventLogWriter.Flush()
+
+		// Bound the range by the events logged so far if the buffer is
+		// empty, so that events published from now on are only served from
+		// the buffer.
+		before := head.Events.Index
+		if before == 0 {
+			before = e.eventLog.LastIndex() + 1
+		}
+
+		logged, err := e.eventLog.Range(req.Index, before, eventLogPageSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(logged) > 0 {
+			head = e.spliceLoggedEvents(logged, before, head)
+			offset = int(head.Events.Index) - int(req.Index)
+		}
+	}
+
 	if offset > 0 && req.StartExactlyAtIndex {
 		return nil, fmt.Errorf("requested index not in buffer")
 	} else if offset > 0 {
@@ -158,7 +208,7 @@ func (e *EventBroker) Subscribe(req *SubscribeRequest) (*Subscription, error) {
 	start.link.next.Store(head)
 	close(start.link.nextCh)
 
-	sub := newSubscription(req, start, e.subscriptions.unsubscribeFn(req))
+	sub := newSubscription(req, evaluator, start, e.subscriptions.unsubscribeFn(req))
 
 	e.subscriptions.add(req, sub)
 	return sub, nil
@@ -177,8 +227,51 @@ func (e *EventBroker) handleUpdates(ctx context.Context) {
 			return
 		case update := <-e.publishCh:
 			e.eventBuf.Append(update)
+
+			// Events are queued to be written to the log once they're in
+			// the buffer, so that a slow disk doesn't hold up publishing
+			if e.eventLogWriter != nil {
+				e.eventLogWriter.Enqueue(update)
+			}
+		}
+	}
+}
+
+// spliceLoggedEvents links a page of events read from the event log in front
+// of the given item of the event buffer, and returns the first of them. If the
+// page is full, the last of its events is linked to the next page of events up
+// to the before index, which is only read once a subscriber reaches it.
+func (e *EventBroker) spliceLoggedEvents(logged []*structs.Events, before uint64, head *bufferItem) *bufferItem {
+	last := newBufferItem(logged[len(logged)-1])
+	if len(logged) < eventLogPageSize {
+		last.link.next.Store(head)
+		close(last.link.nextCh)
+	} else {
+		last.link.load = func() (*bufferItem, error) {
+			index := last.Events.Index + 1
+			if index >= before {
+				return head, nil
+			}
+
+			page, err := e.eventLog.Range(index, before, eventLogPageSize)
+			if err != nil {
+				return nil, err
+			}
+			if len(page) == 0 {
+				return head, nil
+			}
+			return e.spliceLoggedEvents(page, before, head), nil
 		}
 	}
+
+	next := last
+	for i := len(logged) - 2; i >= 0; i-- {
+		item := newBufferItem(logged[i])
+		item.link.next.Store(next)
+		close(item.link.nextCh)
+		next = item
+	}
+	return next
 }
 
 func (e *EventBroker) handleACLUpdates(ctx context.Context) {
@@ -308,6 +401,32 @@ func aclAllowsSubscription(aclObj *acl.ACL, subReq *SubscribeRequest) bool {
 			if ok := aclObj.AllowNodeRead(); !ok {
 				return false
 			}
+		case structs.TopicNamespace:
+			if ok := aclObj.AllowNamespace(subReq.Namespace); !ok {
+				return false
+			}
+		case structs.TopicVariables:
+			// Events are only published for the metadata of variables, but
+			// subscribers must still be able to list every path of the namespace.
+			if ok := aclObj.AllowVariableOperation(subReq.Namespace, "*", acl.VariablesCapabilityList); !ok {
+				return false
+			}
+		case structs.TopicCSIVolume:
+			if ok := aclObj.AllowNsOp(subReq.Namespace, acl.NamespaceCapabilityCSIReadVolume); !ok {
+				return false
+			}
+		case structs.TopicCSIPlugin:
+			if ok := aclObj.AllowPluginRead(); !ok {
+				return false
+			}
+		case structs.TopicScalingPolicy:
+			if ok := aclObj.AllowNsOp(subReq.Namespace, acl.NamespaceCapabilityReadScalingPolicy); !ok {
+				return false
+			}
+		case structs.TopicSchedulerConfig:
+			if ok := aclObj.AllowOperatorRead(); !ok {
+				return false
+			}
 		default:
 			if ok := aclObj.IsManagement(); !ok {
 				return false
@@ -578,6 +578,101 @@ func TestEventBroker_handleACLUpdates_policyupdated(t *testing.T) {
 	}
 }
 
+func TestEventBroker_aclAllowsSubscription(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		desc    string
+		rules   string
+		topic   structs.Topic
+		allowed bool
+	}{
+		{
+			desc:    "namespace with namespace access",
+			rules:   mock.NamespacePolicy(structs.DefaultNamespace, "read", nil),
+			topic:   structs.TopicNamespace,
+			allowed: true,
+		},
+		{
+			desc:    "namespace without namespace access",
+			rules:   mock.NamespacePolicy("other", "read", nil),
+			topic:   structs.TopicNamespace,
+			allowed: false,
+		},
+		{
+			desc: "variables with list on all paths",
+			rules: mock.NamespacePolicyWithVariables(structs.DefaultNamespace, "", nil,
+				map[string][]string{"*": {acl.VariablesCapabilityList}}),
+			topic:   structs.TopicVariables,
+			allowed: true,
+		},
+		{
+			desc: "variables with list on some paths",
+			rules: mock.NamespacePolicyWithVariables(structs.DefaultNamespace, "", nil,
+				map[string][]string{"app/*": {acl.VariablesCapabilityList}}),
+			topic:   structs.TopicVariables,
+			allowed: false,
+		},
+		{
+			desc:    "csi volumes with csi-read-volume",
+			rules:   mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityCSIReadVolume}),
+			topic:   structs.TopicCSIVolume,
+			allowed: true,
+		},
+		{
+			desc:    "csi volumes with read-job",
+			rules:   mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadJob}),
+			topic:   structs.TopicCSIVolume,
+			allowed: false,
+		},
+		{
+			desc:    "csi plugins with plugin read",
+			rules:   mock.PluginPolicy(acl.PolicyRead),
+			topic:   structs.TopicCSIPlugin,
+			allowed: true,
+		},
+		{
+			desc:    "scaling policies with read-scaling-policy",
+			rules:   mock.NamespacePolicy(structs.DefaultNamespace, "", []string{acl.NamespaceCapabilityReadScalingPolicy}),
+			topic:   structs.TopicScalingPolicy,
+			allowed: true,
+		},
+		{
+			desc:    "scheduler config with operator read",
+			rules:   mock.OperatorPolicy(acl.PolicyRead),
+			topic:   structs.TopicSchedulerConfig,
+			allowed: true,
+		},
+		{
+			desc:    "scheduler config with node read",
+			rules:   mock.NodePolicy(acl.PolicyRead),
+			topic:   structs.TopicSchedulerConfig,
+			allowed: false,
+		},
+		{
+			desc:    "acl roles require management",
+			rules:   mock.OperatorPolicy(acl.PolicyWrite),
+			topic:   structs.TopicACLRole,
+			allowed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			policy, err := acl.Parse(tc.rules)
+			require.NoError(t, err)
+			aclObj, err := acl.NewACL(false, []*acl.Policy{policy})
+			require.NoError(t, err)
+
+			req := &SubscribeRequest{
+				Namespace: structs.DefaultNamespace,
+				Topics:    map[structs.Topic][]string{tc.topic: {"*"}},
+			}
+			require.Equal(t, tc.allowed, aclAllowsSubscription(aclObj, req))
+		})
+	}
+}
+
 func consumeSubscription(ctx context.Context, sub *Subscription) <-chan subNextResult {
 	eventCh := make(chan subNextResult, 1)
 	go func() {
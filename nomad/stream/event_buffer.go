@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -234,6 +235,23 @@ type bufferLink struct {
 	// droppedCh is closed when the event is dropped from the buffer due to
 	// sizing constraints.
 	droppedCh chan struct{}
+
+	// load, if set, is called once to produce the next item the first time it
+	// is read, instead of it being published. It is used to read events from
+	// the event log as subscribers reach them.
+	load     func() (*bufferItem, error)
+	loadOnce sync.Once
+}
+
+// loadNext sets the next item to the one returned by the load function, or to
+// an item with its error.
+func (l *bufferLink) loadNext() {
+	next, err := l.load()
+	if err != nil {
+		next = &bufferItem{Err: err, link: &bufferLink{}}
+	}
+	l.next.Store(next)
+	close(l.nextCh)
 }
 
 // newBufferItem returns a blank buffer item with a link and chan ready to have
@@ -252,6 +270,10 @@ func newBufferItem(events *structs.Events) *bufferItem {
 // Next return the next buffer item in the buffer. It may block until ctx is
 // cancelled or until the next item is published.
 func (i *bufferItem) Next(ctx context.Context, forceClose <-chan struct{}) (*bufferItem, error) {
+	if i.link.load != nil {
+		i.link.loadOnce.Do(i.link.loadNext)
+	}
+
 	// See if there is already a next value, block if so. Note we don't rely on
 	// state change (chan nil) as that's not threadsafe but detecting close is.
 	select {
@@ -287,6 +309,10 @@ func (i *bufferItem) Next(ctx context.Context, forceClose <-chan struct{}) (*buf
 // NextNoBlock returns the next item in the buffer without blocking. If it
 // reaches the most recent item it will return nil.
 func (i *bufferItem) NextNoBlock() *bufferItem {
+	if i.link.load != nil {
+		i.link.loadOnce.Do(i.link.loadNext)
+	}
+
 	nextRaw := i.link.next.Load()
 	if nextRaw == nil {
 		return nil
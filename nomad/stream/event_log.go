@@ -0,0 +1,504 @@
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-msgpack/codec"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// eventLogPruneInterval is how often events older than the retention age
+	// are pruned when no new events are appended.
+	eventLogPruneInterval = time.Minute
+
+	// eventLogPageSize is the number of raft indexes whose events are read
+	// from the event log at once when serving subscriptions.
+	eventLogPageSize = 64
+
+	// eventLogQueueSize is the number of raft indexes whose events can wait to
+	// be written to the event log before further events are dropped.
+	eventLogQueueSize = 1024
+)
+
+var (
+	// eventLogBucket stores one record per raft index, keyed by the big
+	// endian index.
+	eventLogBucket = []byte("events")
+
+	// eventLogMetaBucket stores the metadata of the event log.
+	eventLogMetaBucket = []byte("meta")
+
+	// eventLogPrunedIndexKey is the key of the highest index which has been
+	// pruned from the event log.
+	eventLogPrunedIndexKey = []byte("pruned_index")
+)
+
+// ErrIndexTooOld is returned when subscribing at an index which is neither in
+// the event buffer nor retained by the event log anymore.
+var ErrIndexTooOld = errors.New("requested index is too old")
+
+// EventLogConfig is the configuration of an EventLog.
+type EventLogConfig struct {
+	// Path is the path of the database file the event log is written to.
+	Path string
+
+	// RetentionCount is the number of raft indexes whose events are
+	// retained. Zero retains events of any number of indexes.
+	RetentionCount int
+
+	// RetentionAge is how long events are retained. Zero retains events
+	// regardless of their age.
+	RetentionAge time.Duration
+
+	Logger hclog.Logger
+}
+
+// EventLog is an on-disk log of the events published to an EventBroker. It
+// outlives the event broker of a state store, so that subscribers can resume
+// at an index which is older than the in-memory event buffer, including after
+// a server restart or a snapshot restore.
+//
+// Records of the log are the events of a single raft index, stored as the JSON
+// they are streamed to subscribers as. Events read from the log therefore
+// have a json.RawMessage payload.
+type EventLog struct {
+	db             *bbolt.DB
+	retentionCount int
+	retentionAge   time.Duration
+	logger         hclog.Logger
+
+	// l serializes writes to the log and protects count and lastIndex
+	l         sync.Mutex
+	count     int
+	lastIndex uint64
+
+	shutdownCh   chan struct{}
+	shutdownOnce sync.Once
+}
+
+// NewEventLog opens or creates the event log at the configured path.
+func NewEventLog(cfg EventLogConfig) (*EventLog, error) {
+	if cfg.Logger == nil {
+		cfg.Logger = hclog.NewNullLogger()
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create event log directory: %v", err)
+	}
+
+	db, err := bbolt.Open(cfg.Path, 0600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event log: %v", err)
+	}
+
+	l := &EventLog{
+		db:             db,
+		retentionCount: cfg.RetentionCount,
+		retentionAge:   cfg.RetentionAge,
+		logger:         cfg.Logger.Named("event_log"),
+		shutdownCh:     make(chan struct{}),
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(eventLogMetaBucket); err != nil {
+			return err
+		}
+		b, err := tx.CreateBucketIfNotExists(eventLogBucket)
+		if err != nil {
+			return err
+		}
+
+		l.count = b.Stats().KeyN
+		if k, _ := b.Cursor().Last(); k != nil {
+			l.lastIndex = binary.BigEndian.Uint64(k)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize event log: %v", err)
+	}
+
+	if l.retentionAge > 0 {
+		go l.pruneLoop()
+	}
+
+	return l, nil
+}
+
+// Close closes the event log.
+func (l *EventLog) Close() error {
+	l.shutdownOnce.Do(func() { close(l.shutdownCh) })
+	return l.db.Close()
+}
+
+// Append writes the events of one or more raft indexes to the log in a single
+// transaction and prunes the events which are no longer retained. Events at
+// indexes which are already logged, such as those of raft logs replayed when a
+// server starts, are skipped.
+func (l *EventLog) Append(batch []*structs.Events) error {
+	l.l.Lock()
+	defer l.l.Unlock()
+
+	now := time.Now()
+	count, lastIndex := l.count, l.lastIndex
+
+	err := l.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(eventLogBucket)
+		for _, events := range batch {
+			if events.Index <= lastIndex {
+				continue
+			}
+
+			value, err := encodeEventLogRecord(now, events)
+			if err != nil {
+				return err
+			}
+			if err := b.Put(eventLogKey(events.Index), value); err != nil {
+				return err
+			}
+			count++
+			lastIndex = events.Index
+		}
+
+		var err error
+		count, err = l.prune(tx, now, count)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	l.count, l.lastIndex = count, lastIndex
+	return nil
+}
+
+// LastIndex returns the highest raft index whose events have been logged.
+func (l *EventLog) LastIndex() uint64 {
+	l.l.Lock()
+	defer l.l.Unlock()
+	return l.lastIndex
+}
+
+// Range returns the events of the indexes from the given index up to but not
+// including the before index, reading at most limit indexes. A before index of
+// zero returns the events up to the last logged index, and a limit of zero
+// returns the events of every index in the range. ErrIndexTooOld is returned
+// if the events of the index have been pruned already.
+func (l *EventLog) Range(index, before uint64, limit int) ([]*structs.Events, error) {
+	var out []*structs.Events
+	err := l.db.View(func(tx *bbolt.Tx) error {
+		if pruned := eventLogPrunedIndex(tx); index <= pruned {
+			return fmt.Errorf("%w: events up to index %d are no longer retained", ErrIndexTooOld, pruned)
+		}
+
+		c := tx.Bucket(eventLogBucket).Cursor()
+		for k, v := c.Seek(eventLogKey(index)); k != nil; k, v = c.Next() {
+			if limit != 0 && len(out) == limit {
+				break
+			}
+			if before != 0 && binary.BigEndian.Uint64(k) >= before {
+				break
+			}
+
+			events, err := decodeEventLogRecord(v)
+			if err != nil {
+				return err
+			}
+			out = append(out, events)
+		}
+		return nil
+	})
+	return out, err
+}
+
+// Skip marks the events up to the given index as no longer retained, so that
+// subscribers can't resume at an index whose events may not have been logged.
+func (l *EventLog) Skip(index uint64) error {
+	l.l.Lock()
+	defer l.l.Unlock()
+
+	return l.db.Update(func(tx *bbolt.Tx) error {
+		if index <= eventLogPrunedIndex(tx) {
+			return nil
+		}
+		return tx.Bucket(eventLogMetaBucket).Put(eventLogPrunedIndexKey, eventLogKey(index))
+	})
+}
+
+// pruneLoop periodically prunes events older than the retention age, so that
+// they are dropped even if no new events are published.
+func (l *EventLog) pruneLoop() {
+	ticker := time.NewTicker(eventLogPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+
+		if err := l.Append(nil); err != nil {
+			l.logger.Warn("failed to prune event log", "error", err)
+		}
+	}
+}
+
+// prune deletes the oldest records of the log until it is within the
+// retention count and age, and returns the number of records left.
+func (l *EventLog) prune(tx *bbolt.Tx, now time.Time, count int) (int, error) {
+	b := tx.Bucket(eventLogBucket)
+
+	var keys [][]byte
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		expired := l.retentionAge > 0 && now.Sub(eventLogRecordTime(v)) > l.retentionAge
+		if (l.retentionCount == 0 || count-len(keys) <= l.retentionCount) && !expired {
+			break
+		}
+		// Keys are only valid for the life of the transaction and must be
+		// copied to be used after modifying the bucket
+		keys = append(keys, append([]byte(nil), k...))
+	}
+	if len(keys) == 0 {
+		return count, nil
+	}
+
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+
+	pruned := keys[len(keys)-1]
+	if err := tx.Bucket(eventLogMetaBucket).Put(eventLogPrunedIndexKey, pruned); err != nil {
+		return 0, err
+	}
+	return count - len(keys), nil
+}
+
+// eventLogWriter writes published events to an EventLog in the background,
+// so that writing to disk never blocks publishing events to the event buffer.
+// Events are dropped when the queue of events to write is full, and the log is
+// then marked as missing the events up to the last dropped index.
+type eventLogWriter struct {
+	log    *EventLog
+	queue  chan *structs.Events
+	logger hclog.Logger
+
+	// l protects the fields below, cond is broadcast when written changes
+	l    sync.Mutex
+	cond *sync.Cond
+
+	// queued and written count the events sent to and written from the
+	// queue
+	queued  uint64
+	written uint64
+
+	// dropped is the highest index of the dropped events which hasn't been
+	// skipped in the log yet
+	dropped uint64
+
+	// stopped is set once the writer stops writing events
+	stopped bool
+
+	// skipLock serializes marking dropped events in the log, so that a flush
+	// returns only once they are marked
+	skipLock sync.Mutex
+}
+
+func newEventLogWriter(log *EventLog, logger hclog.Logger) *eventLogWriter {
+	w := &eventLogWriter{
+		log:    log,
+		queue:  make(chan *structs.Events, eventLogQueueSize),
+		logger: logger,
+	}
+	w.cond = sync.NewCond(&w.l)
+	return w
+}
+
+// Enqueue queues the events to be written to the log, or drops them if the
+// queue is full. It must not be called concurrently.
+func (w *eventLogWriter) Enqueue(events *structs.Events) {
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	select {
+	case w.queue <- events:
+		w.queued++
+	default:
+		metrics.IncrCounter([]string{"nomad", "event_broker", "event_log", "dropped"}, 1)
+		w.dropped = events.Index
+	}
+}
+
+// Flush blocks until the events queued so far have been written to the log,
+// or the writer is stopped.
+func (w *eventLogWriter) Flush() {
+	w.l.Lock()
+	queued := w.queued
+	for w.written < queued && !w.stopped {
+		w.cond.Wait()
+	}
+	w.l.Unlock()
+
+	w.skipDropped()
+}
+
+// run writes the queued events to the log until the context is done, and
+// then writes the events still queued. Pending events are written in one
+// transaction.
+func (w *eventLogWriter) run(ctx context.Context) {
+	defer func() {
+		w.l.Lock()
+		defer w.l.Unlock()
+		w.stopped = true
+		w.cond.Broadcast()
+	}()
+
+	for {
+		var batch []*structs.Events
+		select {
+		case <-ctx.Done():
+			// Events published before a snapshot restore are logged before
+			// those of the new event broker
+			select {
+			case events := <-w.queue:
+				batch = append(batch, events)
+			default:
+				return
+			}
+		case events := <-w.queue:
+			batch = append(batch, events)
+		}
+
+	DRAIN:
+		for len(batch) < eventLogQueueSize {
+			select {
+			case events := <-w.queue:
+				batch = append(batch, events)
+			default:
+				break DRAIN
+			}
+		}
+
+		if err := w.log.Append(batch); err != nil {
+			w.logger.Error("failed to write events to the event log", "error", err)
+		}
+		w.skipDropped()
+
+		w.l.Lock()
+		w.written += uint64(len(batch))
+		w.cond.Broadcast()
+		w.l.Unlock()
+	}
+}
+
+// skipDropped marks the log as missing the events which have been dropped.
+func (w *eventLogWriter) skipDropped() {
+	w.skipLock.Lock()
+	defer w.skipLock.Unlock()
+
+	w.l.Lock()
+	dropped := w.dropped
+	w.dropped = 0
+	w.l.Unlock()
+
+	if dropped == 0 {
+		return
+	}
+
+	w.logger.Warn("event log queue is full, events were dropped", "last_dropped_index", dropped)
+	if err := w.log.Skip(dropped); err != nil {
+		w.logger.Error("failed to mark dropped events in the event log", "error", err)
+	}
+}
+
+func eventLogPrunedIndex(tx *bbolt.Tx) uint64 {
+	v := tx.Bucket(eventLogMetaBucket).Get(eventLogPrunedIndexKey)
+	if len(v) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(v)
+}
+
+func eventLogKey(index uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, index)
+	return k
+}
+
+// encodeEventLogRecord encodes the events of a raft index as the time they
+// were logged at followed by their JSON.
+func encodeEventLogRecord(now time.Time, events *structs.Events) ([]byte, error) {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, now.UnixNano())
+	if err := codec.NewEncoder(&buf, structs.JsonHandleWithExtensions).Encode(events); err != nil {
+		return nil, fmt.Errorf("failed to encode events: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func eventLogRecordTime(v []byte) time.Time {
+	if len(v) < 8 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(v[:8])))
+}
+
+// loggedEvents mirrors structs.Events, but keeps the payloads as the JSON
+// they were logged as.
+type loggedEvents struct {
+	Index  uint64
+	Events []struct {
+		Topic      structs.Topic
+		Type       string
+		Key        string
+		Namespace  string
+		FilterKeys []string
+		Index      uint64
+		Payload    json.RawMessage
+	}
+}
+
+func decodeEventLogRecord(v []byte) (*structs.Events, error) {
+	if len(v) < 8 {
+		return nil, errors.New("invalid event log record")
+	}
+
+	var logged loggedEvents
+	if err := json.Unmarshal(v[8:], &logged); err != nil {
+		return nil, fmt.Errorf("failed to decode events: %v", err)
+	}
+
+	out := &structs.Events{
+		Index:  logged.Index,
+		Events: make([]structs.Event, len(logged.Events)),
+	}
+	for i, e := range logged.Events {
+		out.Events[i] = structs.Event{
+			Topic:      e.Topic,
+			Type:       e.Type,
+			Key:        e.Key,
+			Namespace:  e.Namespace,
+			FilterKeys: e.FilterKeys,
+			Index:      e.Index,
+			Payload:    e.Payload,
+		}
+	}
+	return out, nil
+}
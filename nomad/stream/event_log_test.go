@@ -0,0 +1,317 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/stretchr/testify/require"
+)
+
+func testEventLog(t *testing.T, cfg EventLogConfig) *EventLog {
+	t.Helper()
+
+	if cfg.Path == "" {
+		cfg.Path = filepath.Join(t.TempDir(), "events.db")
+	}
+	l, err := NewEventLog(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+func testLoggedEvents(index uint64) *structs.Events {
+	return &structs.Events{
+		Index: index,
+		Events: []structs.Event{{
+			Topic:   "Test",
+			Key:     "sub-key",
+			Index:   index,
+			Payload: map[string]int{"Value": int(index)},
+		}},
+	}
+}
+
+func TestEventLog_AppendRange(t *testing.T) {
+	ci.Parallel(t)
+
+	path := filepath.Join(t.TempDir(), "events.db")
+	l := testEventLog(t, EventLogConfig{Path: path, RetentionCount: 5})
+
+	for i := uint64(1); i <= 10; i++ {
+		require.NoError(t, l.Append([]*structs.Events{testLoggedEvents(i)}))
+	}
+
+	// Only the most recent indexes are retained
+	out, err := l.Range(6, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 5)
+	require.Equal(t, uint64(6), out[0].Index)
+	require.Equal(t, uint64(10), out[4].Index)
+
+	// Payloads are returned as the JSON they were logged as
+	require.Equal(t, "Test", string(out[0].Events[0].Topic))
+	require.JSONEq(t, `{"Value": 6}`, string(out[0].Events[0].Payload.(json.RawMessage)))
+
+	out, err = l.Range(7, 9, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.Equal(t, uint64(7), out[0].Index)
+	require.Equal(t, uint64(8), out[1].Index)
+
+	// At most limit indexes are read
+	out, err = l.Range(6, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.Equal(t, uint64(6), out[0].Index)
+	require.Equal(t, uint64(7), out[1].Index)
+
+	// Pruned indexes are too old
+	_, err = l.Range(5, 0, 0)
+	require.True(t, errors.Is(err, ErrIndexTooOld))
+
+	// Replayed indexes are not logged twice
+	require.NoError(t, l.Append([]*structs.Events{testLoggedEvents(9), testLoggedEvents(11)}))
+	out, err = l.Range(9, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+
+	// The log is retained across restarts
+	require.NoError(t, l.Close())
+	l = testEventLog(t, EventLogConfig{Path: path, RetentionCount: 5})
+	out, err = l.Range(7, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 5)
+	require.Equal(t, uint64(11), out[4].Index)
+
+	_, err = l.Range(6, 0, 0)
+	require.True(t, errors.Is(err, ErrIndexTooOld))
+}
+
+func TestEventLog_RetentionAge(t *testing.T) {
+	ci.Parallel(t)
+
+	l := testEventLog(t, EventLogConfig{RetentionAge: 50 * time.Millisecond})
+	require.NoError(t, l.Append([]*structs.Events{testLoggedEvents(1), testLoggedEvents(2)}))
+
+	out, err := l.Range(1, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, l.Append([]*structs.Events{testLoggedEvents(3)}))
+
+	out, err = l.Range(3, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+
+	_, err = l.Range(2, 0, 0)
+	require.True(t, errors.Is(err, ErrIndexTooOld))
+}
+
+func TestEventBroker_SubscribeEventLog(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "events.db")
+	eventLog := testEventLog(t, EventLogConfig{Path: path, RetentionCount: 20})
+
+	broker, err := NewEventBroker(ctx, nil, EventBrokerCfg{EventBufferSize: 2, EventLog: eventLog})
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 10; i++ {
+		broker.Publish(testLoggedEvents(i))
+	}
+	require.Eventually(t, func() bool {
+		out, err := eventLog.Range(10, 0, 0)
+		return err == nil && len(out) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Indexes older than the buffer are served from the log, followed by the
+	// events of the buffer
+	requireIndexes := func(sub *Subscription, from, to uint64) {
+		t.Helper()
+		for i := from; i <= to; i++ {
+			ctx, cancel := context.WithTimeout(ctx, time.Second)
+			events, err := sub.Next(ctx)
+			cancel()
+			require.NoError(t, err)
+			require.Equal(t, i, events.Index)
+		}
+	}
+
+	sub, err := broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  3,
+	})
+	require.NoError(t, err)
+	requireIndexes(sub, 3, 10)
+
+	broker.Publish(testLoggedEvents(11))
+	requireIndexes(sub, 11, 11)
+	sub.Unsubscribe()
+	require.Eventually(t, func() bool {
+		return eventLog.LastIndex() == 11
+	}, time.Second, 10*time.Millisecond)
+
+	// A new broker, such as the one of a restarted server, serves the events
+	// of the log before any are published to its buffer
+	newCtx, newCancel := context.WithCancel(ctx)
+	defer newCancel()
+	broker, err = NewEventBroker(newCtx, nil, EventBrokerCfg{EventBufferSize: 2, EventLog: eventLog})
+	require.NoError(t, err)
+
+	sub, err = broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  8,
+	})
+	require.NoError(t, err)
+	requireIndexes(sub, 8, 11)
+
+	// Replayed events are not served twice
+	broker.Publish(testLoggedEvents(11))
+	broker.Publish(testLoggedEvents(12))
+	requireIndexes(sub, 12, 12)
+}
+
+func TestEventBroker_SubscribeEventLog_TooOld(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventLog := testEventLog(t, EventLogConfig{RetentionCount: 3})
+	broker, err := NewEventBroker(ctx, nil, EventBrokerCfg{EventBufferSize: 2, EventLog: eventLog})
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 10; i++ {
+		broker.Publish(testLoggedEvents(i))
+	}
+	require.Eventually(t, func() bool {
+		out, err := eventLog.Range(10, 0, 0)
+		return err == nil && len(out) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  5,
+	})
+	require.True(t, errors.Is(err, ErrIndexTooOld))
+
+	sub, err := broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  8,
+	})
+	require.NoError(t, err)
+	sub.Unsubscribe()
+}
+
+func TestEventBroker_SubscribeEventLog_Paged(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventLog := testEventLog(t, EventLogConfig{})
+	broker, err := NewEventBroker(ctx, nil, EventBrokerCfg{EventBufferSize: 2, EventLog: eventLog})
+	require.NoError(t, err)
+
+	last := uint64(3*eventLogPageSize + 10)
+	for i := uint64(1); i <= last; i++ {
+		broker.Publish(testLoggedEvents(i))
+	}
+	require.Eventually(t, func() bool {
+		return eventLog.LastIndex() == last
+	}, time.Second, 10*time.Millisecond)
+
+	sub, err := broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  1,
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// Only the first page of events is read from the log when subscribing
+	item := sub.currentItem
+	var linked int
+	for {
+		next := item.link.next.Load()
+		if next == nil {
+			break
+		}
+		item = next.(*bufferItem)
+		linked++
+	}
+	require.Equal(t, eventLogPageSize, linked)
+	require.NotNil(t, item.link.load)
+
+	// The following pages are read as they are reached, followed by the
+	// events of the buffer
+	for i := uint64(1); i <= last; i++ {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		events, err := sub.Next(ctx)
+		cancel()
+		require.NoError(t, err)
+		require.Equal(t, i, events.Index)
+	}
+}
+
+func TestEventBroker_SubscribeEventLog_Dropped(t *testing.T) {
+	ci.Parallel(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventLog := testEventLog(t, EventLogConfig{})
+	broker, err := NewEventBroker(ctx, nil, EventBrokerCfg{EventBufferSize: 2, EventLog: eventLog})
+	require.NoError(t, err)
+
+	// Events are published to the buffer while writes to the log are blocked,
+	// and dropped once the queue and the pending write of events are full
+	eventLog.l.Lock()
+	last := uint64(3 * eventLogQueueSize)
+	for i := uint64(1); i <= last; i++ {
+		broker.Publish(testLoggedEvents(i))
+	}
+	require.Eventually(t, func() bool {
+		return broker.eventBuf.Tail().Events.Index == last
+	}, time.Second, 10*time.Millisecond)
+	eventLog.l.Unlock()
+
+	// Subscribers can't resume before the dropped events
+	_, err = broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  1,
+	})
+	require.True(t, errors.Is(err, ErrIndexTooOld))
+
+	// Events published after the dropped ones are logged
+	for i := last + 1; i <= last+5; i++ {
+		broker.Publish(testLoggedEvents(i))
+	}
+	require.Eventually(t, func() bool {
+		return broker.eventBuf.Tail().Events.Index == last+5
+	}, time.Second, 10*time.Millisecond)
+
+	sub, err := broker.Subscribe(&SubscribeRequest{
+		Topics: map[structs.Topic][]string{"*": {"*"}},
+		Index:  last + 1,
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for i := last + 1; i <= last+5; i++ {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		events, err := sub.Next(ctx)
+		cancel()
+		require.NoError(t, err)
+		require.Equal(t, i, events.Index)
+	}
+}
@@ -2,9 +2,12 @@ package stream
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"sync/atomic"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -24,12 +27,19 @@ const (
 var ErrSubscriptionClosed = errors.New("subscription closed by server, client should resubscribe")
 var ErrACLInvalid = errors.New("Provided ACL token is invalid for requested topics")
 
+// ErrInvalidFilter is returned when subscribing with a filter expression
+// which can't be parsed.
+var ErrInvalidFilter = errors.New("failed to read filter expression")
+
 type Subscription struct {
 	// state must be accessed atomically 0 means open, 1 means closed with reload
 	state uint32
 
 	req *SubscribeRequest
 
+	// evaluator is the compiled filter expression of the request, if any.
+	evaluator *bexpr.Evaluator
+
 	// currentItem stores the current buffer item we are on. It
 	// is mutated by calls to Next.
 	currentItem *bufferItem
@@ -38,6 +48,11 @@ type Subscription struct {
 	// EventBroker to cancel Next().
 	forceClosed chan struct{}
 
+	// lastIndex is the index of the last buffer item the subscription moved
+	// to. Events served from the event log may also have been appended to the
+	// buffer while the log was being read, and are skipped there.
+	lastIndex uint64
+
 	// unsub is a function set by EventBroker that is called to free resources
 	// when the subscription is no longer needed.
 	// It must be safe to call the function from multiple goroutines and the function
@@ -52,6 +67,11 @@ type SubscribeRequest struct {
 
 	Topics map[structs.Topic][]string
 
+	// Filter is an optional go-bexpr expression evaluated against each event
+	// matching the topics and namespace of the request. Only the events the
+	// expression matches are returned.
+	Filter string
+
 	// StartExactlyAtIndex specifies if a subscription needs to
 	// start exactly at the requested Index. If set to false,
 	// the closest index in the buffer will be returned if there is not
@@ -59,15 +79,29 @@ type SubscribeRequest struct {
 	StartExactlyAtIndex bool
 }
 
-func newSubscription(req *SubscribeRequest, item *bufferItem, unsub func()) *Subscription {
+func newSubscription(req *SubscribeRequest, evaluator *bexpr.Evaluator, item *bufferItem, unsub func()) *Subscription {
 	return &Subscription{
 		forceClosed: make(chan struct{}),
 		req:         req,
+		evaluator:   evaluator,
 		currentItem: item,
 		unsub:       unsub,
 	}
 }
 
+// newFilterEvaluator compiles the filter expression of a subscription
+// request. It returns nil if the request has no filter.
+func newFilterEvaluator(req *SubscribeRequest) (*bexpr.Evaluator, error) {
+	if req.Filter == "" {
+		return nil, nil
+	}
+	evaluator, err := bexpr.CreateEvaluator(req.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	return evaluator, nil
+}
+
 func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 	if atomic.LoadUint32(&s.state) == subscriptionStateClosed {
 		return structs.Events{}, ErrSubscriptionClosed
@@ -82,8 +116,11 @@ func (s *Subscription) Next(ctx context.Context) (structs.Events, error) {
 			return structs.Events{}, err
 		}
 		s.currentItem = next
+		if s.skip(next) {
+			continue
+		}
 
-		events := filter(s.req, next.Events.Events)
+		events := filterExpr(s.evaluator, filter(s.req, next.Events.Events))
 		if len(events) == 0 {
 			continue
 		}
@@ -101,9 +138,15 @@ func (s *Subscription) NextNoBlock() ([]structs.Event, error) {
 		if next == nil {
 			return nil, nil
 		}
+		if next.Err != nil {
+			return nil, next.Err
+		}
 		s.currentItem = next
+		if s.skip(next) {
+			continue
+		}
 
-		events := filter(s.req, next.Events.Events)
+		events := filterExpr(s.evaluator, filter(s.req, next.Events.Events))
 		if len(events) == 0 {
 			continue
 		}
@@ -111,6 +154,19 @@ func (s *Subscription) NextNoBlock() ([]structs.Event, error) {
 	}
 }
 
+// skip returns whether the events of a buffer item have already been served
+// to the subscription.
+func (s *Subscription) skip(item *bufferItem) bool {
+	if item.Events.Index == 0 {
+		return false
+	}
+	if item.Events.Index <= s.lastIndex {
+		return true
+	}
+	s.lastIndex = item.Events.Index
+	return false
+}
+
 func (s *Subscription) Unsubscribe() {
 	s.unsub()
 }
@@ -163,6 +219,35 @@ func filter(req *SubscribeRequest, events []structs.Event) []structs.Event {
 	return result
 }
 
+// filterExpr returns the events matched by the filter expression of a
+// subscription. Events the expression can't be evaluated against, such as
+// events missing a field the expression refers to, don't match.
+func filterExpr(evaluator *bexpr.Evaluator, events []structs.Event) []structs.Event {
+	if evaluator == nil || len(events) == 0 {
+		return events
+	}
+
+	var result []structs.Event
+	for _, event := range events {
+		datum := event
+
+		// Events read from the event log keep their payloads as JSON, which
+		// is decoded so that the expression can select its fields
+		if raw, ok := event.Payload.(json.RawMessage); ok {
+			var payload map[string]interface{}
+			if err := json.Unmarshal(raw, &payload); err != nil {
+				continue
+			}
+			datum.Payload = payload
+		}
+
+		if ok, err := evaluator.Evaluate(datum); ok && err == nil {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
 func eventMatchesKey(event structs.Event, key string) bool {
 	if event.Key == key {
 		return true
@@ -1,9 +1,11 @@
 package stream
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/stretchr/testify/require"
 )
@@ -208,3 +210,49 @@ func TestFilter_FilterKeys(t *testing.T) {
 
 	require.Equal(t, 1, cap(actual))
 }
+
+func TestFilterExpr(t *testing.T) {
+	ci.Parallel(t)
+
+	failed := mock.Alloc()
+	failed.JobID = "web-frontend"
+	failed.ClientStatus = structs.AllocClientStatusFailed
+
+	running := mock.Alloc()
+	running.JobID = "web-frontend"
+	running.ClientStatus = structs.AllocClientStatusRunning
+
+	other := mock.Alloc()
+	other.JobID = "batch"
+	other.ClientStatus = structs.AllocClientStatusFailed
+
+	// Events read from the event log carry their payload as JSON
+	logged, err := json.Marshal(&structs.AllocationEvent{Allocation: failed})
+	require.NoError(t, err)
+
+	events := []structs.Event{
+		{Topic: structs.TopicAllocation, Key: failed.ID, Payload: &structs.AllocationEvent{Allocation: failed}},
+		{Topic: structs.TopicAllocation, Key: running.ID, Payload: &structs.AllocationEvent{Allocation: running}},
+		{Topic: structs.TopicAllocation, Key: other.ID, Payload: &structs.AllocationEvent{Allocation: other}},
+		{Topic: structs.TopicNode, Key: "node", Payload: &structs.NodeStreamEvent{Node: mock.Node()}},
+		{Topic: structs.TopicAllocation, Key: failed.ID, Payload: json.RawMessage(logged)},
+	}
+
+	evaluator, err := newFilterEvaluator(&SubscribeRequest{
+		Filter: `Payload.Allocation.ClientStatus == "failed" and Payload.Allocation.JobID matches "^web-"`,
+	})
+	require.NoError(t, err)
+
+	actual := filterExpr(evaluator, events)
+	require.Equal(t, []structs.Event{events[0], events[4]}, actual)
+
+	// No filter returns all events
+	evaluator, err = newFilterEvaluator(&SubscribeRequest{})
+	require.NoError(t, err)
+	require.Nil(t, evaluator)
+	require.Equal(t, events, filterExpr(evaluator, events))
+
+	// Invalid filters are rejected
+	_, err = newFilterEvaluator(&SubscribeRequest{Filter: `Payload ==`})
+	require.ErrorIs(t, err, ErrInvalidFilter)
+}
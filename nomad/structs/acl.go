@@ -5,12 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"golang.org/x/crypto/blake2b"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -77,6 +82,101 @@ const (
 	// Args: ACLRoleByNameRequest
 	// Reply: ACLRoleByNameResponse
 	ACLGetRoleByNameRPCMethod = "ACL.GetRoleByName"
+
+	// ACLUpsertAuthMethodsRPCMethod is the RPC method for batch creating or
+	// modifying auth methods.
+	//
+	// Args: ACLAuthMethodsUpsertRequest
+	// Reply: ACLAuthMethodsUpsertResponse
+	ACLUpsertAuthMethodsRPCMethod = "ACL.UpsertAuthMethods"
+
+	// ACLDeleteAuthMethodsRPCMethod is the RPC method for batch deleting auth
+	// methods.
+	//
+	// Args: ACLAuthMethodsDeleteRequest
+	// Reply: ACLAuthMethodsDeleteResponse
+	ACLDeleteAuthMethodsRPCMethod = "ACL.DeleteAuthMethods"
+
+	// ACLListAuthMethodsRPCMethod is the RPC method for listing auth methods.
+	//
+	// Args: ACLAuthMethodListRequest
+	// Reply: ACLAuthMethodListResponse
+	ACLListAuthMethodsRPCMethod = "ACL.ListAuthMethods"
+
+	// ACLGetAuthMethodRPCMethod is the RPC method for detailing an individual
+	// auth method using its name.
+	//
+	// Args: ACLAuthMethodGetRequest
+	// Reply: ACLAuthMethodGetResponse
+	ACLGetAuthMethodRPCMethod = "ACL.GetAuthMethod"
+
+	// ACLGetAuthMethodsRPCMethod is the RPC method for getting multiple auth
+	// methods using their names. This is an internal only RPC endpoint and
+	// used by the auth method replication process.
+	//
+	// Args: ACLAuthMethodsGetRequest
+	// Reply: ACLAuthMethodsGetResponse
+	ACLGetAuthMethodsRPCMethod = "ACL.GetAuthMethods"
+
+	// ACLUpsertBindingRulesRPCMethod is the RPC method for batch creating or
+	// modifying binding rules.
+	//
+	// Args: ACLBindingRulesUpsertRequest
+	// Reply: ACLBindingRulesUpsertResponse
+	ACLUpsertBindingRulesRPCMethod = "ACL.UpsertBindingRules"
+
+	// ACLDeleteBindingRulesRPCMethod is the RPC method for batch deleting
+	// binding rules.
+	//
+	// Args: ACLBindingRulesDeleteRequest
+	// Reply: ACLBindingRulesDeleteResponse
+	ACLDeleteBindingRulesRPCMethod = "ACL.DeleteBindingRules"
+
+	// ACLListBindingRulesRPCMethod is the RPC method for listing binding
+	// rules.
+	//
+	// Args: ACLBindingRulesListRequest
+	// Reply: ACLBindingRulesListResponse
+	ACLListBindingRulesRPCMethod = "ACL.ListBindingRules"
+
+	// ACLGetBindingRuleRPCMethod is the RPC method for detailing an
+	// individual binding rule using its ID.
+	//
+	// Args: ACLBindingRuleRequest
+	// Reply: ACLBindingRuleResponse
+	ACLGetBindingRuleRPCMethod = "ACL.GetBindingRule"
+
+	// ACLGetBindingRulesRPCMethod is the RPC method for getting multiple
+	// binding rules using their IDs. This is an internal only RPC endpoint
+	// and used by the binding rule replication process.
+	//
+	// Args: ACLBindingRulesRequest
+	// Reply: ACLBindingRulesResponse
+	ACLGetBindingRulesRPCMethod = "ACL.GetBindingRules"
+
+	// ACLOIDCAuthURLRPCMethod is the RPC method for starting the OIDC login
+	// workflow. It generates the OIDC provider URL which will be used for
+	// user authentication.
+	//
+	// Args: ACLOIDCAuthURLRequest
+	// Reply: ACLOIDCAuthURLResponse
+	ACLOIDCAuthURLRPCMethod = "ACL.OIDCAuthURL"
+
+	// ACLOIDCCompleteAuthRPCMethod is the RPC method for completing the OIDC
+	// login workflow. It exchanges the OIDC provider token for a Nomad ACL
+	// token with roles as defined within the remote provider.
+	//
+	// Args: ACLOIDCCompleteAuthRequest
+	// Reply: ACLLoginResponse
+	ACLOIDCCompleteAuthRPCMethod = "ACL.OIDCCompleteAuth"
+
+	// ACLLoginRPCMethod is the RPC method for logging in with a JWT auth
+	// method. It exchanges a JWT signed by a trusted issuer for a Nomad ACL
+	// token with the roles and policies bound to its claims.
+	//
+	// Args: ACLLoginRequest
+	// Reply: ACLLoginResponse
+	ACLLoginRPCMethod = "ACL.Login"
 )
 
 const (
@@ -89,11 +189,18 @@ const (
 
 	// maxACLRoleDescriptionLength limits an ACL roles description length.
 	maxACLRoleDescriptionLength = 256
+
+	// maxACLBindingRuleDescriptionLength limits an ACL binding rules
+	// description length.
+	maxACLBindingRuleDescriptionLength = 256
 )
 
 var (
 	// validACLRoleName is used to validate an ACL role name.
 	validACLRoleName = regexp.MustCompile("^[a-zA-Z0-9-]{1,128}$")
+
+	// validACLAuthMethodName is used to validate an ACL auth method name.
+	validACLAuthMethodName = regexp.MustCompile("^[a-zA-Z0-9-]{1,128}$")
 )
 
 // ACLTokenRoleLink is used to link an ACL token to an ACL role. The ACL token
@@ -498,3 +605,882 @@ type ACLRoleByNameResponse struct {
 	ACLRole *ACLRole
 	QueryMeta
 }
+
+const (
+	// ACLAuthMethodTokenLocalityLocal is the ACLAuthMethod.TokenLocality that
+	// will generate ACL tokens which can only be used on the local cluster the
+	// request was made.
+	ACLAuthMethodTokenLocalityLocal = "local"
+
+	// ACLAuthMethodTokenLocalityGlobal is the ACLAuthMethod.TokenLocality that
+	// will generate ACL tokens which can be used on all federated clusters.
+	ACLAuthMethodTokenLocalityGlobal = "global"
+
+	// ACLAuthMethodTypeOIDC the ACLAuthMethod.Type and represents an
+	// auth-method which uses the OIDC protocol.
+	ACLAuthMethodTypeOIDC = "OIDC"
+
+	// ACLAuthMethodTypeJWT is the ACLAuthMethod.Type and represents an
+	// auth-method which validates JWTs issued by a third party, such as a CI
+	// system, against static public keys or a JWKS.
+	ACLAuthMethodTypeJWT = "JWT"
+)
+
+// ACLAuthMethod is used to capture the properties of an authentication method
+// used for single sign-on.
+type ACLAuthMethod struct {
+
+	// Name is the identifier for this auth method and is unique across all
+	// federated clusters. The name can be used to refer to the method when
+	// logging in.
+	Name string
+
+	// Type is the SSO identifier this auth method is. Either OIDC or JWT.
+	Type string
+
+	// TokenLocality defines whether the ACL tokens created by this method are
+	// local to the cluster the login was made, or global to all federated
+	// clusters.
+	TokenLocality string
+
+	// MaxTokenTTL is the maximum life of the ACL tokens generated by the
+	// method.
+	MaxTokenTTL time.Duration
+
+	// Default identifies whether this is the default auth method of its type,
+	// which is used when a login does not name a method.
+	Default bool
+
+	// Config contains the detailed configuration which is specific to the
+	// auth method type.
+	Config *ACLAuthMethodConfig
+
+	// Hash is the hashed value of the auth method and is generated using all
+	// fields above this point.
+	Hash []byte
+
+	CreateTime  time.Time
+	ModifyTime  time.Time
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// SetHash is used to compute and set the hash of the auth method. This
+// should be called every and each time a user specified field on the method
+// is changed before updating the Nomad state store.
+func (a *ACLAuthMethod) SetHash() []byte {
+
+	// Initialize a 256bit Blake2 hash (32 bytes).
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	_, _ = hash.Write([]byte(a.Name))
+	_, _ = hash.Write([]byte(a.Type))
+	_, _ = hash.Write([]byte(a.TokenLocality))
+	_, _ = hash.Write([]byte(a.MaxTokenTTL.String()))
+	_, _ = hash.Write([]byte(strconv.FormatBool(a.Default)))
+
+	if a.Config != nil {
+		_, _ = hash.Write([]byte(a.Config.OIDCDiscoveryURL))
+		_, _ = hash.Write([]byte(a.Config.OIDCClientID))
+		_, _ = hash.Write([]byte(a.Config.OIDCClientSecret))
+		for _, s := range a.Config.OIDCScopes {
+			_, _ = hash.Write([]byte(s))
+		}
+		for _, s := range a.Config.BoundAudiences {
+			_, _ = hash.Write([]byte(s))
+		}
+		for _, s := range a.Config.AllowedRedirectURIs {
+			_, _ = hash.Write([]byte(s))
+		}
+		for _, s := range a.Config.DiscoveryCaPem {
+			_, _ = hash.Write([]byte(s))
+		}
+		for _, s := range a.Config.SigningAlgs {
+			_, _ = hash.Write([]byte(s))
+		}
+		for _, s := range a.Config.JWTValidationPubKeys {
+			_, _ = hash.Write([]byte(s))
+		}
+		_, _ = hash.Write([]byte(a.Config.JWKSURL))
+		_, _ = hash.Write([]byte(a.Config.JWKSCACert))
+		_, _ = hash.Write([]byte(a.Config.BoundIssuer))
+		_, _ = hash.Write([]byte(a.Config.ClockSkewLeeway.String()))
+		for _, mappings := range []map[string]string{a.Config.ClaimMappings, a.Config.ListClaimMappings} {
+			keys := maps.Keys(mappings)
+			sort.Strings(keys)
+			for _, k := range keys {
+				_, _ = hash.Write([]byte(k))
+				_, _ = hash.Write([]byte(mappings[k]))
+			}
+			_, _ = hash.Write([]byte{0})
+		}
+	}
+
+	// Finalize the hash.
+	hashVal := hash.Sum(nil)
+
+	// Set and return the hash.
+	a.Hash = hashVal
+	return hashVal
+}
+
+// Stub converts the auth method into an ACLAuthMethodStub object.
+func (a *ACLAuthMethod) Stub() *ACLAuthMethodStub {
+	return &ACLAuthMethodStub{
+		Name:        a.Name,
+		Type:        a.Type,
+		Default:     a.Default,
+		Hash:        a.Hash,
+		CreateIndex: a.CreateIndex,
+		ModifyIndex: a.ModifyIndex,
+	}
+}
+
+// Equal performs an equality check on the two auth methods. It handles nil
+// objects.
+func (a *ACLAuthMethod) Equal(other *ACLAuthMethod) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if len(a.Hash) == 0 {
+		a.SetHash()
+	}
+	if len(other.Hash) == 0 {
+		other.SetHash()
+	}
+	return bytes.Equal(a.Hash, other.Hash)
+}
+
+// Copy creates a deep copy of the auth method. This copy can then be safely
+// modified. It handles nil objects.
+func (a *ACLAuthMethod) Copy() *ACLAuthMethod {
+	if a == nil {
+		return nil
+	}
+
+	c := new(ACLAuthMethod)
+	*c = *a
+
+	c.Hash = slices.Clone(a.Hash)
+	c.Config = a.Config.Copy()
+
+	return c
+}
+
+// Canonicalize performs basic canonicalization on the auth method object. It
+// sets the create and modify times, so copies should be taken if needed
+// before calling this function.
+func (a *ACLAuthMethod) Canonicalize() {
+	t := time.Now().UTC()
+
+	if a.CreateTime.IsZero() {
+		a.CreateTime = t
+	}
+	a.ModifyTime = t
+}
+
+// Merge merges the auth method with the existing one, filling the fields the
+// update did not set.
+func (a *ACLAuthMethod) Merge(b *ACLAuthMethod) {
+	if b == nil {
+		return
+	}
+	if a.Type == "" {
+		a.Type = b.Type
+	}
+	if a.TokenLocality == "" {
+		a.TokenLocality = b.TokenLocality
+	}
+	if a.MaxTokenTTL == 0 {
+		a.MaxTokenTTL = b.MaxTokenTTL
+	}
+	if a.Config == nil {
+		a.Config = b.Config.Copy()
+	}
+	a.CreateTime = b.CreateTime
+}
+
+// Validate returns an error if the auth method is invalid. The minimum and
+// maximum token TTLs are those of the server.
+func (a *ACLAuthMethod) Validate(minTTL, maxTTL time.Duration) error {
+	var mErr multierror.Error
+
+	if !validACLAuthMethodName.MatchString(a.Name) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid name '%s'", a.Name))
+	}
+
+	if !slices.Contains([]string{ACLAuthMethodTokenLocalityLocal, ACLAuthMethodTokenLocalityGlobal}, a.TokenLocality) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid token locality '%s'", a.TokenLocality))
+	}
+
+	if !slices.Contains([]string{ACLAuthMethodTypeOIDC, ACLAuthMethodTypeJWT}, a.Type) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid auth method type '%s'", a.Type))
+	}
+
+	if a.MaxTokenTTL < minTTL || a.MaxTokenTTL > maxTTL {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf(
+			"invalid max token TTL, should be between %s and %s", minTTL, maxTTL))
+	}
+
+	if a.Config == nil {
+		mErr.Errors = append(mErr.Errors, errors.New("missing config"))
+	} else if err := a.Config.validate(a.Type); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// TokenLocalityIsGlobal returns whether the auth method creates global ACL
+// tokens or not.
+func (a *ACLAuthMethod) TokenLocalityIsGlobal() bool {
+	return a.TokenLocality == ACLAuthMethodTokenLocalityGlobal
+}
+
+// ACLAuthMethodConfig is used to store configuration of an auth method
+type ACLAuthMethodConfig struct {
+
+	// OIDCDiscoveryURL is the OIDC provider discovery URL, from which the
+	// issuer and the endpoints of the provider are read.
+	OIDCDiscoveryURL string
+
+	// OIDCClientID and OIDCClientSecret are the credentials of Nomad with
+	// the OIDC provider.
+	OIDCClientID     string
+	OIDCClientSecret string
+
+	// OIDCScopes are the scopes requested in addition to openid.
+	OIDCScopes []string
+
+	// BoundAudiences are the audiences the ID token or JWT must be issued
+	// for. Defaults to the client ID for OIDC methods. JWTs with an audience
+	// are rejected by JWT methods without bound audiences.
+	BoundAudiences []string
+
+	// JWTValidationPubKeys are the PEM encoded public keys JWTs are
+	// validated with. Only one of JWTValidationPubKeys and JWKSURL may be set
+	// for JWT methods.
+	JWTValidationPubKeys []string
+
+	// JWKSURL is the URL of the JSON Web Key Set JWTs are validated with,
+	// which is fetched again when a JWT is signed by an unknown key. A
+	// file:// URL reads the key set from the disk of the servers instead.
+	JWKSURL string
+
+	// JWKSCACert is the PEM encoded CA certificate used to talk to the JWKS
+	// URL. The system roots are used if empty.
+	JWKSCACert string
+
+	// BoundIssuer is the issuer JWTs must be issued by, if set.
+	BoundIssuer string
+
+	// ClockSkewLeeway is the leeway given when validating the times of JWTs.
+	// Defaults to a minute.
+	ClockSkewLeeway time.Duration
+
+	// AllowedRedirectURIs are the redirect URIs logins may use.
+	AllowedRedirectURIs []string
+
+	// DiscoveryCaPem are the PEM encoded CA certificates used to talk to the
+	// OIDC provider. The system roots are used if empty.
+	DiscoveryCaPem []string
+
+	// SigningAlgs are the algorithms the ID token or JWT may be signed
+	// with. Defaults to RS256.
+	SigningAlgs []string
+
+	// ClaimMappings and ListClaimMappings map the claims of the ID token to
+	// the values and lists binding rule selectors and bind names can refer
+	// to. Claims are named by their key or by a JSON pointer such as
+	// /groups/0.
+	ClaimMappings     map[string]string
+	ListClaimMappings map[string]string
+}
+
+func (a *ACLAuthMethodConfig) validate(methodType string) error {
+	var mErr multierror.Error
+
+	if methodType == ACLAuthMethodTypeOIDC {
+		if a.OIDCDiscoveryURL == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("missing OIDC discovery URL"))
+		}
+		if a.OIDCClientID == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("missing OIDC client ID"))
+		}
+		if a.OIDCClientSecret == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("missing OIDC client secret"))
+		}
+		if len(a.AllowedRedirectURIs) == 0 {
+			mErr.Errors = append(mErr.Errors, errors.New("missing allowed redirect URIs"))
+		}
+	}
+
+	if methodType == ACLAuthMethodTypeJWT {
+		switch {
+		case len(a.JWTValidationPubKeys) == 0 && a.JWKSURL == "":
+			mErr.Errors = append(mErr.Errors, errors.New("missing JWT validation public keys or JWKS URL"))
+		case len(a.JWTValidationPubKeys) != 0 && a.JWKSURL != "":
+			mErr.Errors = append(mErr.Errors, errors.New("only one of JWT validation public keys and JWKS URL may be set"))
+		}
+		if a.ClockSkewLeeway < 0 {
+			mErr.Errors = append(mErr.Errors, errors.New("clock skew leeway can't be negative"))
+		}
+	}
+
+	// A value and a list mapping to the same name would be ambiguous in
+	// bind names.
+	for _, name := range a.ClaimMappings {
+		for _, listName := range a.ListClaimMappings {
+			if name == listName {
+				mErr.Errors = append(mErr.Errors, fmt.Errorf("claim mapping name %q used as a value and a list", name))
+			}
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Copy creates a deep copy of the auth method config. It handles nil
+// objects.
+func (a *ACLAuthMethodConfig) Copy() *ACLAuthMethodConfig {
+	if a == nil {
+		return nil
+	}
+
+	c := new(ACLAuthMethodConfig)
+	*c = *a
+
+	c.OIDCScopes = slices.Clone(a.OIDCScopes)
+	c.BoundAudiences = slices.Clone(a.BoundAudiences)
+	c.JWTValidationPubKeys = slices.Clone(a.JWTValidationPubKeys)
+	c.AllowedRedirectURIs = slices.Clone(a.AllowedRedirectURIs)
+	c.DiscoveryCaPem = slices.Clone(a.DiscoveryCaPem)
+	c.SigningAlgs = slices.Clone(a.SigningAlgs)
+	c.ClaimMappings = helper.CopyMapStringString(a.ClaimMappings)
+	c.ListClaimMappings = helper.CopyMapStringString(a.ListClaimMappings)
+
+	return c
+}
+
+// ACLAuthMethodStub is used for listing ACL auth methods
+type ACLAuthMethodStub struct {
+	Name    string
+	Type    string
+	Default bool
+
+	// Hash is the hashed value of the auth method and is generated using all
+	// fields from the full object except the create and modify times and
+	// indexes.
+	Hash []byte
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLAuthMethodListRequest is used to list auth methods
+type ACLAuthMethodListRequest struct {
+	QueryOptions
+}
+
+// ACLAuthMethodListResponse is used to list auth methods
+type ACLAuthMethodListResponse struct {
+	AuthMethods []*ACLAuthMethodStub
+	QueryMeta
+}
+
+// ACLAuthMethodGetRequest is used to query a specific auth method
+type ACLAuthMethodGetRequest struct {
+	MethodName string
+	QueryOptions
+}
+
+// ACLAuthMethodGetResponse is used to return a single auth method
+type ACLAuthMethodGetResponse struct {
+	AuthMethod *ACLAuthMethod
+	QueryMeta
+}
+
+// ACLAuthMethodsGetRequest is used to query a set of auth methods
+type ACLAuthMethodsGetRequest struct {
+	Names []string
+	QueryOptions
+}
+
+// ACLAuthMethodsGetResponse is used to return a set of auth methods
+type ACLAuthMethodsGetResponse struct {
+	AuthMethods map[string]*ACLAuthMethod
+	QueryMeta
+}
+
+// ACLAuthMethodsUpsertRequest is used to upsert a set of auth methods
+type ACLAuthMethodsUpsertRequest struct {
+	AuthMethods []*ACLAuthMethod
+	WriteRequest
+}
+
+// ACLAuthMethodsUpsertResponse is a response of the upsert ACL auth methods
+// operation
+type ACLAuthMethodsUpsertResponse struct {
+	AuthMethods []*ACLAuthMethod
+	WriteMeta
+}
+
+// ACLAuthMethodsDeleteRequest is used to delete a set of auth methods by
+// their name
+type ACLAuthMethodsDeleteRequest struct {
+	Names []string
+	WriteRequest
+}
+
+// ACLAuthMethodsDeleteResponse is a response of the delete ACL auth methods
+// operation
+type ACLAuthMethodsDeleteResponse struct {
+	WriteMeta
+}
+
+const (
+	// ACLBindingRuleBindTypeRole is the ACL binding rule bind type that only
+	// allows the binding rule to function if a role exists at login-time. The
+	// role will be specified within the ACLBindingRule.BindName parameter, and
+	// will identify whether this is an ID or Name.
+	ACLBindingRuleBindTypeRole = "role"
+
+	// ACLBindingRuleBindTypePolicy is the ACL binding rule bind type that
+	// assigns the policy named by ACLBindingRule.BindName to the token.
+	ACLBindingRuleBindTypePolicy = "policy"
+
+	// ACLBindingRuleBindTypeManagement is the ACL binding rule bind type that
+	// will generate management ACL tokens when matched.
+	ACLBindingRuleBindTypeManagement = "management"
+)
+
+// ACLBindingRule contains a direct relation to an ACLAuthMethod and
+// represents a rule to apply when logging in via the named AuthMethod. This
+// allows the transformation of OIDC provider claims, to Nomad based ACL
+// concepts such as ACL Roles and Policies.
+type ACLBindingRule struct {
+
+	// ID is an internally generated UUID for this rule and is controlled by
+	// Nomad.
+	ID string
+
+	// Description is a human-readable, operator set description that can
+	// provide additional context about the binding rule. This is an
+	// operational field.
+	Description string
+
+	// AuthMethod is the name of the auth method for which this rule applies
+	// to. This is required and the method must exist within state before the
+	// cluster administrator can create the rule.
+	AuthMethod string
+
+	// Selector is an expression that matches against verified identity
+	// attributes returned from the auth method during login. This is
+	// optional and when not set, provides a catch-all rule.
+	Selector string
+
+	// BindType adjusts how this binding rule is applied at login time. The
+	// valid values are ACLBindingRuleBindTypeRole,
+	// ACLBindingRuleBindTypePolicy and ACLBindingRuleBindTypeManagement.
+	BindType string
+
+	// BindName is the target of the binding. It can use the ${value.NAME}
+	// syntax to refer to the mapped claims of the identity. It is ignored
+	// by management bindings.
+	BindName string
+
+	// Hash is the hashed value of the binding rule and is generated using
+	// all fields from the full object except the create and modify times and
+	// indexes.
+	Hash []byte
+
+	CreateTime  time.Time
+	ModifyTime  time.Time
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// SetHash is used to compute and set the hash of the ACL binding rule. This
+// should be called every and each time a user specified field on the method
+// is changed before updating the Nomad state store.
+func (a *ACLBindingRule) SetHash() []byte {
+
+	// Initialize a 256bit Blake2 hash (32 bytes).
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	_, _ = hash.Write([]byte(a.ID))
+	_, _ = hash.Write([]byte(a.Description))
+	_, _ = hash.Write([]byte(a.AuthMethod))
+	_, _ = hash.Write([]byte(a.Selector))
+	_, _ = hash.Write([]byte(a.BindType))
+	_, _ = hash.Write([]byte(a.BindName))
+
+	// Finalize the hash.
+	hashVal := hash.Sum(nil)
+
+	// Set and return the hash.
+	a.Hash = hashVal
+	return hashVal
+}
+
+// Copy creates a deep copy of the ACL binding rule. This copy can then be
+// safely modified. It handles nil objects.
+func (a *ACLBindingRule) Copy() *ACLBindingRule {
+	if a == nil {
+		return nil
+	}
+
+	c := new(ACLBindingRule)
+	*c = *a
+	c.Hash = slices.Clone(a.Hash)
+
+	return c
+}
+
+// Equal performs an equality check on the two binding rules. It handles nil
+// objects.
+func (a *ACLBindingRule) Equal(other *ACLBindingRule) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if len(a.Hash) == 0 {
+		a.SetHash()
+	}
+	if len(other.Hash) == 0 {
+		other.SetHash()
+	}
+	return bytes.Equal(a.Hash, other.Hash)
+}
+
+// Canonicalize performs basic canonicalization on the ACL binding rule
+// object. It is important for callers to understand certain fields such as
+// ID are set if it is empty, so copies should be taken if needed before
+// calling this function.
+func (a *ACLBindingRule) Canonicalize() {
+	t := time.Now().UTC()
+
+	if a.ID == "" {
+		a.ID = uuid.Generate()
+		a.CreateTime = t
+	}
+	a.ModifyTime = t
+}
+
+// Merge merges the binding rule with the existing one, filling the fields
+// the update did not set. The auth method of a binding rule can't be
+// changed.
+func (a *ACLBindingRule) Merge(b *ACLBindingRule) {
+	if b == nil {
+		return
+	}
+	a.AuthMethod = b.AuthMethod
+	a.CreateTime = b.CreateTime
+	if a.BindType == "" {
+		a.BindType = b.BindType
+	}
+	if a.BindName == "" && a.BindType != ACLBindingRuleBindTypeManagement {
+		a.BindName = b.BindName
+	}
+}
+
+// Validate ensures the ACL binding rule contains valid information which
+// meets Nomad's internal requirements. This does not include any state
+// calls, such as ensuring the auth method exists.
+func (a *ACLBindingRule) Validate() error {
+
+	var mErr multierror.Error
+
+	if a.AuthMethod == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("auth method is missing"))
+	}
+	if len(a.Description) > maxACLBindingRuleDescriptionLength {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("description longer than %d", maxACLBindingRuleDescriptionLength))
+	}
+
+	// Depending on the bind type, we have some specific validation. Catching
+	// the empty string also provides easier to understand feedback to the
+	// user.
+	switch a.BindType {
+	case "":
+		mErr.Errors = append(mErr.Errors, errors.New("bind type is missing"))
+	case ACLBindingRuleBindTypeRole, ACLBindingRuleBindTypePolicy:
+		if a.BindName == "" {
+			mErr.Errors = append(mErr.Errors, errors.New("bind name is missing"))
+		}
+	case ACLBindingRuleBindTypeManagement:
+		if a.BindName != "" {
+			mErr.Errors = append(mErr.Errors, errors.New("bind name should be empty"))
+		}
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("unsupported bind type: %q", a.BindType))
+	}
+
+	if a.Selector != "" {
+		if _, err := bexpr.CreateEvaluator(a.Selector); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("selector is invalid: %v", err))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Stub converts the ACLBindingRule object into a ACLBindingRuleListStub
+// object.
+func (a *ACLBindingRule) Stub() *ACLBindingRuleListStub {
+	return &ACLBindingRuleListStub{
+		ID:          a.ID,
+		Description: a.Description,
+		AuthMethod:  a.AuthMethod,
+		Hash:        a.Hash,
+		CreateIndex: a.CreateIndex,
+		ModifyIndex: a.ModifyIndex,
+	}
+}
+
+// ACLBindingRuleListStub is the stub object returned when performing a
+// listing of ACL binding rules.
+type ACLBindingRuleListStub struct {
+
+	// ID is an internally generated UUID for this role and is controlled by
+	// Nomad.
+	ID string
+
+	// Description is a human-readable, operator set description that can
+	// provide additional context about the binding role. This is an
+	// operational field.
+	Description string
+
+	// AuthMethod is the name of the auth method for which this rule applies
+	// to. This is required and the method must exist within state before the
+	// cluster administrator can create the rule.
+	AuthMethod string
+
+	// Hash is the hashed value of the binding rule and is generated using
+	// all fields from the full object except the create and modify times and
+	// indexes.
+	Hash []byte
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// ACLBindingRulesUpsertRequest is used to upsert one or more ACL binding
+// rules.
+type ACLBindingRulesUpsertRequest struct {
+	ACLBindingRules []*ACLBindingRule
+
+	// AllowMissingAuthMethods skips the ACL binding rule auth method link
+	// verification and is used by the replication process. The replication
+	// cannot ensure auth methods are present before ACL binding rules are
+	// replicated.
+	AllowMissingAuthMethods bool
+
+	WriteRequest
+}
+
+// ACLBindingRulesUpsertResponse is the response object when one or more ACL
+// binding rules have been successfully upserted into state.
+type ACLBindingRulesUpsertResponse struct {
+	ACLBindingRules []*ACLBindingRule
+	WriteMeta
+}
+
+// ACLBindingRulesDeleteRequest is the request object to delete one or more
+// ACL binding rules using the rule ID.
+type ACLBindingRulesDeleteRequest struct {
+	ACLBindingRuleIDs []string
+	WriteRequest
+}
+
+// ACLBindingRulesDeleteResponse is the response object when performing a
+// deletion of one or more ACL binding rules using the rule ID.
+type ACLBindingRulesDeleteResponse struct {
+	WriteMeta
+}
+
+// ACLBindingRulesListRequest is the request object when performing ACL
+// binding rules listings.
+type ACLBindingRulesListRequest struct {
+	QueryOptions
+}
+
+// ACLBindingRulesListResponse is the response object when performing ACL
+// binding rule listings.
+type ACLBindingRulesListResponse struct {
+	ACLBindingRules []*ACLBindingRuleListStub
+	QueryMeta
+}
+
+// ACLBindingRulesRequest is the request object when performing a lookup of
+// multiple binding rules by the ID.
+type ACLBindingRulesRequest struct {
+	ACLBindingRuleIDs []string
+	QueryOptions
+}
+
+// ACLBindingRulesResponse is the response object when performing a lookup of
+// multiple binding rules by their IDs.
+type ACLBindingRulesResponse struct {
+	ACLBindingRules map[string]*ACLBindingRule
+	QueryMeta
+}
+
+// ACLBindingRuleRequest is the request object to perform a lookup of an ACL
+// binding rule using a specific ID.
+type ACLBindingRuleRequest struct {
+	ACLBindingRuleID string
+	QueryOptions
+}
+
+// ACLBindingRuleResponse is the response object when performing a lookup of
+// an ACL binding rule matching a specific ID.
+type ACLBindingRuleResponse struct {
+	ACLBindingRule *ACLBindingRule
+	QueryMeta
+}
+
+// ACLOIDCAuthURLRequest is the request to make when starting the OIDC
+// authentication login flow.
+type ACLOIDCAuthURLRequest struct {
+
+	// AuthMethodName is the OIDC auth-method to use. This is a required
+	// parameter.
+	AuthMethodName string
+
+	// RedirectURI is the URL that authorization should redirect to. This is a
+	// required parameter.
+	RedirectURI string
+
+	// ClientNonce is a randomly generated string to prevent replay attacks.
+	// It is up to the client to generate this and it must then be passed
+	// back to ACLOIDCCompleteAuthRequest. This is a required parameter.
+	ClientNonce string
+
+	// WriteRequest is used due to the requirement by the RPC forwarding
+	// mechanism. This request doesn't write anything to Nomad's internal
+	// state.
+	WriteRequest
+}
+
+// Validate ensures the request object contains all the required fields in
+// order to start the OIDC authentication flow.
+func (a *ACLOIDCAuthURLRequest) Validate() error {
+
+	var mErr multierror.Error
+
+	if a.AuthMethodName == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing auth method name"))
+	}
+	if a.ClientNonce == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing client nonce"))
+	}
+	if a.RedirectURI == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing redirect URI"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLOIDCAuthURLResponse is the response when starting the OIDC
+// authentication login flow.
+type ACLOIDCAuthURLResponse struct {
+
+	// AuthURL is URL to begin authorization and is where the user logging in
+	// should go.
+	AuthURL string
+}
+
+// ACLOIDCCompleteAuthRequest is the request object to begin completing the
+// OIDC auth cycle after receiving the callback from the OIDC provider.
+type ACLOIDCCompleteAuthRequest struct {
+
+	// AuthMethodName is the name of the auth method being used to login via
+	// OIDC. This will match AuthUrlArgs.AuthMethodName. This is a required
+	// parameter.
+	AuthMethodName string
+
+	// ClientNonce, State, and Code are provided from the parameters given to
+	// the redirect URL. These are all required parameters.
+	ClientNonce string
+	State       string
+	Code        string
+
+	// RedirectURI is the URL that authorization should redirect to. This is a
+	// required parameter.
+	RedirectURI string
+
+	// WriteRequest is used due to the requirement by the RPC forwarding
+	// mechanism. This request doesn't write anything to Nomad's internal
+	// state.
+	WriteRequest
+}
+
+// Validate ensures the request object contains all the required fields in
+// order to complete the OIDC authentication flow.
+func (a *ACLOIDCCompleteAuthRequest) Validate() error {
+
+	var mErr multierror.Error
+
+	if a.AuthMethodName == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing auth method name"))
+	}
+	if a.ClientNonce == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing client nonce"))
+	}
+	if a.State == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing state"))
+	}
+	if a.Code == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing code"))
+	}
+	if a.RedirectURI == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing redirect URI"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLLoginRequest is the request object to log in with a JWT auth method.
+type ACLLoginRequest struct {
+
+	// AuthMethodName is the name of the JWT auth method the login token is
+	// validated by. This is a required parameter.
+	AuthMethodName string
+
+	// LoginToken is the JWT exchanged for a Nomad ACL token. This is a
+	// required parameter.
+	LoginToken string
+
+	// WriteRequest is used due to the requirement by the RPC forwarding
+	// mechanism. The request writes the created ACL token to Nomad's state.
+	WriteRequest
+}
+
+// Validate ensures the request object contains all the required fields in
+// order to log in.
+func (a *ACLLoginRequest) Validate() error {
+
+	var mErr multierror.Error
+
+	if a.AuthMethodName == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing auth method name"))
+	}
+	if a.LoginToken == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("missing login token"))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// ACLLoginResponse is the response when the auth flow has been completed
+// successfully.
+type ACLLoginResponse struct {
+
+	// ACLToken is the token created by Nomad as the login was completed
+	// successfully.
+	ACLToken *ACLToken
+	WriteMeta
+}
@@ -140,6 +140,11 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, cDiff)
 	}
 
+	// GC policy diff
+	if gcDiff := primitiveObjectDiff(j.GC, other.GC, nil, "GC", contextual); gcDiff != nil {
+		diff.Objects = append(diff.Objects, gcDiff)
+	}
+
 	// Multiregion diff
 	if mrDiff := multiregionDiff(j.Multiregion, other.Multiregion, contextual); mrDiff != nil {
 		diff.Objects = append(diff.Objects, mrDiff)
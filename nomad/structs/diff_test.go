@@ -1782,12 +1782,24 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "",
 								New:  "1000000000",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "MaxDelay",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Mode",
 								Old:  "",
 								New:  "fail",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "ResetAfter",
+								Old:  "",
+								New:  "0",
+							},
 						},
 					},
 				},
@@ -1829,12 +1841,24 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "MaxDelay",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Mode",
 								Old:  "fail",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "ResetAfter",
+								Old:  "0",
+								New:  "",
+							},
 						},
 					},
 				},
@@ -1926,6 +1950,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1",
 								New:  "2",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "Backoff",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "Delay",
@@ -1938,12 +1968,24 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "2000000000",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "MaxDelay",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "Mode",
 								Old:  "fail",
 								New:  "fail",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "ResetAfter",
+								Old:  "0",
+								New:  "0",
+							},
 						},
 					},
 				},
@@ -7200,6 +7242,12 @@ func TestTaskDiff(t *testing.T) {
 								Old:  "",
 								New:  "false",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "ErrMissingKey",
+								Old:  "",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Gid",
@@ -7330,6 +7378,12 @@ func TestTaskDiff(t *testing.T) {
 								Old:  "true",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "ErrMissingKey",
+								Old:  "false",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Gid",
@@ -16,15 +16,22 @@ type EventStreamWrapper struct {
 type Topic string
 
 const (
-	TopicDeployment Topic = "Deployment"
-	TopicEvaluation Topic = "Evaluation"
-	TopicAllocation Topic = "Allocation"
-	TopicJob        Topic = "Job"
-	TopicNode       Topic = "Node"
-	TopicACLPolicy  Topic = "ACLPolicy"
-	TopicACLToken   Topic = "ACLToken"
-	TopicService    Topic = "Service"
-	TopicAll        Topic = "*"
+	TopicDeployment      Topic = "Deployment"
+	TopicEvaluation      Topic = "Evaluation"
+	TopicAllocation      Topic = "Allocation"
+	TopicJob             Topic = "Job"
+	TopicNode            Topic = "Node"
+	TopicACLPolicy       Topic = "ACLPolicy"
+	TopicACLToken        Topic = "ACLToken"
+	TopicService         Topic = "Service"
+	TopicNamespace       Topic = "Namespace"
+	TopicVariables       Topic = "Variables"
+	TopicACLRole         Topic = "ACLRole"
+	TopicCSIVolume       Topic = "CSIVolume"
+	TopicCSIPlugin       Topic = "CSIPlugin"
+	TopicScalingPolicy   Topic = "ScalingPolicy"
+	TopicSchedulerConfig Topic = "SchedulerConfig"
+	TopicAll             Topic = "*"
 
 	TypeNodeRegistration              = "NodeRegistration"
 	TypeNodeDeregistration            = "NodeDeregistration"
@@ -48,6 +55,20 @@ const (
 	TypeACLPolicyUpserted             = "ACLPolicyUpserted"
 	TypeServiceRegistration           = "ServiceRegistration"
 	TypeServiceDeregistration         = "ServiceDeregistration"
+	TypeNamespaceUpserted             = "NamespaceUpserted"
+	TypeNamespaceDeleted              = "NamespaceDeleted"
+	TypeVariableUpserted              = "VariableUpserted"
+	TypeVariableDeleted               = "VariableDeleted"
+	TypeACLRoleUpserted               = "ACLRoleUpserted"
+	TypeACLRoleDeleted                = "ACLRoleDeleted"
+	TypeCSIVolumeRegistered           = "CSIVolumeRegistered"
+	TypeCSIVolumeDeregistered         = "CSIVolumeDeregistered"
+	TypeCSIVolumeClaim                = "CSIVolumeClaim"
+	TypeCSIPluginUpserted             = "CSIPluginUpserted"
+	TypeCSIPluginDeleted              = "CSIPluginDeleted"
+	TypeScalingPolicyUpserted         = "ScalingPolicyUpserted"
+	TypeScalingPolicyDeleted          = "ScalingPolicyDeleted"
+	TypeSchedulerConfigUpdated        = "SchedulerConfigUpdated"
 )
 
 // Event represents a change in Nomads state.
@@ -132,6 +153,43 @@ type ServiceRegistrationStreamEvent struct {
 	Service *ServiceRegistration
 }
 
+// NamespaceEvent holds a newly updated or deleted namespace.
+type NamespaceEvent struct {
+	Namespace *Namespace
+}
+
+// VariableEvent holds the metadata of a newly updated or deleted variable.
+// The encrypted contents of variables are never included in events.
+type VariableEvent struct {
+	Variable *VariableMetadata
+}
+
+// ACLRoleStreamEvent holds a newly updated or deleted ACL role.
+type ACLRoleStreamEvent struct {
+	ACLRole *ACLRole
+}
+
+// CSIVolumeEvent holds a newly updated or deleted CSI volume. The secrets of
+// the volume are removed.
+type CSIVolumeEvent struct {
+	Volume *CSIVolume
+}
+
+// CSIPluginEvent holds a newly updated or deleted CSI plugin.
+type CSIPluginEvent struct {
+	Plugin *CSIPlugin
+}
+
+// ScalingPolicyEvent holds a newly updated or deleted scaling policy.
+type ScalingPolicyEvent struct {
+	ScalingPolicy *ScalingPolicy
+}
+
+// SchedulerConfigEvent holds the newly updated scheduler configuration.
+type SchedulerConfigEvent struct {
+	SchedulerConfig *SchedulerConfiguration
+}
+
 // NewACLTokenEvent takes a token and creates a new ACLTokenEvent.  It creates
 // a copy of the passed in ACLToken and empties out the copied tokens SecretID
 func NewACLTokenEvent(token *ACLToken) *ACLTokenEvent {
@@ -0,0 +1,197 @@
+package structs
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/go-bexpr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/nomad/helper"
+)
+
+// SinkType is the type of destination an EventSink delivers events to.
+type SinkType string
+
+const (
+	// SinkWebhook delivers batches of events to an HTTP endpoint.
+	SinkWebhook SinkType = "webhook"
+)
+
+var (
+	// validEventSinkID is used to validate the ID of an event sink.
+	validEventSinkID = regexp.MustCompile("^[a-zA-Z0-9-]{1,128}$")
+)
+
+// EventSink is a destination the events of the event stream are delivered to
+// by the leader. Events matching the topics, namespace and filter of the sink are
+// delivered at least once, and the index of the latest events acknowledged by
+// the sink is tracked so that delivery resumes after a leader election.
+type EventSink struct {
+	// ID is the unique, operator chosen, identifier of the sink.
+	ID string
+
+	// Type is the type of the sink.
+	Type SinkType
+
+	// Topics are the topics and keys of the events delivered to the sink, in
+	// the same format as the topics of an event stream request.
+	Topics map[Topic][]string
+
+	// Namespace is the namespace of the events delivered to the sink. The
+	// wildcard "*" delivers the events of all namespaces.
+	Namespace string
+
+	// Filter is an optional go-bexpr expression further restricting the
+	// events delivered to the sink.
+	Filter string
+
+	// Address is the URL events are delivered to.
+	Address string
+
+	// LatestIndex is the raft index of the latest events acknowledged by the
+	// sink.
+	LatestIndex uint64
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// Canonicalize sets the defaults of an event sink.
+func (e *EventSink) Canonicalize() {
+	if e.Namespace == "" {
+		e.Namespace = DefaultNamespace
+	}
+	if len(e.Topics) == 0 {
+		e.Topics = map[Topic][]string{TopicAll: {"*"}}
+	}
+}
+
+// Validate returns an error if the event sink is not valid.
+func (e *EventSink) Validate() error {
+	var mErr multierror.Error
+
+	if !validEventSinkID.MatchString(e.ID) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid ID %q. Must match regex %s", e.ID, validEventSinkID))
+	}
+
+	switch e.Type {
+	case SinkWebhook:
+		u, err := url.Parse(e.Address)
+		if err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid address: %v", err))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid address %q: scheme must be http or https", e.Address))
+		} else if u.Host == "" {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid address %q: missing host", e.Address))
+		}
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("unsupported sink type %q", e.Type))
+	}
+
+	if e.Namespace == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing namespace"))
+	}
+
+	if e.Filter != "" {
+		if _, err := bexpr.CreateEvaluator(e.Filter); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid filter: %v", err))
+		}
+	}
+
+	for topic, keys := range e.Topics {
+		if topic == "" {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid empty topic"))
+		}
+		if len(keys) == 0 {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("topic %q must have at least one key", topic))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Copy returns a deep copy of the event sink.
+func (e *EventSink) Copy() *EventSink {
+	if e == nil {
+		return nil
+	}
+
+	c := new(EventSink)
+	*c = *e
+
+	if e.Topics != nil {
+		c.Topics = make(map[Topic][]string, len(e.Topics))
+		for topic, keys := range e.Topics {
+			c.Topics[topic] = helper.CopySliceString(keys)
+		}
+	}
+	return c
+}
+
+// EqualSubscription returns whether both event sinks deliver the same events
+// to the same destination.
+func (e *EventSink) EqualSubscription(o *EventSink) bool {
+	if e.Type != o.Type || e.Address != o.Address || e.Namespace != o.Namespace || e.Filter != o.Filter {
+		return false
+	}
+	if len(e.Topics) != len(o.Topics) {
+		return false
+	}
+	for topic, keys := range e.Topics {
+		otherKeys, ok := o.Topics[topic]
+		if !ok || !helper.SliceSetEq(keys, otherKeys) {
+			return false
+		}
+	}
+	return true
+}
+
+// EventSinkUpsertRequest is used to register or update an event sink.
+type EventSinkUpsertRequest struct {
+	Sink *EventSink
+	WriteRequest
+}
+
+// EventSinkDeleteRequest is used to deregister event sinks.
+type EventSinkDeleteRequest struct {
+	IDs []string
+	WriteRequest
+}
+
+// EventSinkSpecificRequest is used to look up an event sink by ID.
+type EventSinkSpecificRequest struct {
+	ID string
+	QueryOptions
+}
+
+// EventSinkResponse is used to return a single event sink.
+type EventSinkResponse struct {
+	Sink *EventSink
+	QueryMeta
+}
+
+// EventSinkListRequest is used to list the event sinks.
+type EventSinkListRequest struct {
+	QueryOptions
+}
+
+// EventSinkListResponse is used to return the event sinks.
+type EventSinkListResponse struct {
+	Sinks []*EventSink
+	QueryMeta
+}
+
+// EventSinkProgressRequest records the index of the latest events
+// acknowledged by an event sink.
+type EventSinkProgressRequest struct {
+	ID          string
+	LatestIndex uint64
+}
+
+// BatchEventSinkUpdateProgressRequest is used by the leader to record the
+// delivery progress of its event sinks.
+type BatchEventSinkUpdateProgressRequest struct {
+	Updates []*EventSinkProgressRequest
+	WriteRequest
+}
@@ -0,0 +1,152 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSink_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name      string
+		sink      *EventSink
+		expectErr string
+	}{
+		{
+			name: "valid",
+			sink: &EventSink{
+				ID:      "audit-webhook",
+				Type:    SinkWebhook,
+				Address: "https://example.com/events",
+			},
+		},
+		{
+			name: "invalid ID",
+			sink: &EventSink{
+				ID:      "audit webhook",
+				Type:    SinkWebhook,
+				Address: "https://example.com/events",
+			},
+			expectErr: "invalid ID",
+		},
+		{
+			name: "unsupported type",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    "kafka",
+				Address: "https://example.com/events",
+			},
+			expectErr: `unsupported sink type "kafka"`,
+		},
+		{
+			name: "invalid scheme",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    SinkWebhook,
+				Address: "ftp://example.com/events",
+			},
+			expectErr: "scheme must be http or https",
+		},
+		{
+			name: "missing host",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    SinkWebhook,
+				Address: "http:///events",
+			},
+			expectErr: "missing host",
+		},
+		{
+			name: "topic without keys",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    SinkWebhook,
+				Address: "https://example.com/events",
+				Topics:  map[Topic][]string{TopicJob: {}},
+			},
+			expectErr: `topic "Job" must have at least one key`,
+		},
+		{
+			name: "invalid filter",
+			sink: &EventSink{
+				ID:      "audit",
+				Type:    SinkWebhook,
+				Address: "https://example.com/events",
+				Filter:  `Type ==`,
+			},
+			expectErr: "invalid filter",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.sink.Canonicalize()
+			err := tc.sink.Validate()
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestEventSink_Canonicalize(t *testing.T) {
+	ci.Parallel(t)
+
+	sink := &EventSink{ID: "audit", Type: SinkWebhook}
+	sink.Canonicalize()
+	require.Equal(t, DefaultNamespace, sink.Namespace)
+	require.Equal(t, map[Topic][]string{TopicAll: {"*"}}, sink.Topics)
+
+	sink = &EventSink{
+		ID:        "audit",
+		Type:      SinkWebhook,
+		Namespace: "*",
+		Topics:    map[Topic][]string{TopicJob: {"example"}},
+	}
+	sink.Canonicalize()
+	require.Equal(t, "*", sink.Namespace)
+	require.Equal(t, map[Topic][]string{TopicJob: {"example"}}, sink.Topics)
+}
+
+func TestEventSink_CopyEqualSubscription(t *testing.T) {
+	ci.Parallel(t)
+
+	sink := &EventSink{
+		ID:          "audit",
+		Type:        SinkWebhook,
+		Namespace:   "*",
+		Topics:      map[Topic][]string{TopicJob: {"example", "cache"}},
+		Address:     "https://example.com/events",
+		LatestIndex: 10,
+	}
+
+	c := sink.Copy()
+	require.Equal(t, sink, c)
+	require.True(t, sink.EqualSubscription(c))
+
+	// Progress does not change the subscription
+	c.LatestIndex = 20
+	require.True(t, sink.EqualSubscription(c))
+
+	// The copy does not share its topics
+	c.Topics[TopicJob][0] = "other"
+	require.Equal(t, "example", sink.Topics[TopicJob][0])
+	require.False(t, sink.EqualSubscription(c))
+
+	c = sink.Copy()
+	c.Address = "https://example.com/other"
+	require.False(t, sink.EqualSubscription(c))
+
+	c = sink.Copy()
+	c.Topics[TopicNode] = []string{"*"}
+	require.False(t, sink.EqualSubscription(c))
+
+	c = sink.Copy()
+	c.Filter = `Type == "JobRegistered"`
+	require.False(t, sink.EqualSubscription(c))
+}
@@ -291,3 +291,53 @@ type SnapshotRestoreResponse struct {
 
 	QueryMeta
 }
+
+// SnapshotStatusResponse is used by the Operator endpoint to return the status
+// of the scheduled snapshots of a server.
+type SnapshotStatusResponse struct {
+	Status *SnapshotAgentStatus
+
+	QueryMeta
+}
+
+// SnapshotAgentStatus is the status of the scheduled snapshots of a server.
+type SnapshotAgentStatus struct {
+	// Server is the name of the server reporting the status.
+	Server string
+
+	// Enabled is whether scheduled snapshots are configured on the server.
+	Enabled bool
+
+	// Active is whether the server is currently taking scheduled snapshots.
+	// Servers configured to only take snapshots while they are the leader
+	// are inactive while they are followers.
+	Active bool
+
+	// Storage describes where snapshots are written to.
+	Storage string
+
+	// Interval is the interval between snapshots.
+	Interval time.Duration
+
+	// LastSuccess is when the latest snapshot was successfully taken, and
+	// LastSnapshot the name it is stored under.
+	LastSuccess  time.Time
+	LastSnapshot string
+
+	// LastFailure is when the latest snapshot failed, and LastError the
+	// reason it failed.
+	LastFailure time.Time
+	LastError   string
+
+	// Snapshots are the retained snapshots, oldest first.
+	Snapshots []*StoredSnapshot
+}
+
+// StoredSnapshot describes a snapshot retained by the snapshot storage of a
+// server.
+type StoredSnapshot struct {
+	Name  string
+	Index uint64
+	Time  time.Time
+	Size  int64
+}
@@ -17,6 +17,7 @@ import (
 	"math"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -116,6 +117,10 @@ const (
 	RootKeyMetaDeleteRequestType                 MessageType = 52
 	ACLRolesUpsertRequestType                    MessageType = 53
 	ACLRolesDeleteByIDRequestType                MessageType = 54
+	ACLAuthMethodsUpsertRequestType              MessageType = 55
+	ACLAuthMethodsDeleteRequestType              MessageType = 56
+	ACLBindingRulesUpsertRequestType             MessageType = 57
+	ACLBindingRulesDeleteRequestType             MessageType = 58
 
 	// Namespace types were moved from enterprise and therefore start at 64
 	NamespaceUpsertRequestType MessageType = 64
@@ -1021,6 +1026,17 @@ type AllocSignalRequest struct {
 	QueryOptions
 }
 
+// AllocPauseRequest is used to pause or resume the tasks of an allocation.
+type AllocPauseRequest struct {
+	AllocID string
+	Task    string
+
+	// Resume thaws the paused tasks rather than pausing them.
+	Resume bool
+
+	QueryOptions
+}
+
 // AllocsGetRequest is used to query a set of allocations
 type AllocsGetRequest struct {
 	AllocIDs []string
@@ -3545,7 +3561,7 @@ func (a *AllocatedResources) Comparable() *ComparableResources {
 
 	for taskName, r := range a.Tasks {
 		lc := a.TaskLifecycles[taskName]
-		if lc == nil {
+		if lc == nil || lc.Hook == "" {
 			main.Add(r)
 		} else if lc.Hook == TaskLifecycleHookPrestart {
 			if lc.Sidecar {
@@ -4110,6 +4126,10 @@ type Job struct {
 	// for dispatching.
 	ParameterizedJob *ParameterizedJobConfig
 
+	// GC controls how long clients retain the allocation directories of
+	// this job's terminal allocations before garbage collecting them.
+	GC *GCPolicy
+
 	// Dispatched is used to identify if the Job has been dispatched from a
 	// parameterized job.
 	Dispatched bool
@@ -4268,6 +4288,7 @@ func (j *Job) Copy() *Job {
 	nj.Periodic = nj.Periodic.Copy()
 	nj.Meta = helper.CopyMapStringString(nj.Meta)
 	nj.ParameterizedJob = nj.ParameterizedJob.Copy()
+	nj.GC = nj.GC.Copy()
 	return nj
 }
 
@@ -4417,6 +4438,13 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if j.GC != nil {
+		if err := j.GC.Validate(); err != nil {
+			outer := fmt.Errorf("GC policy validation failed: %v", err)
+			mErr.Errors = append(mErr.Errors, outer)
+		}
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -5398,6 +5426,50 @@ func (d *DispatchPayloadConfig) Validate() error {
 	return nil
 }
 
+// GCPolicy configures how long a client retains the allocation directory of
+// a terminal allocation before the garbage collector is allowed to destroy it.
+type GCPolicy struct {
+	// RetainFailed is the minimum amount of time the directory of a failed
+	// allocation is kept after the allocation becomes terminal.
+	RetainFailed time.Duration
+
+	// RetainSuccessful is the minimum amount of time the directory of any
+	// other terminal allocation is kept after it becomes terminal.
+	RetainSuccessful time.Duration
+}
+
+func (g *GCPolicy) Copy() *GCPolicy {
+	if g == nil {
+		return nil
+	}
+	ng := new(GCPolicy)
+	*ng = *g
+	return ng
+}
+
+func (g *GCPolicy) Validate() error {
+	var mErr multierror.Error
+	if g.RetainFailed < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("retain_failed must be non-negative: %v", g.RetainFailed))
+	}
+	if g.RetainSuccessful < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("retain_successful must be non-negative: %v", g.RetainSuccessful))
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Retention returns how long a terminal allocation with the given client
+// status should be retained before it may be garbage collected.
+func (g *GCPolicy) Retention(clientStatus string) time.Duration {
+	if g == nil {
+		return 0
+	}
+	if clientStatus == AllocClientStatusFailed {
+		return g.RetainFailed
+	}
+	return g.RetainSuccessful
+}
+
 const (
 	TaskLifecycleHookPrestart  = "prestart"
 	TaskLifecycleHookPoststart = "poststart"
@@ -5407,6 +5479,15 @@ const (
 type TaskLifecycleConfig struct {
 	Hook    string
 	Sidecar bool
+
+	// PreStop is a command executed inside the task before it is sent its
+	// kill signal. A lifecycle block with only PreStop set leaves the task a
+	// main task.
+	PreStop *TaskPreStopConfig
+
+	// Timeout bounds how long a poststop task may run before it is killed
+	// so the allocation can finish terminating. Zero means no limit.
+	Timeout time.Duration
 }
 
 func (d *TaskLifecycleConfig) Copy() *TaskLifecycleConfig {
@@ -5415,6 +5496,7 @@ func (d *TaskLifecycleConfig) Copy() *TaskLifecycleConfig {
 	}
 	nd := new(TaskLifecycleConfig)
 	*nd = *d
+	nd.PreStop = d.PreStop.Copy()
 	return nd
 }
 
@@ -5428,14 +5510,74 @@ func (d *TaskLifecycleConfig) Validate() error {
 	case TaskLifecycleHookPoststart:
 	case TaskLifecycleHookPoststop:
 	case "":
-		return fmt.Errorf("no lifecycle hook provided")
+		if d.PreStop == nil {
+			return fmt.Errorf("no lifecycle hook provided")
+		}
+		if d.Sidecar {
+			return fmt.Errorf("sidecar requires a lifecycle hook")
+		}
 	default:
 		return fmt.Errorf("invalid hook: %v", d.Hook)
 	}
 
+	if d.Timeout < 0 {
+		return fmt.Errorf("timeout cannot be negative (got %v)", d.Timeout)
+	}
+	if d.Timeout > 0 && d.Hook != TaskLifecycleHookPoststop {
+		return fmt.Errorf("timeout is only supported for %q tasks", TaskLifecycleHookPoststop)
+	}
+
+	if err := d.PreStop.Validate(); err != nil {
+		return fmt.Errorf("invalid prestop: %v", err)
+	}
+
 	return nil
 }
 
+// DefaultPreStopTimeout is the default amount of time a prestop command may run
+// before the task is signalled anyway.
+const DefaultPreStopTimeout = 30 * time.Second
+
+// TaskPreStopConfig is a command run inside a task through the driver before
+// the task is killed, e.g. to drain connections or deregister from a load
+// balancer.
+type TaskPreStopConfig struct {
+	// Command is the command to execute
+	Command string
+
+	// Args is a slice of arguments passed to the command
+	Args []string
+
+	// Timeout is how long to wait for the command before killing the task
+	// anyway
+	Timeout time.Duration
+}
+
+func (p *TaskPreStopConfig) Copy() *TaskPreStopConfig {
+	if p == nil {
+		return nil
+	}
+	np := new(TaskPreStopConfig)
+	*np = *p
+	np.Args = slices.Clone(p.Args)
+	return np
+}
+
+func (p *TaskPreStopConfig) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	if p.Command == "" {
+		_ = multierror.Append(&mErr, fmt.Errorf("must specify a command"))
+	}
+	if p.Timeout < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("timeout cannot be negative (got %v)", p.Timeout))
+	}
+	return mErr.ErrorOrNil()
+}
+
 var (
 	// These default restart policies needs to be in sync with
 	// Canonicalize in api/tasks.go
@@ -5485,6 +5627,13 @@ const (
 	// restart policy.
 	RestartPolicyMinInterval = 5 * time.Second
 
+	// RestartBackoffConstant waits the same delay before every restart.
+	RestartBackoffConstant = "constant"
+
+	// RestartBackoffExponential doubles the delay on each consecutive restart
+	// up to the policy's max delay.
+	RestartBackoffExponential = "exponential"
+
 	// ReasonWithinPolicy describes restart events that are within policy
 	ReasonWithinPolicy = "Restart within policy"
 )
@@ -5772,6 +5921,19 @@ type RestartPolicy struct {
 	// Mode controls what happens when the task restarts more than attempt times
 	// in an interval.
 	Mode string
+
+	// Backoff determines how the delay changes on consecutive restarts. Valid
+	// values are "constant" and "exponential". An empty value is treated as
+	// "constant".
+	Backoff string
+
+	// MaxDelay is an upper bound on the delay when using an exponential
+	// backoff.
+	MaxDelay time.Duration
+
+	// ResetAfter is how long a task has to run before the exponential backoff
+	// is reset. If zero, the backoff is reset when a new interval begins.
+	ResetAfter time.Duration
 }
 
 func (r *RestartPolicy) Copy() *RestartPolicy {
@@ -5803,6 +5965,21 @@ func (r *RestartPolicy) Validate() error {
 		_ = multierror.Append(&mErr,
 			fmt.Errorf("Nomad can't restart the TaskGroup %v times in an interval of %v with a delay of %v", r.Attempts, r.Interval, r.Delay))
 	}
+
+	switch r.Backoff {
+	case "", RestartBackoffConstant:
+	case RestartBackoffExponential:
+		if r.MaxDelay < r.Delay {
+			_ = multierror.Append(&mErr,
+				fmt.Errorf("Max delay cannot be less than delay %v (got %v)", r.Delay, r.MaxDelay))
+		}
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Unsupported restart backoff: %q", r.Backoff))
+	}
+
+	if r.ResetAfter < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Reset after cannot be negative (got %v)", r.ResetAfter))
+	}
 	return mErr.ErrorOrNil()
 }
 
@@ -7272,6 +7449,10 @@ func (t *Task) Validate(ephemeralDisk *EphemeralDisk, jobType string, tgServices
 		}
 	}
 
+	if err := validateTemplateChain(t.Templates); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+
 	// Validate the dispatch payload block if there
 	if t.DispatchPayload != nil {
 		if err := t.DispatchPayload.Validate(); err != nil {
@@ -7710,6 +7891,11 @@ type Template struct {
 
 	// WaitConfig is used to override the global WaitConfig on a per-template basis
 	Wait *WaitConfig
+
+	// ErrMissingKey is used to control how the template behaves when attempting
+	// to index a struct or map key that does not exist. If true, rendering
+	// fails instead of writing "<no value>" to the destination.
+	ErrMissingKey bool
 }
 
 // DefaultTemplate returns a default template.
@@ -7813,6 +7999,42 @@ func (t *Template) Warnings() error {
 	return mErr.ErrorOrNil()
 }
 
+// validateTemplateChain checks that templates sourcing the destination of
+// another template of the same task do not form a cycle, since such templates
+// could never be rendered.
+func validateTemplateChain(tmpls []*Template) error {
+	destinations := make(map[string]int, len(tmpls))
+	for idx, tmpl := range tmpls {
+		if tmpl.DestPath != "" {
+			destinations[filepath.Clean(tmpl.DestPath)] = idx
+		}
+	}
+
+	// deps maps a template index to the index of the template rendering its
+	// source, if any.
+	deps := make(map[int]int, len(tmpls))
+	for idx, tmpl := range tmpls {
+		if tmpl.SourcePath == "" {
+			continue
+		}
+		if other, ok := destinations[filepath.Clean(tmpl.SourcePath)]; ok {
+			deps[idx] = other
+		}
+	}
+
+	for idx := range tmpls {
+		seen := map[int]struct{}{idx: {}}
+		for next, ok := deps[idx]; ok; next, ok = deps[next] {
+			if _, ok := seen[next]; ok {
+				return fmt.Errorf("Template %d has a cyclic source dependency", idx+1)
+			}
+			seen[next] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 // DiffID fulfills the DiffableWithID interface.
 func (t *Template) DiffID() string {
 	return t.DestPath
@@ -7969,6 +8191,7 @@ func (h *TaskHandle) Copy() *TaskHandle {
 const (
 	TaskStatePending = "pending" // The task is waiting to be run.
 	TaskStateRunning = "running" // The task is currently running.
+	TaskStatePaused  = "paused"  // The task is running but its processes are frozen.
 	TaskStateDead    = "dead"    // Terminal state of task.
 )
 
@@ -7988,6 +8211,11 @@ type TaskState struct {
 	// task restarts
 	LastRestart time.Time
 
+	// NextRestart is the time at which the task is expected to be started
+	// again while it is waiting on a restart delay. It is cleared once the
+	// task starts.
+	NextRestart time.Time
+
 	// StartedAt is the time the task is started. It is updated each time the
 	// task starts
 	StartedAt time.Time
@@ -8088,6 +8316,13 @@ const (
 	// TaskSignaling indicates that the task is being signalled.
 	TaskSignaling = "Signaling"
 
+	// TaskPaused indicates that the processes of the task have been frozen.
+	TaskPaused = "Paused"
+
+	// TaskResumed indicates that the processes of a paused task have been
+	// thawed.
+	TaskResumed = "Resumed"
+
 	// TaskDownloadingArtifacts means the task is downloading the artifacts
 	// specified in the task.
 	TaskDownloadingArtifacts = "Downloading Artifacts"
@@ -8129,6 +8364,13 @@ const (
 	// message.
 	TaskHookMessage = "Task hook message"
 
+	// TaskPreStop indicates the prestop command of a task has been run.
+	TaskPreStop = "Pre-Stop"
+
+	// TaskCheckpointed indicates the task of a migrating allocation was
+	// checkpointed, or failed to be, to be restored on its new client.
+	TaskCheckpointed = "Checkpointed"
+
 	// TaskRestoreFailed indicates Nomad was unable to reattach to a
 	// restored task.
 	TaskRestoreFailed = "Failed Restoring Task"
@@ -8354,6 +8596,16 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		desc = "Main tasks in the group died"
 	case TaskClientReconnected:
 		desc = "Client reconnected"
+	case TaskPaused:
+		desc = "Task paused"
+	case TaskResumed:
+		desc = "Task resumed"
+	case TaskCheckpointed:
+		if e.Message != "" {
+			desc = e.Message
+		} else {
+			desc = "Task checkpointed for migration"
+		}
 	default:
 		desc = e.Message
 	}
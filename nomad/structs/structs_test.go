@@ -2607,6 +2607,42 @@ func TestTask_Validate_Template(t *testing.T) {
 	if expected := "cannot use signals"; !strings.Contains(err.Error(), expected) {
 		t.Errorf("expected to find %q but found %v", expected, err)
 	}
+
+	// Templates sourcing each other's destination can't be rendered
+	task.Templates = []*Template{
+		{
+			SourcePath: "local/b.tpl",
+			DestPath:   "local/a.tpl",
+			ChangeMode: "noop",
+		},
+		{
+			SourcePath: "local/a.tpl",
+			DestPath:   "./local/b.tpl",
+			ChangeMode: "noop",
+		},
+	}
+
+	err = task.Validate(ephemeralDisk, JobTypeService, nil, nil)
+	if expected := "cyclic source dependency"; err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected to find %q but found %v", expected, err)
+	}
+}
+
+func TestTemplate_validateTemplateChain(t *testing.T) {
+	ci.Parallel(t)
+
+	tmpls := []*Template{
+		{SourcePath: "local/b.conf", DestPath: "local/c.conf"},
+		{SourcePath: "local/a.conf", DestPath: "local/b.conf"},
+		{EmbeddedTmpl: "{{ env \"NOMAD_TASK_NAME\" }}", DestPath: "local/a.conf"},
+	}
+	require.NoError(t, validateTemplateChain(tmpls))
+
+	tmpls[2].SourcePath = "local/c.conf"
+	require.ErrorContains(t, validateTemplateChain(tmpls), "cyclic source dependency")
+
+	self := []*Template{{SourcePath: "local/a.conf", DestPath: "local/a.conf"}}
+	require.ErrorContains(t, validateTemplateChain(self), "Template 1 has a cyclic source dependency")
 }
 
 func TestTemplate_Copy(t *testing.T) {
@@ -3894,6 +3930,45 @@ func TestTaskLifecycleConfig_Validate(t *testing.T) {
 			},
 			err: fmt.Errorf("no lifecycle hook provided"),
 		},
+		{
+			name: "prestop only",
+			tlc: &TaskLifecycleConfig{
+				PreStop: &TaskPreStopConfig{Command: "/bin/drain"},
+			},
+			err: nil,
+		},
+		{
+			name: "prestop sidecar without hook",
+			tlc: &TaskLifecycleConfig{
+				Sidecar: true,
+				PreStop: &TaskPreStopConfig{Command: "/bin/drain"},
+			},
+			err: fmt.Errorf("sidecar requires a lifecycle hook"),
+		},
+		{
+			name: "prestop missing command",
+			tlc: &TaskLifecycleConfig{
+				Hook:    "prestart",
+				PreStop: &TaskPreStopConfig{Timeout: -1},
+			},
+			err: fmt.Errorf("must specify a command"),
+		},
+		{
+			name: "poststop timeout",
+			tlc: &TaskLifecycleConfig{
+				Hook:    "poststop",
+				Timeout: time.Minute,
+			},
+			err: nil,
+		},
+		{
+			name: "prestart timeout",
+			tlc: &TaskLifecycleConfig{
+				Hook:    "prestart",
+				Timeout: time.Minute,
+			},
+			err: fmt.Errorf(`timeout is only supported for "poststop" tasks`),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3964,6 +4039,32 @@ func TestRestartPolicy_Validate(t *testing.T) {
 	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Interval can not be less than") {
 		t.Fatalf("expect interval too small error, got: %v", err)
 	}
+
+	// Exponential backoff passes with a max delay
+	p = &RestartPolicy{
+		Mode:       RestartPolicyModeDelay,
+		Attempts:   3,
+		Delay:      time.Second,
+		Interval:   time.Minute,
+		Backoff:    RestartBackoffExponential,
+		MaxDelay:   time.Minute,
+		ResetAfter: 10 * time.Minute,
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// Exponential backoff fails when max delay is less than delay
+	p.MaxDelay = 0
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Max delay cannot be less than") {
+		t.Fatalf("expect max delay error, got: %v", err)
+	}
+
+	// Bad backoff fails
+	p.Backoff = "fibonacci"
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Unsupported restart backoff") {
+		t.Fatalf("expect backoff error, got: %v", err)
+	}
 }
 
 func TestReschedulePolicy_Validate(t *testing.T) {
@@ -5846,6 +5947,38 @@ func TestParameterizedJobConfig_Validate_NonBatch(t *testing.T) {
 	}
 }
 
+func TestGCPolicy_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	job := testJob()
+	job.GC = &GCPolicy{
+		RetainFailed:     -1 * time.Hour,
+		RetainSuccessful: time.Hour,
+	}
+
+	err := job.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "retain_failed must be non-negative")
+
+	job.GC.RetainFailed = 24 * time.Hour
+	require.NoError(t, job.Validate())
+}
+
+func TestGCPolicy_Retention(t *testing.T) {
+	ci.Parallel(t)
+
+	var nilPolicy *GCPolicy
+	require.Zero(t, nilPolicy.Retention(AllocClientStatusFailed))
+
+	p := &GCPolicy{
+		RetainFailed:     24 * time.Hour,
+		RetainSuccessful: time.Hour,
+	}
+	require.Equal(t, 24*time.Hour, p.Retention(AllocClientStatusFailed))
+	require.Equal(t, time.Hour, p.Retention(AllocClientStatusComplete))
+	require.Equal(t, time.Hour, p.Retention(AllocClientStatusLost))
+}
+
 func TestJobConfig_Validate_StopAferClientDisconnect(t *testing.T) {
 	ci.Parallel(t)
 	// Setup a system Job with stop_after_client_disconnect set, which is invalid
@@ -51,7 +51,7 @@ func TestVariablesEndpoint_auth(t *testing.T) {
 	alloc3.Job.ParentID = jobID
 
 	store := srv.fsm.State()
-	must.NoError(t, store.UpsertNamespaces(1000, []*structs.Namespace{{Name: ns}}))
+	must.NoError(t, store.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{{Name: ns}}))
 	must.NoError(t, store.UpsertAllocs(
 		structs.MsgTypeTestSetup, 1001, []*structs.Allocation{alloc1, alloc2, alloc3}))
 
@@ -487,7 +487,7 @@ namespace "*" {}
 
 	store := srv.fsm.State()
 
-	must.NoError(t, store.UpsertNamespaces(1000, []*structs.Namespace{
+	must.NoError(t, store.UpsertNamespaces(structs.MsgTypeTestSetup, 1000, []*structs.Namespace{
 		{Name: "dev"}, {Name: "prod"}, {Name: "other"}}))
 
 	idx++
@@ -498,7 +498,7 @@ namespace "*" {}
 		sv := mock.VariableEncrypted()
 		sv.Namespace = ns
 		sv.Path = path
-		resp := store.VarSet(idx, &structs.VarApplyStateRequest{
+		resp := store.VarSet(structs.MsgTypeTestSetup, idx, &structs.VarApplyStateRequest{
 			Op:  structs.VarOpSet,
 			Var: sv,
 		})
@@ -657,7 +657,7 @@ func TestVariablesEndpoint_GetVariable_Blocking(t *testing.T) {
 	time.AfterFunc(delay, func() {
 		sv := mock.VariableEncrypted()
 		sv.Path = "bbb"
-		if resp := state.VarDelete(400, &structs.VarApplyStateRequest{Op: structs.VarOpDelete, Var: sv}); !resp.IsOk() {
+		if resp := state.VarDelete(structs.MsgTypeTestSetup, 400, &structs.VarApplyStateRequest{Op: structs.VarOpDelete, Var: sv}); !resp.IsOk() {
 			t.Fatalf("err: %v", resp.Error)
 		}
 	})
@@ -700,7 +700,7 @@ func writeVar(t *testing.T, s *Server, idx uint64, ns, path string) {
 			KeyID: kID,
 		},
 	}
-	resp := store.VarSet(idx, &structs.VarApplyStateRequest{
+	resp := store.VarSet(structs.MsgTypeTestSetup, idx, &structs.VarApplyStateRequest{
 		Op:  structs.VarOpSet,
 		Var: sve,
 	})
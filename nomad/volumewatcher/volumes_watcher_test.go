@@ -34,7 +34,7 @@ func TestVolumeWatch_EnableDisable(t *testing.T) {
 	vol := testVolume(plugin, alloc, node.ID)
 
 	index++
-	err := srv.State().UpsertCSIVolume(index, []*structs.CSIVolume{vol})
+	err := srv.State().UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{vol})
 	require.NoError(t, err)
 
 	// need to have just enough of a volume and claim in place so that
@@ -44,7 +44,7 @@ func TestVolumeWatch_EnableDisable(t *testing.T) {
 		State: structs.CSIVolumeClaimStateNodeDetached,
 	}
 	index++
-	err = srv.State().CSIVolumeClaim(index, vol.Namespace, vol.ID, claim)
+	err = srv.State().CSIVolumeClaim(structs.MsgTypeTestSetup, index, vol.Namespace, vol.ID, claim)
 	require.NoError(t, err)
 	require.Eventually(t, func() bool {
 		watcher.wlock.RLock()
@@ -82,7 +82,7 @@ func TestVolumeWatch_LeadershipTransition(t *testing.T) {
 	watcher.SetEnabled(true, srv.State(), "")
 
 	index++
-	err = srv.State().UpsertCSIVolume(index, []*structs.CSIVolume{vol})
+	err = srv.State().UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{vol})
 	require.NoError(t, err)
 
 	// we should get or start up a watcher when we get an update for
@@ -119,7 +119,7 @@ func TestVolumeWatch_LeadershipTransition(t *testing.T) {
 		State:        structs.CSIVolumeClaimStateUnpublishing,
 	}
 	index++
-	err = srv.State().CSIVolumeClaim(index, vol.Namespace, vol.ID, claim)
+	err = srv.State().CSIVolumeClaim(structs.MsgTypeTestSetup, index, vol.Namespace, vol.ID, claim)
 	require.NoError(t, err)
 
 	// create a new watcher and enable it to simulate the leadership
@@ -171,7 +171,7 @@ func TestVolumeWatch_StartStop(t *testing.T) {
 	// register a volume
 	vol := testVolume(plugin, alloc1, node.ID)
 	index++
-	err = srv.State().UpsertCSIVolume(index, []*structs.CSIVolume{vol})
+	err = srv.State().UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{vol})
 	require.NoError(t, err)
 
 	// assert we get a watcher; there are no claims so it should immediately stop
@@ -191,11 +191,11 @@ func TestVolumeWatch_StartStop(t *testing.T) {
 	}
 
 	index++
-	err = srv.State().CSIVolumeClaim(index, vol.Namespace, vol.ID, claim)
+	err = srv.State().CSIVolumeClaim(structs.MsgTypeTestSetup, index, vol.Namespace, vol.ID, claim)
 	require.NoError(t, err)
 	claim.AllocationID = alloc2.ID
 	index++
-	err = srv.State().CSIVolumeClaim(index, vol.Namespace, vol.ID, claim)
+	err = srv.State().CSIVolumeClaim(structs.MsgTypeTestSetup, index, vol.Namespace, vol.ID, claim)
 	require.NoError(t, err)
 
 	// reap the volume and assert nothing has happened
@@ -204,7 +204,7 @@ func TestVolumeWatch_StartStop(t *testing.T) {
 		NodeID:       node.ID,
 	}
 	index++
-	err = srv.State().CSIVolumeClaim(index, vol.Namespace, vol.ID, claim)
+	err = srv.State().CSIVolumeClaim(structs.MsgTypeTestSetup, index, vol.Namespace, vol.ID, claim)
 	require.NoError(t, err)
 
 	ws := memdb.NewWatchSet()
@@ -218,7 +218,7 @@ func TestVolumeWatch_StartStop(t *testing.T) {
 	require.NoError(t, err)
 	index++
 	claim.State = structs.CSIVolumeClaimStateReadyToFree
-	err = srv.State().CSIVolumeClaim(index, vol.Namespace, vol.ID, claim)
+	err = srv.State().CSIVolumeClaim(structs.MsgTypeTestSetup, index, vol.Namespace, vol.ID, claim)
 	require.NoError(t, err)
 
 	// 1 claim has been released and watcher stops
@@ -258,7 +258,7 @@ func TestVolumeWatch_RegisterDeregister(t *testing.T) {
 	// register a volume without claims
 	vol := mock.CSIVolume(plugin)
 	index++
-	err := srv.State().UpsertCSIVolume(index, []*structs.CSIVolume{vol})
+	err := srv.State().UpsertCSIVolume(structs.MsgTypeTestSetup, index, []*structs.CSIVolume{vol})
 	require.NoError(t, err)
 
 	// watcher should be started but immediately stopped
@@ -62,6 +62,10 @@ type ClientDriverConfig struct {
 	// ClientMinPort is the lower range of the ports that the client uses for
 	// communicating with plugin subsystems over loopback
 	ClientMinPort uint
+
+	// DataDir is the directory the client keeps its state in, under which
+	// plugins may keep state that outlives allocations
+	DataDir string
 }
 
 func (c *AgentConfig) toProto() *proto.NomadConfig {
@@ -75,6 +79,7 @@ func (c *AgentConfig) toProto() *proto.NomadConfig {
 		cfg.Driver = &proto.NomadDriverConfig{
 			ClientMaxPort: uint32(c.Driver.ClientMaxPort),
 			ClientMinPort: uint32(c.Driver.ClientMinPort),
+			DataDir:       c.Driver.DataDir,
 		}
 	}
 
@@ -91,6 +96,7 @@ func nomadConfigFromProto(pb *proto.NomadConfig) *AgentConfig {
 		cfg.Driver = &ClientDriverConfig{
 			ClientMaxPort: uint(pb.Driver.ClientMaxPort),
 			ClientMinPort: uint(pb.Driver.ClientMinPort),
+			DataDir:       pb.Driver.DataDir,
 		}
 	}
 
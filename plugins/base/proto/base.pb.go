@@ -340,7 +340,11 @@ type NomadDriverConfig struct {
 	// ClientMinPort is the lower range of the ports that the client uses for
 	// communicating with plugin subsystems over loopback
 	// buf:lint:ignore FIELD_LOWER_SNAKE_CASE
-	ClientMinPort        uint32   `protobuf:"varint,2,opt,name=ClientMinPort,proto3" json:"ClientMinPort,omitempty"`
+	ClientMinPort uint32 `protobuf:"varint,2,opt,name=ClientMinPort,proto3" json:"ClientMinPort,omitempty"`
+	// DataDir is the directory the client keeps its state in, under which
+	// plugins may keep state that outlives allocations
+	// buf:lint:ignore FIELD_LOWER_SNAKE_CASE
+	DataDir              string   `protobuf:"bytes,3,opt,name=DataDir,proto3" json:"DataDir,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -385,6 +389,13 @@ func (m *NomadDriverConfig) GetClientMinPort() uint32 {
 	return 0
 }
 
+func (m *NomadDriverConfig) GetDataDir() string {
+	if m != nil {
+		return m.DataDir
+	}
+	return ""
+}
+
 // SetConfigResponse is used to respond to setting the configuration
 type SetConfigResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -434,40 +445,41 @@ func init() {
 }
 
 var fileDescriptor_19edef855873449e = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x51, 0x6f, 0x12, 0x41,
-	0x10, 0xee, 0x01, 0xd2, 0x30, 0x40, 0x73, 0x2c, 0x9a, 0x10, 0x12, 0x13, 0x72, 0xb1, 0x09, 0x31,
-	0xcd, 0x12, 0x51, 0xd4, 0x47, 0x85, 0xf2, 0x40, 0x4c, 0xb1, 0x59, 0x14, 0x8d, 0x31, 0xb9, 0x6c,
-	0x8f, 0x2d, 0x77, 0x11, 0xf6, 0xd6, 0xdb, 0x6b, 0x63, 0x4d, 0x7c, 0xf2, 0xd9, 0x5f, 0xe4, 0xa3,
-	0x7f, 0xcc, 0xdc, 0xee, 0x5e, 0x39, 0x5a, 0x4d, 0x8f, 0xa7, 0x1b, 0x66, 0xbe, 0xef, 0x9b, 0x99,
-	0x8f, 0x59, 0x78, 0x28, 0x56, 0x17, 0xcb, 0x80, 0xcb, 0xde, 0x19, 0x95, 0xac, 0x27, 0xa2, 0x30,
-	0x0e, 0x55, 0x88, 0x55, 0x88, 0x1c, 0x9f, 0x4a, 0x3f, 0xf0, 0xc2, 0x48, 0x60, 0x1e, 0xae, 0xe9,
-	0x02, 0x1b, 0x38, 0xde, 0x60, 0xda, 0x87, 0xa9, 0x84, 0xf4, 0x69, 0xc4, 0x16, 0x3d, 0xdf, 0x5b,
-	0x49, 0xc1, 0xbc, 0xe4, 0xeb, 0x26, 0x81, 0x86, 0x39, 0x4d, 0x68, 0x9c, 0x2a, 0xe0, 0x84, 0x9f,
-	0x87, 0x84, 0x7d, 0xbd, 0x60, 0x32, 0x76, 0xfe, 0x58, 0x80, 0xb2, 0x59, 0x29, 0x42, 0x2e, 0x19,
-	0x1a, 0x42, 0x29, 0xbe, 0x12, 0xac, 0x65, 0x75, 0xac, 0xee, 0x41, 0x1f, 0xe3, 0xbb, 0xa7, 0xc0,
-	0x5a, 0xe5, 0xdd, 0x95, 0x60, 0x44, 0x71, 0x11, 0x86, 0xa6, 0x86, 0xb9, 0x54, 0x04, 0xee, 0x25,
-	0x8b, 0x64, 0x10, 0x72, 0xd9, 0x2a, 0x74, 0x8a, 0xdd, 0x0a, 0x69, 0xe8, 0xd2, 0x6b, 0x11, 0xcc,
-	0x4d, 0x01, 0x1d, 0xc2, 0x81, 0xc1, 0x1b, 0x6c, 0xab, 0xd8, 0xb1, 0xba, 0x15, 0x52, 0xd7, 0x59,
-	0x83, 0x43, 0x08, 0x4a, 0x9c, 0xae, 0x59, 0xab, 0xa4, 0x8a, 0x2a, 0x76, 0x1e, 0x40, 0x73, 0x14,
-	0xf2, 0xf3, 0x60, 0x39, 0xf3, 0x7c, 0xb6, 0xa6, 0xe9, 0x72, 0x1f, 0xe1, 0xfe, 0x76, 0xda, 0x6c,
-	0xf7, 0x0a, 0x4a, 0x89, 0x2f, 0x6a, 0xbb, 0x6a, 0xff, 0xe8, 0xbf, 0xdb, 0x69, 0x3f, 0xb1, 0xf1,
-	0x13, 0xcf, 0x04, 0xf3, 0x88, 0x62, 0x3a, 0xbf, 0x2d, 0xb0, 0x67, 0x2c, 0xd6, 0xea, 0xa6, 0x5d,
-	0xb2, 0xc0, 0x5a, 0x2e, 0x05, 0xf5, 0xbe, 0xb8, 0x9e, 0x2a, 0xa8, 0x06, 0x35, 0x52, 0x37, 0x59,
-	0x8d, 0x46, 0x04, 0x6a, 0xaa, 0x4d, 0x0a, 0x2a, 0xa8, 0x29, 0x7a, 0x79, 0x3c, 0x9e, 0x26, 0x05,
-	0xd3, 0xb4, 0xca, 0x37, 0x3f, 0xd0, 0x11, 0xa0, 0xdb, 0x5e, 0x1b, 0xff, 0xec, 0x9b, 0x56, 0x3b,
-	0x9f, 0xa1, 0x9a, 0x51, 0x42, 0x27, 0x50, 0x5e, 0x44, 0xc1, 0x25, 0x8b, 0x8c, 0x21, 0x83, 0xdc,
-	0xa3, 0x1c, 0x2b, 0x9a, 0x19, 0xc8, 0x88, 0x38, 0x2e, 0x34, 0x6e, 0x15, 0xd1, 0x23, 0xa8, 0x8f,
-	0x56, 0x01, 0xe3, 0xf1, 0x09, 0xfd, 0x76, 0x1a, 0x46, 0xb1, 0x6a, 0x55, 0x27, 0xdb, 0xc9, 0x0c,
-	0x2a, 0xe0, 0x0a, 0x55, 0xd8, 0x42, 0xe9, 0x64, 0x72, 0xc8, 0x19, 0xef, 0xf5, 0x7f, 0xfa, 0xf8,
-	0x09, 0xc0, 0xe6, 0x02, 0x51, 0x15, 0xf6, 0xdf, 0x4f, 0xdf, 0x4c, 0xdf, 0x7e, 0x98, 0xda, 0x7b,
-	0x08, 0xa0, 0x7c, 0x4c, 0x26, 0xf3, 0x31, 0xb1, 0x0b, 0x2a, 0x1e, 0xcf, 0x27, 0xa3, 0xb1, 0x5d,
-	0xec, 0xff, 0x2a, 0x02, 0x0c, 0xa9, 0x64, 0x9a, 0x87, 0x7e, 0xa4, 0x0a, 0xc9, 0x4b, 0x40, 0x83,
-	0xfc, 0x37, 0x9f, 0x79, 0x4f, 0xed, 0xe7, 0xbb, 0xd2, 0xf4, 0xf8, 0xce, 0x1e, 0xfa, 0x69, 0x41,
-	0x2d, 0x7b, 0xad, 0xe8, 0x45, 0x1e, 0xa9, 0x7f, 0x9c, 0x7d, 0xfb, 0xe5, 0xee, 0xc4, 0xeb, 0x29,
-	0xbe, 0x43, 0xe5, 0xda, 0x5b, 0xf4, 0x2c, 0x8f, 0xd0, 0xcd, 0x67, 0xd0, 0x1e, 0xec, 0xc8, 0x4a,
-	0x7b, 0x0f, 0xf7, 0x3f, 0xdd, 0x53, 0xc5, 0xb3, 0xb2, 0xfa, 0x3c, 0xfd, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xdc, 0x0f, 0xf5, 0x51, 0x1c, 0x05, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xdf, 0x8f, 0x12, 0x3f,
+	0x10, 0xbf, 0x05, 0xbe, 0x10, 0x06, 0xb8, 0xc0, 0xf0, 0x35, 0x21, 0x24, 0x26, 0x64, 0xe3, 0x25,
+	0xc4, 0x5c, 0x96, 0x88, 0xa2, 0x3e, 0x2a, 0x3f, 0x1e, 0x88, 0x39, 0xbc, 0x14, 0x45, 0x63, 0x4c,
+	0x48, 0x6f, 0xe9, 0x41, 0x23, 0x74, 0xeb, 0x76, 0xef, 0x22, 0x26, 0x3e, 0xf9, 0xec, 0x5f, 0xe4,
+	0xa3, 0xff, 0x98, 0xd9, 0xb6, 0x1c, 0xcb, 0x9d, 0x46, 0x78, 0xea, 0x74, 0xe6, 0x33, 0x9f, 0x99,
+	0xf9, 0xb4, 0x03, 0xf7, 0xe5, 0xf2, 0x6a, 0xce, 0x85, 0x6a, 0x5d, 0x50, 0xc5, 0x5a, 0x32, 0x0c,
+	0xa2, 0x40, 0x9b, 0x9e, 0x36, 0xd1, 0x5d, 0x50, 0xb5, 0xe0, 0x7e, 0x10, 0x4a, 0x4f, 0x04, 0x2b,
+	0x3a, 0xf3, 0x2c, 0xdc, 0xdb, 0x62, 0xea, 0x27, 0x1b, 0x0a, 0xb5, 0xa0, 0x21, 0x9b, 0xb5, 0x16,
+	0xfe, 0x52, 0x49, 0xe6, 0xc7, 0xe7, 0x34, 0x36, 0x0c, 0xcc, 0xad, 0x42, 0xe5, 0x5c, 0x03, 0x87,
+	0xe2, 0x32, 0x20, 0xec, 0xf3, 0x15, 0x53, 0x91, 0xfb, 0xcb, 0x01, 0x4c, 0x7a, 0x95, 0x0c, 0x84,
+	0x62, 0xd8, 0x85, 0x4c, 0xb4, 0x96, 0xac, 0xe6, 0x34, 0x9c, 0xe6, 0x71, 0xdb, 0xf3, 0xfe, 0xdd,
+	0x85, 0x67, 0x58, 0xde, 0xac, 0x25, 0x23, 0x3a, 0x17, 0x3d, 0xa8, 0x1a, 0xd8, 0x94, 0x4a, 0x3e,
+	0xbd, 0x66, 0xa1, 0xe2, 0x81, 0x50, 0xb5, 0x54, 0x23, 0xdd, 0xcc, 0x93, 0x8a, 0x09, 0xbd, 0x94,
+	0x7c, 0x62, 0x03, 0x78, 0x02, 0xc7, 0x16, 0x6f, 0xb1, 0xb5, 0x74, 0xc3, 0x69, 0xe6, 0x49, 0xc9,
+	0x78, 0x2d, 0x0e, 0x11, 0x32, 0x82, 0xae, 0x58, 0x2d, 0xa3, 0x83, 0xda, 0x76, 0xef, 0x41, 0xb5,
+	0x17, 0x88, 0x4b, 0x3e, 0x1f, 0xfb, 0x0b, 0xb6, 0xa2, 0x9b, 0xe1, 0xde, 0xc3, 0xff, 0xbb, 0x6e,
+	0x3b, 0xdd, 0x0b, 0xc8, 0xc4, 0xba, 0xe8, 0xe9, 0x0a, 0xed, 0xd3, 0xbf, 0x4e, 0x67, 0xf4, 0xf4,
+	0xac, 0x9e, 0xde, 0x58, 0x32, 0x9f, 0xe8, 0x4c, 0xf7, 0xa7, 0x03, 0xe5, 0x31, 0x8b, 0x0c, 0xbb,
+	0x2d, 0x17, 0x0f, 0xb0, 0x52, 0x73, 0x49, 0xfd, 0x4f, 0x53, 0x5f, 0x07, 0x74, 0x81, 0x22, 0x29,
+	0x59, 0xaf, 0x41, 0x23, 0x81, 0xa2, 0x2e, 0xb3, 0x01, 0xa5, 0x74, 0x17, 0xad, 0x7d, 0x34, 0x1e,
+	0xc5, 0x01, 0x5b, 0xb4, 0x20, 0xb6, 0x17, 0x3c, 0x05, 0xbc, 0xab, 0xb5, 0xd5, 0xaf, 0x7c, 0x5b,
+	0x6a, 0xf7, 0x23, 0x14, 0x12, 0x4c, 0x78, 0x06, 0xd9, 0x59, 0xc8, 0xaf, 0x59, 0x68, 0x05, 0xe9,
+	0xec, 0xdd, 0x4a, 0x5f, 0xa7, 0xd9, 0x86, 0x2c, 0x89, 0xbb, 0x86, 0xca, 0x9d, 0x20, 0x3e, 0x80,
+	0x52, 0x6f, 0xc9, 0x99, 0x88, 0xce, 0xe8, 0x97, 0xf3, 0x20, 0x8c, 0x74, 0xa9, 0x12, 0xd9, 0x75,
+	0x26, 0x50, 0x5c, 0x68, 0x54, 0x6a, 0x07, 0x65, 0x9c, 0x58, 0x83, 0x5c, 0x9f, 0x46, 0xb4, 0xcf,
+	0x43, 0x3b, 0xe1, 0xe6, 0x1a, 0x7f, 0xf1, 0xc4, 0xab, 0x98, 0xd7, 0x7e, 0xf8, 0x08, 0x60, 0xfb,
+	0x37, 0xb1, 0x00, 0xb9, 0xb7, 0xa3, 0x57, 0xa3, 0xd7, 0xef, 0x46, 0xe5, 0x23, 0x04, 0xc8, 0xf6,
+	0xc9, 0x70, 0x32, 0x20, 0xe5, 0x94, 0xb6, 0x07, 0x93, 0x61, 0x6f, 0x50, 0x4e, 0xb7, 0x7f, 0xa4,
+	0x01, 0xba, 0x54, 0x31, 0x93, 0x87, 0xdf, 0x00, 0xb6, 0x3b, 0x82, 0x9d, 0xfd, 0xb7, 0x21, 0xb1,
+	0x69, 0xf5, 0xa7, 0x87, 0xa6, 0x99, 0xf6, 0xdd, 0x23, 0xfc, 0xee, 0x40, 0x31, 0xf9, 0x8f, 0xf1,
+	0xd9, 0x3e, 0x54, 0x7f, 0x58, 0x88, 0xfa, 0xf3, 0xc3, 0x13, 0x6f, 0xba, 0xf8, 0x0a, 0xf9, 0x1b,
+	0x6d, 0xf1, 0xc9, 0x3e, 0x44, 0xb7, 0x17, 0xa4, 0xde, 0x39, 0x30, 0x6b, 0x53, 0xbb, 0x9b, 0xfb,
+	0xf0, 0x9f, 0x0e, 0x5e, 0x64, 0xf5, 0xf1, 0xf8, 0xf7, 0x00, 0x7f, 0xf8, 0x86, 0x80, 0x36, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
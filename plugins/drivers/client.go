@@ -74,6 +74,8 @@ func (d *driverPluginClient) Capabilities() (*Capabilities, error) {
 
 		caps.MountConfigs = MountConfigSupport(resp.Capabilities.MountConfigs)
 		caps.RemoteTasks = resp.Capabilities.RemoteTasks
+		caps.Checkpoint = resp.Capabilities.Checkpoint
+		caps.Pause = resp.Capabilities.Pause
 	}
 
 	return caps, nil
@@ -497,3 +499,42 @@ func (d *driverPluginClient) DestroyNetwork(allocID string, spec *NetworkIsolati
 
 	return nil
 }
+
+func (d *driverPluginClient) CheckpointTask(taskID string) error {
+	req := &proto.CheckpointTaskRequest{
+		TaskId: taskID,
+	}
+
+	_, err := d.client.CheckpointTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) PauseTask(taskID string) error {
+	req := &proto.PauseTaskRequest{
+		TaskId: taskID,
+	}
+
+	_, err := d.client.PauseTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
+
+func (d *driverPluginClient) ResumeTask(taskID string) error {
+	req := &proto.ResumeTaskRequest{
+		TaskId: taskID,
+	}
+
+	_, err := d.client.ResumeTask(d.doneCtx, req)
+	if err != nil {
+		return grpcutils.HandleGrpcErr(err, d.doneCtx)
+	}
+
+	return nil
+}
@@ -21,6 +21,9 @@ type ResourceUsage = cstructs.ResourceUsage
 // and the resource usage of the individual pids
 type TaskResourceUsage = cstructs.TaskResourceUsage
 
+// ProcessInfo describes a process of a task
+type ProcessInfo = cstructs.ProcessInfo
+
 // CheckBufSize is the size of the buffer that is used for job output
 const CheckBufSize = cstructs.CheckBufSize
 
@@ -94,6 +94,23 @@ type DriverNetworkManager interface {
 	DestroyNetwork(allocID string, spec *NetworkIsolationSpec) error
 }
 
+// CheckpointDriver is the interface implemented by drivers which can
+// checkpoint running tasks. CheckpointTask dumps the state of the task into
+// its TaskDir().CheckpointDir and stops it. A task started while its
+// checkpoint directory exists is restored from the checkpoint, falling back
+// to a fresh start if that fails.
+type CheckpointDriver interface {
+	CheckpointTask(taskID string) error
+}
+
+// PauseDriver is the interface implemented by drivers which can pause
+// running tasks. PauseTask freezes all processes of the task, which keep
+// their state until thawed by ResumeTask.
+type PauseDriver interface {
+	PauseTask(taskID string) error
+	ResumeTask(taskID string) error
+}
+
 // DriverSignalTaskNotSupported can be embedded by drivers which don't support
 // the SignalTask RPC. This satisfies the SignalTask func requirement for the
 // DriverPlugin interface.
@@ -171,6 +188,13 @@ type Capabilities struct {
 	// adjust behavior such as propogating task handles between allocations
 	// to avoid downtime when a client is lost.
 	RemoteTasks bool
+
+	// Checkpoint indicates the driver implements CheckpointDriver and
+	// restores tasks started with a checkpoint in their CheckpointDir.
+	Checkpoint bool
+
+	// Pause indicates the driver implements PauseDriver.
+	Pause bool
 }
 
 func (c *Capabilities) HasNetIsolationMode(m NetIsolationMode) bool {
@@ -335,6 +359,7 @@ func (tc *TaskConfig) TaskDir() *allocdir.TaskDir {
 		SharedTaskDir:  filepath.Join(taskDir, allocdir.SharedAllocName),
 		LocalDir:       filepath.Join(taskDir, allocdir.TaskLocal),
 		SecretsDir:     filepath.Join(taskDir, allocdir.TaskSecrets),
+		CheckpointDir:  filepath.Join(tc.AllocDir, allocdir.CheckpointDirName, tc.Name),
 	}
 }
 
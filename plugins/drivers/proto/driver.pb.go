@@ -137,7 +137,7 @@ func (x DriverCapabilities_FSIsolation) String() string {
 }
 
 func (DriverCapabilities_FSIsolation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{38, 0}
 }
 
 type DriverCapabilities_MountConfigs int32
@@ -165,7 +165,7 @@ func (x DriverCapabilities_MountConfigs) String() string {
 }
 
 func (DriverCapabilities_MountConfigs) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 1}
+	return fileDescriptor_4a8f45747846a74d, []int{38, 1}
 }
 
 type NetworkIsolationSpec_NetworkIsolationMode int32
@@ -196,7 +196,7 @@ func (x NetworkIsolationSpec_NetworkIsolationMode) String() string {
 }
 
 func (NetworkIsolationSpec_NetworkIsolationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{39, 0}
 }
 
 type CPUUsage_Fields int32
@@ -233,7 +233,7 @@ func (x CPUUsage_Fields) String() string {
 }
 
 func (CPUUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{61, 0}
 }
 
 type MemoryUsage_Fields int32
@@ -273,7 +273,7 @@ func (x MemoryUsage_Fields) String() string {
 }
 
 func (MemoryUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{62, 0}
 }
 
 type TaskConfigSchemaRequest struct {
@@ -1833,6 +1833,219 @@ func (m *DestroyNetworkResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DestroyNetworkResponse proto.InternalMessageInfo
 
+type CheckpointTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskRequest) Reset()         { *m = CheckpointTaskRequest{} }
+func (m *CheckpointTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskRequest) ProtoMessage()    {}
+func (*CheckpointTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{32}
+}
+
+func (m *CheckpointTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskRequest.Unmarshal(m, b)
+}
+func (m *CheckpointTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskRequest.Merge(m, src)
+}
+func (m *CheckpointTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskRequest.Size(m)
+}
+func (m *CheckpointTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskRequest proto.InternalMessageInfo
+
+func (m *CheckpointTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type CheckpointTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointTaskResponse) Reset()         { *m = CheckpointTaskResponse{} }
+func (m *CheckpointTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTaskResponse) ProtoMessage()    {}
+func (*CheckpointTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{33}
+}
+
+func (m *CheckpointTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointTaskResponse.Unmarshal(m, b)
+}
+func (m *CheckpointTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckpointTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTaskResponse.Merge(m, src)
+}
+func (m *CheckpointTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckpointTaskResponse.Size(m)
+}
+func (m *CheckpointTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTaskResponse proto.InternalMessageInfo
+
+type PauseTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskRequest) Reset()         { *m = PauseTaskRequest{} }
+func (m *PauseTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskRequest) ProtoMessage()    {}
+func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{34}
+}
+
+func (m *PauseTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTaskRequest.Unmarshal(m, b)
+}
+func (m *PauseTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskRequest.Merge(m, src)
+}
+func (m *PauseTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseTaskRequest.Size(m)
+}
+func (m *PauseTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskRequest proto.InternalMessageInfo
+
+func (m *PauseTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type PauseTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseTaskResponse) Reset()         { *m = PauseTaskResponse{} }
+func (m *PauseTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseTaskResponse) ProtoMessage()    {}
+func (*PauseTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{35}
+}
+
+func (m *PauseTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseTaskResponse.Unmarshal(m, b)
+}
+func (m *PauseTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseTaskResponse.Merge(m, src)
+}
+func (m *PauseTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseTaskResponse.Size(m)
+}
+func (m *PauseTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseTaskResponse proto.InternalMessageInfo
+
+type ResumeTaskRequest struct {
+	// TaskId is the ID of the target task
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskRequest) Reset()         { *m = ResumeTaskRequest{} }
+func (m *ResumeTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskRequest) ProtoMessage()    {}
+func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{36}
+}
+
+func (m *ResumeTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTaskRequest.Unmarshal(m, b)
+}
+func (m *ResumeTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskRequest.Merge(m, src)
+}
+func (m *ResumeTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeTaskRequest.Size(m)
+}
+func (m *ResumeTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskRequest proto.InternalMessageInfo
+
+func (m *ResumeTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type ResumeTaskResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeTaskResponse) Reset()         { *m = ResumeTaskResponse{} }
+func (m *ResumeTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeTaskResponse) ProtoMessage()    {}
+func (*ResumeTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{37}
+}
+
+func (m *ResumeTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeTaskResponse.Unmarshal(m, b)
+}
+func (m *ResumeTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeTaskResponse.Merge(m, src)
+}
+func (m *ResumeTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeTaskResponse.Size(m)
+}
+func (m *ResumeTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeTaskResponse proto.InternalMessageInfo
+
 type DriverCapabilities struct {
 	// SendSignals indicates that the driver can send process signals (ex. SIGUSR1)
 	// to the task.
@@ -1848,7 +2061,12 @@ type DriverCapabilities struct {
 	MountConfigs DriverCapabilities_MountConfigs `protobuf:"varint,6,opt,name=mount_configs,json=mountConfigs,proto3,enum=hashicorp.nomad.plugins.drivers.proto.DriverCapabilities_MountConfigs" json:"mount_configs,omitempty"`
 	// remote_tasks indicates whether the driver executes tasks remotely such
 	// on cloud runtimes like AWS ECS.
-	RemoteTasks          bool     `protobuf:"varint,7,opt,name=remote_tasks,json=remoteTasks,proto3" json:"remote_tasks,omitempty"`
+	RemoteTasks bool `protobuf:"varint,7,opt,name=remote_tasks,json=remoteTasks,proto3" json:"remote_tasks,omitempty"`
+	// checkpoint indicates whether the driver can checkpoint running tasks
+	// and restore them when started again, possibly on another client.
+	Checkpoint bool `protobuf:"varint,8,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// pause indicates whether the driver can pause and resume running tasks.
+	Pause                bool     `protobuf:"varint,9,opt,name=pause,proto3" json:"pause,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1858,7 +2076,7 @@ func (m *DriverCapabilities) Reset()         { *m = DriverCapabilities{} }
 func (m *DriverCapabilities) String() string { return proto.CompactTextString(m) }
 func (*DriverCapabilities) ProtoMessage()    {}
 func (*DriverCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32}
+	return fileDescriptor_4a8f45747846a74d, []int{38}
 }
 
 func (m *DriverCapabilities) XXX_Unmarshal(b []byte) error {
@@ -1928,6 +2146,20 @@ func (m *DriverCapabilities) GetRemoteTasks() bool {
 	return false
 }
 
+func (m *DriverCapabilities) GetCheckpoint() bool {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return false
+}
+
+func (m *DriverCapabilities) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
 type NetworkIsolationSpec struct {
 	Mode                 NetworkIsolationSpec_NetworkIsolationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec_NetworkIsolationMode" json:"mode,omitempty"`
 	Path                 string                                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -1942,7 +2174,7 @@ func (m *NetworkIsolationSpec) Reset()         { *m = NetworkIsolationSpec{} }
 func (m *NetworkIsolationSpec) String() string { return proto.CompactTextString(m) }
 func (*NetworkIsolationSpec) ProtoMessage()    {}
 func (*NetworkIsolationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33}
+	return fileDescriptor_4a8f45747846a74d, []int{39}
 }
 
 func (m *NetworkIsolationSpec) XXX_Unmarshal(b []byte) error {
@@ -2003,7 +2235,7 @@ func (m *HostsConfig) Reset()         { *m = HostsConfig{} }
 func (m *HostsConfig) String() string { return proto.CompactTextString(m) }
 func (*HostsConfig) ProtoMessage()    {}
 func (*HostsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{34}
+	return fileDescriptor_4a8f45747846a74d, []int{40}
 }
 
 func (m *HostsConfig) XXX_Unmarshal(b []byte) error {
@@ -2051,7 +2283,7 @@ func (m *DNSConfig) Reset()         { *m = DNSConfig{} }
 func (m *DNSConfig) String() string { return proto.CompactTextString(m) }
 func (*DNSConfig) ProtoMessage()    {}
 func (*DNSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{35}
+	return fileDescriptor_4a8f45747846a74d, []int{41}
 }
 
 func (m *DNSConfig) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2375,7 @@ func (m *TaskConfig) Reset()         { *m = TaskConfig{} }
 func (m *TaskConfig) String() string { return proto.CompactTextString(m) }
 func (*TaskConfig) ProtoMessage()    {}
 func (*TaskConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{36}
+	return fileDescriptor_4a8f45747846a74d, []int{42}
 }
 
 func (m *TaskConfig) XXX_Unmarshal(b []byte) error {
@@ -2300,7 +2532,7 @@ func (m *Resources) Reset()         { *m = Resources{} }
 func (m *Resources) String() string { return proto.CompactTextString(m) }
 func (*Resources) ProtoMessage()    {}
 func (*Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{37}
+	return fileDescriptor_4a8f45747846a74d, []int{43}
 }
 
 func (m *Resources) XXX_Unmarshal(b []byte) error {
@@ -2355,7 +2587,7 @@ func (m *AllocatedTaskResources) Reset()         { *m = AllocatedTaskResources{}
 func (m *AllocatedTaskResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedTaskResources) ProtoMessage()    {}
 func (*AllocatedTaskResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{38}
+	return fileDescriptor_4a8f45747846a74d, []int{44}
 }
 
 func (m *AllocatedTaskResources) XXX_Unmarshal(b []byte) error {
@@ -2408,7 +2640,7 @@ func (m *AllocatedCpuResources) Reset()         { *m = AllocatedCpuResources{} }
 func (m *AllocatedCpuResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedCpuResources) ProtoMessage()    {}
 func (*AllocatedCpuResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{39}
+	return fileDescriptor_4a8f45747846a74d, []int{45}
 }
 
 func (m *AllocatedCpuResources) XXX_Unmarshal(b []byte) error {
@@ -2448,7 +2680,7 @@ func (m *AllocatedMemoryResources) Reset()         { *m = AllocatedMemoryResourc
 func (m *AllocatedMemoryResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedMemoryResources) ProtoMessage()    {}
 func (*AllocatedMemoryResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{40}
+	return fileDescriptor_4a8f45747846a74d, []int{46}
 }
 
 func (m *AllocatedMemoryResources) XXX_Unmarshal(b []byte) error {
@@ -2499,7 +2731,7 @@ func (m *NetworkResource) Reset()         { *m = NetworkResource{} }
 func (m *NetworkResource) String() string { return proto.CompactTextString(m) }
 func (*NetworkResource) ProtoMessage()    {}
 func (*NetworkResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{41}
+	return fileDescriptor_4a8f45747846a74d, []int{47}
 }
 
 func (m *NetworkResource) XXX_Unmarshal(b []byte) error {
@@ -2574,7 +2806,7 @@ func (m *NetworkPort) Reset()         { *m = NetworkPort{} }
 func (m *NetworkPort) String() string { return proto.CompactTextString(m) }
 func (*NetworkPort) ProtoMessage()    {}
 func (*NetworkPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{42}
+	return fileDescriptor_4a8f45747846a74d, []int{48}
 }
 
 func (m *NetworkPort) XXX_Unmarshal(b []byte) error {
@@ -2623,7 +2855,7 @@ func (m *PortMapping) Reset()         { *m = PortMapping{} }
 func (m *PortMapping) String() string { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()    {}
 func (*PortMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{43}
+	return fileDescriptor_4a8f45747846a74d, []int{49}
 }
 
 func (m *PortMapping) XXX_Unmarshal(b []byte) error {
@@ -2700,7 +2932,7 @@ func (m *LinuxResources) Reset()         { *m = LinuxResources{} }
 func (m *LinuxResources) String() string { return proto.CompactTextString(m) }
 func (*LinuxResources) ProtoMessage()    {}
 func (*LinuxResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{44}
+	return fileDescriptor_4a8f45747846a74d, []int{50}
 }
 
 func (m *LinuxResources) XXX_Unmarshal(b []byte) error {
@@ -2793,7 +3025,7 @@ func (m *Mount) Reset()         { *m = Mount{} }
 func (m *Mount) String() string { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()    {}
 func (*Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{45}
+	return fileDescriptor_4a8f45747846a74d, []int{51}
 }
 
 func (m *Mount) XXX_Unmarshal(b []byte) error {
@@ -2857,7 +3089,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{46}
+	return fileDescriptor_4a8f45747846a74d, []int{52}
 }
 
 func (m *Device) XXX_Unmarshal(b []byte) error {
@@ -2919,7 +3151,7 @@ func (m *TaskHandle) Reset()         { *m = TaskHandle{} }
 func (m *TaskHandle) String() string { return proto.CompactTextString(m) }
 func (*TaskHandle) ProtoMessage()    {}
 func (*TaskHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{47}
+	return fileDescriptor_4a8f45747846a74d, []int{53}
 }
 
 func (m *TaskHandle) XXX_Unmarshal(b []byte) error {
@@ -2987,7 +3219,7 @@ func (m *NetworkOverride) Reset()         { *m = NetworkOverride{} }
 func (m *NetworkOverride) String() string { return proto.CompactTextString(m) }
 func (*NetworkOverride) ProtoMessage()    {}
 func (*NetworkOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{48}
+	return fileDescriptor_4a8f45747846a74d, []int{54}
 }
 
 func (m *NetworkOverride) XXX_Unmarshal(b []byte) error {
@@ -3046,7 +3278,7 @@ func (m *ExitResult) Reset()         { *m = ExitResult{} }
 func (m *ExitResult) String() string { return proto.CompactTextString(m) }
 func (*ExitResult) ProtoMessage()    {}
 func (*ExitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{49}
+	return fileDescriptor_4a8f45747846a74d, []int{55}
 }
 
 func (m *ExitResult) XXX_Unmarshal(b []byte) error {
@@ -3110,7 +3342,7 @@ func (m *TaskStatus) Reset()         { *m = TaskStatus{} }
 func (m *TaskStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskStatus) ProtoMessage()    {}
 func (*TaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{50}
+	return fileDescriptor_4a8f45747846a74d, []int{56}
 }
 
 func (m *TaskStatus) XXX_Unmarshal(b []byte) error {
@@ -3186,7 +3418,7 @@ func (m *TaskDriverStatus) Reset()         { *m = TaskDriverStatus{} }
 func (m *TaskDriverStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskDriverStatus) ProtoMessage()    {}
 func (*TaskDriverStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{51}
+	return fileDescriptor_4a8f45747846a74d, []int{57}
 }
 
 func (m *TaskDriverStatus) XXX_Unmarshal(b []byte) error {
@@ -3222,17 +3454,19 @@ type TaskStats struct {
 	// AggResourceUsage is the aggreate usage of all processes
 	AggResourceUsage *TaskResourceUsage `protobuf:"bytes,3,opt,name=agg_resource_usage,json=aggResourceUsage,proto3" json:"agg_resource_usage,omitempty"`
 	// ResourceUsageByPid breaks the usage stats by process
-	ResourceUsageByPid   map[string]*TaskResourceUsage `protobuf:"bytes,4,rep,name=resource_usage_by_pid,json=resourceUsageByPid,proto3" json:"resource_usage_by_pid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	ResourceUsageByPid map[string]*TaskResourceUsage `protobuf:"bytes,4,rep,name=resource_usage_by_pid,json=resourceUsageByPid,proto3" json:"resource_usage_by_pid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Processes describes each process of the task, keyed by pid
+	Processes            map[string]*ProcessInfo `protobuf:"bytes,5,rep,name=processes,proto3" json:"processes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *TaskStats) Reset()         { *m = TaskStats{} }
 func (m *TaskStats) String() string { return proto.CompactTextString(m) }
 func (*TaskStats) ProtoMessage()    {}
 func (*TaskStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{52}
+	return fileDescriptor_4a8f45747846a74d, []int{58}
 }
 
 func (m *TaskStats) XXX_Unmarshal(b []byte) error {
@@ -3281,6 +3515,62 @@ func (m *TaskStats) GetResourceUsageByPid() map[string]*TaskResourceUsage {
 	return nil
 }
 
+func (m *TaskStats) GetProcesses() map[string]*ProcessInfo {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+type ProcessInfo struct {
+	// Ppid is the pid of the parent process
+	Ppid int32 `protobuf:"varint,1,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	// Cmdline is the command line of the process
+	Cmdline              []string `protobuf:"bytes,2,rep,name=cmdline,proto3" json:"cmdline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
+func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
+func (*ProcessInfo) ProtoMessage()    {}
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{59}
+}
+
+func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessInfo.Unmarshal(m, b)
+}
+func (m *ProcessInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessInfo.Marshal(b, m, deterministic)
+}
+func (m *ProcessInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessInfo.Merge(m, src)
+}
+func (m *ProcessInfo) XXX_Size() int {
+	return xxx_messageInfo_ProcessInfo.Size(m)
+}
+func (m *ProcessInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessInfo proto.InternalMessageInfo
+
+func (m *ProcessInfo) GetPpid() int32 {
+	if m != nil {
+		return m.Ppid
+	}
+	return 0
+}
+
+func (m *ProcessInfo) GetCmdline() []string {
+	if m != nil {
+		return m.Cmdline
+	}
+	return nil
+}
+
 type TaskResourceUsage struct {
 	// CPU usage stats
 	Cpu *CPUUsage `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
@@ -3295,7 +3585,7 @@ func (m *TaskResourceUsage) Reset()         { *m = TaskResourceUsage{} }
 func (m *TaskResourceUsage) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceUsage) ProtoMessage()    {}
 func (*TaskResourceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{53}
+	return fileDescriptor_4a8f45747846a74d, []int{60}
 }
 
 func (m *TaskResourceUsage) XXX_Unmarshal(b []byte) error {
@@ -3348,7 +3638,7 @@ func (m *CPUUsage) Reset()         { *m = CPUUsage{} }
 func (m *CPUUsage) String() string { return proto.CompactTextString(m) }
 func (*CPUUsage) ProtoMessage()    {}
 func (*CPUUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54}
+	return fileDescriptor_4a8f45747846a74d, []int{61}
 }
 
 func (m *CPUUsage) XXX_Unmarshal(b []byte) error {
@@ -3437,7 +3727,7 @@ func (m *MemoryUsage) Reset()         { *m = MemoryUsage{} }
 func (m *MemoryUsage) String() string { return proto.CompactTextString(m) }
 func (*MemoryUsage) ProtoMessage()    {}
 func (*MemoryUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55}
+	return fileDescriptor_4a8f45747846a74d, []int{62}
 }
 
 func (m *MemoryUsage) XXX_Unmarshal(b []byte) error {
@@ -3536,7 +3826,7 @@ func (m *DriverTaskEvent) Reset()         { *m = DriverTaskEvent{} }
 func (m *DriverTaskEvent) String() string { return proto.CompactTextString(m) }
 func (*DriverTaskEvent) ProtoMessage()    {}
 func (*DriverTaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{56}
+	return fileDescriptor_4a8f45747846a74d, []int{63}
 }
 
 func (m *DriverTaskEvent) XXX_Unmarshal(b []byte) error {
@@ -3643,6 +3933,12 @@ func init() {
 	proto.RegisterType((*CreateNetworkResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CreateNetworkResponse")
 	proto.RegisterType((*DestroyNetworkRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.DestroyNetworkRequest")
 	proto.RegisterType((*DestroyNetworkResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.DestroyNetworkResponse")
+	proto.RegisterType((*CheckpointTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskRequest")
+	proto.RegisterType((*CheckpointTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CheckpointTaskResponse")
+	proto.RegisterType((*PauseTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.PauseTaskRequest")
+	proto.RegisterType((*PauseTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.PauseTaskResponse")
+	proto.RegisterType((*ResumeTaskRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.ResumeTaskRequest")
+	proto.RegisterType((*ResumeTaskResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.ResumeTaskResponse")
 	proto.RegisterType((*DriverCapabilities)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverCapabilities")
 	proto.RegisterType((*NetworkIsolationSpec)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec.LabelsEntry")
@@ -3669,7 +3965,9 @@ func init() {
 	proto.RegisterType((*TaskDriverStatus)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskDriverStatus")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskDriverStatus.AttributesEntry")
 	proto.RegisterType((*TaskStats)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskStats")
+	proto.RegisterMapType((map[string]*ProcessInfo)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskStats.ProcessesEntry")
 	proto.RegisterMapType((map[string]*TaskResourceUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskStats.ResourceUsageByPidEntry")
+	proto.RegisterType((*ProcessInfo)(nil), "hashicorp.nomad.plugins.drivers.proto.ProcessInfo")
 	proto.RegisterType((*TaskResourceUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.TaskResourceUsage")
 	proto.RegisterType((*CPUUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.CPUUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "hashicorp.nomad.plugins.drivers.proto.MemoryUsage")
@@ -3682,243 +3980,254 @@ func init() {
 }
 
 var fileDescriptor_4a8f45747846a74d = []byte{
-	// 3776 bytes of a gzipped FileDescriptorProto
+	// 3946 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0x1b, 0x49,
-	0x76, 0x57, 0xb3, 0xf9, 0xf9, 0x48, 0x51, 0xad, 0xb2, 0x6c, 0xd3, 0x9c, 0x24, 0xe3, 0xed, 0x60,
-	0x02, 0x61, 0x77, 0x86, 0x9e, 0xd5, 0x22, 0xe3, 0xf1, 0xac, 0x67, 0x3d, 0x1c, 0x8a, 0xb6, 0x34,
-	0x96, 0x28, 0xa5, 0x48, 0xc1, 0xeb, 0x38, 0x3b, 0x9d, 0x16, 0xbb, 0x4c, 0xb5, 0xc5, 0xfe, 0x98,
-	0xae, 0xa6, 0x2c, 0x6d, 0x10, 0x24, 0xd8, 0x00, 0xc1, 0x06, 0x48, 0x90, 0x5c, 0x26, 0x7b, 0xc9,
-	0x69, 0x81, 0x9c, 0xf2, 0x0f, 0x04, 0x1b, 0xec, 0x29, 0x87, 0xfc, 0x13, 0xb9, 0xe4, 0x96, 0x63,
-	0x72, 0xca, 0x35, 0xa8, 0x8f, 0x6e, 0x76, 0x93, 0xf4, 0xb8, 0x49, 0xf9, 0xc4, 0x7e, 0xf5, 0xf1,
-	0xab, 0xc7, 0xf7, 0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0x81, 0xee, 0x8f, 0x27, 0x23, 0xdb, 0xa5, 0xf7,
-	0xac, 0xc0, 0xbe, 0x20, 0x01, 0xbd, 0xe7, 0x07, 0x5e, 0xe8, 0x49, 0xaa, 0xc5, 0x09, 0xf4, 0xc1,
-	0x99, 0x49, 0xcf, 0xec, 0xa1, 0x17, 0xf8, 0x2d, 0xd7, 0x73, 0x4c, 0xab, 0x25, 0xe7, 0xb4, 0xe4,
-	0x1c, 0x31, 0xac, 0xf9, 0x7b, 0x23, 0xcf, 0x1b, 0x8d, 0x89, 0x40, 0x38, 0x9d, 0xbc, 0xbc, 0x67,
-	0x4d, 0x02, 0x33, 0xb4, 0x3d, 0x57, 0xf6, 0xbf, 0x3f, 0xdb, 0x1f, 0xda, 0x0e, 0xa1, 0xa1, 0xe9,
-	0xf8, 0x72, 0xc0, 0x07, 0x11, 0x2f, 0xf4, 0xcc, 0x0c, 0x88, 0x75, 0xef, 0x6c, 0x38, 0xa6, 0x3e,
-	0x19, 0xb2, 0x5f, 0x83, 0x7d, 0xc8, 0x61, 0x1f, 0xce, 0x0c, 0xa3, 0x61, 0x30, 0x19, 0x86, 0x11,
-	0xe7, 0x66, 0x18, 0x06, 0xf6, 0xe9, 0x24, 0x24, 0x62, 0xb4, 0x7e, 0x07, 0x6e, 0x0f, 0x4c, 0x7a,
-	0xde, 0xf1, 0xdc, 0x97, 0xf6, 0xa8, 0x3f, 0x3c, 0x23, 0x8e, 0x89, 0xc9, 0x37, 0x13, 0x42, 0x43,
-	0xfd, 0x4f, 0xa0, 0x31, 0xdf, 0x45, 0x7d, 0xcf, 0xa5, 0x04, 0x7d, 0x01, 0x79, 0xb6, 0x64, 0x43,
-	0xb9, 0xab, 0x6c, 0x57, 0x77, 0x3e, 0x6c, 0xbd, 0x49, 0x04, 0x82, 0x87, 0x96, 0x64, 0xb5, 0xd5,
-	0xf7, 0xc9, 0x10, 0xf3, 0x99, 0xfa, 0x4d, 0xb8, 0xd1, 0x31, 0x7d, 0xf3, 0xd4, 0x1e, 0xdb, 0xa1,
-	0x4d, 0x68, 0xb4, 0xe8, 0x04, 0xb6, 0xd2, 0xcd, 0x72, 0xc1, 0x9f, 0x41, 0x6d, 0x98, 0x68, 0x97,
-	0x0b, 0x3f, 0x68, 0x65, 0x92, 0x7d, 0x6b, 0x97, 0x53, 0x29, 0xe0, 0x14, 0x9c, 0xbe, 0x05, 0xe8,
-	0xb1, 0xed, 0x8e, 0x48, 0xe0, 0x07, 0xb6, 0x1b, 0x46, 0xcc, 0xfc, 0x56, 0x85, 0x1b, 0xa9, 0x66,
-	0xc9, 0xcc, 0x2b, 0x80, 0x58, 0x8e, 0x8c, 0x15, 0x75, 0xbb, 0xba, 0xf3, 0x55, 0x46, 0x56, 0x16,
-	0xe0, 0xb5, 0xda, 0x31, 0x58, 0xd7, 0x0d, 0x83, 0x2b, 0x9c, 0x40, 0x47, 0x5f, 0x43, 0xf1, 0x8c,
-	0x98, 0xe3, 0xf0, 0xac, 0x91, 0xbb, 0xab, 0x6c, 0xd7, 0x77, 0x1e, 0x5f, 0x63, 0x9d, 0x3d, 0x0e,
-	0xd4, 0x0f, 0xcd, 0x90, 0x60, 0x89, 0x8a, 0x3e, 0x02, 0x24, 0xbe, 0x0c, 0x8b, 0xd0, 0x61, 0x60,
-	0xfb, 0xcc, 0x24, 0x1b, 0xea, 0x5d, 0x65, 0xbb, 0x82, 0x37, 0x45, 0xcf, 0xee, 0xb4, 0xa3, 0xe9,
-	0xc3, 0xc6, 0x0c, 0xb7, 0x48, 0x03, 0xf5, 0x9c, 0x5c, 0x71, 0x8d, 0x54, 0x30, 0xfb, 0x44, 0x4f,
-	0xa0, 0x70, 0x61, 0x8e, 0x27, 0x84, 0xb3, 0x5c, 0xdd, 0xf9, 0xe1, 0xdb, 0xcc, 0x43, 0x9a, 0xe8,
-	0x54, 0x0e, 0x58, 0xcc, 0xff, 0x2c, 0xf7, 0xa9, 0xa2, 0x3f, 0x80, 0x6a, 0x82, 0x6f, 0x54, 0x07,
-	0x38, 0xe9, 0xed, 0x76, 0x07, 0xdd, 0xce, 0xa0, 0xbb, 0xab, 0xad, 0xa1, 0x75, 0xa8, 0x9c, 0xf4,
-	0xf6, 0xba, 0xed, 0x83, 0xc1, 0xde, 0x73, 0x4d, 0x41, 0x55, 0x28, 0x45, 0x44, 0x4e, 0xbf, 0x04,
-	0x84, 0xc9, 0xd0, 0xbb, 0x20, 0x01, 0x33, 0x64, 0xa9, 0x55, 0x74, 0x1b, 0x4a, 0xa1, 0x49, 0xcf,
-	0x0d, 0xdb, 0x92, 0x3c, 0x17, 0x19, 0xb9, 0x6f, 0xa1, 0x7d, 0x28, 0x9e, 0x99, 0xae, 0x35, 0x7e,
-	0x3b, 0xdf, 0x69, 0x51, 0x33, 0xf0, 0x3d, 0x3e, 0x11, 0x4b, 0x00, 0x66, 0xdd, 0xa9, 0x95, 0x85,
-	0x02, 0xf4, 0xe7, 0xa0, 0xf5, 0x43, 0x33, 0x08, 0x93, 0xec, 0x74, 0x21, 0xcf, 0xd6, 0x97, 0x16,
-	0xbd, 0xcc, 0x9a, 0x62, 0x67, 0x62, 0x3e, 0x5d, 0xff, 0xdf, 0x1c, 0x6c, 0x26, 0xb0, 0xa5, 0xa5,
-	0x3e, 0x83, 0x62, 0x40, 0xe8, 0x64, 0x1c, 0x72, 0xf8, 0xfa, 0xce, 0xa3, 0x8c, 0xf0, 0x73, 0x48,
-	0x2d, 0xcc, 0x61, 0xb0, 0x84, 0x43, 0xdb, 0xa0, 0x89, 0x19, 0x06, 0x09, 0x02, 0x2f, 0x30, 0x1c,
-	0x3a, 0xe2, 0x52, 0xab, 0xe0, 0xba, 0x68, 0xef, 0xb2, 0xe6, 0x43, 0x3a, 0x4a, 0x48, 0x55, 0xbd,
-	0xa6, 0x54, 0x91, 0x09, 0x9a, 0x4b, 0xc2, 0xd7, 0x5e, 0x70, 0x6e, 0x30, 0xd1, 0x06, 0xb6, 0x45,
-	0x1a, 0x79, 0x0e, 0xfa, 0x49, 0x46, 0xd0, 0x9e, 0x98, 0x7e, 0x24, 0x67, 0xe3, 0x0d, 0x37, 0xdd,
-	0xa0, 0xff, 0x00, 0x8a, 0xe2, 0x9f, 0x32, 0x4b, 0xea, 0x9f, 0x74, 0x3a, 0xdd, 0x7e, 0x5f, 0x5b,
-	0x43, 0x15, 0x28, 0xe0, 0xee, 0x00, 0x33, 0x0b, 0xab, 0x40, 0xe1, 0x71, 0x7b, 0xd0, 0x3e, 0xd0,
-	0x72, 0xfa, 0xf7, 0x61, 0xe3, 0x99, 0x69, 0x87, 0x59, 0x8c, 0x4b, 0xf7, 0x40, 0x9b, 0x8e, 0x95,
-	0xda, 0xd9, 0x4f, 0x69, 0x27, 0xbb, 0x68, 0xba, 0x97, 0x76, 0x38, 0xa3, 0x0f, 0x0d, 0x54, 0x12,
-	0x04, 0x52, 0x05, 0xec, 0x53, 0x7f, 0x0d, 0x1b, 0xfd, 0xd0, 0xf3, 0x33, 0x59, 0xfe, 0x8f, 0xa0,
-	0xc4, 0x4e, 0x1b, 0x6f, 0x12, 0x4a, 0xd3, 0xbf, 0xd3, 0x12, 0xa7, 0x51, 0x2b, 0x3a, 0x8d, 0x5a,
-	0xbb, 0xf2, 0xb4, 0xc2, 0xd1, 0x48, 0x74, 0x0b, 0x8a, 0xd4, 0x1e, 0xb9, 0xe6, 0x58, 0x7a, 0x0b,
-	0x49, 0xe9, 0x88, 0x19, 0x79, 0xb4, 0xb0, 0x34, 0xfc, 0x0e, 0xa0, 0x5d, 0x42, 0xc3, 0xc0, 0xbb,
-	0xca, 0xc4, 0xcf, 0x16, 0x14, 0x5e, 0x7a, 0xc1, 0x50, 0x6c, 0xc4, 0x32, 0x16, 0x04, 0xdb, 0x54,
-	0x29, 0x10, 0x89, 0xfd, 0x11, 0xa0, 0x7d, 0x97, 0x9d, 0x29, 0xd9, 0x14, 0xf1, 0x0f, 0x39, 0xb8,
-	0x91, 0x1a, 0x2f, 0x95, 0xb1, 0xfa, 0x3e, 0x64, 0x8e, 0x69, 0x42, 0xc5, 0x3e, 0x44, 0x47, 0x50,
-	0x14, 0x23, 0xa4, 0x24, 0xef, 0x2f, 0x01, 0x24, 0x8e, 0x29, 0x09, 0x27, 0x61, 0x16, 0x1a, 0xbd,
-	0xfa, 0x6e, 0x8d, 0xfe, 0x35, 0x68, 0xd1, 0xff, 0xa0, 0x6f, 0xd5, 0xcd, 0x57, 0x70, 0x63, 0xe8,
-	0x8d, 0xc7, 0x64, 0xc8, 0xac, 0xc1, 0xb0, 0xdd, 0x90, 0x04, 0x17, 0xe6, 0xf8, 0xed, 0x76, 0x83,
-	0xa6, 0xb3, 0xf6, 0xe5, 0x24, 0xfd, 0x05, 0x6c, 0x26, 0x16, 0x96, 0x8a, 0x78, 0x0c, 0x05, 0xca,
-	0x1a, 0xa4, 0x26, 0x3e, 0x5e, 0x52, 0x13, 0x14, 0x8b, 0xe9, 0xfa, 0x0d, 0x01, 0xde, 0xbd, 0x20,
-	0x6e, 0xfc, 0xb7, 0xf4, 0x5d, 0xd8, 0xec, 0x73, 0x33, 0xcd, 0x64, 0x87, 0x53, 0x13, 0xcf, 0xa5,
-	0x4c, 0x7c, 0x0b, 0x50, 0x12, 0x45, 0x1a, 0xe2, 0x15, 0x6c, 0x74, 0x2f, 0xc9, 0x30, 0x13, 0x72,
-	0x03, 0x4a, 0x43, 0xcf, 0x71, 0x4c, 0xd7, 0x6a, 0xe4, 0xee, 0xaa, 0xdb, 0x15, 0x1c, 0x91, 0xc9,
-	0xbd, 0xa8, 0x66, 0xdd, 0x8b, 0xfa, 0xdf, 0x29, 0xa0, 0x4d, 0xd7, 0x96, 0x82, 0x64, 0xdc, 0x87,
-	0x16, 0x03, 0x62, 0x6b, 0xd7, 0xb0, 0xa4, 0x64, 0x7b, 0xe4, 0x2e, 0x44, 0x3b, 0x09, 0x82, 0x84,
-	0x3b, 0x52, 0xaf, 0xe9, 0x8e, 0xf4, 0x3d, 0xf8, 0x9d, 0x88, 0x9d, 0x7e, 0x18, 0x10, 0xd3, 0xb1,
-	0xdd, 0xd1, 0xfe, 0xd1, 0x91, 0x4f, 0x04, 0xe3, 0x08, 0x41, 0xde, 0x32, 0x43, 0x53, 0x32, 0xc6,
-	0xbf, 0xd9, 0xa6, 0x1f, 0x8e, 0x3d, 0x1a, 0x6f, 0x7a, 0x4e, 0xe8, 0xff, 0xa1, 0x42, 0x63, 0x0e,
-	0x2a, 0x12, 0xef, 0x0b, 0x28, 0x50, 0x12, 0x4e, 0x7c, 0x69, 0x2a, 0xdd, 0xcc, 0x0c, 0x2f, 0xc6,
-	0x6b, 0xf5, 0x19, 0x18, 0x16, 0x98, 0x68, 0x04, 0xe5, 0x30, 0xbc, 0x32, 0xa8, 0xfd, 0xf3, 0x28,
-	0x20, 0x38, 0xb8, 0x2e, 0xfe, 0x80, 0x04, 0x8e, 0xed, 0x9a, 0xe3, 0xbe, 0xfd, 0x73, 0x82, 0x4b,
-	0x61, 0x78, 0xc5, 0x3e, 0xd0, 0x73, 0x66, 0xf0, 0x96, 0xed, 0x4a, 0xb1, 0x77, 0x56, 0x5d, 0x25,
-	0x21, 0x60, 0x2c, 0x10, 0x9b, 0x07, 0x50, 0xe0, 0xff, 0x69, 0x15, 0x43, 0xd4, 0x40, 0x0d, 0xc3,
-	0x2b, 0xce, 0x54, 0x19, 0xb3, 0xcf, 0xe6, 0x43, 0xa8, 0x25, 0xff, 0x01, 0x33, 0xa4, 0x33, 0x62,
-	0x8f, 0xce, 0x84, 0x81, 0x15, 0xb0, 0xa4, 0x98, 0x26, 0x5f, 0xdb, 0x96, 0x0c, 0x59, 0x0b, 0x58,
-	0x10, 0xfa, 0xbf, 0xe6, 0xe0, 0xce, 0x02, 0xc9, 0x48, 0x63, 0x7d, 0x91, 0x32, 0xd6, 0x77, 0x24,
-	0x85, 0xc8, 0xe2, 0x5f, 0xa4, 0x2c, 0xfe, 0x1d, 0x82, 0xb3, 0x6d, 0x73, 0x0b, 0x8a, 0xe4, 0xd2,
-	0x0e, 0x89, 0x25, 0x45, 0x25, 0xa9, 0xc4, 0x76, 0xca, 0x5f, 0x77, 0x3b, 0x1d, 0xc2, 0x56, 0x27,
-	0x20, 0x66, 0x48, 0xa4, 0x2b, 0x8f, 0xec, 0xff, 0x0e, 0x94, 0xcd, 0xf1, 0xd8, 0x1b, 0x4e, 0xd5,
-	0x5a, 0xe2, 0xf4, 0xbe, 0x85, 0x9a, 0x50, 0x3e, 0xf3, 0x68, 0xe8, 0x9a, 0x0e, 0x91, 0xce, 0x2b,
-	0xa6, 0xf5, 0x6f, 0x15, 0xb8, 0x39, 0x83, 0x27, 0xb5, 0x70, 0x0a, 0x75, 0x9b, 0x7a, 0x63, 0xfe,
-	0x07, 0x8d, 0xc4, 0x0d, 0xef, 0xc7, 0xcb, 0x1d, 0x35, 0xfb, 0x11, 0x06, 0xbf, 0xf0, 0xad, 0xdb,
-	0x49, 0x92, 0x5b, 0x1c, 0x5f, 0xdc, 0x92, 0x3b, 0x3d, 0x22, 0xf5, 0x7f, 0x54, 0xe0, 0xa6, 0x3c,
-	0xe1, 0xb3, 0xff, 0xd1, 0x79, 0x96, 0x73, 0xef, 0x9a, 0x65, 0xbd, 0x01, 0xb7, 0x66, 0xf9, 0x92,
-	0x3e, 0xff, 0xff, 0xf2, 0x80, 0xe6, 0x6f, 0x97, 0xe8, 0x7b, 0x50, 0xa3, 0xc4, 0xb5, 0x0c, 0x71,
-	0x5e, 0x88, 0xa3, 0xac, 0x8c, 0xab, 0xac, 0x4d, 0x1c, 0x1c, 0x94, 0xb9, 0x40, 0x72, 0x29, 0xb9,
-	0x2d, 0x63, 0xfe, 0x8d, 0xce, 0xa0, 0xf6, 0x92, 0x1a, 0xf1, 0xda, 0xdc, 0xa0, 0xea, 0x99, 0xdd,
-	0xda, 0x3c, 0x1f, 0xad, 0xc7, 0xfd, 0xf8, 0x7f, 0xe1, 0xea, 0x4b, 0x1a, 0x13, 0xe8, 0x97, 0x0a,
-	0xdc, 0x8e, 0xc2, 0x8a, 0xa9, 0xf8, 0x1c, 0xcf, 0x22, 0xb4, 0x91, 0xbf, 0xab, 0x6e, 0xd7, 0x77,
-	0x8e, 0xaf, 0x21, 0xbf, 0xb9, 0xc6, 0x43, 0xcf, 0x22, 0xf8, 0xa6, 0xbb, 0xa0, 0x95, 0xa2, 0x16,
-	0xdc, 0x70, 0x26, 0x34, 0x34, 0x84, 0x15, 0x18, 0x72, 0x50, 0xa3, 0xc0, 0xe5, 0xb2, 0xc9, 0xba,
-	0x52, 0xb6, 0x8a, 0xce, 0x61, 0xdd, 0xf1, 0x26, 0x6e, 0x68, 0x0c, 0xf9, 0xfd, 0x87, 0x36, 0x8a,
-	0x4b, 0x5d, 0x8c, 0x17, 0x48, 0xe9, 0x90, 0xc1, 0x89, 0xdb, 0x14, 0xc5, 0x35, 0x27, 0x41, 0x31,
-	0x45, 0x06, 0xc4, 0xf1, 0x42, 0x62, 0x30, 0x7f, 0x49, 0x1b, 0x25, 0xa1, 0x48, 0xd1, 0xc6, 0x5c,
-	0x03, 0xd5, 0x5b, 0x50, 0x4d, 0x88, 0x19, 0x95, 0x21, 0xdf, 0x3b, 0xea, 0x75, 0xb5, 0x35, 0x04,
-	0x50, 0xec, 0xec, 0xe1, 0xa3, 0xa3, 0x81, 0xb8, 0x35, 0xec, 0x1f, 0xb6, 0x9f, 0x74, 0xb5, 0x9c,
-	0xde, 0x85, 0x5a, 0x72, 0x41, 0x84, 0xa0, 0x7e, 0xd2, 0x7b, 0xda, 0x3b, 0x7a, 0xd6, 0x33, 0x0e,
-	0x8f, 0x4e, 0x7a, 0x03, 0x76, 0xdf, 0xa8, 0x03, 0xb4, 0x7b, 0xcf, 0xa7, 0xf4, 0x3a, 0x54, 0x7a,
-	0x47, 0x11, 0xa9, 0x34, 0x73, 0x9a, 0xa2, 0xff, 0xbb, 0x0a, 0x5b, 0x8b, 0x64, 0x8f, 0x2c, 0xc8,
-	0x33, 0x3d, 0xca, 0x1b, 0xdf, 0xbb, 0x57, 0x23, 0x47, 0x67, 0xe6, 0xeb, 0x9b, 0xd2, 0xc5, 0x57,
-	0x30, 0xff, 0x46, 0x06, 0x14, 0xc7, 0xe6, 0x29, 0x19, 0xd3, 0x86, 0xca, 0x73, 0x22, 0x4f, 0xae,
-	0xb3, 0xf6, 0x01, 0x47, 0x12, 0x09, 0x11, 0x09, 0x8b, 0x06, 0x50, 0x65, 0x4e, 0x8c, 0x0a, 0xd1,
-	0x49, 0xbf, 0xba, 0x93, 0x71, 0x95, 0xbd, 0xe9, 0x4c, 0x9c, 0x84, 0x69, 0x3e, 0x80, 0x6a, 0x62,
-	0xb1, 0x05, 0xf9, 0x8c, 0xad, 0x64, 0x3e, 0xa3, 0x92, 0x4c, 0x4e, 0x3c, 0x9a, 0xd7, 0x01, 0x93,
-	0x11, 0x33, 0x82, 0xbd, 0xa3, 0xfe, 0x40, 0xdc, 0x1c, 0x9f, 0xe0, 0xa3, 0x93, 0x63, 0x4d, 0x61,
-	0x8d, 0x83, 0x76, 0xff, 0xa9, 0x96, 0x8b, 0x6d, 0x44, 0xd5, 0x3b, 0x50, 0x4d, 0xf0, 0x95, 0xf2,
-	0xda, 0x4a, 0xda, 0x6b, 0x33, 0xbf, 0x69, 0x5a, 0x56, 0x40, 0x28, 0x95, 0x7c, 0x44, 0xa4, 0xfe,
-	0x02, 0x2a, 0xbb, 0xbd, 0xbe, 0x84, 0x68, 0x40, 0x89, 0x92, 0x80, 0xfd, 0x6f, 0x9e, 0x99, 0xaa,
-	0xe0, 0x88, 0x64, 0xe0, 0x94, 0x98, 0xc1, 0xf0, 0x8c, 0x50, 0x79, 0xd6, 0xc7, 0x34, 0x9b, 0xe5,
-	0xf1, 0x0c, 0x8f, 0xd0, 0x5d, 0x05, 0x47, 0xa4, 0xfe, 0x3f, 0x25, 0x80, 0x69, 0xb6, 0x01, 0xd5,
-	0x21, 0x17, 0xfb, 0xe0, 0x9c, 0x6d, 0x31, 0x3b, 0x48, 0x9c, 0x31, 0xfc, 0x1b, 0xed, 0xc0, 0x4d,
-	0x87, 0x8e, 0x7c, 0x73, 0x78, 0x6e, 0xc8, 0x24, 0x81, 0xd8, 0xaa, 0xdc, 0x9f, 0xd5, 0xf0, 0x0d,
-	0xd9, 0x29, 0x77, 0xa2, 0xc0, 0x3d, 0x00, 0x95, 0xb8, 0x17, 0xdc, 0xf7, 0x54, 0x77, 0x3e, 0x5b,
-	0x3a, 0x0b, 0xd2, 0xea, 0xba, 0x17, 0xc2, 0x56, 0x18, 0x0c, 0x32, 0x00, 0x2c, 0x72, 0x61, 0x0f,
-	0x89, 0xc1, 0x40, 0x0b, 0x1c, 0xf4, 0x8b, 0xe5, 0x41, 0x77, 0x39, 0x46, 0x0c, 0x5d, 0xb1, 0x22,
-	0x1a, 0xf5, 0xa0, 0x12, 0x10, 0xea, 0x4d, 0x82, 0x21, 0x11, 0x0e, 0x28, 0xfb, 0x45, 0x05, 0x47,
-	0xf3, 0xf0, 0x14, 0x02, 0xed, 0x42, 0x91, 0xfb, 0x1d, 0xe6, 0x61, 0xd4, 0xef, 0x4c, 0xa9, 0xa6,
-	0xc1, 0xb8, 0x27, 0xc1, 0x72, 0x2e, 0x7a, 0x02, 0x25, 0xc1, 0x22, 0x6d, 0x94, 0x39, 0xcc, 0x47,
-	0x59, 0x9d, 0x22, 0x9f, 0x85, 0xa3, 0xd9, 0x4c, 0xab, 0x13, 0x4a, 0x82, 0x46, 0x45, 0x68, 0x95,
-	0x7d, 0xa3, 0xf7, 0xa0, 0x22, 0xce, 0x60, 0xcb, 0x0e, 0x1a, 0x20, 0x8c, 0x93, 0x37, 0xec, 0xda,
-	0x01, 0x7a, 0x1f, 0xaa, 0x22, 0xd6, 0x32, 0xb8, 0x57, 0xa8, 0xf2, 0x6e, 0x10, 0x4d, 0xc7, 0xcc,
-	0x37, 0x88, 0x01, 0x24, 0x08, 0xc4, 0x80, 0x5a, 0x3c, 0x80, 0x04, 0x01, 0x1f, 0xf0, 0x07, 0xb0,
-	0xc1, 0x23, 0xd4, 0x51, 0xe0, 0x4d, 0x7c, 0x83, 0xdb, 0xd4, 0x3a, 0x1f, 0xb4, 0xce, 0x9a, 0x9f,
-	0xb0, 0xd6, 0x1e, 0x33, 0xae, 0x3b, 0x50, 0x7e, 0xe5, 0x9d, 0x8a, 0x01, 0x75, 0xb1, 0x0f, 0x5e,
-	0x79, 0xa7, 0x51, 0x57, 0x1c, 0x25, 0x6c, 0xa4, 0xa3, 0x84, 0x6f, 0xe0, 0xd6, 0xfc, 0x71, 0xc7,
-	0xa3, 0x05, 0xed, 0xfa, 0xd1, 0xc2, 0x96, 0xbb, 0xc8, 0x0f, 0x7f, 0x09, 0xaa, 0xe5, 0xd2, 0xc6,
-	0xe6, 0x52, 0xc6, 0x11, 0xef, 0x63, 0xcc, 0x26, 0x37, 0x3f, 0x81, 0x72, 0x64, 0x7d, 0xcb, 0xf8,
-	0xa5, 0xe6, 0x43, 0xa8, 0xa7, 0x6d, 0x77, 0x29, 0xaf, 0xf6, 0xcf, 0x39, 0xa8, 0xc4, 0x56, 0x8a,
-	0x5c, 0xb8, 0xc1, 0xa5, 0xc8, 0x42, 0x34, 0x63, 0x6a, 0xf4, 0x22, 0x30, 0xfc, 0x3c, 0xe3, 0xff,
-	0x6a, 0x47, 0x08, 0xf2, 0x86, 0x2a, 0x77, 0x00, 0x8a, 0x91, 0xa7, 0xeb, 0x7d, 0x0d, 0x1b, 0x63,
-	0xdb, 0x9d, 0x5c, 0x26, 0xd6, 0x12, 0x11, 0xdd, 0x1f, 0x66, 0x5c, 0xeb, 0x80, 0xcd, 0x9e, 0xae,
-	0x51, 0x1f, 0xa7, 0x68, 0xb4, 0x07, 0x05, 0xdf, 0x0b, 0xc2, 0xe8, 0x90, 0xca, 0x7a, 0x7c, 0x1c,
-	0x7b, 0x41, 0x78, 0x68, 0xfa, 0x3e, 0xbb, 0xb4, 0x08, 0x00, 0xfd, 0xdb, 0x1c, 0xdc, 0x5a, 0xfc,
-	0xc7, 0x50, 0x0f, 0xd4, 0xa1, 0x3f, 0x91, 0x42, 0x7a, 0xb8, 0xac, 0x90, 0x3a, 0xfe, 0x64, 0xca,
-	0x3f, 0x03, 0x42, 0xcf, 0xa0, 0xe8, 0x10, 0xc7, 0x0b, 0xae, 0xa4, 0x2c, 0x1e, 0x2d, 0x0b, 0x79,
-	0xc8, 0x67, 0x4f, 0x51, 0x25, 0x1c, 0xc2, 0x50, 0x96, 0xd6, 0x4b, 0xa5, 0x9f, 0x5c, 0x32, 0xad,
-	0x14, 0x41, 0xe2, 0x18, 0x47, 0xff, 0x04, 0x6e, 0x2e, 0xfc, 0x2b, 0xe8, 0x77, 0x01, 0x86, 0xfe,
-	0xc4, 0xe0, 0x69, 0x7f, 0x61, 0x41, 0x2a, 0xae, 0x0c, 0xfd, 0x49, 0x9f, 0x37, 0xe8, 0x2f, 0xa0,
-	0xf1, 0x26, 0x7e, 0x99, 0xf7, 0x11, 0x1c, 0x1b, 0xce, 0x29, 0x97, 0x81, 0x8a, 0xcb, 0xa2, 0xe1,
-	0xf0, 0x14, 0xe9, 0xb0, 0x1e, 0x75, 0x9a, 0x97, 0x6c, 0x80, 0xca, 0x07, 0x54, 0xe5, 0x00, 0xf3,
-	0xf2, 0xf0, 0x54, 0xff, 0x55, 0x0e, 0x36, 0x66, 0x58, 0x66, 0x57, 0x37, 0xe1, 0xf1, 0xa2, 0x4b,
-	0xb1, 0xa0, 0x98, 0xfb, 0x1b, 0xda, 0x56, 0x94, 0x4e, 0xe5, 0xdf, 0xfc, 0xe0, 0xf3, 0x65, 0xaa,
-	0x33, 0x67, 0xfb, 0x6c, 0xfb, 0x38, 0xa7, 0x76, 0x48, 0x79, 0x14, 0x52, 0xc0, 0x82, 0x40, 0xcf,
-	0xa1, 0x1e, 0x10, 0x7e, 0xe0, 0x5a, 0x86, 0xb0, 0xb2, 0xc2, 0x52, 0x56, 0x26, 0x39, 0x64, 0xc6,
-	0x86, 0xd7, 0x23, 0x24, 0x46, 0x51, 0xf4, 0x0c, 0xd6, 0xad, 0x2b, 0xd7, 0x74, 0xec, 0xa1, 0x44,
-	0x2e, 0xae, 0x8c, 0x5c, 0x93, 0x40, 0x1c, 0x58, 0x7f, 0x00, 0xd5, 0x44, 0x27, 0xfb, 0x63, 0x3c,
-	0xdc, 0x92, 0x32, 0x11, 0x44, 0xda, 0x5b, 0x14, 0xa4, 0xb7, 0xd0, 0x4f, 0xa1, 0x9a, 0xd8, 0x17,
-	0xcb, 0x4c, 0x65, 0xf2, 0x0c, 0x3d, 0x2e, 0xcf, 0x02, 0xce, 0x85, 0x1e, 0xba, 0x0d, 0x25, 0x16,
-	0xea, 0x18, 0xb6, 0xcf, 0x25, 0x5a, 0xc1, 0x45, 0x46, 0xee, 0xfb, 0xfa, 0x6f, 0x72, 0x50, 0x4f,
-	0x6f, 0xe9, 0xc8, 0x8e, 0x7c, 0x12, 0xd8, 0x9e, 0x95, 0xb0, 0xa3, 0x63, 0xde, 0xc0, 0x6c, 0x85,
-	0x75, 0x7f, 0x33, 0xf1, 0x42, 0x33, 0xb2, 0x95, 0xa1, 0x3f, 0xf9, 0x23, 0x46, 0xcf, 0xd8, 0xa0,
-	0x3a, 0x63, 0x83, 0xe8, 0x43, 0x40, 0xd2, 0x94, 0xc6, 0xb6, 0x63, 0x87, 0xc6, 0xe9, 0x55, 0x48,
-	0x84, 0x8e, 0x55, 0xac, 0x89, 0x9e, 0x03, 0xd6, 0xf1, 0x25, 0x6b, 0x67, 0x86, 0xe7, 0x79, 0x8e,
-	0x41, 0x87, 0x5e, 0x40, 0x0c, 0xd3, 0x7a, 0xc5, 0x6f, 0x2d, 0x2a, 0xae, 0x7a, 0x9e, 0xd3, 0x67,
-	0x6d, 0x6d, 0xeb, 0x15, 0x3b, 0xf9, 0x86, 0xfe, 0x84, 0x92, 0xd0, 0x60, 0x3f, 0x3c, 0x58, 0xa8,
-	0x60, 0x10, 0x4d, 0x1d, 0x7f, 0x42, 0xd1, 0xef, 0xc3, 0x7a, 0x34, 0x80, 0x1f, 0x7e, 0xf2, 0xd4,
-	0xad, 0xc9, 0x21, 0xbc, 0x0d, 0xe9, 0x50, 0x3b, 0x26, 0xc1, 0x90, 0xb8, 0xe1, 0xc0, 0x1e, 0x9e,
-	0xb3, 0xf3, 0x5d, 0xd9, 0x56, 0x70, 0xaa, 0xed, 0xab, 0x7c, 0xb9, 0xa4, 0x95, 0x71, 0xb4, 0x9a,
-	0x43, 0x1c, 0xaa, 0xff, 0x0c, 0x0a, 0x3c, 0x44, 0x60, 0x32, 0xe1, 0xc7, 0x2b, 0x3f, 0x7d, 0x65,
-	0x68, 0xc9, 0x1a, 0xf8, 0xd9, 0xfb, 0x1e, 0x54, 0xb8, 0xec, 0x13, 0x11, 0x3d, 0x8f, 0x3b, 0x79,
-	0x67, 0x13, 0xca, 0x01, 0x31, 0x2d, 0xcf, 0x1d, 0x47, 0xc9, 0xa0, 0x98, 0xd6, 0xbf, 0x81, 0xa2,
-	0x38, 0x67, 0xae, 0x81, 0xff, 0x11, 0x20, 0xf1, 0xbf, 0x99, 0x3e, 0x1d, 0x9b, 0x52, 0x19, 0x85,
-	0xf2, 0x17, 0x48, 0xd1, 0x73, 0x3c, 0xed, 0xd0, 0xff, 0x53, 0x11, 0xf1, 0xa8, 0x78, 0x1b, 0x62,
-	0x81, 0x2b, 0x33, 0x72, 0x76, 0x5b, 0x16, 0x49, 0xa8, 0x88, 0x44, 0xfb, 0x50, 0x94, 0x61, 0x67,
-	0x6e, 0xd5, 0xa7, 0x35, 0x09, 0x10, 0xa5, 0xa4, 0x89, 0xbc, 0x90, 0x2f, 0x9b, 0x92, 0x26, 0x22,
-	0x25, 0x4d, 0xd8, 0x6d, 0x52, 0x06, 0xc4, 0x02, 0x2e, 0xcf, 0xe3, 0xe1, 0xaa, 0x15, 0xe7, 0xfd,
-	0x89, 0xfe, 0xdf, 0x4a, 0xec, 0xa6, 0xa2, 0xfc, 0x3c, 0xfa, 0x1a, 0xca, 0x6c, 0xc7, 0x1b, 0x8e,
-	0xe9, 0xcb, 0xd7, 0xe6, 0xce, 0x6a, 0xa9, 0xff, 0xe8, 0x10, 0x13, 0xe1, 0x6c, 0xc9, 0x17, 0x14,
-	0x73, 0x77, 0xec, 0x2a, 0x11, 0xb9, 0x3b, 0xf6, 0x8d, 0x3e, 0x80, 0xba, 0x39, 0x09, 0x3d, 0xc3,
-	0xb4, 0x2e, 0x48, 0x10, 0xda, 0x94, 0x48, 0xdd, 0xaf, 0xb3, 0xd6, 0x76, 0xd4, 0xd8, 0xfc, 0x0c,
-	0x6a, 0x49, 0xcc, 0xb7, 0x85, 0x19, 0x85, 0x64, 0x98, 0xf1, 0xa7, 0x00, 0xd3, 0x5c, 0x17, 0xb3,
-	0x11, 0x72, 0x69, 0xb3, 0x5b, 0xbd, 0xbc, 0xbb, 0x16, 0x70, 0x99, 0x35, 0x74, 0xd8, 0x7d, 0x2a,
-	0x9d, 0x88, 0x2f, 0x44, 0x89, 0x78, 0xb6, 0x99, 0xd9, 0xfe, 0x3b, 0xb7, 0xc7, 0xe3, 0x38, 0xff,
-	0x56, 0xf1, 0x3c, 0xe7, 0x29, 0x6f, 0xd0, 0x7f, 0x9b, 0x13, 0xb6, 0x22, 0x9e, 0x54, 0x32, 0xdd,
-	0x5d, 0xde, 0x95, 0xaa, 0x1f, 0x00, 0xd0, 0xd0, 0x0c, 0x58, 0xcc, 0x64, 0x46, 0x19, 0xc0, 0xe6,
-	0x5c, 0x26, 0x7f, 0x10, 0xd5, 0x78, 0xe0, 0x8a, 0x1c, 0xdd, 0x0e, 0xd1, 0xe7, 0x50, 0x1b, 0x7a,
-	0x8e, 0x3f, 0x26, 0x72, 0x72, 0xe1, 0xad, 0x93, 0xab, 0xf1, 0xf8, 0x76, 0x98, 0xc8, 0x3b, 0x16,
-	0xaf, 0x9b, 0x77, 0xfc, 0x8d, 0x22, 0x5e, 0x86, 0x92, 0x0f, 0x53, 0x68, 0xb4, 0xa0, 0xfa, 0xe1,
-	0xc9, 0x8a, 0xaf, 0x5c, 0xdf, 0x55, 0xfa, 0xd0, 0xfc, 0x3c, 0x4b, 0xad, 0xc1, 0x9b, 0xa3, 0xd8,
-	0x7f, 0x53, 0xa1, 0x12, 0x3f, 0x0a, 0xcd, 0xe9, 0xfe, 0x53, 0xa8, 0xc4, 0x05, 0x36, 0xd2, 0x41,
-	0x7c, 0xa7, 0x7a, 0xe2, 0xc1, 0xe8, 0x25, 0x20, 0x73, 0x34, 0x8a, 0xa3, 0x53, 0x63, 0x42, 0xcd,
-	0x51, 0xf4, 0x24, 0xf7, 0xe9, 0x12, 0x72, 0x88, 0x8e, 0xb3, 0x13, 0x36, 0x1f, 0x6b, 0xe6, 0x68,
-	0x94, 0x6a, 0x41, 0x7f, 0x06, 0x37, 0xd3, 0x6b, 0x18, 0xa7, 0x57, 0x86, 0x6f, 0x5b, 0xf2, 0x8e,
-	0xbc, 0xb7, 0xec, 0xbb, 0x58, 0x2b, 0x05, 0xff, 0xe5, 0xd5, 0xb1, 0x6d, 0x09, 0x99, 0xa3, 0x60,
-	0xae, 0xa3, 0xf9, 0x17, 0x70, 0xfb, 0x0d, 0xc3, 0x17, 0xe8, 0xa0, 0x97, 0xae, 0xf7, 0x58, 0x5d,
-	0x08, 0x09, 0xed, 0xfd, 0x5a, 0x11, 0xcf, 0x77, 0x69, 0x99, 0xb4, 0x93, 0x61, 0xf5, 0xbd, 0x8c,
-	0xeb, 0x74, 0x8e, 0x4f, 0x04, 0x3c, 0x8f, 0xa4, 0xbf, 0x9a, 0x89, 0xa4, 0xb3, 0xc6, 0x4f, 0x22,
-	0x20, 0x15, 0x40, 0x12, 0x41, 0xff, 0x17, 0x15, 0xca, 0x11, 0x3a, 0xbf, 0xe1, 0x5e, 0xd1, 0x90,
-	0x38, 0x46, 0x9c, 0x7e, 0x53, 0x30, 0x88, 0x26, 0x9e, 0x14, 0x7a, 0x0f, 0x2a, 0xec, 0x22, 0x2d,
-	0xba, 0x73, 0xbc, 0xbb, 0xcc, 0x1a, 0x78, 0xe7, 0xfb, 0x50, 0x0d, 0xbd, 0xd0, 0x1c, 0x1b, 0x21,
-	0x3f, 0xde, 0x55, 0x31, 0x9b, 0x37, 0xf1, 0xc3, 0x1d, 0xfd, 0x00, 0x36, 0xc3, 0xb3, 0xc0, 0x0b,
-	0xc3, 0x31, 0x0b, 0x2d, 0x79, 0xa0, 0x23, 0xe2, 0x92, 0x3c, 0xd6, 0xe2, 0x0e, 0x11, 0x00, 0x51,
-	0xe6, 0xbd, 0xa7, 0x83, 0x99, 0xe9, 0x72, 0x27, 0x92, 0xc7, 0xeb, 0x71, 0x2b, 0x33, 0x6d, 0x76,
-	0x78, 0xfa, 0x22, 0x80, 0xe0, 0xbe, 0x42, 0xc1, 0x11, 0x89, 0x0c, 0xd8, 0x70, 0x88, 0x49, 0x27,
-	0x01, 0xb1, 0x8c, 0x97, 0x36, 0x19, 0x5b, 0x22, 0x31, 0x51, 0xcf, 0x7c, 0x3b, 0x88, 0xc4, 0xd2,
-	0x7a, 0xcc, 0x67, 0xe3, 0x7a, 0x04, 0x27, 0x68, 0x16, 0x39, 0x88, 0x2f, 0xb4, 0x01, 0xd5, 0xfe,
-	0xf3, 0xfe, 0xa0, 0x7b, 0x68, 0x1c, 0x1e, 0xed, 0x76, 0x65, 0x49, 0x4f, 0xbf, 0x8b, 0x05, 0xa9,
-	0xb0, 0xfe, 0xc1, 0xd1, 0xa0, 0x7d, 0x60, 0x0c, 0xf6, 0x3b, 0x4f, 0xfb, 0x5a, 0x0e, 0xdd, 0x84,
-	0xcd, 0xc1, 0x1e, 0x3e, 0x1a, 0x0c, 0x0e, 0xba, 0xbb, 0xc6, 0x71, 0x17, 0xef, 0x1f, 0xed, 0xf6,
-	0x35, 0x15, 0x21, 0xa8, 0x4f, 0x9b, 0x07, 0xfb, 0x87, 0x5d, 0x2d, 0x8f, 0xaa, 0x50, 0x3a, 0xee,
-	0xe2, 0x4e, 0xb7, 0x37, 0xd0, 0x0a, 0xfa, 0xaf, 0x54, 0xa8, 0x26, 0xb4, 0xc8, 0x0c, 0x39, 0xa0,
-	0xe2, 0x1a, 0x92, 0xc7, 0xec, 0x93, 0x3f, 0x41, 0x9a, 0xc3, 0x33, 0xa1, 0x9d, 0x3c, 0x16, 0x04,
-	0xbf, 0x7a, 0x98, 0x97, 0x89, 0x7d, 0x9e, 0xc7, 0x65, 0xc7, 0xbc, 0x14, 0x20, 0xdf, 0x83, 0xda,
-	0x39, 0x09, 0x5c, 0x32, 0x96, 0xfd, 0x42, 0x23, 0x55, 0xd1, 0x26, 0x86, 0x6c, 0x83, 0x26, 0x87,
-	0x4c, 0x61, 0x84, 0x3a, 0xea, 0xa2, 0xfd, 0x30, 0x02, 0xdb, 0x82, 0x82, 0xe8, 0x2e, 0x89, 0xf5,
-	0x39, 0xc1, 0x8e, 0x29, 0xfa, 0xda, 0xf4, 0x79, 0xc8, 0x97, 0xc7, 0xfc, 0x1b, 0x9d, 0xce, 0xeb,
-	0xa7, 0xc8, 0xf5, 0xf3, 0x60, 0x79, 0x73, 0x7e, 0x93, 0x8a, 0xce, 0x62, 0x15, 0x95, 0x40, 0xc5,
-	0x51, 0x1d, 0x4c, 0xa7, 0xdd, 0xd9, 0x63, 0x6a, 0x59, 0x87, 0xca, 0x61, 0xfb, 0xa7, 0xc6, 0x49,
-	0x9f, 0x67, 0xb5, 0x91, 0x06, 0xb5, 0xa7, 0x5d, 0xdc, 0xeb, 0x1e, 0xc8, 0x16, 0x15, 0x6d, 0x81,
-	0x26, 0x5b, 0xa6, 0xe3, 0xf2, 0x0c, 0x41, 0x7c, 0x16, 0x50, 0x19, 0xf2, 0xfd, 0x67, 0xed, 0x63,
-	0xad, 0xa8, 0xff, 0x57, 0x0e, 0x36, 0xc4, 0xb1, 0x10, 0xbf, 0xd8, 0xbf, 0xf9, 0xc5, 0x32, 0x99,
-	0xe5, 0xc9, 0xa5, 0xb3, 0x3c, 0x51, 0x10, 0xca, 0x4f, 0x75, 0x75, 0x1a, 0x84, 0xf2, 0xec, 0x50,
-	0xca, 0xe3, 0xe7, 0x97, 0xf1, 0xf8, 0x0d, 0x28, 0x39, 0x84, 0xc6, 0x7a, 0xab, 0xe0, 0x88, 0x44,
-	0x36, 0x54, 0x4d, 0xd7, 0xf5, 0x42, 0x53, 0xa4, 0x4e, 0x8b, 0x4b, 0x1d, 0x86, 0x33, 0xff, 0xb8,
-	0xd5, 0x9e, 0x22, 0x09, 0xc7, 0x9c, 0xc4, 0x6e, 0xfe, 0x04, 0xb4, 0xd9, 0x01, 0xcb, 0x1c, 0x87,
-	0xdf, 0xff, 0xe1, 0xf4, 0x34, 0x24, 0x6c, 0x5f, 0xc8, 0x37, 0x07, 0x6d, 0x8d, 0x11, 0xf8, 0xa4,
-	0xd7, 0xdb, 0xef, 0x3d, 0xd1, 0x14, 0x04, 0x50, 0xec, 0xfe, 0x74, 0x7f, 0xd0, 0xdd, 0xd5, 0x72,
-	0x3b, 0xbf, 0xde, 0x84, 0xa2, 0x60, 0x12, 0x7d, 0x2b, 0x23, 0x81, 0x64, 0x35, 0x28, 0xfa, 0xc9,
-	0xd2, 0x11, 0x75, 0xaa, 0xc2, 0xb4, 0xf9, 0x68, 0xe5, 0xf9, 0xf2, 0xf5, 0x6d, 0x0d, 0xfd, 0x8d,
-	0x02, 0xb5, 0xd4, 0xcb, 0x5b, 0xd6, 0xd4, 0xf1, 0x82, 0xe2, 0xd3, 0xe6, 0x8f, 0x57, 0x9a, 0x1b,
-	0xf3, 0xf2, 0x4b, 0x05, 0xaa, 0x89, 0xb2, 0x4b, 0xf4, 0x60, 0x95, 0x52, 0x4d, 0xc1, 0xc9, 0x67,
-	0xab, 0x57, 0x79, 0xea, 0x6b, 0x1f, 0x2b, 0xe8, 0xaf, 0x15, 0xa8, 0x26, 0x0a, 0x10, 0x33, 0xb3,
-	0x32, 0x5f, 0x2e, 0x99, 0x99, 0x95, 0x45, 0xf5, 0x8e, 0x6b, 0xe8, 0x2f, 0x15, 0xa8, 0xc4, 0xc5,
-	0x84, 0xe8, 0xfe, 0xf2, 0xe5, 0x87, 0x82, 0x89, 0x4f, 0x57, 0xad, 0x5b, 0xd4, 0xd7, 0xd0, 0x9f,
-	0x43, 0x39, 0xaa, 0xbc, 0x43, 0x59, 0x4f, 0xaf, 0x99, 0xb2, 0xbe, 0xe6, 0xfd, 0xa5, 0xe7, 0x25,
-	0x97, 0x8f, 0xca, 0xe1, 0x32, 0x2f, 0x3f, 0x53, 0xb8, 0xd7, 0xbc, 0xbf, 0xf4, 0xbc, 0x78, 0x79,
-	0x66, 0x09, 0x89, 0xaa, 0xb9, 0xcc, 0x96, 0x30, 0x5f, 0xae, 0x97, 0xd9, 0x12, 0x16, 0x15, 0xe9,
-	0x09, 0x46, 0x12, 0x75, 0x77, 0x99, 0x19, 0x99, 0xaf, 0xed, 0xcb, 0xcc, 0xc8, 0x82, 0x32, 0x3f,
-	0x7d, 0x0d, 0xfd, 0x42, 0x49, 0xde, 0x0b, 0xee, 0x2f, 0x5d, 0x5e, 0xb6, 0xa4, 0x49, 0xce, 0x15,
-	0xb8, 0xf1, 0x0d, 0xfa, 0x0b, 0x99, 0xc5, 0x10, 0xd5, 0x69, 0x68, 0x19, 0xb0, 0x54, 0x41, 0x5b,
-	0xf3, 0x93, 0xd5, 0x0e, 0x1b, 0xce, 0xc4, 0x5f, 0x29, 0x00, 0xd3, 0x3a, 0xb6, 0xcc, 0x4c, 0xcc,
-	0x15, 0xd0, 0x35, 0x1f, 0xac, 0x30, 0x33, 0xb9, 0x41, 0xa2, 0x3a, 0x9b, 0xcc, 0x1b, 0x64, 0xa6,
-	0xce, 0x2e, 0xf3, 0x06, 0x99, 0xad, 0x91, 0xd3, 0xd7, 0xd0, 0x3f, 0x29, 0xb0, 0x39, 0x57, 0xe7,
-	0x83, 0x1e, 0x5d, 0xb3, 0xd4, 0xab, 0xf9, 0xc5, 0xea, 0x00, 0x11, 0x6b, 0xdb, 0xca, 0xc7, 0x0a,
-	0xfa, 0x5b, 0x05, 0xd6, 0xd3, 0xf5, 0x0f, 0x99, 0x4f, 0xa9, 0x05, 0x15, 0x43, 0xcd, 0x87, 0xab,
-	0x4d, 0x8e, 0xa5, 0xf5, 0xf7, 0x0a, 0xd4, 0xd3, 0xa5, 0x30, 0xe8, 0xe1, 0x72, 0x6e, 0x61, 0x86,
-	0xa1, 0xcf, 0x57, 0x9c, 0x1d, 0x71, 0xf4, 0x65, 0xe9, 0x8f, 0x0b, 0x22, 0x7a, 0x2b, 0xf2, 0x9f,
-	0x1f, 0xfd, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x8b, 0xb6, 0x52, 0xb4, 0x33, 0x00, 0x00,
+	0x76, 0x77, 0xf3, 0x4b, 0xe4, 0xa3, 0x44, 0xb5, 0xca, 0x92, 0x87, 0xe6, 0x24, 0x3b, 0xde, 0x0e,
+	0x26, 0x10, 0x76, 0x66, 0xe8, 0x59, 0x2d, 0x32, 0xfe, 0x18, 0x7b, 0x3d, 0x1c, 0x8a, 0xb6, 0x34,
+	0x96, 0x28, 0xa5, 0x48, 0xc1, 0xeb, 0x38, 0x33, 0x9d, 0x56, 0x77, 0x99, 0x6c, 0x8b, 0xfd, 0x31,
+	0x5d, 0x4d, 0x59, 0xda, 0x20, 0x48, 0xb0, 0x01, 0x82, 0x0d, 0xb0, 0x41, 0x72, 0x99, 0xec, 0x25,
+	0xa7, 0x00, 0x39, 0x05, 0xb9, 0x07, 0x0b, 0xcc, 0x29, 0x87, 0xfc, 0x13, 0xb9, 0xe4, 0x96, 0x63,
+	0xf2, 0x1f, 0x04, 0xf5, 0xd1, 0xcd, 0x6e, 0x92, 0x1e, 0x37, 0x29, 0x9f, 0xd8, 0xef, 0x55, 0xbd,
+	0x5f, 0x3d, 0xbe, 0x7a, 0x55, 0xef, 0x55, 0xd5, 0x03, 0xcd, 0x1f, 0x8d, 0x07, 0xb6, 0x4b, 0x6f,
+	0x5b, 0x81, 0x7d, 0x4e, 0x02, 0x7a, 0xdb, 0x0f, 0xbc, 0xd0, 0x93, 0x54, 0x93, 0x13, 0xe8, 0xc3,
+	0xa1, 0x41, 0x87, 0xb6, 0xe9, 0x05, 0x7e, 0xd3, 0xf5, 0x1c, 0xc3, 0x6a, 0x4a, 0x99, 0xa6, 0x94,
+	0x11, 0xdd, 0x1a, 0x3f, 0x1a, 0x78, 0xde, 0x60, 0x44, 0x04, 0xc2, 0xe9, 0xf8, 0xe5, 0x6d, 0x6b,
+	0x1c, 0x18, 0xa1, 0xed, 0xb9, 0xb2, 0xfd, 0x83, 0xe9, 0xf6, 0xd0, 0x76, 0x08, 0x0d, 0x0d, 0xc7,
+	0x97, 0x1d, 0x3e, 0x8c, 0x74, 0xa1, 0x43, 0x23, 0x20, 0xd6, 0xed, 0xa1, 0x39, 0xa2, 0x3e, 0x31,
+	0xd9, 0xaf, 0xce, 0x3e, 0x64, 0xb7, 0x8f, 0xa7, 0xba, 0xd1, 0x30, 0x18, 0x9b, 0x61, 0xa4, 0xb9,
+	0x11, 0x86, 0x81, 0x7d, 0x3a, 0x0e, 0x89, 0xe8, 0xad, 0xdd, 0x84, 0xf7, 0xfa, 0x06, 0x3d, 0x6b,
+	0x7b, 0xee, 0x4b, 0x7b, 0xd0, 0x33, 0x87, 0xc4, 0x31, 0x30, 0xf9, 0x76, 0x4c, 0x68, 0xa8, 0xfd,
+	0x29, 0xd4, 0x67, 0x9b, 0xa8, 0xef, 0xb9, 0x94, 0xa0, 0x2f, 0xa0, 0xc0, 0x86, 0xac, 0x2b, 0xb7,
+	0x94, 0xed, 0xea, 0xce, 0xc7, 0xcd, 0x37, 0x99, 0x40, 0xe8, 0xd0, 0x94, 0xaa, 0x36, 0x7b, 0x3e,
+	0x31, 0x31, 0x97, 0xd4, 0xb6, 0xe0, 0x7a, 0xdb, 0xf0, 0x8d, 0x53, 0x7b, 0x64, 0x87, 0x36, 0xa1,
+	0xd1, 0xa0, 0x63, 0xd8, 0x4c, 0xb3, 0xe5, 0x80, 0x5f, 0xc3, 0xaa, 0x99, 0xe0, 0xcb, 0x81, 0xef,
+	0x35, 0x33, 0xd9, 0xbe, 0xb9, 0xcb, 0xa9, 0x14, 0x70, 0x0a, 0x4e, 0xdb, 0x04, 0xf4, 0xd8, 0x76,
+	0x07, 0x24, 0xf0, 0x03, 0xdb, 0x0d, 0x23, 0x65, 0xbe, 0xcf, 0xc3, 0xf5, 0x14, 0x5b, 0x2a, 0xf3,
+	0x0a, 0x20, 0xb6, 0x23, 0x53, 0x25, 0xbf, 0x5d, 0xdd, 0xf9, 0x2a, 0xa3, 0x2a, 0x73, 0xf0, 0x9a,
+	0xad, 0x18, 0xac, 0xe3, 0x86, 0xc1, 0x25, 0x4e, 0xa0, 0xa3, 0x6f, 0xa0, 0x34, 0x24, 0xc6, 0x28,
+	0x1c, 0xd6, 0x73, 0xb7, 0x94, 0xed, 0xda, 0xce, 0xe3, 0x2b, 0x8c, 0xb3, 0xc7, 0x81, 0x7a, 0xa1,
+	0x11, 0x12, 0x2c, 0x51, 0xd1, 0x27, 0x80, 0xc4, 0x97, 0x6e, 0x11, 0x6a, 0x06, 0xb6, 0xcf, 0x5c,
+	0xb2, 0x9e, 0xbf, 0xa5, 0x6c, 0x57, 0xf0, 0x86, 0x68, 0xd9, 0x9d, 0x34, 0x34, 0x7c, 0x58, 0x9f,
+	0xd2, 0x16, 0xa9, 0x90, 0x3f, 0x23, 0x97, 0x7c, 0x46, 0x2a, 0x98, 0x7d, 0xa2, 0x27, 0x50, 0x3c,
+	0x37, 0x46, 0x63, 0xc2, 0x55, 0xae, 0xee, 0xfc, 0xf4, 0x6d, 0xee, 0x21, 0x5d, 0x74, 0x62, 0x07,
+	0x2c, 0xe4, 0xef, 0xe7, 0xee, 0x2a, 0xda, 0x3d, 0xa8, 0x26, 0xf4, 0x46, 0x35, 0x80, 0x93, 0xee,
+	0x6e, 0xa7, 0xdf, 0x69, 0xf7, 0x3b, 0xbb, 0xea, 0x35, 0xb4, 0x06, 0x95, 0x93, 0xee, 0x5e, 0xa7,
+	0x75, 0xd0, 0xdf, 0x7b, 0xae, 0x2a, 0xa8, 0x0a, 0x2b, 0x11, 0x91, 0xd3, 0x2e, 0x00, 0x61, 0x62,
+	0x7a, 0xe7, 0x24, 0x60, 0x8e, 0x2c, 0x67, 0x15, 0xbd, 0x07, 0x2b, 0xa1, 0x41, 0xcf, 0x74, 0xdb,
+	0x92, 0x3a, 0x97, 0x18, 0xb9, 0x6f, 0xa1, 0x7d, 0x28, 0x0d, 0x0d, 0xd7, 0x1a, 0xbd, 0x5d, 0xef,
+	0xb4, 0xa9, 0x19, 0xf8, 0x1e, 0x17, 0xc4, 0x12, 0x80, 0x79, 0x77, 0x6a, 0x64, 0x31, 0x01, 0xda,
+	0x73, 0x50, 0x7b, 0xa1, 0x11, 0x84, 0x49, 0x75, 0x3a, 0x50, 0x60, 0xe3, 0xd7, 0x95, 0x85, 0xc7,
+	0x14, 0x2b, 0x13, 0x73, 0x71, 0xed, 0xff, 0x72, 0xb0, 0x91, 0xc0, 0x96, 0x9e, 0xfa, 0x0c, 0x4a,
+	0x01, 0xa1, 0xe3, 0x51, 0xc8, 0xe1, 0x6b, 0x3b, 0x8f, 0x32, 0xc2, 0xcf, 0x20, 0x35, 0x31, 0x87,
+	0xc1, 0x12, 0x0e, 0x6d, 0x83, 0x2a, 0x24, 0x74, 0x12, 0x04, 0x5e, 0xa0, 0x3b, 0x74, 0xc0, 0xad,
+	0x56, 0xc1, 0x35, 0xc1, 0xef, 0x30, 0xf6, 0x21, 0x1d, 0x24, 0xac, 0x9a, 0xbf, 0xa2, 0x55, 0x91,
+	0x01, 0xaa, 0x4b, 0xc2, 0xd7, 0x5e, 0x70, 0xa6, 0x33, 0xd3, 0x06, 0xb6, 0x45, 0xea, 0x05, 0x0e,
+	0xfa, 0x59, 0x46, 0xd0, 0xae, 0x10, 0x3f, 0x92, 0xd2, 0x78, 0xdd, 0x4d, 0x33, 0xb4, 0x8f, 0xa0,
+	0x24, 0xfe, 0x29, 0xf3, 0xa4, 0xde, 0x49, 0xbb, 0xdd, 0xe9, 0xf5, 0xd4, 0x6b, 0xa8, 0x02, 0x45,
+	0xdc, 0xe9, 0x63, 0xe6, 0x61, 0x15, 0x28, 0x3e, 0x6e, 0xf5, 0x5b, 0x07, 0x6a, 0x4e, 0xfb, 0x09,
+	0xac, 0x3f, 0x33, 0xec, 0x30, 0x8b, 0x73, 0x69, 0x1e, 0xa8, 0x93, 0xbe, 0x72, 0x76, 0xf6, 0x53,
+	0xb3, 0x93, 0xdd, 0x34, 0x9d, 0x0b, 0x3b, 0x9c, 0x9a, 0x0f, 0x15, 0xf2, 0x24, 0x08, 0xe4, 0x14,
+	0xb0, 0x4f, 0xed, 0x35, 0xac, 0xf7, 0x42, 0xcf, 0xcf, 0xe4, 0xf9, 0x3f, 0x83, 0x15, 0x16, 0x6d,
+	0xbc, 0x71, 0x28, 0x5d, 0xff, 0x66, 0x53, 0x44, 0xa3, 0x66, 0x14, 0x8d, 0x9a, 0xbb, 0x32, 0x5a,
+	0xe1, 0xa8, 0x27, 0xba, 0x01, 0x25, 0x6a, 0x0f, 0x5c, 0x63, 0x24, 0x77, 0x0b, 0x49, 0x69, 0x08,
+	0xd4, 0xc9, 0xc0, 0xd2, 0xf1, 0xdb, 0x80, 0x76, 0x09, 0x0d, 0x03, 0xef, 0x32, 0x93, 0x3e, 0x9b,
+	0x50, 0x7c, 0xe9, 0x05, 0xa6, 0x58, 0x88, 0x65, 0x2c, 0x08, 0xb6, 0xa8, 0x52, 0x20, 0x12, 0xfb,
+	0x13, 0x40, 0xfb, 0x2e, 0x8b, 0x29, 0xd9, 0x26, 0xe2, 0x1f, 0x72, 0x70, 0x3d, 0xd5, 0x5f, 0x4e,
+	0xc6, 0xf2, 0xeb, 0x90, 0x6d, 0x4c, 0x63, 0x2a, 0xd6, 0x21, 0x3a, 0x82, 0x92, 0xe8, 0x21, 0x2d,
+	0x79, 0x67, 0x01, 0x20, 0x11, 0xa6, 0x24, 0x9c, 0x84, 0x99, 0xeb, 0xf4, 0xf9, 0x77, 0xeb, 0xf4,
+	0xaf, 0x41, 0x8d, 0xfe, 0x07, 0x7d, 0xeb, 0xdc, 0x7c, 0x05, 0xd7, 0x4d, 0x6f, 0x34, 0x22, 0x26,
+	0xf3, 0x06, 0xdd, 0x76, 0x43, 0x12, 0x9c, 0x1b, 0xa3, 0xb7, 0xfb, 0x0d, 0x9a, 0x48, 0xed, 0x4b,
+	0x21, 0xed, 0x05, 0x6c, 0x24, 0x06, 0x96, 0x13, 0xf1, 0x18, 0x8a, 0x94, 0x31, 0xe4, 0x4c, 0x7c,
+	0xba, 0xe0, 0x4c, 0x50, 0x2c, 0xc4, 0xb5, 0xeb, 0x02, 0xbc, 0x73, 0x4e, 0xdc, 0xf8, 0x6f, 0x69,
+	0xbb, 0xb0, 0xd1, 0xe3, 0x6e, 0x9a, 0xc9, 0x0f, 0x27, 0x2e, 0x9e, 0x4b, 0xb9, 0xf8, 0x26, 0xa0,
+	0x24, 0x8a, 0x74, 0xc4, 0x4b, 0x58, 0xef, 0x5c, 0x10, 0x33, 0x13, 0x72, 0x1d, 0x56, 0x4c, 0xcf,
+	0x71, 0x0c, 0xd7, 0xaa, 0xe7, 0x6e, 0xe5, 0xb7, 0x2b, 0x38, 0x22, 0x93, 0x6b, 0x31, 0x9f, 0x75,
+	0x2d, 0x6a, 0x7f, 0xa7, 0x80, 0x3a, 0x19, 0x5b, 0x1a, 0x92, 0x69, 0x1f, 0x5a, 0x0c, 0x88, 0x8d,
+	0xbd, 0x8a, 0x25, 0x25, 0xf9, 0xd1, 0x76, 0x21, 0xf8, 0x24, 0x08, 0x12, 0xdb, 0x51, 0xfe, 0x8a,
+	0xdb, 0x91, 0xb6, 0x07, 0xbf, 0x17, 0xa9, 0xd3, 0x0b, 0x03, 0x62, 0x38, 0xb6, 0x3b, 0xd8, 0x3f,
+	0x3a, 0xf2, 0x89, 0x50, 0x1c, 0x21, 0x28, 0x58, 0x46, 0x68, 0x48, 0xc5, 0xf8, 0x37, 0x5b, 0xf4,
+	0xe6, 0xc8, 0xa3, 0xf1, 0xa2, 0xe7, 0x84, 0xf6, 0x9f, 0x79, 0xa8, 0xcf, 0x40, 0x45, 0xe6, 0x7d,
+	0x01, 0x45, 0x4a, 0xc2, 0xb1, 0x2f, 0x5d, 0xa5, 0x93, 0x59, 0xe1, 0xf9, 0x78, 0xcd, 0x1e, 0x03,
+	0xc3, 0x02, 0x13, 0x0d, 0xa0, 0x1c, 0x86, 0x97, 0x3a, 0xb5, 0x7f, 0x19, 0x25, 0x04, 0x07, 0x57,
+	0xc5, 0xef, 0x93, 0xc0, 0xb1, 0x5d, 0x63, 0xd4, 0xb3, 0x7f, 0x49, 0xf0, 0x4a, 0x18, 0x5e, 0xb2,
+	0x0f, 0xf4, 0x9c, 0x39, 0xbc, 0x65, 0xbb, 0xd2, 0xec, 0xed, 0x65, 0x47, 0x49, 0x18, 0x18, 0x0b,
+	0xc4, 0xc6, 0x01, 0x14, 0xf9, 0x7f, 0x5a, 0xc6, 0x11, 0x55, 0xc8, 0x87, 0xe1, 0x25, 0x57, 0xaa,
+	0x8c, 0xd9, 0x67, 0xe3, 0x01, 0xac, 0x26, 0xff, 0x01, 0x73, 0xa4, 0x21, 0xb1, 0x07, 0x43, 0xe1,
+	0x60, 0x45, 0x2c, 0x29, 0x36, 0x93, 0xaf, 0x6d, 0x4b, 0xa6, 0xac, 0x45, 0x2c, 0x08, 0xed, 0xdf,
+	0x73, 0x70, 0x73, 0x8e, 0x65, 0xa4, 0xb3, 0xbe, 0x48, 0x39, 0xeb, 0x3b, 0xb2, 0x42, 0xe4, 0xf1,
+	0x2f, 0x52, 0x1e, 0xff, 0x0e, 0xc1, 0xd9, 0xb2, 0xb9, 0x01, 0x25, 0x72, 0x61, 0x87, 0xc4, 0x92,
+	0xa6, 0x92, 0x54, 0x62, 0x39, 0x15, 0xae, 0xba, 0x9c, 0x0e, 0x61, 0xb3, 0x1d, 0x10, 0x23, 0x24,
+	0x72, 0x2b, 0x8f, 0xfc, 0xff, 0x26, 0x94, 0x8d, 0xd1, 0xc8, 0x33, 0x27, 0xd3, 0xba, 0xc2, 0xe9,
+	0x7d, 0x0b, 0x35, 0xa0, 0x3c, 0xf4, 0x68, 0xe8, 0x1a, 0x0e, 0x91, 0x9b, 0x57, 0x4c, 0x6b, 0xdf,
+	0x29, 0xb0, 0x35, 0x85, 0x27, 0x67, 0xe1, 0x14, 0x6a, 0x36, 0xf5, 0x46, 0xfc, 0x0f, 0xea, 0x89,
+	0x13, 0xde, 0xe7, 0x8b, 0x85, 0x9a, 0xfd, 0x08, 0x83, 0x1f, 0xf8, 0xd6, 0xec, 0x24, 0xc9, 0x3d,
+	0x8e, 0x0f, 0x6e, 0xc9, 0x95, 0x1e, 0x91, 0xda, 0x3f, 0x2a, 0xb0, 0x25, 0x23, 0x7c, 0xf6, 0x3f,
+	0x3a, 0xab, 0x72, 0xee, 0x5d, 0xab, 0xac, 0xd5, 0xe1, 0xc6, 0xb4, 0x5e, 0x72, 0xcf, 0xff, 0x14,
+	0xb6, 0xda, 0x43, 0x62, 0x9e, 0xf9, 0x9e, 0xed, 0x66, 0xcb, 0x3f, 0xea, 0x70, 0x63, 0x5a, 0x42,
+	0x62, 0x7d, 0x04, 0xea, 0xb1, 0x31, 0xa6, 0x24, 0x13, 0xcc, 0x75, 0xd8, 0x48, 0x74, 0x96, 0x08,
+	0x1f, 0xc3, 0x06, 0xf3, 0x1c, 0x27, 0x1b, 0xc4, 0x26, 0xa0, 0x64, 0x6f, 0x89, 0xf1, 0x6f, 0x45,
+	0x40, 0xb3, 0xe7, 0x65, 0xf4, 0x63, 0x58, 0xa5, 0xc4, 0xb5, 0x74, 0x11, 0x01, 0x45, 0x70, 0x2e,
+	0xe3, 0x2a, 0xe3, 0x89, 0x50, 0x48, 0xd9, 0xa6, 0x4e, 0x2e, 0xa4, 0xfd, 0xcb, 0x98, 0x7f, 0xa3,
+	0x21, 0xac, 0xbe, 0xa4, 0x7a, 0x6c, 0x4d, 0xbe, 0x44, 0x6a, 0x99, 0x37, 0xea, 0x59, 0x3d, 0x9a,
+	0x8f, 0x7b, 0xf1, 0x4c, 0xe1, 0xea, 0x4b, 0x1a, 0x13, 0xe8, 0xd7, 0x0a, 0xbc, 0x17, 0x25, 0x4a,
+	0x13, 0x87, 0x70, 0x3c, 0x8b, 0xd0, 0x7a, 0xe1, 0x56, 0x7e, 0xbb, 0xb6, 0x73, 0x7c, 0x05, 0x8f,
+	0x98, 0x61, 0x1e, 0x7a, 0x16, 0xc1, 0x5b, 0xee, 0x1c, 0x2e, 0x45, 0x4d, 0xb8, 0xee, 0x8c, 0x69,
+	0xa8, 0x0b, 0xbf, 0xd6, 0x65, 0xa7, 0x7a, 0x91, 0xdb, 0x65, 0x83, 0x35, 0xa5, 0x56, 0x1f, 0x3a,
+	0x83, 0x35, 0xc7, 0x1b, 0xbb, 0xa1, 0x6e, 0xf2, 0x13, 0x1d, 0xad, 0x97, 0x16, 0x3a, 0xea, 0xcf,
+	0xb1, 0xd2, 0x21, 0x83, 0x13, 0xe7, 0x43, 0x8a, 0x57, 0x9d, 0x04, 0xc5, 0x26, 0x32, 0x20, 0x8e,
+	0x17, 0x12, 0x9d, 0xb9, 0x01, 0xad, 0xaf, 0x88, 0x89, 0x14, 0x3c, 0xe6, 0x09, 0x14, 0xfd, 0x08,
+	0xc0, 0x8c, 0x5d, 0xb4, 0x5e, 0xe6, 0x1d, 0x12, 0x1c, 0xb6, 0xbf, 0xfb, 0xcc, 0xf7, 0xea, 0x15,
+	0x11, 0xa9, 0x39, 0xa1, 0x35, 0xa1, 0x9a, 0x98, 0x1c, 0x54, 0x86, 0x42, 0xf7, 0xa8, 0xdb, 0x51,
+	0xaf, 0x21, 0x80, 0x52, 0x7b, 0x0f, 0x1f, 0x1d, 0xf5, 0xc5, 0xe9, 0x69, 0xff, 0xb0, 0xf5, 0xa4,
+	0xa3, 0xe6, 0xb4, 0x0e, 0xac, 0x26, 0xd5, 0x44, 0x08, 0x6a, 0x27, 0xdd, 0xa7, 0xdd, 0xa3, 0x67,
+	0x5d, 0xfd, 0xf0, 0xe8, 0xa4, 0xdb, 0x67, 0xe7, 0xae, 0x1a, 0x40, 0xab, 0xfb, 0x7c, 0x42, 0xaf,
+	0x41, 0xa5, 0x7b, 0x14, 0x91, 0x4a, 0x23, 0xa7, 0x2a, 0xda, 0x7f, 0xe4, 0x61, 0x73, 0xde, 0x8c,
+	0x21, 0x0b, 0x0a, 0x6c, 0xf6, 0xe5, 0xc9, 0xf7, 0xdd, 0x4f, 0x3e, 0x47, 0x67, 0x4e, 0xef, 0x1b,
+	0x32, 0xd4, 0x55, 0x30, 0xff, 0x46, 0x3a, 0x94, 0x46, 0xc6, 0x29, 0x19, 0xd1, 0x7a, 0x9e, 0xdf,
+	0x0d, 0x3d, 0xb9, 0xca, 0xd8, 0x07, 0x1c, 0x49, 0x5c, 0x0c, 0x49, 0x58, 0xd4, 0x87, 0x2a, 0xdb,
+	0xcc, 0xa9, 0x30, 0x9d, 0x8c, 0x2f, 0x3b, 0x19, 0x47, 0xd9, 0x9b, 0x48, 0xe2, 0x24, 0x4c, 0xe3,
+	0x1e, 0x54, 0x13, 0x83, 0xcd, 0xb9, 0xd7, 0xd9, 0x4c, 0xde, 0xeb, 0x54, 0x92, 0x97, 0x34, 0x8f,
+	0x60, 0x73, 0x9e, 0x8d, 0x98, 0x13, 0xec, 0x1d, 0xf5, 0xfa, 0xe2, 0x04, 0xfd, 0x04, 0x1f, 0x9d,
+	0x1c, 0xab, 0x0a, 0x63, 0xf6, 0x5b, 0xbd, 0xa7, 0x6a, 0x2e, 0xf6, 0x91, 0xbc, 0xd6, 0x86, 0x6a,
+	0x42, 0xaf, 0x54, 0xf4, 0x52, 0xd2, 0xd1, 0x8b, 0xc5, 0x0f, 0xc3, 0xb2, 0x02, 0x42, 0xa9, 0xd4,
+	0x23, 0x22, 0xb5, 0x17, 0x50, 0xd9, 0xed, 0xf6, 0x24, 0x44, 0x1d, 0x56, 0x28, 0x09, 0xd8, 0xff,
+	0xe6, 0x37, 0x74, 0x15, 0x1c, 0x91, 0x0c, 0x9c, 0x12, 0x23, 0x30, 0x87, 0x84, 0xca, 0x9c, 0x27,
+	0xa6, 0x99, 0x94, 0xc7, 0x6f, 0xba, 0xc4, 0xdc, 0x55, 0x70, 0x44, 0x6a, 0xff, 0xbb, 0x02, 0x30,
+	0xb9, 0x75, 0x41, 0x35, 0xc8, 0xc5, 0x1b, 0x6a, 0xce, 0xb6, 0x98, 0x1f, 0x24, 0x62, 0x2d, 0xff,
+	0x46, 0x3b, 0xb0, 0xe5, 0xd0, 0x81, 0x6f, 0x98, 0x67, 0xba, 0xbc, 0x2c, 0x11, 0x0b, 0x9c, 0xef,
+	0x82, 0xab, 0xf8, 0xba, 0x6c, 0x94, 0xeb, 0x57, 0xe0, 0x1e, 0x40, 0x9e, 0xb8, 0xe7, 0x7c, 0xc7,
+	0xaa, 0xee, 0xdc, 0x5f, 0xf8, 0x36, 0xa8, 0xd9, 0x71, 0xcf, 0x85, 0xaf, 0x30, 0x18, 0xa4, 0x03,
+	0x58, 0xe4, 0xdc, 0x36, 0x89, 0xce, 0x40, 0x8b, 0x1c, 0xf4, 0x8b, 0xc5, 0x41, 0x77, 0x39, 0x46,
+	0x0c, 0x5d, 0xb1, 0x22, 0x1a, 0x75, 0xa1, 0x12, 0x10, 0xea, 0x8d, 0x03, 0x93, 0x88, 0x6d, 0x2b,
+	0xfb, 0x81, 0x0d, 0x47, 0x72, 0x78, 0x02, 0x81, 0x76, 0xa1, 0xc4, 0x77, 0x2b, 0xb6, 0x2f, 0xe5,
+	0x7f, 0xf0, 0x6a, 0x39, 0x0d, 0xc6, 0x77, 0x12, 0x2c, 0x65, 0xd1, 0x13, 0x58, 0x11, 0x2a, 0xd2,
+	0x7a, 0x99, 0xc3, 0x7c, 0x92, 0x75, 0x2b, 0xe5, 0x52, 0x38, 0x92, 0x66, 0xb3, 0x3a, 0xa6, 0x24,
+	0xe0, 0x1b, 0x5d, 0x05, 0xf3, 0x6f, 0xf4, 0x3e, 0x54, 0x44, 0x2e, 0x62, 0xd9, 0x41, 0x1d, 0x84,
+	0x73, 0x72, 0xc6, 0xae, 0x1d, 0xa0, 0x0f, 0xa0, 0x2a, 0x72, 0x4e, 0x9d, 0xef, 0x0a, 0x55, 0xde,
+	0x0c, 0x82, 0x75, 0xcc, 0xf6, 0x06, 0xd1, 0x81, 0x04, 0x81, 0xe8, 0xb0, 0x1a, 0x77, 0x20, 0x41,
+	0xc0, 0x3b, 0xfc, 0x21, 0xac, 0xf3, 0x70, 0x3d, 0x08, 0xbc, 0xb1, 0xaf, 0x73, 0x9f, 0x5a, 0xe3,
+	0x9d, 0xd6, 0x18, 0xfb, 0x09, 0xe3, 0x76, 0x99, 0x73, 0xdd, 0x84, 0xf2, 0x2b, 0xef, 0x54, 0x74,
+	0xa8, 0x89, 0x75, 0xf0, 0xca, 0x3b, 0x8d, 0x9a, 0xe2, 0x6c, 0x69, 0x3d, 0x9d, 0x2d, 0x7d, 0x0b,
+	0x37, 0x66, 0x83, 0x24, 0xcf, 0x9a, 0xd4, 0xab, 0x67, 0x4d, 0x9b, 0xee, 0x1c, 0x2e, 0xfa, 0x12,
+	0xf2, 0x96, 0x4b, 0xeb, 0x1b, 0x0b, 0x39, 0x47, 0xbc, 0x8e, 0x31, 0x13, 0x6e, 0x7c, 0x06, 0xe5,
+	0xc8, 0xfb, 0x16, 0xd9, 0x97, 0x1a, 0x0f, 0xa0, 0x96, 0xf6, 0xdd, 0x85, 0x76, 0xb5, 0x7f, 0xc9,
+	0x41, 0x25, 0xf6, 0x52, 0xe4, 0xc2, 0x75, 0x6e, 0x45, 0x23, 0x24, 0x96, 0x3e, 0x71, 0x7a, 0x91,
+	0x20, 0x3f, 0xcc, 0xf8, 0xbf, 0x5a, 0x11, 0x82, 0xcc, 0xb9, 0xe4, 0x0a, 0x40, 0x31, 0xf2, 0x64,
+	0xbc, 0x6f, 0x60, 0x7d, 0x64, 0xbb, 0xe3, 0x8b, 0xc4, 0x58, 0x22, 0xb3, 0xfd, 0xa3, 0x8c, 0x63,
+	0x1d, 0x30, 0xe9, 0xc9, 0x18, 0xb5, 0x51, 0x8a, 0x46, 0x7b, 0x50, 0xf4, 0xbd, 0x20, 0x8c, 0x82,
+	0x54, 0xd6, 0xf0, 0x71, 0xec, 0x05, 0xe1, 0xa1, 0xe1, 0xfb, 0xec, 0xf0, 0x26, 0x00, 0xb4, 0xef,
+	0x72, 0x70, 0x63, 0xfe, 0x1f, 0x43, 0x5d, 0xc8, 0x9b, 0xfe, 0x58, 0x1a, 0xe9, 0xc1, 0xa2, 0x46,
+	0x6a, 0xfb, 0xe3, 0x89, 0xfe, 0x0c, 0x88, 0x5d, 0x68, 0x3b, 0xc4, 0xf1, 0x82, 0x4b, 0x69, 0x8b,
+	0x47, 0x8b, 0x42, 0x1e, 0x72, 0xe9, 0x09, 0xaa, 0x84, 0x43, 0x18, 0xca, 0xd2, 0x7b, 0xa9, 0xdc,
+	0x27, 0x17, 0xbc, 0x5e, 0x8b, 0x20, 0x71, 0x8c, 0xa3, 0x7d, 0x06, 0x5b, 0x73, 0xff, 0x0a, 0xfa,
+	0x7d, 0x00, 0xd3, 0x1f, 0xeb, 0xfc, 0xf9, 0x43, 0x78, 0x50, 0x1e, 0x57, 0x4c, 0x7f, 0xdc, 0xe3,
+	0x0c, 0xed, 0x05, 0xd4, 0xdf, 0xa4, 0x2f, 0xdb, 0x7d, 0x84, 0xc6, 0xba, 0x73, 0xca, 0x6d, 0x90,
+	0xc7, 0x65, 0xc1, 0x38, 0x3c, 0x45, 0x1a, 0xac, 0x45, 0x8d, 0xc6, 0x05, 0xeb, 0x90, 0xe7, 0x1d,
+	0xaa, 0xb2, 0x83, 0x71, 0x71, 0x78, 0xaa, 0xfd, 0x36, 0x07, 0xeb, 0x53, 0x2a, 0xb3, 0x23, 0xac,
+	0xd8, 0xf1, 0xa2, 0x13, 0x82, 0xa0, 0xd8, 0xf6, 0x67, 0xda, 0x56, 0x74, 0xad, 0xcc, 0xbf, 0x79,
+	0xe0, 0xf3, 0xe5, 0x95, 0x6f, 0xce, 0xf6, 0xd9, 0xf2, 0x71, 0x4e, 0xed, 0x90, 0xf2, 0x2c, 0xa4,
+	0x88, 0x05, 0x81, 0x9e, 0x43, 0x2d, 0x20, 0x3c, 0xe0, 0x5a, 0xba, 0xf0, 0xb2, 0xe2, 0x42, 0x5e,
+	0x26, 0x35, 0x64, 0xce, 0x86, 0xd7, 0x22, 0x24, 0x46, 0x51, 0xf4, 0x0c, 0xd6, 0xac, 0x4b, 0xd7,
+	0x70, 0x6c, 0x53, 0x22, 0x97, 0x96, 0x46, 0x5e, 0x95, 0x40, 0x1c, 0x98, 0xbd, 0x34, 0x25, 0x1a,
+	0xd9, 0x1f, 0xe3, 0xe9, 0x96, 0xb4, 0x89, 0x20, 0xd2, 0xbb, 0x45, 0x51, 0xee, 0x16, 0xda, 0x29,
+	0x54, 0x13, 0xeb, 0x62, 0x11, 0x51, 0x66, 0xcf, 0xd0, 0xe3, 0xf6, 0x2c, 0xe2, 0x5c, 0xe8, 0xb1,
+	0xe3, 0x1a, 0x4b, 0x75, 0x74, 0xdb, 0xe7, 0x16, 0xad, 0xe0, 0x12, 0x23, 0xf7, 0x7d, 0xed, 0x77,
+	0x39, 0xa8, 0xa5, 0x97, 0x74, 0xe4, 0x47, 0x3e, 0x09, 0x6c, 0xcf, 0x4a, 0xf8, 0xd1, 0x31, 0x67,
+	0x30, 0x5f, 0x61, 0xcd, 0xdf, 0x8e, 0xbd, 0xd0, 0x88, 0x7c, 0xc5, 0xf4, 0xc7, 0x7f, 0xcc, 0xe8,
+	0x29, 0x1f, 0xcc, 0x4f, 0xf9, 0x20, 0xfa, 0x18, 0x90, 0x74, 0xa5, 0x91, 0xed, 0xd8, 0xa1, 0x7e,
+	0x7a, 0x19, 0x12, 0x31, 0xc7, 0x79, 0xac, 0x8a, 0x96, 0x03, 0xd6, 0xf0, 0x25, 0xe3, 0x33, 0xc7,
+	0xf3, 0x3c, 0x47, 0xa7, 0xa6, 0x17, 0x10, 0xdd, 0xb0, 0x5e, 0xf1, 0xb3, 0x4e, 0x1e, 0x57, 0x3d,
+	0xcf, 0xe9, 0x31, 0x5e, 0xcb, 0x7a, 0xc5, 0x22, 0x9f, 0xe9, 0x8f, 0x29, 0x09, 0x75, 0xf6, 0xc3,
+	0x93, 0x85, 0x0a, 0x06, 0xc1, 0x6a, 0xfb, 0x63, 0x8a, 0xfe, 0x00, 0xd6, 0xa2, 0x0e, 0x3c, 0xf8,
+	0xc9, 0xa8, 0xbb, 0x2a, 0xbb, 0x70, 0x1e, 0xd2, 0x60, 0xf5, 0x98, 0x04, 0x26, 0x71, 0xc3, 0xbe,
+	0x6d, 0x9e, 0x51, 0x7e, 0x3a, 0x51, 0x70, 0x8a, 0xf7, 0x55, 0xa1, 0xbc, 0xa2, 0x96, 0x71, 0x34,
+	0x9a, 0x43, 0x1c, 0xaa, 0x7d, 0x0d, 0x45, 0x9e, 0x22, 0x30, 0x9b, 0xf0, 0xf0, 0xca, 0xa3, 0xaf,
+	0x4c, 0x2d, 0x19, 0x83, 0xc7, 0xde, 0xf7, 0xa1, 0xc2, 0x6d, 0x9f, 0xc8, 0xe8, 0x79, 0xde, 0xc9,
+	0x1b, 0x1b, 0x50, 0x0e, 0x88, 0x61, 0x79, 0xee, 0x28, 0xba, 0x14, 0x8b, 0x69, 0xed, 0x5b, 0x28,
+	0x89, 0x38, 0x73, 0x05, 0xfc, 0x4f, 0x00, 0x89, 0xff, 0xcd, 0xe6, 0xd3, 0xb1, 0x29, 0x95, 0x59,
+	0x28, 0x7f, 0x89, 0x15, 0x2d, 0xc7, 0x93, 0x06, 0xed, 0xbf, 0x14, 0x80, 0xc9, 0x1b, 0x19, 0x4b,
+	0x5c, 0x99, 0x93, 0xb3, 0x33, 0xb6, 0xb8, 0x8c, 0x8b, 0x48, 0x76, 0x0f, 0x25, 0xd3, 0xce, 0xdc,
+	0xb2, 0x4f, 0x8c, 0x12, 0x20, 0xba, 0x9a, 0x27, 0xf2, 0x18, 0xbf, 0xe8, 0xd5, 0x3c, 0x11, 0x57,
+	0xf3, 0x84, 0x9d, 0x41, 0x65, 0x42, 0x2c, 0xe0, 0x0a, 0x3c, 0x1f, 0xae, 0x5a, 0xf1, 0xfb, 0x07,
+	0xd1, 0xfe, 0x47, 0x89, 0xb7, 0xa9, 0xe8, 0x9d, 0x02, 0x7d, 0x03, 0x65, 0xb6, 0xe2, 0x75, 0xc7,
+	0xf0, 0xe5, 0xab, 0x7b, 0x7b, 0xb9, 0x27, 0x90, 0x28, 0x88, 0x89, 0x74, 0x76, 0xc5, 0x17, 0x14,
+	0xdb, 0xee, 0xd8, 0x51, 0x22, 0xda, 0xee, 0xd8, 0x37, 0xfa, 0x10, 0x6a, 0xc6, 0x38, 0xf4, 0x74,
+	0xc3, 0x3a, 0x27, 0x41, 0x68, 0x53, 0x22, 0xe7, 0x7e, 0x8d, 0x71, 0x5b, 0x11, 0xb3, 0x71, 0x1f,
+	0x56, 0x93, 0x98, 0x6f, 0x4b, 0x33, 0x8a, 0xc9, 0x34, 0xe3, 0xcf, 0x00, 0x26, 0x77, 0x7e, 0xcc,
+	0x47, 0xd8, 0x05, 0xa2, 0x6e, 0x46, 0x67, 0xd7, 0x22, 0x2e, 0x33, 0x46, 0x9b, 0x9d, 0xa7, 0xd2,
+	0x0f, 0x12, 0xc5, 0xe8, 0x41, 0x82, 0x2d, 0x66, 0xb6, 0xfe, 0xce, 0xec, 0xd1, 0x28, 0xbe, 0x87,
+	0xac, 0x78, 0x9e, 0xf3, 0x94, 0x33, 0xb4, 0xef, 0x73, 0xc2, 0x57, 0xc4, 0xd3, 0x52, 0xa6, 0xb3,
+	0xcb, 0xbb, 0x9a, 0xea, 0x7b, 0x00, 0x34, 0x34, 0x02, 0x96, 0x33, 0x19, 0xd1, 0x4d, 0x68, 0x63,
+	0xe6, 0x45, 0xa3, 0x1f, 0xd5, 0xba, 0xe0, 0x8a, 0xec, 0xdd, 0x0a, 0xd1, 0x43, 0x58, 0x35, 0x3d,
+	0xc7, 0x1f, 0x11, 0x29, 0x5c, 0x7c, 0xab, 0x70, 0x35, 0xee, 0xdf, 0x0a, 0x13, 0xf7, 0xaf, 0xa5,
+	0xab, 0xde, 0xbf, 0xfe, 0x4e, 0x11, 0x2f, 0x64, 0xc9, 0x07, 0x3a, 0x34, 0x98, 0x53, 0x05, 0xf2,
+	0x64, 0xc9, 0xd7, 0xbe, 0x1f, 0x2a, 0x01, 0x69, 0x3c, 0xcc, 0x52, 0x73, 0xf1, 0xe6, 0x2c, 0xf6,
+	0x37, 0x45, 0xa8, 0x44, 0xd3, 0x32, 0x3b, 0xf7, 0x77, 0xa1, 0x12, 0x17, 0x1a, 0xd5, 0x73, 0x6f,
+	0xb5, 0xf0, 0xa4, 0x33, 0x7a, 0x09, 0xc8, 0x18, 0x0c, 0xe2, 0xec, 0x54, 0x1f, 0x53, 0x63, 0x10,
+	0x3d, 0x4d, 0xde, 0x5d, 0xc0, 0x0e, 0x51, 0x38, 0x3b, 0x61, 0xf2, 0x58, 0x35, 0x06, 0x83, 0x14,
+	0x07, 0xfd, 0x39, 0x6c, 0xa5, 0xc7, 0xd0, 0x4f, 0x2f, 0x75, 0xdf, 0xb6, 0xe4, 0x19, 0x79, 0x6f,
+	0xd1, 0xf7, 0xc1, 0x66, 0x0a, 0xfe, 0xcb, 0xcb, 0x63, 0xdb, 0x12, 0x36, 0x47, 0xc1, 0x4c, 0x03,
+	0xfa, 0x1a, 0x2a, 0x7e, 0xe0, 0x99, 0x84, 0x52, 0x12, 0xa5, 0x30, 0x8f, 0x16, 0x1e, 0xf0, 0x38,
+	0x42, 0x90, 0xc7, 0xe7, 0x18, 0xb1, 0xf1, 0x97, 0xf0, 0xde, 0x1b, 0xb4, 0x99, 0x33, 0xc5, 0xdd,
+	0x74, 0x59, 0xcd, 0xf2, 0x36, 0x4e, 0x1c, 0x90, 0x7c, 0xa8, 0xa5, 0xb5, 0x9b, 0x33, 0xee, 0x5e,
+	0x7a, 0xdc, 0xcc, 0x07, 0x05, 0x81, 0xbb, 0xef, 0xbe, 0xf4, 0x92, 0xee, 0xf8, 0x39, 0x54, 0x13,
+	0x2d, 0xfc, 0xfe, 0xcc, 0x97, 0x1e, 0x59, 0xc4, 0xfc, 0x9b, 0xbf, 0x10, 0x38, 0xd6, 0xc8, 0x76,
+	0x49, 0xfc, 0x26, 0x25, 0x48, 0xed, 0x9f, 0x15, 0xd8, 0x98, 0xf9, 0x3f, 0xa8, 0x95, 0x3c, 0x64,
+	0xdc, 0xce, 0xa8, 0x5e, 0xfb, 0xf8, 0x44, 0x58, 0x83, 0xc9, 0xa2, 0xaf, 0xa6, 0xce, 0x15, 0x59,
+	0xff, 0xa4, 0x48, 0xcf, 0x05, 0x90, 0x44, 0xd0, 0xfe, 0x35, 0x0f, 0xe5, 0x08, 0x9d, 0x9f, 0xf7,
+	0x2f, 0x69, 0x48, 0x1c, 0x3d, 0xbe, 0x8c, 0x54, 0x30, 0x08, 0x16, 0xbf, 0x22, 0x7b, 0x1f, 0x2a,
+	0x63, 0x4a, 0x02, 0xd1, 0x9c, 0xe3, 0xcd, 0x65, 0xc6, 0xe0, 0x8d, 0x1f, 0x40, 0x35, 0xf4, 0x42,
+	0x63, 0xa4, 0x87, 0x3c, 0xd9, 0xc9, 0x0b, 0x69, 0xce, 0xe2, 0xa9, 0x0e, 0xfa, 0x08, 0x36, 0xc2,
+	0x61, 0xe0, 0x85, 0xe1, 0x88, 0x25, 0xda, 0x3c, 0xed, 0x13, 0x59, 0x5a, 0x01, 0xab, 0x71, 0x83,
+	0x48, 0x07, 0x29, 0x8b, 0x65, 0x93, 0xce, 0x6c, 0x21, 0xf3, 0x2d, 0xb5, 0x80, 0xd7, 0x62, 0x2e,
+	0x5b, 0xe8, 0xcc, 0xfc, 0xbe, 0x48, 0xa7, 0xf8, 0xce, 0xa9, 0xe0, 0x88, 0x44, 0x3a, 0xac, 0x3b,
+	0xc4, 0xa0, 0xe3, 0x80, 0x58, 0xfa, 0x4b, 0x9b, 0x8c, 0x2c, 0x71, 0x4d, 0x53, 0xcb, 0x7c, 0x56,
+	0x8a, 0xcc, 0xd2, 0x7c, 0xcc, 0xa5, 0x71, 0x2d, 0x82, 0x13, 0x34, 0xcb, 0xa3, 0xc4, 0x17, 0x5a,
+	0x87, 0x6a, 0xef, 0x79, 0xaf, 0xdf, 0x39, 0xd4, 0x0f, 0x8f, 0x76, 0x3b, 0xb2, 0xd0, 0xab, 0xd7,
+	0xc1, 0x82, 0x54, 0x58, 0x7b, 0xff, 0xa8, 0xdf, 0x3a, 0xd0, 0xfb, 0xfb, 0xed, 0xa7, 0x3d, 0x35,
+	0x87, 0xb6, 0x60, 0xa3, 0xbf, 0x87, 0x8f, 0xfa, 0xfd, 0x83, 0xce, 0xae, 0x7e, 0xdc, 0xc1, 0xfb,
+	0x47, 0xbb, 0x3d, 0x35, 0xcf, 0x6e, 0x95, 0x27, 0xec, 0xfe, 0xfe, 0x61, 0x47, 0x2d, 0xb0, 0xd2,
+	0x9e, 0xe3, 0x0e, 0x6e, 0x77, 0xba, 0x7d, 0xb5, 0xa8, 0xfd, 0x36, 0x0f, 0xd5, 0xc4, 0x2c, 0x32,
+	0xff, 0x0f, 0xa8, 0x38, 0x94, 0x15, 0x30, 0xfb, 0xe4, 0x0f, 0xd3, 0x86, 0x39, 0x14, 0xb3, 0x53,
+	0xc0, 0x82, 0xe0, 0x07, 0x31, 0xe3, 0x22, 0xb1, 0xeb, 0x15, 0x70, 0xd9, 0x31, 0x2e, 0x04, 0xc8,
+	0x8f, 0x61, 0xf5, 0x8c, 0x04, 0x2e, 0x19, 0xc9, 0x76, 0x31, 0x23, 0x55, 0xc1, 0x13, 0x5d, 0xb6,
+	0x41, 0x95, 0x5d, 0x26, 0x30, 0x62, 0x3a, 0x6a, 0x82, 0x7f, 0x18, 0x81, 0x6d, 0x42, 0x51, 0x34,
+	0xaf, 0x88, 0xf1, 0x39, 0xc1, 0x16, 0x0e, 0x7d, 0x6d, 0xf8, 0x3c, 0x01, 0x2e, 0x60, 0xfe, 0x8d,
+	0x4e, 0x67, 0xe7, 0xa7, 0xc4, 0xe7, 0xe7, 0xde, 0xe2, 0xee, 0xfc, 0xa6, 0x29, 0x1a, 0xc6, 0x53,
+	0xb4, 0x02, 0x79, 0x1c, 0x55, 0x47, 0xb5, 0x5b, 0xed, 0x3d, 0x36, 0x2d, 0x6b, 0x50, 0x39, 0x6c,
+	0xfd, 0x42, 0x3f, 0xe9, 0xf1, 0x3b, 0x7e, 0xa4, 0xc2, 0xea, 0xd3, 0x0e, 0xee, 0x76, 0x0e, 0x24,
+	0x27, 0x8f, 0x36, 0x41, 0x95, 0x9c, 0x49, 0xbf, 0x02, 0x43, 0x10, 0x9f, 0x45, 0x76, 0x27, 0xdc,
+	0x7b, 0xd6, 0x3a, 0x56, 0x4b, 0xda, 0x7f, 0xe7, 0x60, 0x5d, 0x04, 0xc9, 0xb8, 0x8e, 0xe3, 0xcd,
+	0xef, 0xd8, 0xc9, 0x3b, 0xaf, 0x5c, 0xfa, 0xce, 0x2b, 0x4a, 0xc9, 0x79, 0x8e, 0x93, 0x9f, 0xa4,
+	0xe4, 0xfc, 0xae, 0x2c, 0x15, 0xff, 0x0a, 0x8b, 0xc4, 0xbf, 0x3a, 0xac, 0x38, 0x84, 0xc6, 0xf3,
+	0x56, 0xc1, 0x11, 0x89, 0x6c, 0xa8, 0x1a, 0xae, 0xeb, 0x85, 0x86, 0xb8, 0x48, 0x2e, 0x2d, 0x94,
+	0x1a, 0x4c, 0xfd, 0xe3, 0x66, 0x6b, 0x82, 0x24, 0xc2, 0x47, 0x12, 0xbb, 0xf1, 0x73, 0x50, 0xa7,
+	0x3b, 0x2c, 0x92, 0x1c, 0xfc, 0xe4, 0xa7, 0x93, 0xdc, 0x80, 0xb0, 0x75, 0x21, 0x5f, 0x60, 0xd4,
+	0x6b, 0x8c, 0xc0, 0x27, 0xdd, 0xee, 0x7e, 0xf7, 0x89, 0xaa, 0xb0, 0x27, 0x9c, 0xce, 0x2f, 0xf6,
+	0x59, 0xc5, 0x65, 0x6e, 0xe7, 0xfb, 0x4d, 0x28, 0x09, 0x25, 0xd1, 0x77, 0x32, 0x2f, 0x4a, 0xd6,
+	0x08, 0xa3, 0x9f, 0x2f, 0x7c, 0xbe, 0x48, 0xd5, 0x1d, 0x37, 0x1e, 0x2d, 0x2d, 0x2f, 0x5f, 0x30,
+	0xaf, 0xa1, 0xbf, 0x55, 0x60, 0x35, 0xf5, 0x7a, 0x99, 0xf5, 0x22, 0x7d, 0x4e, 0x49, 0x72, 0xe3,
+	0xf3, 0xa5, 0x64, 0x63, 0x5d, 0x7e, 0xad, 0x40, 0x35, 0x51, 0x8c, 0x8b, 0xee, 0x2d, 0x53, 0xc0,
+	0x2b, 0x34, 0xb9, 0xbf, 0x7c, 0xed, 0xaf, 0x76, 0xed, 0x53, 0x05, 0xfd, 0x8d, 0x02, 0xd5, 0x44,
+	0x59, 0x6a, 0x66, 0x55, 0x66, 0x8b, 0x68, 0x1b, 0xf7, 0x97, 0x11, 0x8d, 0x6d, 0xf2, 0x57, 0x0a,
+	0x54, 0xe2, 0x12, 0x53, 0x74, 0x67, 0xf1, 0xa2, 0x54, 0xa1, 0xc4, 0xdd, 0x65, 0xab, 0x59, 0xb5,
+	0x6b, 0xe8, 0x2f, 0xa0, 0x1c, 0xd5, 0x63, 0xa2, 0xac, 0xd1, 0x6b, 0xaa, 0xd8, 0xb3, 0x71, 0x67,
+	0x61, 0xb9, 0xe4, 0xf0, 0x51, 0x91, 0x64, 0xe6, 0xe1, 0xa7, 0xca, 0x39, 0x1b, 0x77, 0x16, 0x96,
+	0x8b, 0x87, 0x67, 0x9e, 0x90, 0xa8, 0xa5, 0xcc, 0xec, 0x09, 0xb3, 0x45, 0x9c, 0x8d, 0xfb, 0xcb,
+	0x88, 0xa6, 0x14, 0x49, 0x54, 0x63, 0x66, 0x56, 0x64, 0xb6, 0xe2, 0xb3, 0x71, 0x7f, 0x19, 0xd1,
+	0x58, 0x91, 0x5f, 0x29, 0xc9, 0x53, 0xd2, 0x9d, 0x85, 0x8b, 0x0e, 0x17, 0x74, 0xc9, 0x99, 0xb2,
+	0x47, 0xbe, 0x40, 0x7f, 0x25, 0xef, 0x74, 0x44, 0xcd, 0x22, 0x5a, 0x04, 0x2c, 0x55, 0xe6, 0xd8,
+	0xf8, 0x6c, 0xb9, 0x60, 0xc3, 0x95, 0xf8, 0x6b, 0x05, 0x60, 0x52, 0xdd, 0x98, 0x59, 0x89, 0x99,
+	0xb2, 0xca, 0xc6, 0xbd, 0x25, 0x24, 0x93, 0x0b, 0x24, 0xaa, 0xbe, 0xca, 0xbc, 0x40, 0xa6, 0xaa,
+	0x2f, 0x1b, 0x77, 0x16, 0x96, 0x8b, 0x87, 0xff, 0x27, 0x05, 0x36, 0x66, 0xaa, 0xbf, 0xd0, 0xa3,
+	0x2b, 0x16, 0x00, 0x36, 0xbe, 0x58, 0x1e, 0x20, 0x52, 0x6d, 0x5b, 0xf9, 0x54, 0x41, 0xbf, 0x51,
+	0x60, 0x2d, 0x5d, 0x43, 0x92, 0x39, 0x4a, 0xcd, 0xa9, 0x23, 0x6b, 0x3c, 0x58, 0x4e, 0x38, 0xb6,
+	0xd6, 0xdf, 0x2b, 0x50, 0x93, 0xeb, 0x3b, 0xd2, 0xe7, 0xc1, 0x62, 0xdb, 0xc2, 0x94, 0x42, 0x0f,
+	0x97, 0x94, 0x4e, 0x69, 0x94, 0x2e, 0xb3, 0xca, 0xac, 0xd1, 0xdc, 0x7a, 0xae, 0xc6, 0xc3, 0x25,
+	0xa5, 0x53, 0x31, 0x2f, 0xae, 0xd8, 0xca, 0xbc, 0xc1, 0x4c, 0x17, 0x84, 0x35, 0xee, 0x2e, 0x2e,
+	0x18, 0xab, 0xc0, 0x56, 0xf6, 0xa4, 0xe2, 0x2b, 0xf3, 0xca, 0x9e, 0x29, 0x29, 0x6b, 0xdc, 0x5b,
+	0x42, 0x32, 0xd2, 0xe2, 0xcb, 0x95, 0x3f, 0x29, 0x8a, 0xc4, 0xba, 0xc4, 0x7f, 0x7e, 0xf6, 0xff,
+	0x03, 0x00, 0x3c, 0x2f, 0x05, 0x7a, 0x65, 0x37, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3986,6 +4295,16 @@ type DriverClient interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(ctx context.Context, in *DestroyNetworkRequest, opts ...grpc.CallOption) (*DestroyNetworkResponse, error)
+	// CheckpointTask dumps the state of a running task into its checkpoint
+	// directory and stops it. This rpc is only implemented if the driver
+	// sets the checkpoint capability.
+	CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error)
+	// PauseTask freezes all processes of a running task without stopping
+	// it. This rpc is only implemented if the driver sets the pause
+	// capability.
+	PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error)
+	// ResumeTask thaws the processes of a task paused by PauseTask.
+	ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error)
 }
 
 type driverClient struct {
@@ -4231,6 +4550,33 @@ func (c *driverClient) DestroyNetwork(ctx context.Context, in *DestroyNetworkReq
 	return out, nil
 }
 
+func (c *driverClient) CheckpointTask(ctx context.Context, in *CheckpointTaskRequest, opts ...grpc.CallOption) (*CheckpointTaskResponse, error) {
+	out := new(CheckpointTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*PauseTaskResponse, error) {
+	out := new(PauseTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/PauseTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*ResumeTaskResponse, error) {
+	out := new(ResumeTaskResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/ResumeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServer is the server API for Driver service.
 type DriverServer interface {
 	// TaskConfigSchema returns the schema for parsing the driver
@@ -4286,6 +4632,16 @@ type DriverServer interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(context.Context, *DestroyNetworkRequest) (*DestroyNetworkResponse, error)
+	// CheckpointTask dumps the state of a running task into its checkpoint
+	// directory and stops it. This rpc is only implemented if the driver
+	// sets the checkpoint capability.
+	CheckpointTask(context.Context, *CheckpointTaskRequest) (*CheckpointTaskResponse, error)
+	// PauseTask freezes all processes of a running task without stopping
+	// it. This rpc is only implemented if the driver sets the pause
+	// capability.
+	PauseTask(context.Context, *PauseTaskRequest) (*PauseTaskResponse, error)
+	// ResumeTask thaws the processes of a task paused by PauseTask.
+	ResumeTask(context.Context, *ResumeTaskRequest) (*ResumeTaskResponse, error)
 }
 
 // UnimplementedDriverServer can be embedded to have forward compatible implementations.
@@ -4340,6 +4696,15 @@ func (*UnimplementedDriverServer) CreateNetwork(ctx context.Context, req *Create
 func (*UnimplementedDriverServer) DestroyNetwork(ctx context.Context, req *DestroyNetworkRequest) (*DestroyNetworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyNetwork not implemented")
 }
+func (*UnimplementedDriverServer) CheckpointTask(ctx context.Context, req *CheckpointTaskRequest) (*CheckpointTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointTask not implemented")
+}
+func (*UnimplementedDriverServer) PauseTask(ctx context.Context, req *PauseTaskRequest) (*PauseTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTask not implemented")
+}
+func (*UnimplementedDriverServer) ResumeTask(ctx context.Context, req *ResumeTaskRequest) (*ResumeTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTask not implemented")
+}
 
 func RegisterDriverServer(s *grpc.Server, srv DriverServer) {
 	s.RegisterService(&_Driver_serviceDesc, srv)
@@ -4650,6 +5015,60 @@ func _Driver_DestroyNetwork_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_CheckpointTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).CheckpointTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/CheckpointTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).CheckpointTask(ctx, req.(*CheckpointTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).PauseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/PauseTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).PauseTask(ctx, req.(*PauseTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ResumeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/ResumeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Driver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.drivers.proto.Driver",
 	HandlerType: (*DriverServer)(nil),
@@ -4702,6 +5121,18 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyNetwork",
 			Handler:    _Driver_DestroyNetwork_Handler,
 		},
+		{
+			MethodName: "CheckpointTask",
+			Handler:    _Driver_CheckpointTask_Handler,
+		},
+		{
+			MethodName: "PauseTask",
+			Handler:    _Driver_PauseTask_Handler,
+		},
+		{
+			MethodName: "ResumeTask",
+			Handler:    _Driver_ResumeTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
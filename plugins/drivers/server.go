@@ -46,6 +46,8 @@ func (b *driverPluginServer) Capabilities(ctx context.Context, req *proto.Capabi
 			MustCreateNetwork:     caps.MustInitiateNetwork,
 			NetworkIsolationModes: []proto.NetworkIsolationSpec_NetworkIsolationMode{},
 			RemoteTasks:           caps.RemoteTasks,
+			Checkpoint:            caps.Checkpoint,
+			Pause:                 caps.Pause,
 		},
 	}
 
@@ -417,3 +419,42 @@ func (b *driverPluginServer) DestroyNetwork(ctx context.Context, req *proto.Dest
 
 	return &proto.DestroyNetworkResponse{}, nil
 }
+
+func (b *driverPluginServer) CheckpointTask(ctx context.Context, req *proto.CheckpointTaskRequest) (*proto.CheckpointTaskResponse, error) {
+	cd, ok := b.impl.(CheckpointDriver)
+	if !ok {
+		return nil, fmt.Errorf("CheckpointTask RPC not supported by driver")
+	}
+
+	if err := cd.CheckpointTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	return &proto.CheckpointTaskResponse{}, nil
+}
+
+func (b *driverPluginServer) PauseTask(ctx context.Context, req *proto.PauseTaskRequest) (*proto.PauseTaskResponse, error) {
+	pd, ok := b.impl.(PauseDriver)
+	if !ok {
+		return nil, fmt.Errorf("PauseTask RPC not supported by driver")
+	}
+
+	if err := pd.PauseTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	return &proto.PauseTaskResponse{}, nil
+}
+
+func (b *driverPluginServer) ResumeTask(ctx context.Context, req *proto.ResumeTaskRequest) (*proto.ResumeTaskResponse, error) {
+	pd, ok := b.impl.(PauseDriver)
+	if !ok {
+		return nil, fmt.Errorf("ResumeTask RPC not supported by driver")
+	}
+
+	if err := pd.ResumeTask(req.TaskId); err != nil {
+		return nil, err
+	}
+
+	return &proto.ResumeTaskResponse{}, nil
+}
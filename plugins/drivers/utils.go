@@ -426,10 +426,22 @@ func TaskStatsToProto(stats *TaskResourceUsage) (*proto.TaskStats, error) {
 		pids[pid] = resourceUsageToProto(ru)
 	}
 
+	var processes map[string]*proto.ProcessInfo
+	if len(stats.Processes) > 0 {
+		processes = make(map[string]*proto.ProcessInfo, len(stats.Processes))
+		for pid, p := range stats.Processes {
+			processes[pid] = &proto.ProcessInfo{
+				Ppid:    int32(p.PPID),
+				Cmdline: p.Cmdline,
+			}
+		}
+	}
+
 	return &proto.TaskStats{
 		Timestamp:          timestamp,
 		AggResourceUsage:   resourceUsageToProto(stats.ResourceUsage),
 		ResourceUsageByPid: pids,
+		Processes:          processes,
 	}, nil
 }
 
@@ -450,6 +462,16 @@ func TaskStatsFromProto(pb *proto.TaskStats) (*TaskResourceUsage, error) {
 		Pids:          pids,
 	}
 
+	if len(pb.Processes) > 0 {
+		stats.Processes = make(map[string]*ProcessInfo, len(pb.Processes))
+		for pid, p := range pb.Processes {
+			stats.Processes[pid] = &ProcessInfo{
+				PPID:    int(p.Ppid),
+				Cmdline: p.Cmdline,
+			}
+		}
+	}
+
 	return stats, nil
 }
 
@@ -168,7 +168,7 @@ func TestServiceSched_JobRegister_MemoryMaxHonored(t *testing.T) {
 			res.MemoryMaxMB = c.memoryMax
 
 			h := NewHarness(t)
-			h.State.SchedulerSetConfig(h.NextIndex(), &structs.SchedulerConfiguration{
+			h.State.SchedulerSetConfig(structs.MsgTypeTestSetup, h.NextIndex(), &structs.SchedulerConfiguration{
 				MemoryOversubscriptionEnabled: c.memoryOversubscriptionEnabled,
 			})
 
@@ -6271,7 +6271,7 @@ func TestServiceSched_CSIVolumesPerAlloc(t *testing.T) {
 	// once its been fixed
 	shared.AccessMode = structs.CSIVolumeAccessModeMultiNodeReader
 
-	require.NoError(h.State.UpsertCSIVolume(
+	require.NoError(h.State.UpsertCSIVolume(structs.MsgTypeTestSetup, 
 		h.NextIndex(), []*structs.CSIVolume{shared, vol0, vol1, vol2}))
 
 	// Create a job that uses both
@@ -6364,7 +6364,7 @@ func TestServiceSched_CSIVolumesPerAlloc(t *testing.T) {
 	vol4.ID = "volume-unique[3]"
 	vol5 := vol0.Copy()
 	vol5.ID = "volume-unique[4]"
-	require.NoError(h.State.UpsertCSIVolume(
+	require.NoError(h.State.UpsertCSIVolume(structs.MsgTypeTestSetup, 
 		h.NextIndex(), []*structs.CSIVolume{vol4, vol5}))
 
 	// Process again with failure fixed. It should create a new plan
@@ -6443,7 +6443,7 @@ func TestServiceSched_CSITopology(t *testing.T) {
 	vol1.PluginID = "test-plugin-zone-1"
 	vol1.RequestedTopologies.Required[0].Segments["zone"] = "zone-1"
 
-	require.NoError(t, h.State.UpsertCSIVolume(
+	require.NoError(t, h.State.UpsertCSIVolume(structs.MsgTypeTestSetup, 
 		h.NextIndex(), []*structs.CSIVolume{vol0, vol1}))
 
 	// Create a job that uses those volumes
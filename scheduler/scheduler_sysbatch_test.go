@@ -1454,7 +1454,7 @@ func TestSysBatch_Preemption(t *testing.T) {
 	}
 
 	// Enable Preemption
-	err := h.State.SchedulerSetConfig(h.NextIndex(), &structs.SchedulerConfiguration{
+	err := h.State.SchedulerSetConfig(structs.MsgTypeTestSetup, h.NextIndex(), &structs.SchedulerConfiguration{
 		PreemptionConfig: structs.PreemptionConfig{
 			SysBatchSchedulerEnabled: true,
 		},